@@ -0,0 +1,193 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package activities
+
+import (
+	"context"
+	"slices"
+
+	"gitea.dev/models/db"
+	"gitea.dev/modules/optional"
+	"gitea.dev/modules/secret"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"xorm.io/builder"
+)
+
+// ErrNotificationChannelNotExist represents a "notification channel does not exist" error
+var ErrNotificationChannelNotExist = util.NewNotExistErrorf("notification channel does not exist")
+
+// NotificationChannelType is the kind of endpoint a personal notification channel delivers to
+type NotificationChannelType string
+
+const (
+	// NotificationChannelWebhook posts a JSON payload to an arbitrary URL
+	NotificationChannelWebhook NotificationChannelType = "webhook"
+	// NotificationChannelNtfy publishes a message to an ntfy topic URL
+	NotificationChannelNtfy NotificationChannelType = "ntfy"
+	// NotificationChannelMatrix sends a message to a Matrix room
+	NotificationChannelMatrix NotificationChannelType = "matrix"
+)
+
+// NotificationChannelTypes returns all supported personal notification channel types
+func NotificationChannelTypes() []NotificationChannelType {
+	return []NotificationChannelType{NotificationChannelWebhook, NotificationChannelNtfy, NotificationChannelMatrix}
+}
+
+// IsValid reports whether the channel type is supported
+func (t NotificationChannelType) IsValid() bool {
+	return slices.Contains(NotificationChannelTypes(), t)
+}
+
+// NotificationReason explains why a user received a notification
+type NotificationReason string
+
+const (
+	// NotificationReasonSubscribed is used when the user watches the repository or the issue
+	NotificationReasonSubscribed NotificationReason = "subscribed"
+	// NotificationReasonMention is used when the user was mentioned
+	NotificationReasonMention NotificationReason = "mention"
+	// NotificationReasonReviewRequested is used when a review was requested from the user
+	NotificationReasonReviewRequested NotificationReason = "review_requested"
+	// NotificationReasonAssigned is used when the user was assigned to an issue or a pull request
+	NotificationReasonAssigned NotificationReason = "assigned"
+	// NotificationReasonCIFailed is used when a workflow run triggered by the user failed
+	NotificationReasonCIFailed NotificationReason = "ci_failed"
+)
+
+// NotificationReasons returns all reasons a personal notification channel can be filtered by
+func NotificationReasons() []NotificationReason {
+	return []NotificationReason{
+		NotificationReasonSubscribed,
+		NotificationReasonMention,
+		NotificationReasonReviewRequested,
+		NotificationReasonAssigned,
+		NotificationReasonCIFailed,
+	}
+}
+
+// IsValid reports whether the reason is known
+func (r NotificationReason) IsValid() bool {
+	return slices.Contains(NotificationReasons(), r)
+}
+
+// NotificationChannel is a personal endpoint the notifications of a user are pushed to
+type NotificationChannel struct {
+	ID     int64                   `xorm:"pk autoincr"`
+	UserID int64                   `xorm:"INDEX NOT NULL"`
+	Name   string                  `xorm:"NOT NULL"`
+	Type   NotificationChannelType `xorm:"VARCHAR(16) NOT NULL"`
+	// URL is the webhook URL, the ntfy topic URL or the Matrix homeserver URL
+	URL string `xorm:"TEXT NOT NULL"`
+	// RoomID is the Matrix room the messages are sent to
+	RoomID string
+	// TokenEncrypted should be accessed using Token() and SetToken()
+	TokenEncrypted string `xorm:"TEXT"`
+	// Reasons limits the delivered notifications, an empty list delivers all of them
+	Reasons     []NotificationReason `xorm:"TEXT JSON"`
+	IsActive    bool                 `xorm:"NOT NULL DEFAULT true"`
+	CreatedUnix timeutil.TimeStamp   `xorm:"created NOT NULL"`
+	UpdatedUnix timeutil.TimeStamp   `xorm:"updated NOT NULL"`
+}
+
+func init() {
+	db.RegisterModel(new(NotificationChannel))
+}
+
+// Token returns the decrypted access token of the channel
+func (c *NotificationChannel) Token() (string, error) {
+	if c.TokenEncrypted == "" {
+		return "", nil
+	}
+	return secret.DecryptSecret(setting.SecretKey, c.TokenEncrypted)
+}
+
+// SetToken encrypts and stores the access token of the channel
+func (c *NotificationChannel) SetToken(cleartext string) (err error) {
+	if cleartext == "" {
+		c.TokenEncrypted = ""
+		return nil
+	}
+	c.TokenEncrypted, err = secret.EncryptSecret(setting.SecretKey, cleartext)
+	return err
+}
+
+// MatchReason reports whether notifications of the given reason should be delivered to the channel
+func (c *NotificationChannel) MatchReason(reason NotificationReason) bool {
+	return len(c.Reasons) == 0 || slices.Contains(c.Reasons, reason)
+}
+
+// FindNotificationChannelsOptions represents the options to find personal notification channels
+type FindNotificationChannelsOptions struct {
+	db.ListOptions
+	UserID   int64
+	IsActive optional.Option[bool]
+}
+
+func (opts FindNotificationChannelsOptions) ToConds() builder.Cond {
+	cond := builder.NewCond()
+	if opts.UserID != 0 {
+		cond = cond.And(builder.Eq{"user_id": opts.UserID})
+	}
+	if opts.IsActive.Has() {
+		cond = cond.And(builder.Eq{"is_active": opts.IsActive.Value()})
+	}
+	return cond
+}
+
+func (opts FindNotificationChannelsOptions) ToOrders() string {
+	return "id ASC"
+}
+
+// CreateNotificationChannel inserts a personal notification channel
+func CreateNotificationChannel(ctx context.Context, c *NotificationChannel) error {
+	return db.Insert(ctx, c)
+}
+
+// GetNotificationChannelByID returns the personal notification channel of the user
+func GetNotificationChannelByID(ctx context.Context, userID, id int64) (*NotificationChannel, error) {
+	c := &NotificationChannel{}
+	has, err := db.GetEngine(ctx).Where(builder.Eq{"id": id, "user_id": userID}).Get(c)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, ErrNotificationChannelNotExist
+	}
+	return c, nil
+}
+
+// UpdateNotificationChannel updates all user-editable columns of the channel
+func UpdateNotificationChannel(ctx context.Context, c *NotificationChannel) error {
+	_, err := db.GetEngine(ctx).ID(c.ID).Cols("name", "url", "room_id", "token_encrypted", "reasons", "is_active").Update(c)
+	return err
+}
+
+// DeleteNotificationChannel deletes the personal notification channel of the user
+func DeleteNotificationChannel(ctx context.Context, userID, id int64) error {
+	n, err := db.GetEngine(ctx).Where(builder.Eq{"id": id, "user_id": userID}).Delete(&NotificationChannel{})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotificationChannelNotExist
+	}
+	return nil
+}
+
+// GetNotificationChannelsForReason returns the active channels of the user which accept the given reason
+func GetNotificationChannelsForReason(ctx context.Context, userID int64, reason NotificationReason) ([]*NotificationChannel, error) {
+	channels, err := db.Find[NotificationChannel](ctx, FindNotificationChannelsOptions{
+		UserID:   userID,
+		IsActive: optional.Some(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(channels, func(c *NotificationChannel) bool {
+		return !c.MatchReason(reason)
+	}), nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package activities_test
+
+import (
+	"testing"
+
+	activities_model "gitea.dev/models/activities"
+	"gitea.dev/models/unittest"
+	"gitea.dev/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationChannels(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	ctx := t.Context()
+
+	all := &activities_model.NotificationChannel{UserID: 2, Name: "all", Type: activities_model.NotificationChannelWebhook, URL: "https://example.com/hook", IsActive: true}
+	require.NoError(t, all.SetToken("secret-token"))
+	require.NoError(t, activities_model.CreateNotificationChannel(ctx, all))
+
+	mentions := &activities_model.NotificationChannel{
+		UserID:   2,
+		Name:     "mentions",
+		Type:     activities_model.NotificationChannelNtfy,
+		URL:      "https://ntfy.example.com/topic",
+		Reasons:  []activities_model.NotificationReason{activities_model.NotificationReasonMention},
+		IsActive: true,
+	}
+	require.NoError(t, activities_model.CreateNotificationChannel(ctx, mentions))
+
+	inactive := &activities_model.NotificationChannel{UserID: 2, Name: "inactive", Type: activities_model.NotificationChannelWebhook, URL: "https://example.com/other", IsActive: false}
+	require.NoError(t, activities_model.CreateNotificationChannel(ctx, inactive))
+
+	loaded, err := activities_model.GetNotificationChannelByID(ctx, 2, all.ID)
+	require.NoError(t, err)
+	token, err := loaded.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "secret-token", token)
+	assert.NotEqual(t, "secret-token", loaded.TokenEncrypted)
+
+	_, err = activities_model.GetNotificationChannelByID(ctx, 3, all.ID)
+	assert.ErrorIs(t, err, util.ErrNotExist)
+
+	channels, err := activities_model.GetNotificationChannelsForReason(ctx, 2, activities_model.NotificationReasonMention)
+	require.NoError(t, err)
+	if assert.Len(t, channels, 2) {
+		assert.Equal(t, all.ID, channels[0].ID)
+		assert.Equal(t, mentions.ID, channels[1].ID)
+	}
+
+	channels, err = activities_model.GetNotificationChannelsForReason(ctx, 2, activities_model.NotificationReasonAssigned)
+	require.NoError(t, err)
+	if assert.Len(t, channels, 1) {
+		assert.Equal(t, all.ID, channels[0].ID)
+	}
+
+	assert.ErrorIs(t, activities_model.DeleteNotificationChannel(ctx, 3, all.ID), util.ErrNotExist)
+	require.NoError(t, activities_model.DeleteNotificationChannel(ctx, 2, all.ID))
+	unittest.AssertNotExistsBean(t, &activities_model.NotificationChannel{ID: all.ID})
+}
+
+func TestCreateOrUpdateIssueNotificationsWithReceivers(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	receiverIDs, err := activities_model.CreateOrUpdateIssueNotificationsWithReceivers(t.Context(), 1, 0, 2, 0)
+	require.NoError(t, err)
+	// User 9 is inactive, thus user 1 and 4 are notified
+	assert.Contains(t, receiverIDs, int64(1))
+	assert.Contains(t, receiverIDs, int64(4))
+	assert.NotContains(t, receiverIDs, int64(9))
+
+	receiverIDs, err = activities_model.CreateOrUpdateIssueNotificationsWithReceivers(t.Context(), 1, 0, 2, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4}, receiverIDs)
+}
@@ -70,24 +70,33 @@ func (opts FindNotificationOptions) ToOrders() string {
 // for each watcher, or updates it if already exists
 // receiverID > 0 just send to receiver, else send to all watcher
 func CreateOrUpdateIssueNotifications(ctx context.Context, issueID, commentID, notificationAuthorID, receiverID int64) error {
-	return db.WithTx(ctx, func(ctx context.Context) error {
-		return createOrUpdateIssueNotifications(ctx, issueID, commentID, notificationAuthorID, receiverID)
+	_, err := CreateOrUpdateIssueNotificationsWithReceivers(ctx, issueID, commentID, notificationAuthorID, receiverID)
+	return err
+}
+
+// CreateOrUpdateIssueNotificationsWithReceivers is like CreateOrUpdateIssueNotifications
+// but also returns the IDs of the users whose notification has been created or updated
+func CreateOrUpdateIssueNotificationsWithReceivers(ctx context.Context, issueID, commentID, notificationAuthorID, receiverID int64) (receiverIDs []int64, err error) {
+	err = db.WithTx(ctx, func(ctx context.Context) error {
+		receiverIDs, err = createOrUpdateIssueNotifications(ctx, issueID, commentID, notificationAuthorID, receiverID)
+		return err
 	})
+	return receiverIDs, err
 }
 
-func createOrUpdateIssueNotifications(ctx context.Context, issueID, commentID, notificationAuthorID, receiverID int64) error {
+func createOrUpdateIssueNotifications(ctx context.Context, issueID, commentID, notificationAuthorID, receiverID int64) ([]int64, error) {
 	// init
 	var toNotify container.Set[int64]
 	notifications, err := db.Find[Notification](ctx, FindNotificationOptions{
 		IssueID: issueID,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	issue, err := issues_model.GetIssueByID(ctx, issueID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if receiverID > 0 {
@@ -97,19 +106,19 @@ func createOrUpdateIssueNotifications(ctx context.Context, issueID, commentID, n
 		toNotify = make(container.Set[int64], 32)
 		issueWatches, err := issues_model.GetIssueWatchersIDs(ctx, issueID, true)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		toNotify.AddMultiple(issueWatches...)
 		if !(issue.IsPull && issues_model.HasWorkInProgressPrefix(issue.Title)) {
 			repoWatches, err := repo_model.GetRepoWatchersIDs(ctx, issue.RepoID)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			toNotify.AddMultiple(repoWatches...)
 		}
 		issueParticipants, err := issue.GetParticipantIDsByIssue(ctx)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		toNotify.AddMultiple(issueParticipants...)
 
@@ -118,7 +127,7 @@ func createOrUpdateIssueNotifications(ctx context.Context, issueID, commentID, n
 		// explicit unwatch on issue
 		issueUnWatches, err := issues_model.GetIssueWatchersIDs(ctx, issueID, false)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		for _, id := range issueUnWatches {
 			toNotify.Remove(id)
@@ -127,10 +136,11 @@ func createOrUpdateIssueNotifications(ctx context.Context, issueID, commentID, n
 
 	err = issue.LoadRepo(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// notify
+	receiverIDs := make([]int64, 0, len(toNotify))
 	for userID := range toNotify {
 		issue.Repo.Units = nil
 		user, err := user_model.GetUserByID(ctx, userID)
@@ -139,7 +149,7 @@ func createOrUpdateIssueNotifications(ctx context.Context, issueID, commentID, n
 				continue
 			}
 
-			return err
+			return nil, err
 		}
 		if issue.IsPull && !access_model.CheckRepoUnitUser(ctx, issue.Repo, user, unit.TypePullRequests) {
 			continue
@@ -150,15 +160,17 @@ func createOrUpdateIssueNotifications(ctx context.Context, issueID, commentID, n
 
 		if notificationExists(notifications, issue.ID, userID) {
 			if err = updateIssueNotification(ctx, userID, issue.ID, commentID, notificationAuthorID); err != nil {
-				return err
+				return nil, err
 			}
+			receiverIDs = append(receiverIDs, userID)
 			continue
 		}
 		if err = createIssueNotification(ctx, userID, issue, commentID, notificationAuthorID); err != nil {
-			return err
+			return nil, err
 		}
+		receiverIDs = append(receiverIDs, userID)
 	}
-	return nil
+	return receiverIDs, nil
 }
 
 // NotificationList contains a list of notifications
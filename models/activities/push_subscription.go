@@ -0,0 +1,77 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package activities
+
+import (
+	"context"
+
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"xorm.io/builder"
+)
+
+// ErrPushSubscriptionNotExist represents a "push subscription does not exist" error
+var ErrPushSubscriptionNotExist = util.NewNotExistErrorf("push subscription does not exist")
+
+// PushSubscription is the Web Push subscription of a browser of the user
+type PushSubscription struct {
+	ID       int64  `xorm:"pk autoincr"`
+	UserID   int64  `xorm:"INDEX NOT NULL"`
+	Endpoint string `xorm:"TEXT NOT NULL"`
+	// P256DH is the base64url encoded public key of the browser
+	P256DH string `xorm:"'p256dh' VARCHAR(255) NOT NULL"`
+	// Auth is the base64url encoded authentication secret of the browser
+	Auth string `xorm:"VARCHAR(255) NOT NULL"`
+	// UserAgent helps the user to recognize the device of the subscription
+	UserAgent   string             `xorm:"VARCHAR(255)"`
+	CreatedUnix timeutil.TimeStamp `xorm:"created NOT NULL"`
+	UpdatedUnix timeutil.TimeStamp `xorm:"updated NOT NULL"`
+}
+
+func init() {
+	db.RegisterModel(new(PushSubscription))
+}
+
+// CreateOrUpdatePushSubscription stores the subscription, the keys of an already known endpoint of the user are replaced
+func CreateOrUpdatePushSubscription(ctx context.Context, s *PushSubscription) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		existing := &PushSubscription{}
+		has, err := db.GetEngine(ctx).Where(builder.Eq{"user_id": s.UserID, "endpoint": s.Endpoint}).Get(existing)
+		if err != nil {
+			return err
+		}
+		if !has {
+			return db.Insert(ctx, s)
+		}
+		s.ID = existing.ID
+		_, err = db.GetEngine(ctx).ID(s.ID).Cols("p256dh", "auth", "user_agent").Update(s)
+		return err
+	})
+}
+
+// GetPushSubscriptionsByUserID returns all push subscriptions of the user
+func GetPushSubscriptionsByUserID(ctx context.Context, userID int64) ([]*PushSubscription, error) {
+	subs := make([]*PushSubscription, 0, 2)
+	return subs, db.GetEngine(ctx).Where(builder.Eq{"user_id": userID}).OrderBy("id ASC").Find(&subs)
+}
+
+// DeletePushSubscription deletes the push subscription of the user
+func DeletePushSubscription(ctx context.Context, userID, id int64) error {
+	n, err := db.GetEngine(ctx).Where(builder.Eq{"id": id, "user_id": userID}).Delete(&PushSubscription{})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrPushSubscriptionNotExist
+	}
+	return nil
+}
+
+// DeletePushSubscriptionByEndpoint deletes the push subscription of the user for the endpoint
+func DeletePushSubscriptionByEndpoint(ctx context.Context, userID int64, endpoint string) error {
+	_, err := db.GetEngine(ctx).Where(builder.Eq{"user_id": userID, "endpoint": endpoint}).Delete(&PushSubscription{})
+	return err
+}
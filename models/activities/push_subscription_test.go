@@ -0,0 +1,42 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package activities_test
+
+import (
+	"testing"
+
+	activities_model "gitea.dev/models/activities"
+	"gitea.dev/models/unittest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushSubscriptions(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	ctx := t.Context()
+
+	sub := &activities_model.PushSubscription{UserID: 2, Endpoint: "https://push.example.com/a", P256DH: "key1", Auth: "auth1"}
+	require.NoError(t, activities_model.CreateOrUpdatePushSubscription(ctx, sub))
+	require.NoError(t, activities_model.CreateOrUpdatePushSubscription(ctx, &activities_model.PushSubscription{UserID: 2, Endpoint: "https://push.example.com/b", P256DH: "key2", Auth: "auth2"}))
+
+	// subscribing the same endpoint again replaces the keys
+	renewed := &activities_model.PushSubscription{UserID: 2, Endpoint: "https://push.example.com/a", P256DH: "key3", Auth: "auth3"}
+	require.NoError(t, activities_model.CreateOrUpdatePushSubscription(ctx, renewed))
+	assert.Equal(t, sub.ID, renewed.ID)
+
+	subs, err := activities_model.GetPushSubscriptionsByUserID(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, subs, 2)
+	assert.Equal(t, "key3", subs[0].P256DH)
+	assert.Equal(t, "auth3", subs[0].Auth)
+
+	require.NoError(t, activities_model.DeletePushSubscriptionByEndpoint(ctx, 2, "https://push.example.com/b"))
+	assert.ErrorIs(t, activities_model.DeletePushSubscription(ctx, 1, sub.ID), activities_model.ErrPushSubscriptionNotExist)
+	require.NoError(t, activities_model.DeletePushSubscription(ctx, 2, sub.ID))
+
+	subs, err = activities_model.GetPushSubscriptionsByUserID(ctx, 2)
+	require.NoError(t, err)
+	assert.Empty(t, subs)
+}
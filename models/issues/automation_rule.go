@@ -0,0 +1,224 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"xorm.io/builder"
+)
+
+// AutomationTrigger represents the event which runs an automation rule
+type AutomationTrigger string
+
+const (
+	AutomationTriggerIssueOpened   AutomationTrigger = "issue_opened"
+	AutomationTriggerIssueClosed   AutomationTrigger = "issue_closed"
+	AutomationTriggerIssueReopened AutomationTrigger = "issue_reopened"
+	// AutomationTriggerPullRequestOpened runs the rule for the issues which are closed by the new pull request
+	AutomationTriggerPullRequestOpened AutomationTrigger = "pull_request_opened"
+	AutomationTriggerLabelAdded        AutomationTrigger = "label_added"
+	AutomationTriggerLabelRemoved      AutomationTrigger = "label_removed"
+	// AutomationTriggerInactive runs the rule periodically for the open issues which haven't been updated for some days
+	AutomationTriggerInactive AutomationTrigger = "inactive"
+)
+
+// AutomationTriggers contains all the supported automation triggers
+var AutomationTriggers = []AutomationTrigger{
+	AutomationTriggerIssueOpened,
+	AutomationTriggerIssueClosed,
+	AutomationTriggerIssueReopened,
+	AutomationTriggerPullRequestOpened,
+	AutomationTriggerLabelAdded,
+	AutomationTriggerLabelRemoved,
+	AutomationTriggerInactive,
+}
+
+// IsValid checks if the automation trigger is supported
+func (t AutomationTrigger) IsValid() bool {
+	return slices.Contains(AutomationTriggers, t)
+}
+
+// AutomationAction represents the change an automation rule makes to an issue
+type AutomationAction string
+
+const (
+	AutomationActionMoveToColumn AutomationAction = "move_to_column"
+	AutomationActionClose        AutomationAction = "close"
+	AutomationActionReopen       AutomationAction = "reopen"
+	AutomationActionAddLabel     AutomationAction = "add_label"
+	AutomationActionRemoveLabel  AutomationAction = "remove_label"
+	AutomationActionAssign       AutomationAction = "assign"
+)
+
+// AutomationActions contains all the supported automation actions
+var AutomationActions = []AutomationAction{
+	AutomationActionMoveToColumn,
+	AutomationActionClose,
+	AutomationActionReopen,
+	AutomationActionAddLabel,
+	AutomationActionRemoveLabel,
+	AutomationActionAssign,
+}
+
+// IsValid checks if the automation action is supported
+func (a AutomationAction) IsValid() bool {
+	return slices.Contains(AutomationActions, a)
+}
+
+// ErrAutomationRuleNotExist represents a "AutomationRuleNotExist" kind of error.
+type ErrAutomationRuleNotExist struct {
+	ID int64
+}
+
+// IsErrAutomationRuleNotExist checks if an error is a ErrAutomationRuleNotExist.
+func IsErrAutomationRuleNotExist(err error) bool {
+	_, ok := err.(ErrAutomationRuleNotExist)
+	return ok
+}
+
+func (err ErrAutomationRuleNotExist) Error() string {
+	return fmt.Sprintf("automation rule does not exist [id: %d]", err.ID)
+}
+
+func (err ErrAutomationRuleNotExist) Unwrap() error {
+	return util.ErrNotExist
+}
+
+// AutomationRule represents a declarative rule of a repository which changes its issues and pull requests
+// when an event happens, the rules of a project only apply to the items of the project.
+// The rules are run by their creator, the changes they make don't run other rules.
+type AutomationRule struct {
+	ID        int64  `xorm:"pk autoincr"`
+	RepoID    int64  `xorm:"INDEX NOT NULL"`
+	ProjectID int64  `xorm:"INDEX NOT NULL DEFAULT 0"`
+	Name      string `xorm:"NOT NULL"`
+	// TriggerEvent is the event which runs the rule, "trigger" is a reserved word of some databases
+	TriggerEvent AutomationTrigger `xorm:"VARCHAR(32) INDEX NOT NULL"`
+	// ConditionLabelID is the label which is added or removed for the label triggers,
+	// the issue has to have the label for the other triggers, any label or issue if zero
+	ConditionLabelID int64 `xorm:"NOT NULL DEFAULT 0"`
+	// InactiveDays is the number of days without update of the inactive trigger
+	InactiveDays int              `xorm:"NOT NULL DEFAULT 0"`
+	Action       AutomationAction `xorm:"VARCHAR(32) NOT NULL"`
+	// ColumnID is the project column of the move_to_column action
+	ColumnID int64 `xorm:"NOT NULL DEFAULT 0"`
+	// LabelID is the label of the add_label and remove_label actions
+	LabelID int64 `xorm:"NOT NULL DEFAULT 0"`
+	// AssigneeID is the user of the assign action
+	AssigneeID int64 `xorm:"NOT NULL DEFAULT 0"`
+	IsActive   bool  `xorm:"INDEX NOT NULL DEFAULT true"`
+	CreatorID  int64 `xorm:"NOT NULL"`
+
+	CreatedUnix timeutil.TimeStamp `xorm:"INDEX created"`
+	UpdatedUnix timeutil.TimeStamp `xorm:"INDEX updated"`
+}
+
+// AutomationRuleLog represents a run of an automation rule for an issue
+type AutomationRuleLog struct {
+	ID      int64  `xorm:"pk autoincr"`
+	RuleID  int64  `xorm:"INDEX NOT NULL"`
+	RepoID  int64  `xorm:"INDEX NOT NULL"`
+	IssueID int64  `xorm:"NOT NULL"`
+	Success bool   `xorm:"NOT NULL DEFAULT false"`
+	Message string `xorm:"TEXT"`
+
+	CreatedUnix timeutil.TimeStamp `xorm:"INDEX created"`
+}
+
+func init() {
+	db.RegisterModel(new(AutomationRule))
+	db.RegisterModel(new(AutomationRuleLog))
+}
+
+// FindAutomationRulesOptions represents the options to find the automation rules of a repository
+type FindAutomationRulesOptions struct {
+	db.ListOptions
+	RepoID       int64
+	TriggerEvent AutomationTrigger
+	IsActive     bool
+}
+
+func (opts FindAutomationRulesOptions) ToConds() builder.Cond {
+	cond := builder.NewCond()
+	if opts.RepoID > 0 {
+		cond = cond.And(builder.Eq{"repo_id": opts.RepoID})
+	}
+	if opts.TriggerEvent != "" {
+		cond = cond.And(builder.Eq{"trigger_event": opts.TriggerEvent})
+	}
+	if opts.IsActive {
+		cond = cond.And(builder.Eq{"is_active": true})
+	}
+	return cond
+}
+
+func (opts FindAutomationRulesOptions) ToOrders() string {
+	return "id ASC"
+}
+
+// GetAutomationRuleByRepoID returns an automation rule of a repository
+func GetAutomationRuleByRepoID(ctx context.Context, repoID, id int64) (*AutomationRule, error) {
+	rule := new(AutomationRule)
+	has, err := db.GetEngine(ctx).Where("id = ? AND repo_id = ?", id, repoID).Get(rule)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrAutomationRuleNotExist{ID: id}
+	}
+	return rule, nil
+}
+
+// CreateAutomationRule inserts a new automation rule
+func CreateAutomationRule(ctx context.Context, rule *AutomationRule) error {
+	return db.Insert(ctx, rule)
+}
+
+// UpdateAutomationRule updates all the columns of an automation rule
+func UpdateAutomationRule(ctx context.Context, rule *AutomationRule) error {
+	_, err := db.GetEngine(ctx).ID(rule.ID).AllCols().Update(rule)
+	return err
+}
+
+// DeleteAutomationRule deletes an automation rule and its logs
+func DeleteAutomationRule(ctx context.Context, rule *AutomationRule) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.DeleteByID[AutomationRule](ctx, rule.ID); err != nil {
+			return err
+		}
+		_, err := db.DeleteByBean(ctx, &AutomationRuleLog{RuleID: rule.ID})
+		return err
+	})
+}
+
+// FindAutomationRuleLogsOptions represents the options to find the logs of an automation rule
+type FindAutomationRuleLogsOptions struct {
+	db.ListOptions
+	RuleID int64
+}
+
+func (opts FindAutomationRuleLogsOptions) ToConds() builder.Cond {
+	return builder.Eq{"rule_id": opts.RuleID}
+}
+
+func (opts FindAutomationRuleLogsOptions) ToOrders() string {
+	return "id DESC"
+}
+
+// CreateAutomationRuleLog inserts a log of a run of an automation rule
+func CreateAutomationRuleLog(ctx context.Context, log *AutomationRuleLog) error {
+	return db.Insert(ctx, log)
+}
+
+// DeleteAutomationRuleLogsOlderThan deletes the logs of the automation rules which are older than the time
+func DeleteAutomationRuleLogsOlderThan(ctx context.Context, olderThan timeutil.TimeStamp) error {
+	_, err := db.GetEngine(ctx).Where("created_unix < ?", olderThan).Delete(new(AutomationRuleLog))
+	return err
+}
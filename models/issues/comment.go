@@ -119,6 +119,8 @@ const (
 	CommentTypeUnpin // 37 unpin Issue/PullRequest
 
 	CommentTypeChangeTimeEstimate // 38 Change time estimate
+
+	CommentTypeChangeIssueType // 39 Change issue type
 )
 
 var commentStrings = []string{
@@ -161,6 +163,7 @@ var commentStrings = []string{
 	"pin",
 	"unpin",
 	"change_time_estimate",
+	"change_issue_type",
 }
 
 func (t CommentType) String() string {
@@ -247,6 +250,10 @@ type CommentMetaData struct {
 	ProjectColumnTitle string `json:"project_column_title,omitempty"`
 	ProjectTitle       string `json:"project_title,omitempty"`
 
+	// the names of the issue types are kept because the types may be deleted later
+	OldIssueTypeName string `json:"old_issue_type_name,omitempty"`
+	IssueTypeName    string `json:"issue_type_name,omitempty"`
+
 	SpecialDoerName SpecialDoerNameType `json:"special_doer_name,omitempty"` // e.g. "CODEOWNERS" for CODEOWNERS-triggered review requests
 }
 
@@ -836,6 +843,12 @@ func CreateComment(ctx context.Context, opts *CreateCommentOptions) (_ *Comment,
 				SpecialDoerName: opts.SpecialDoerName,
 			}
 		}
+		if opts.OldIssueTypeName != "" || opts.IssueTypeName != "" {
+			commentMetaData = &CommentMetaData{
+				OldIssueTypeName: opts.OldIssueTypeName,
+				IssueTypeName:    opts.IssueTypeName,
+			}
+		}
 
 		comment := &Comment{
 			Type:             opts.Type,
@@ -1033,6 +1046,8 @@ type CreateCommentOptions struct {
 	IsForcePush        bool
 	Invalidated        bool
 	SpecialDoerName    SpecialDoerNameType // e.g. "CODEOWNERS" for CODEOWNERS-triggered review requests
+	OldIssueTypeName   string
+	IssueTypeName      string
 }
 
 // GetCommentByID returns the comment by given ID.
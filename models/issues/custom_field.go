@@ -0,0 +1,452 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"xorm.io/builder"
+)
+
+// CustomFieldType represents the type of the values of a custom field
+type CustomFieldType string
+
+const (
+	CustomFieldTypeSingleSelect CustomFieldType = "single_select"
+	CustomFieldTypeMultiSelect  CustomFieldType = "multi_select"
+	CustomFieldTypeNumber       CustomFieldType = "number"
+	CustomFieldTypeDate         CustomFieldType = "date"
+	CustomFieldTypeText         CustomFieldType = "text"
+	CustomFieldTypeUser         CustomFieldType = "user"
+)
+
+// CustomFieldTypes contains all the supported custom field types
+var CustomFieldTypes = []CustomFieldType{
+	CustomFieldTypeSingleSelect,
+	CustomFieldTypeMultiSelect,
+	CustomFieldTypeNumber,
+	CustomFieldTypeDate,
+	CustomFieldTypeText,
+	CustomFieldTypeUser,
+}
+
+// IsValid checks if the custom field type is supported
+func (t CustomFieldType) IsValid() bool {
+	return slices.Contains(CustomFieldTypes, t)
+}
+
+// HasOptions returns whether the values of the custom field type are selected from a list of options
+func (t CustomFieldType) HasOptions() bool {
+	return t == CustomFieldTypeSingleSelect || t == CustomFieldTypeMultiSelect
+}
+
+// CustomFieldDateFormat is the format of the values of date custom fields
+const CustomFieldDateFormat = "2006-01-02"
+
+// customFieldTextMaxLength is the maximum length of the value of a text custom field
+const customFieldTextMaxLength = 1024
+
+// ErrCustomFieldNotExist represents a "CustomFieldNotExist" kind of error.
+type ErrCustomFieldNotExist struct {
+	ID int64
+}
+
+// IsErrCustomFieldNotExist checks if an error is a ErrCustomFieldNotExist.
+func IsErrCustomFieldNotExist(err error) bool {
+	_, ok := err.(ErrCustomFieldNotExist)
+	return ok
+}
+
+func (err ErrCustomFieldNotExist) Error() string {
+	return fmt.Sprintf("custom field does not exist [id: %d]", err.ID)
+}
+
+func (err ErrCustomFieldNotExist) Unwrap() error {
+	return util.ErrNotExist
+}
+
+// ErrCustomFieldAlreadyExist represents a "CustomFieldAlreadyExist" kind of error.
+type ErrCustomFieldAlreadyExist struct {
+	Name string
+}
+
+// IsErrCustomFieldAlreadyExist checks if an error is a ErrCustomFieldAlreadyExist.
+func IsErrCustomFieldAlreadyExist(err error) bool {
+	_, ok := err.(ErrCustomFieldAlreadyExist)
+	return ok
+}
+
+func (err ErrCustomFieldAlreadyExist) Error() string {
+	return fmt.Sprintf("custom field already exists [name: %s]", err.Name)
+}
+
+func (err ErrCustomFieldAlreadyExist) Unwrap() error {
+	return util.ErrAlreadyExist
+}
+
+// CustomField represents a custom field definition of an organization or a repository,
+// the fields of an organization are available to the issues and pull requests of all its repositories
+type CustomField struct {
+	ID          int64           `xorm:"pk autoincr"`
+	OrgID       int64           `xorm:"INDEX NOT NULL DEFAULT 0"`
+	RepoID      int64           `xorm:"INDEX NOT NULL DEFAULT 0"`
+	Name        string          `xorm:"NOT NULL"`
+	Description string          `xorm:"TEXT"`
+	Type        CustomFieldType `xorm:"VARCHAR(20) NOT NULL"`
+	Options     []string        `xorm:"TEXT JSON"`
+	Sort        int             `xorm:"NOT NULL DEFAULT 0"`
+
+	CreatedUnix timeutil.TimeStamp `xorm:"INDEX created"`
+	UpdatedUnix timeutil.TimeStamp `xorm:"INDEX updated"`
+}
+
+// CustomFieldValue represents a value of a custom field of an issue, multi select fields have one row per selected option
+type CustomFieldValue struct {
+	ID      int64  `xorm:"pk autoincr"`
+	IssueID int64  `xorm:"INDEX NOT NULL"`
+	FieldID int64  `xorm:"INDEX NOT NULL"`
+	Value   string `xorm:"VARCHAR(1024) NOT NULL"`
+}
+
+func init() {
+	db.RegisterModel(new(CustomField))
+	db.RegisterModel(new(CustomFieldValue))
+}
+
+// BelongsToOrg returns true if the custom field is defined by an organization
+func (f *CustomField) BelongsToOrg() bool {
+	return f.OrgID > 0
+}
+
+// BelongsToRepo returns true if the custom field is defined by a repository
+func (f *CustomField) BelongsToRepo() bool {
+	return f.RepoID > 0
+}
+
+// NormalizeValues validates the values against the type and the options of the custom field and returns them in their canonical form,
+// values of user fields are the IDs of the users.
+func (f *CustomField) NormalizeValues(values []string) ([]string, error) {
+	normalized := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+		switch f.Type {
+		case CustomFieldTypeSingleSelect, CustomFieldTypeMultiSelect:
+			idx := slices.IndexFunc(f.Options, func(option string) bool { return strings.EqualFold(option, value) })
+			if idx == -1 {
+				return nil, util.NewInvalidArgumentErrorf("%q is not an option of the custom field %q", value, f.Name)
+			}
+			value = f.Options[idx]
+		case CustomFieldTypeNumber:
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, util.NewInvalidArgumentErrorf("%q is not a number", value)
+			}
+			value = strconv.FormatFloat(number, 'f', -1, 64)
+		case CustomFieldTypeDate:
+			date, err := time.Parse(CustomFieldDateFormat, value)
+			if err != nil {
+				return nil, util.NewInvalidArgumentErrorf("%q is not a date in the format YYYY-MM-DD", value)
+			}
+			value = date.Format(CustomFieldDateFormat)
+		case CustomFieldTypeText:
+			if len(value) > customFieldTextMaxLength {
+				return nil, util.NewInvalidArgumentErrorf("the value of the custom field %q is too long", f.Name)
+			}
+		case CustomFieldTypeUser:
+			if userID, err := strconv.ParseInt(value, 10, 64); err != nil || userID <= 0 {
+				return nil, util.NewInvalidArgumentErrorf("%q is not a user ID", value)
+			}
+		default:
+			return nil, util.NewInvalidArgumentErrorf("unsupported custom field type %q", f.Type)
+		}
+		if !slices.Contains(normalized, value) {
+			normalized = append(normalized, value)
+		}
+	}
+	if f.Type != CustomFieldTypeMultiSelect && len(normalized) > 1 {
+		return nil, util.NewInvalidArgumentErrorf("the custom field %q only accepts a single value", f.Name)
+	}
+	return normalized, nil
+}
+
+// Validate checks the definition of the custom field
+func (f *CustomField) Validate() error {
+	f.Name = strings.TrimSpace(f.Name)
+	if f.Name == "" || len(f.Name) > 50 {
+		return util.NewInvalidArgumentErrorf("custom field name must be between 1 and 50 characters")
+	}
+	if strings.ContainsAny(f.Name, "=\"") {
+		return util.NewInvalidArgumentErrorf("custom field name must not contain '=' or '\"'")
+	}
+	if !f.Type.IsValid() {
+		return util.NewInvalidArgumentErrorf("unsupported custom field type %q", f.Type)
+	}
+	if !f.Type.HasOptions() {
+		f.Options = nil
+		return nil
+	}
+
+	options := make([]string, 0, len(f.Options))
+	for _, option := range f.Options {
+		if option = strings.TrimSpace(option); option == "" {
+			continue
+		}
+		if slices.ContainsFunc(options, func(o string) bool { return strings.EqualFold(o, option) }) {
+			return util.NewInvalidArgumentErrorf("duplicate option %q", option)
+		}
+		options = append(options, option)
+	}
+	if len(options) == 0 {
+		return util.NewInvalidArgumentErrorf("select custom fields must have at least one option")
+	}
+	f.Options = options
+	return nil
+}
+
+func customFieldScopeCond(orgID, repoID int64) builder.Cond {
+	return builder.Eq{"org_id": orgID, "repo_id": repoID}
+}
+
+func checkCustomFieldNameUnique(ctx context.Context, f *CustomField) error {
+	has, err := db.GetEngine(ctx).Where(customFieldScopeCond(f.OrgID, f.RepoID)).
+		And("id <> ?", f.ID).
+		And("LOWER(name) = ?", strings.ToLower(f.Name)).
+		Exist(new(CustomField))
+	if err != nil {
+		return err
+	}
+	if has {
+		return ErrCustomFieldAlreadyExist{f.Name}
+	}
+	return nil
+}
+
+// NewCustomField creates a custom field of an organization or a repository
+func NewCustomField(ctx context.Context, f *CustomField) error {
+	if (f.OrgID == 0) == (f.RepoID == 0) {
+		return util.NewInvalidArgumentErrorf("custom field must belong to either an organization or a repository")
+	}
+	if err := f.Validate(); err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if err := checkCustomFieldNameUnique(ctx, f); err != nil {
+			return err
+		}
+		return db.Insert(ctx, f)
+	})
+}
+
+// UpdateCustomField updates the name, description, options and sort of a custom field,
+// values of options which have been removed are deleted
+func UpdateCustomField(ctx context.Context, f *CustomField) error {
+	if err := f.Validate(); err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if err := checkCustomFieldNameUnique(ctx, f); err != nil {
+			return err
+		}
+		if _, err := db.GetEngine(ctx).ID(f.ID).Cols("name", "description", "options", "sort").Update(f); err != nil {
+			return err
+		}
+		if !f.Type.HasOptions() {
+			return nil
+		}
+		_, err := db.GetEngine(ctx).Where("field_id = ?", f.ID).
+			And(builder.NotIn("value", f.Options)).
+			Delete(new(CustomFieldValue))
+		return err
+	})
+}
+
+// DeleteCustomField deletes a custom field and all its values
+func DeleteCustomField(ctx context.Context, f *CustomField) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.GetEngine(ctx).Where("field_id = ?", f.ID).Delete(new(CustomFieldValue)); err != nil {
+			return err
+		}
+		_, err := db.DeleteByID[CustomField](ctx, f.ID)
+		return err
+	})
+}
+
+// DeleteCustomFieldsByScope deletes all the custom fields of an organization or a repository, and their values
+func DeleteCustomFieldsByScope(ctx context.Context, orgID, repoID int64) error {
+	fieldIDs := builder.Select("id").From("custom_field").Where(customFieldScopeCond(orgID, repoID))
+	if _, err := db.GetEngine(ctx).Where(builder.In("field_id", fieldIDs)).Delete(new(CustomFieldValue)); err != nil {
+		return err
+	}
+	_, err := db.GetEngine(ctx).Where(customFieldScopeCond(orgID, repoID)).Delete(new(CustomField))
+	return err
+}
+
+// TransferCustomFieldValues is used when a repository is transferred: the values of the issues of the repository for the custom fields
+// of the old owner are moved to the custom fields of the new owner with the same name and type, the other values are deleted
+func TransferCustomFieldValues(ctx context.Context, repoID, oldOwnerID, newOwnerID int64) error {
+	oldFields, err := GetCustomFieldsByOrgID(ctx, oldOwnerID)
+	if err != nil || len(oldFields) == 0 {
+		return err
+	}
+	newFields, err := GetCustomFieldsByOrgID(ctx, newOwnerID)
+	if err != nil {
+		return err
+	}
+
+	repoIssueIDs := builder.Select("id").From("issue").Where(builder.Eq{"repo_id": repoID})
+	for _, oldField := range oldFields {
+		cond := builder.Eq{"field_id": oldField.ID}.And(builder.In("issue_id", repoIssueIDs))
+		rows := make([]*CustomFieldValue, 0, 10)
+		if err := db.GetEngine(ctx).Where(cond).OrderBy("id").Find(&rows); err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		if _, err := db.GetEngine(ctx).Where(cond).Delete(new(CustomFieldValue)); err != nil {
+			return err
+		}
+
+		idx := slices.IndexFunc(newFields, func(f *CustomField) bool {
+			return f.Type == oldField.Type && strings.EqualFold(f.Name, oldField.Name)
+		})
+		if idx == -1 {
+			continue
+		}
+		newField := newFields[idx]
+
+		values := make(map[int64][]string)
+		for _, row := range rows {
+			values[row.IssueID] = append(values[row.IssueID], row.Value)
+		}
+		for issueID, issueValues := range values {
+			// e.g. an option which doesn't exist in the field of the new owner
+			normalized, err := newField.NormalizeValues(issueValues)
+			if err != nil {
+				continue
+			}
+			if err := SetIssueCustomFieldValues(ctx, issueID, newField.ID, normalized); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GetCustomFieldByID returns a custom field by its ID
+func GetCustomFieldByID(ctx context.Context, id int64) (*CustomField, error) {
+	f, exist, err := db.GetByID[CustomField](ctx, id)
+	if err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, ErrCustomFieldNotExist{id}
+	}
+	return f, nil
+}
+
+// GetCustomFieldsByOrgID returns the custom fields defined by an organization
+func GetCustomFieldsByOrgID(ctx context.Context, orgID int64) ([]*CustomField, error) {
+	fields := make([]*CustomField, 0, 5)
+	return fields, db.GetEngine(ctx).Where(customFieldScopeCond(orgID, 0)).OrderBy("sort, name").Find(&fields)
+}
+
+// GetCustomFieldsByRepoID returns the custom fields defined by a repository, without the fields of its owner
+func GetCustomFieldsByRepoID(ctx context.Context, repoID int64) ([]*CustomField, error) {
+	fields := make([]*CustomField, 0, 5)
+	return fields, db.GetEngine(ctx).Where(customFieldScopeCond(0, repoID)).OrderBy("sort, name").Find(&fields)
+}
+
+// GetAvailableCustomFields returns the custom fields available to the issues of a repository,
+// the fields of the owner organization come first
+func GetAvailableCustomFields(ctx context.Context, ownerID, repoID int64) ([]*CustomField, error) {
+	fields := make([]*CustomField, 0, 5)
+	return fields, db.GetEngine(ctx).
+		Where(builder.Or(customFieldScopeCond(ownerID, 0), customFieldScopeCond(0, repoID))).
+		OrderBy("repo_id, sort, name").
+		Find(&fields)
+}
+
+// CustomFieldFilter matches the issues which have the value for any of the custom fields,
+// several fields are needed when organization and repository fields share the same name
+type CustomFieldFilter struct {
+	FieldIDs []int64
+	Value    string
+}
+
+// GetCustomFieldsInReposByName returns the custom fields with the given name which are available to the issues of the repositories,
+// the name is compared case-insensitively
+func GetCustomFieldsInReposByName(ctx context.Context, repoIDs []int64, name string) ([]*CustomField, error) {
+	cond := db.BuildCaseInsensitiveIn("name", []string{name})
+	if len(repoIDs) > 0 {
+		cond = cond.And(builder.Or(
+			builder.In("repo_id", repoIDs),
+			builder.In("org_id", builder.Select("owner_id").From("repository").Where(builder.In("id", repoIDs))),
+		))
+	}
+	fields := make([]*CustomField, 0, 2)
+	return fields, db.GetEngine(ctx).Where(cond).Find(&fields)
+}
+
+// CustomFieldValues maps the IDs of custom fields to their values
+type CustomFieldValues map[int64][]string
+
+// GetIssueCustomFieldValues returns the custom field values of an issue
+func GetIssueCustomFieldValues(ctx context.Context, issueID int64) (CustomFieldValues, error) {
+	rows := make([]*CustomFieldValue, 0, 5)
+	if err := db.GetEngine(ctx).Where("issue_id = ?", issueID).OrderBy("id").Find(&rows); err != nil {
+		return nil, err
+	}
+	values := make(CustomFieldValues, len(rows))
+	for _, row := range rows {
+		values[row.FieldID] = append(values[row.FieldID], row.Value)
+	}
+	return values, nil
+}
+
+// GetCustomFieldValuesByIssueIDs returns the values of a custom field for the given issues
+func GetCustomFieldValuesByIssueIDs(ctx context.Context, fieldID int64, issueIDs []int64) (map[int64][]string, error) {
+	rows := make([]*CustomFieldValue, 0, len(issueIDs))
+	if err := db.GetEngine(ctx).Where("field_id = ?", fieldID).In("issue_id", issueIDs).OrderBy("id").Find(&rows); err != nil {
+		return nil, err
+	}
+	values := make(map[int64][]string, len(rows))
+	for _, row := range rows {
+		values[row.IssueID] = append(values[row.IssueID], row.Value)
+	}
+	return values, nil
+}
+
+// GetIssueIDsByCustomFieldID returns the IDs of the issues which have a value for the custom field
+func GetIssueIDsByCustomFieldID(ctx context.Context, fieldID int64) ([]int64, error) {
+	issueIDs := make([]int64, 0, 10)
+	return issueIDs, db.GetEngine(ctx).Table("custom_field_value").Where("field_id = ?", fieldID).Distinct("issue_id").Find(&issueIDs)
+}
+
+// SetIssueCustomFieldValues replaces the values of a custom field of an issue, the values must have been normalized
+func SetIssueCustomFieldValues(ctx context.Context, issueID, fieldID int64, values []string) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.GetEngine(ctx).Where("issue_id = ? AND field_id = ?", issueID, fieldID).Delete(new(CustomFieldValue)); err != nil {
+			return err
+		}
+		rows := make([]*CustomFieldValue, 0, len(values))
+		for _, value := range values {
+			rows = append(rows, &CustomFieldValue{IssueID: issueID, FieldID: fieldID, Value: value})
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return db.Insert(ctx, rows)
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues_test
+
+import (
+	"testing"
+
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	"gitea.dev/modules/optional"
+	"gitea.dev/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomFieldNormalizeValues(t *testing.T) {
+	cases := []struct {
+		field    *issues_model.CustomField
+		values   []string
+		expected []string
+	}{
+		{&issues_model.CustomField{Type: issues_model.CustomFieldTypeSingleSelect, Options: []string{"High", "Low"}}, []string{" high "}, []string{"High"}},
+		{&issues_model.CustomField{Type: issues_model.CustomFieldTypeSingleSelect, Options: []string{"High", "Low"}}, []string{"Medium"}, nil},
+		{&issues_model.CustomField{Type: issues_model.CustomFieldTypeSingleSelect, Options: []string{"High", "Low"}}, []string{"High", "Low"}, nil},
+		{&issues_model.CustomField{Type: issues_model.CustomFieldTypeMultiSelect, Options: []string{"A", "B"}}, []string{"b", "A", "B", ""}, []string{"B", "A"}},
+		{&issues_model.CustomField{Type: issues_model.CustomFieldTypeNumber}, []string{"1.50"}, []string{"1.5"}},
+		{&issues_model.CustomField{Type: issues_model.CustomFieldTypeNumber}, []string{"one"}, nil},
+		{&issues_model.CustomField{Type: issues_model.CustomFieldTypeDate}, []string{"2026-01-31"}, []string{"2026-01-31"}},
+		{&issues_model.CustomField{Type: issues_model.CustomFieldTypeDate}, []string{"31/01/2026"}, nil},
+		{&issues_model.CustomField{Type: issues_model.CustomFieldTypeText}, []string{" some text "}, []string{"some text"}},
+		{&issues_model.CustomField{Type: issues_model.CustomFieldTypeUser}, []string{"2"}, []string{"2"}},
+		{&issues_model.CustomField{Type: issues_model.CustomFieldTypeUser}, []string{"user2"}, nil},
+		{&issues_model.CustomField{Type: issues_model.CustomFieldTypeText}, nil, []string{}},
+	}
+	for _, c := range cases {
+		values, err := c.field.NormalizeValues(c.values)
+		if c.expected == nil {
+			assert.ErrorIs(t, err, util.ErrInvalidArgument, "values: %v", c.values)
+			continue
+		}
+		require.NoError(t, err, "values: %v", c.values)
+		assert.Equal(t, c.expected, values)
+	}
+}
+
+func TestCustomFieldValidate(t *testing.T) {
+	field := &issues_model.CustomField{Name: " Priority ", Type: issues_model.CustomFieldTypeSingleSelect, Options: []string{"High", " Low ", ""}}
+	require.NoError(t, field.Validate())
+	assert.Equal(t, "Priority", field.Name)
+	assert.Equal(t, []string{"High", "Low"}, field.Options)
+
+	field = &issues_model.CustomField{Name: "Estimate", Type: issues_model.CustomFieldTypeNumber, Options: []string{"1"}}
+	require.NoError(t, field.Validate())
+	assert.Nil(t, field.Options)
+
+	for _, field := range []*issues_model.CustomField{
+		{Name: "", Type: issues_model.CustomFieldTypeText},
+		{Name: "a=b", Type: issues_model.CustomFieldTypeText},
+		{Name: "Name", Type: "color"},
+		{Name: "Name", Type: issues_model.CustomFieldTypeMultiSelect},
+		{Name: "Name", Type: issues_model.CustomFieldTypeSingleSelect, Options: []string{"A", "a"}},
+	} {
+		assert.ErrorIs(t, field.Validate(), util.ErrInvalidArgument, "field: %v", field)
+	}
+}
+
+func TestCustomFields(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	orgField := &issues_model.CustomField{OrgID: 3, Name: "Priority", Type: issues_model.CustomFieldTypeSingleSelect, Options: []string{"High", "Low"}}
+	require.NoError(t, issues_model.NewCustomField(t.Context(), orgField))
+	repoField := &issues_model.CustomField{RepoID: 1, Name: "Priority", Type: issues_model.CustomFieldTypeSingleSelect, Options: []string{"High", "Medium", "Low"}}
+	require.NoError(t, issues_model.NewCustomField(t.Context(), repoField))
+	estimateField := &issues_model.CustomField{RepoID: 1, Name: "Estimate", Type: issues_model.CustomFieldTypeNumber}
+	require.NoError(t, issues_model.NewCustomField(t.Context(), estimateField))
+
+	// the names are unique in a scope, and a field belongs to exactly one scope
+	assert.True(t, issues_model.IsErrCustomFieldAlreadyExist(issues_model.NewCustomField(t.Context(), &issues_model.CustomField{RepoID: 1, Name: "priority", Type: issues_model.CustomFieldTypeText})))
+	assert.ErrorIs(t, issues_model.NewCustomField(t.Context(), &issues_model.CustomField{Name: "Size", Type: issues_model.CustomFieldTypeText}), util.ErrInvalidArgument)
+	assert.ErrorIs(t, issues_model.NewCustomField(t.Context(), &issues_model.CustomField{OrgID: 3, RepoID: 1, Name: "Size", Type: issues_model.CustomFieldTypeText}), util.ErrInvalidArgument)
+
+	fields, err := issues_model.GetAvailableCustomFields(t.Context(), 2, 1)
+	require.NoError(t, err)
+	assert.Len(t, fields, 2)
+	fields, err = issues_model.GetAvailableCustomFields(t.Context(), 3, 3)
+	require.NoError(t, err)
+	if assert.Len(t, fields, 1) {
+		assert.Equal(t, orgField.ID, fields[0].ID)
+	}
+	fields, err = issues_model.GetCustomFieldsInReposByName(t.Context(), []int64{1, 3}, "PRIORITY")
+	require.NoError(t, err)
+	assert.Len(t, fields, 2)
+
+	require.NoError(t, issues_model.SetIssueCustomFieldValues(t.Context(), 1, repoField.ID, []string{"Medium"}))
+	require.NoError(t, issues_model.SetIssueCustomFieldValues(t.Context(), 5, repoField.ID, []string{"Low"}))
+	require.NoError(t, issues_model.SetIssueCustomFieldValues(t.Context(), 1, estimateField.ID, []string{"3"}))
+	values, err := issues_model.GetIssueCustomFieldValues(t.Context(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, issues_model.CustomFieldValues{repoField.ID: {"Medium"}, estimateField.ID: {"3"}}, values)
+
+	ids, _, err := issues_model.IssueIDs(t.Context(), &issues_model.IssuesOptions{
+		RepoIDs:      []int64{1},
+		IsPull:       optional.Some(false),
+		CustomFields: []issues_model.CustomFieldFilter{{FieldIDs: []int64{repoField.ID, orgField.ID}, Value: "Low"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{5}, ids)
+
+	// the values of removed options are deleted
+	repoField.Options = []string{"High", "Low"}
+	require.NoError(t, issues_model.UpdateCustomField(t.Context(), repoField))
+	grouped, err := issues_model.GetCustomFieldValuesByIssueIDs(t.Context(), repoField.ID, []int64{1, 5})
+	require.NoError(t, err)
+	assert.Equal(t, map[int64][]string{5: {"Low"}}, grouped)
+
+	require.NoError(t, issues_model.DeleteCustomField(t.Context(), estimateField))
+	_, err = issues_model.GetCustomFieldByID(t.Context(), estimateField.ID)
+	assert.True(t, issues_model.IsErrCustomFieldNotExist(err))
+	unittest.AssertNotExistsBean(t, &issues_model.CustomFieldValue{FieldID: estimateField.ID})
+
+	require.NoError(t, issues_model.DeleteCustomFieldsByScope(t.Context(), 0, 1))
+	unittest.AssertNotExistsBean(t, &issues_model.CustomFieldValue{FieldID: repoField.ID})
+	unittest.AssertExistsAndLoadBean(t, &issues_model.CustomField{ID: orgField.ID})
+	unittest.AssertCount(t, &issues_model.CustomField{RepoID: 1}, 0)
+}
+
+func TestTransferCustomFieldValues(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	// issue6 and issue12 are in repo3 of org3
+	oldPriority := &issues_model.CustomField{OrgID: 3, Name: "Priority", Type: issues_model.CustomFieldTypeSingleSelect, Options: []string{"High", "Low"}}
+	oldEstimate := &issues_model.CustomField{OrgID: 3, Name: "Estimate", Type: issues_model.CustomFieldTypeNumber}
+	newPriority := &issues_model.CustomField{OrgID: 6, Name: "priority", Type: issues_model.CustomFieldTypeSingleSelect, Options: []string{"high", "medium"}}
+	newEstimate := &issues_model.CustomField{OrgID: 6, Name: "Estimate", Type: issues_model.CustomFieldTypeText}
+	for _, f := range []*issues_model.CustomField{oldPriority, oldEstimate, newPriority, newEstimate} {
+		require.NoError(t, issues_model.NewCustomField(t.Context(), f))
+	}
+	require.NoError(t, issues_model.SetIssueCustomFieldValues(t.Context(), 6, oldPriority.ID, []string{"High"}))
+	require.NoError(t, issues_model.SetIssueCustomFieldValues(t.Context(), 12, oldPriority.ID, []string{"Low"}))
+	require.NoError(t, issues_model.SetIssueCustomFieldValues(t.Context(), 6, oldEstimate.ID, []string{"3"}))
+
+	require.NoError(t, issues_model.TransferCustomFieldValues(t.Context(), 3, 3, 6))
+
+	// the values are mapped to the field with the same name and type if they are valid for it
+	values, err := issues_model.GetIssueCustomFieldValues(t.Context(), 6)
+	require.NoError(t, err)
+	assert.Equal(t, issues_model.CustomFieldValues{newPriority.ID: {"high"}}, values)
+	values, err = issues_model.GetIssueCustomFieldValues(t.Context(), 12)
+	require.NoError(t, err)
+	assert.Empty(t, values)
+	unittest.AssertNotExistsBean(t, &issues_model.CustomFieldValue{FieldID: oldPriority.ID})
+	unittest.AssertNotExistsBean(t, &issues_model.CustomFieldValue{FieldID: oldEstimate.ID})
+}
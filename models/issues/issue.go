@@ -71,6 +71,8 @@ type Issue struct {
 	isMilestoneLoaded bool                     `xorm:"-"`
 	Projects          []*project_model.Project `xorm:"-"`
 	isProjectsLoaded  bool                     `xorm:"-"`
+	TypeID            int64                    `xorm:"INDEX NOT NULL DEFAULT 0"`
+	Type              *IssueType               `xorm:"-"`
 	Priority          int
 	AssigneeID        int64            `xorm:"-"`
 	Assignee          *user_model.User `xorm:"-"`
@@ -306,6 +308,10 @@ func (issue *Issue) LoadAttributes(ctx context.Context) (err error) {
 		return err
 	}
 
+	if err = issue.LoadType(ctx); err != nil {
+		return err
+	}
+
 	if err = issue.LoadProjects(ctx); err != nil {
 		return err
 	}
@@ -532,6 +532,10 @@ func (issues IssueList) LoadAttributes(ctx context.Context) error {
 		return fmt.Errorf("issue.loadAttributes: LoadMilestones: %w", err)
 	}
 
+	if err := issues.LoadTypes(ctx); err != nil {
+		return fmt.Errorf("issue.loadAttributes: LoadTypes: %w", err)
+	}
+
 	if err := issues.LoadProjects(ctx); err != nil {
 		return fmt.Errorf("issue.loadAttributes: loadProjects: %w", err)
 	}
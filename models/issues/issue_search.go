@@ -35,7 +35,10 @@ type IssuesOptions struct { //nolint:revive // export stutter
 	ReviewRequestedID  int64
 	ReviewedID         int64
 	SubscriberID       int64
+	ParentID           int64 // db.NoConditionID means the issues without parent
+	CustomFields       []CustomFieldFilter
 	MilestoneIDs       []int64
+	TypeIDs            []int64 // db.NoConditionID means the issues without type
 	ProjectIDs         []int64
 	IsClosed           optional.Option[bool]
 	IsPull             optional.Option[bool]
@@ -196,6 +199,14 @@ func applyMilestoneCondition(sess db.Session, opts *IssuesOptions) {
 	}
 }
 
+func applyTypeCondition(sess db.Session, typeIDs []int64) {
+	if len(typeIDs) == 1 && typeIDs[0] == db.NoConditionID {
+		sess.And("issue.type_id = 0")
+	} else if len(typeIDs) > 0 {
+		sess.In("issue.type_id", typeIDs)
+	}
+}
+
 func applyProjectCondition(sess db.Session, opts *IssuesOptions) {
 	projectIDs := util.SliceRemoveAll(opts.ProjectIDs, 0)
 	if len(projectIDs) == 1 && projectIDs[0] == db.NoConditionID { // show those that are in no project
@@ -257,8 +268,14 @@ func applyConditions(sess db.Session, opts *IssuesOptions) {
 		applySubscribedCondition(sess, opts.SubscriberID)
 	}
 
+	applyParentCondition(sess, opts.ParentID)
+
+	applyCustomFieldsCondition(sess, opts.CustomFields)
+
 	applyMilestoneCondition(sess, opts)
 
+	applyTypeCondition(sess, opts.TypeIDs)
+
 	if opts.UpdatedAfterUnix != 0 {
 		sess.And(builder.Gte{"issue.updated_unix": opts.UpdatedAfterUnix})
 	}
@@ -444,6 +461,21 @@ func applyReviewedCondition(sess db.Session, reviewedID int64) {
 	sess.And(notPoster, builder.Or(reviewed, commented))
 }
 
+func applyParentCondition(sess db.Session, parentID int64) {
+	if parentID > 0 {
+		sess.In("issue.id", builder.Select("issue_id").From("sub_issue").Where(builder.Eq{"parent_id": parentID}))
+	} else if parentID == db.NoConditionID {
+		sess.NotIn("issue.id", builder.Select("issue_id").From("sub_issue"))
+	}
+}
+
+func applyCustomFieldsCondition(sess db.Session, filters []CustomFieldFilter) {
+	for _, filter := range filters {
+		sess.In("issue.id", builder.Select("issue_id").From("custom_field_value").
+			Where(builder.In("field_id", filter.FieldIDs).And(builder.Eq{"value": filter.Value})))
+	}
+}
+
 func applySubscribedCondition(sess db.Session, subscriberID int64) {
 	sess.And(
 		builder.
@@ -0,0 +1,294 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gitea.dev/models/db"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/container"
+	"gitea.dev/modules/label"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"xorm.io/builder"
+)
+
+// IssueTypeDefaultIcon is the icon of issue types which don't have one
+const IssueTypeDefaultIcon = "octicon-issue-opened"
+
+// IssueTypeIcons are the icons which can be used by issue types
+var IssueTypeIcons = []string{
+	"octicon-issue-opened",
+	"octicon-bug",
+	"octicon-rocket",
+	"octicon-light-bulb",
+	"octicon-tasklist",
+	"octicon-checklist",
+	"octicon-zap",
+	"octicon-flame",
+	"octicon-shield",
+	"octicon-tools",
+	"octicon-beaker",
+	"octicon-book",
+	"octicon-package",
+	"octicon-question",
+	"octicon-star",
+}
+
+// ErrIssueTypeNotExist represents a "IssueTypeNotExist" kind of error.
+type ErrIssueTypeNotExist struct {
+	ID   int64
+	Name string
+}
+
+// IsErrIssueTypeNotExist checks if an error is a ErrIssueTypeNotExist.
+func IsErrIssueTypeNotExist(err error) bool {
+	_, ok := err.(ErrIssueTypeNotExist)
+	return ok
+}
+
+func (err ErrIssueTypeNotExist) Error() string {
+	return fmt.Sprintf("issue type does not exist [id: %d, name: %s]", err.ID, err.Name)
+}
+
+func (err ErrIssueTypeNotExist) Unwrap() error {
+	return util.ErrNotExist
+}
+
+// ErrIssueTypeAlreadyExist represents a "IssueTypeAlreadyExist" kind of error.
+type ErrIssueTypeAlreadyExist struct {
+	Name string
+}
+
+// IsErrIssueTypeAlreadyExist checks if an error is a ErrIssueTypeAlreadyExist.
+func IsErrIssueTypeAlreadyExist(err error) bool {
+	_, ok := err.(ErrIssueTypeAlreadyExist)
+	return ok
+}
+
+func (err ErrIssueTypeAlreadyExist) Error() string {
+	return fmt.Sprintf("issue type already exists [name: %s]", err.Name)
+}
+
+func (err ErrIssueTypeAlreadyExist) Unwrap() error {
+	return util.ErrAlreadyExist
+}
+
+// IssueType represents a type of issues like bug, feature or task, defined by an organization.
+// Unlike labels, an issue has at most one type.
+type IssueType struct {
+	ID          int64  `xorm:"pk autoincr"`
+	OrgID       int64  `xorm:"INDEX NOT NULL"`
+	Name        string `xorm:"NOT NULL"`
+	Description string `xorm:"TEXT"`
+	Color       string `xorm:"VARCHAR(7)"`
+	Icon        string `xorm:"VARCHAR(50)"`
+	Sort        int    `xorm:"NOT NULL DEFAULT 0"`
+
+	CreatedUnix timeutil.TimeStamp `xorm:"INDEX created"`
+	UpdatedUnix timeutil.TimeStamp `xorm:"INDEX updated"`
+}
+
+func init() {
+	db.RegisterModel(new(IssueType))
+}
+
+// Validate checks and normalizes the definition of the issue type
+func (t *IssueType) Validate() error {
+	t.Name = strings.TrimSpace(t.Name)
+	if t.Name == "" || len(t.Name) > 50 {
+		return util.NewInvalidArgumentErrorf("issue type name must be between 1 and 50 characters")
+	}
+	if strings.Contains(t.Name, `"`) {
+		return util.NewInvalidArgumentErrorf("issue type name must not contain '\"'")
+	}
+	if t.Color != "" {
+		color, err := label.NormalizeColor(t.Color)
+		if err != nil {
+			return err
+		}
+		t.Color = color
+	}
+	if t.Icon == "" {
+		t.Icon = IssueTypeDefaultIcon
+	} else if !slices.Contains(IssueTypeIcons, t.Icon) {
+		return util.NewInvalidArgumentErrorf("unsupported issue type icon %q", t.Icon)
+	}
+	return nil
+}
+
+func checkIssueTypeNameUnique(ctx context.Context, t *IssueType) error {
+	has, err := db.GetEngine(ctx).Where("org_id = ? AND id <> ?", t.OrgID, t.ID).
+		And("LOWER(name) = ?", strings.ToLower(t.Name)).
+		Exist(new(IssueType))
+	if err != nil {
+		return err
+	}
+	if has {
+		return ErrIssueTypeAlreadyExist{t.Name}
+	}
+	return nil
+}
+
+// NewIssueType creates an issue type of an organization
+func NewIssueType(ctx context.Context, t *IssueType) error {
+	if t.OrgID <= 0 {
+		return util.NewInvalidArgumentErrorf("issue type must belong to an organization")
+	}
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if err := checkIssueTypeNameUnique(ctx, t); err != nil {
+			return err
+		}
+		return db.Insert(ctx, t)
+	})
+}
+
+// UpdateIssueType updates the name, description, color, icon and sort of an issue type
+func UpdateIssueType(ctx context.Context, t *IssueType) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if err := checkIssueTypeNameUnique(ctx, t); err != nil {
+			return err
+		}
+		_, err := db.GetEngine(ctx).ID(t.ID).Cols("name", "description", "color", "icon", "sort").Update(t)
+		return err
+	})
+}
+
+// DeleteIssueType deletes an issue type, the issues of this type don't have a type anymore
+func DeleteIssueType(ctx context.Context, t *IssueType) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.GetEngine(ctx).Where("type_id = ?", t.ID).Cols("type_id").NoAutoTime().Update(&Issue{TypeID: 0}); err != nil {
+			return err
+		}
+		_, err := db.DeleteByID[IssueType](ctx, t.ID)
+		return err
+	})
+}
+
+// DeleteIssueTypesByOrgID deletes all the issue types of an organization
+func DeleteIssueTypesByOrgID(ctx context.Context, orgID int64) error {
+	_, err := db.GetEngine(ctx).Where("org_id = ?", orgID).Delete(new(IssueType))
+	return err
+}
+
+// GetIssueTypeByID returns an issue type by its ID
+func GetIssueTypeByID(ctx context.Context, id int64) (*IssueType, error) {
+	t, exist, err := db.GetByID[IssueType](ctx, id)
+	if err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, ErrIssueTypeNotExist{ID: id}
+	}
+	return t, nil
+}
+
+// GetIssueTypeByOrgIDAndName returns the issue type of an organization with the given name, case-insensitively
+func GetIssueTypeByOrgIDAndName(ctx context.Context, orgID int64, name string) (*IssueType, error) {
+	t := new(IssueType)
+	has, err := db.GetEngine(ctx).Where("org_id = ?", orgID).And("LOWER(name) = ?", strings.ToLower(name)).Get(t)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrIssueTypeNotExist{Name: name}
+	}
+	return t, nil
+}
+
+// GetIssueTypesByOrgID returns the issue types of an organization
+func GetIssueTypesByOrgID(ctx context.Context, orgID int64) ([]*IssueType, error) {
+	types := make([]*IssueType, 0, 5)
+	return types, db.GetEngine(ctx).Where("org_id = ?", orgID).OrderBy("sort, name").Find(&types)
+}
+
+// GetIssueTypeIDsInReposByName returns the IDs of the issue types with the given name which are available to the issues of the repositories,
+// the name is compared case-insensitively
+func GetIssueTypeIDsInReposByName(ctx context.Context, repoIDs []int64, name string) ([]int64, error) {
+	cond := builder.Expr("LOWER(name) = ?", strings.ToLower(name))
+	if len(repoIDs) > 0 {
+		cond = cond.And(builder.In("org_id", builder.Select("owner_id").From("repository").Where(builder.In("id", repoIDs))))
+	}
+	ids := make([]int64, 0, 2)
+	return ids, db.GetEngine(ctx).Table("issue_type").Where(cond).Cols("id").Find(&ids)
+}
+
+// GetIssueIDsByTypeID returns the IDs of the issues of an issue type
+func GetIssueIDsByTypeID(ctx context.Context, typeID int64) ([]int64, error) {
+	issueIDs := make([]int64, 0, 10)
+	return issueIDs, db.GetEngine(ctx).Table("issue").Where("type_id = ?", typeID).Cols("id").Find(&issueIDs)
+}
+
+// LoadType loads the type of the issue
+func (issue *Issue) LoadType(ctx context.Context) (err error) {
+	if issue.TypeID == 0 || issue.Type != nil && issue.Type.ID == issue.TypeID {
+		return nil
+	}
+	issue.Type, err = GetIssueTypeByID(ctx, issue.TypeID)
+	if IsErrIssueTypeNotExist(err) {
+		issue.Type, err = nil, nil
+	}
+	return err
+}
+
+// LoadTypes loads the types of the issues
+func (issues IssueList) LoadTypes(ctx context.Context) error {
+	typeIDs := container.FilterSlice(issues, func(issue *Issue) (int64, bool) {
+		return issue.TypeID, issue.TypeID > 0
+	})
+	if len(typeIDs) == 0 {
+		return nil
+	}
+
+	types := make(map[int64]*IssueType, len(typeIDs))
+	if err := db.GetEngine(ctx).In("id", typeIDs).Find(&types); err != nil {
+		return err
+	}
+	for _, issue := range issues {
+		issue.Type = types[issue.TypeID]
+	}
+	return nil
+}
+
+// ChangeIssueType changes the type of an issue and adds the change to its timeline, a nil type removes the type
+func ChangeIssueType(ctx context.Context, issue *Issue, doer *user_model.User, issueType *IssueType) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if err := issue.LoadRepo(ctx); err != nil {
+			return err
+		}
+		if err := issue.LoadType(ctx); err != nil {
+			return err
+		}
+
+		opts := &CreateCommentOptions{
+			Type:  CommentTypeChangeIssueType,
+			Doer:  doer,
+			Repo:  issue.Repo,
+			Issue: issue,
+		}
+		if issue.TypeID != 0 && issue.Type != nil {
+			opts.OldIssueTypeName = issue.Type.Name
+		}
+		issue.TypeID, issue.Type = 0, issueType
+		if issueType != nil {
+			issue.TypeID = issueType.ID
+			opts.IssueTypeName = issueType.Name
+		}
+
+		if err := UpdateIssueCols(ctx, issue, "type_id"); err != nil {
+			return err
+		}
+		_, err := CreateComment(ctx, opts)
+		return err
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues_test
+
+import (
+	"testing"
+
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/optional"
+	"gitea.dev/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueTypeValidate(t *testing.T) {
+	issueType := &issues_model.IssueType{Name: " Bug ", Color: "D73A4A"}
+	require.NoError(t, issueType.Validate())
+	assert.Equal(t, "Bug", issueType.Name)
+	assert.Equal(t, "#d73a4a", issueType.Color)
+	assert.Equal(t, issues_model.IssueTypeDefaultIcon, issueType.Icon)
+
+	for _, issueType := range []*issues_model.IssueType{
+		{Name: ""},
+		{Name: `"Bug"`},
+		{Name: "Bug", Color: "red"},
+		{Name: "Bug", Icon: "octicon-unknown"},
+	} {
+		assert.ErrorIs(t, issueType.Validate(), util.ErrInvalidArgument, "issue type: %v", issueType)
+	}
+}
+
+func TestIssueTypes(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	bug := &issues_model.IssueType{OrgID: 3, Name: "Bug", Icon: "octicon-bug", Sort: 1}
+	require.NoError(t, issues_model.NewIssueType(t.Context(), bug))
+	feature := &issues_model.IssueType{OrgID: 3, Name: "Feature", Icon: "octicon-rocket"}
+	require.NoError(t, issues_model.NewIssueType(t.Context(), feature))
+
+	// the names are unique in an organization, and a type belongs to an organization
+	assert.True(t, issues_model.IsErrIssueTypeAlreadyExist(issues_model.NewIssueType(t.Context(), &issues_model.IssueType{OrgID: 3, Name: "BUG"})))
+	assert.ErrorIs(t, issues_model.NewIssueType(t.Context(), &issues_model.IssueType{Name: "Task"}), util.ErrInvalidArgument)
+	feature.Name = "bug"
+	assert.True(t, issues_model.IsErrIssueTypeAlreadyExist(issues_model.UpdateIssueType(t.Context(), feature)))
+	feature.Name = "Feature"
+
+	types, err := issues_model.GetIssueTypesByOrgID(t.Context(), 3)
+	require.NoError(t, err)
+	if assert.Len(t, types, 2) {
+		assert.Equal(t, feature.ID, types[0].ID)
+		assert.Equal(t, bug.ID, types[1].ID)
+	}
+	ids, err := issues_model.GetIssueTypeIDsInReposByName(t.Context(), []int64{1, 3}, "bug")
+	require.NoError(t, err)
+	assert.Equal(t, []int64{bug.ID}, ids)
+
+	issue := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: 3, Index: 1})
+	doer := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	require.NoError(t, issues_model.ChangeIssueType(t.Context(), issue, doer, bug))
+	issue = unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: issue.ID})
+	require.NoError(t, issue.LoadType(t.Context()))
+	assert.Equal(t, "Bug", issue.Type.Name)
+	comment := unittest.AssertExistsAndLoadBean(t, &issues_model.Comment{IssueID: issue.ID, Type: issues_model.CommentTypeChangeIssueType})
+	if assert.NotNil(t, comment.CommentMetaData) {
+		assert.Empty(t, comment.CommentMetaData.OldIssueTypeName)
+		assert.Equal(t, "Bug", comment.CommentMetaData.IssueTypeName)
+	}
+
+	issueIDs, _, err := issues_model.IssueIDs(t.Context(), &issues_model.IssuesOptions{
+		RepoIDs: []int64{3},
+		IsPull:  optional.Some(false),
+		TypeIDs: []int64{bug.ID},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{issue.ID}, issueIDs)
+
+	// deleting the type removes it from the issues
+	require.NoError(t, issues_model.DeleteIssueType(t.Context(), bug))
+	_, err = issues_model.GetIssueTypeByID(t.Context(), bug.ID)
+	assert.True(t, issues_model.IsErrIssueTypeNotExist(err))
+	unittest.AssertCount(t, &issues_model.Issue{TypeID: bug.ID}, 0)
+
+	require.NoError(t, issues_model.DeleteIssueTypesByOrgID(t.Context(), 3))
+	unittest.AssertCount(t, &issues_model.IssueType{OrgID: 3}, 0)
+}
@@ -489,6 +489,23 @@ func GetLabelIDsByNames(ctx context.Context, labelNames []string) ([]int64, erro
 		Find(&labelIDs)
 }
 
+// GetLabelIDsInReposByName returns the IDs of the labels with the given name which belong to the repositories or to their owners.
+// The name is compared case-insensitively, all labels with the name are returned if no repository is given.
+func GetLabelIDsInReposByName(ctx context.Context, repoIDs []int64, name string) ([]int64, error) {
+	cond := db.BuildCaseInsensitiveIn("name", []string{name})
+	if len(repoIDs) > 0 {
+		cond = cond.And(builder.Or(
+			builder.In("repo_id", repoIDs),
+			builder.In("org_id", builder.Select("owner_id").From("repository").Where(builder.In("id", repoIDs))),
+		))
+	}
+	var labelIDs []int64
+	return labelIDs, db.GetEngine(ctx).Table("label").
+		Where(cond).
+		Cols("id").
+		Find(&labelIDs)
+}
+
 // CountLabelsByOrgID count all labels that belong to given organization by ID.
 func CountLabelsByOrgID(ctx context.Context, orgID int64) (int64, error) {
 	return db.GetEngine(ctx).Where("org_id = ?", orgID).Count(&Label{})
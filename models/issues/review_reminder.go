@@ -0,0 +1,204 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"xorm.io/builder"
+)
+
+// ErrReviewReminderNotExist represents a "review reminder does not exist" error
+var ErrReviewReminderNotExist = util.NewNotExistErrorf("review reminder does not exist")
+
+// ReviewReminderDelivery is how a review reminder is delivered
+type ReviewReminderDelivery string
+
+const (
+	// ReviewReminderDeliveryMail mails the reminder to the user or to the members of the team
+	ReviewReminderDeliveryMail ReviewReminderDelivery = "mail"
+	// ReviewReminderDeliveryWebhook posts the reminder to a chat webhook, e.g. of Slack or Mattermost
+	ReviewReminderDeliveryWebhook ReviewReminderDelivery = "webhook"
+)
+
+// IsValid reports whether the delivery is supported
+func (d ReviewReminderDelivery) IsValid() bool {
+	return d == ReviewReminderDeliveryMail || d == ReviewReminderDeliveryWebhook
+}
+
+// ReviewReminder is a schedule to remind a user or a team of the pull requests awaiting their review.
+// A personal reminder is owned by the user, a team reminder is owned by the organization of the team.
+type ReviewReminder struct {
+	ID      int64 `xorm:"pk autoincr"`
+	OwnerID int64 `xorm:"INDEX NOT NULL"`
+	TeamID  int64 `xorm:"INDEX NOT NULL DEFAULT 0"`
+	// Weekdays is a bit mask of the days of the week the reminder is sent on, the bit of a day is 1 << time.Weekday
+	Weekdays int `xorm:"NOT NULL DEFAULT 0"`
+	// TimeOfDay is the number of minutes after midnight the reminder is sent at
+	TimeOfDay int `xorm:"NOT NULL DEFAULT 0"`
+	// TimeZone is the time zone of the schedule, the time zone of the user is used if it's empty
+	TimeZone   string                 `xorm:"VARCHAR(64)"`
+	SkipDrafts bool                   `xorm:"NOT NULL DEFAULT false"`
+	Delivery   ReviewReminderDelivery `xorm:"VARCHAR(16) NOT NULL"`
+	WebhookURL string                 `xorm:"TEXT"`
+
+	LastSentUnix timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+	CreatedUnix  timeutil.TimeStamp `xorm:"created"`
+	UpdatedUnix  timeutil.TimeStamp `xorm:"updated"`
+}
+
+func init() {
+	db.RegisterModel(new(ReviewReminder))
+}
+
+// IsTeamReminder returns true if the reminder is sent for the review requests of a team
+func (r *ReviewReminder) IsTeamReminder() bool {
+	return r.TeamID > 0
+}
+
+// HasWeekday reports whether the reminder is sent on the day of the week
+func (r *ReviewReminder) HasWeekday(day time.Weekday) bool {
+	return r.Weekdays&(1<<day) != 0
+}
+
+// Clock returns the time of day of the reminder as "15:04"
+func (r *ReviewReminder) Clock() string {
+	return time.Date(0, 1, 1, r.TimeOfDay/60, r.TimeOfDay%60, 0, 0, time.UTC).Format("15:04")
+}
+
+// IsDue reports whether the reminder has to be sent at now in the time zone loc:
+// it's scheduled on the day, the time of day has been reached and it hasn't been sent since
+func (r *ReviewReminder) IsDue(now time.Time, loc *time.Location) bool {
+	local := now.In(loc)
+	if !r.HasWeekday(local.Weekday()) {
+		return false
+	}
+	scheduled := time.Date(local.Year(), local.Month(), local.Day(), r.TimeOfDay/60, r.TimeOfDay%60, 0, 0, loc)
+	return !local.Before(scheduled) && r.LastSentUnix.AsTime().Before(scheduled)
+}
+
+// Validate checks the schedule and the delivery of the reminder
+func (r *ReviewReminder) Validate() error {
+	if r.Weekdays <= 0 || r.Weekdays >= 1<<7 {
+		return util.NewInvalidArgumentErrorf("the review reminder needs at least one day of the week")
+	}
+	if r.TimeOfDay < 0 || r.TimeOfDay >= 24*60 {
+		return util.NewInvalidArgumentErrorf("invalid time of day of the review reminder")
+	}
+	if r.TimeZone != "" {
+		if _, err := time.LoadLocation(r.TimeZone); err != nil {
+			return util.NewInvalidArgumentErrorf("unknown time zone %q", r.TimeZone)
+		}
+	}
+	if !r.Delivery.IsValid() {
+		return util.NewInvalidArgumentErrorf("invalid delivery %q of the review reminder", r.Delivery)
+	}
+	if r.Delivery == ReviewReminderDeliveryWebhook {
+		if u, err := url.Parse(r.WebhookURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return util.NewInvalidArgumentErrorf("invalid webhook URL of the review reminder")
+		}
+	}
+	return nil
+}
+
+// CreateReviewReminder inserts a new review reminder, it's first sent at its next scheduled time
+func CreateReviewReminder(ctx context.Context, r *ReviewReminder) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	if r.Delivery != ReviewReminderDeliveryWebhook {
+		r.WebhookURL = ""
+	}
+	// a reminder created after its time of day isn't sent right away
+	r.LastSentUnix = timeutil.TimeStampNow()
+	return db.Insert(ctx, r)
+}
+
+// GetReviewReminderByID returns a review reminder of the owner
+func GetReviewReminderByID(ctx context.Context, ownerID, id int64) (*ReviewReminder, error) {
+	r := &ReviewReminder{}
+	has, err := db.GetEngine(ctx).Where(builder.Eq{"id": id, "owner_id": ownerID}).Get(r)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, ErrReviewReminderNotExist
+	}
+	return r, nil
+}
+
+// GetReviewRemindersByOwner returns the review reminders of a user or of the teams of an organization
+func GetReviewRemindersByOwner(ctx context.Context, ownerID int64) ([]*ReviewReminder, error) {
+	reminders := make([]*ReviewReminder, 0, 5)
+	return reminders, db.GetEngine(ctx).Where(builder.Eq{"owner_id": ownerID}).OrderBy("id ASC").Find(&reminders)
+}
+
+// GetAllReviewReminders returns the review reminders of all the users and teams
+func GetAllReviewReminders(ctx context.Context) ([]*ReviewReminder, error) {
+	reminders := make([]*ReviewReminder, 0, 10)
+	return reminders, db.GetEngine(ctx).OrderBy("id ASC").Find(&reminders)
+}
+
+// DeleteReviewReminder deletes a review reminder of the owner
+func DeleteReviewReminder(ctx context.Context, ownerID, id int64) error {
+	n, err := db.GetEngine(ctx).Where(builder.Eq{"id": id, "owner_id": ownerID}).Delete(&ReviewReminder{})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrReviewReminderNotExist
+	}
+	return nil
+}
+
+// UpdateReviewReminderLastSent records when the reminder has been sent
+func UpdateReviewReminderLastSent(ctx context.Context, r *ReviewReminder, sent timeutil.TimeStamp) error {
+	r.LastSentUnix = sent
+	_, err := db.GetEngine(ctx).ID(r.ID).Cols("last_sent_unix").NoAutoTime().Update(r)
+	return err
+}
+
+// GetOpenPullIDsRequestingTeamReview returns the IDs of the open pull requests whose review is requested from the team,
+// the oldest first
+func GetOpenPullIDsRequestingTeamReview(ctx context.Context, teamID int64) ([]int64, error) {
+	return getOpenPullIDsRequestingTeamReview(ctx, builder.Eq{"reviewer_team_id": teamID})
+}
+
+// GetOpenPullIDsRequestingUserTeamsReview returns the IDs of the open pull requests whose review is requested
+// from one of the teams of the user, the oldest first
+func GetOpenPullIDsRequestingUserTeamsReview(ctx context.Context, userID int64) ([]int64, error) {
+	teams := builder.Select("team_id").From("team_user").Where(builder.Eq{"uid": userID})
+	return getOpenPullIDsRequestingTeamReview(ctx, builder.In("reviewer_team_id", teams))
+}
+
+func getOpenPullIDsRequestingTeamReview(ctx context.Context, teamCond builder.Cond) ([]int64, error) {
+	requested := builder.Select("issue_id").From("review").
+		Where(builder.Eq{"type": ReviewTypeRequest}.And(teamCond))
+	ids := make([]int64, 0, 10)
+	return ids, db.GetEngine(ctx).Table("issue").
+		Where(builder.Eq{"is_pull": true, "is_closed": false}).
+		And(builder.In("id", requested)).
+		OrderBy("created_unix ASC, id ASC").
+		Cols("id").
+		Find(&ids)
+}
+
+// GetReviewRequestedUserIDs returns the IDs of the users whose review of the pull request is requested,
+// directly or through one of their teams
+func GetReviewRequestedUserIDs(ctx context.Context, issueID int64) ([]int64, error) {
+	requested := builder.Eq{"issue_id": issueID, "type": ReviewTypeRequest}
+	users := builder.Select("reviewer_id").From("review").Where(requested.And(builder.Gt{"reviewer_id": 0}))
+	teams := builder.Select("reviewer_team_id").From("review").Where(requested.And(builder.Gt{"reviewer_team_id": 0}))
+	ids := make([]int64, 0, 10)
+	return ids, db.GetEngine(ctx).Table("user").
+		Where(builder.In("id", users).Or(builder.In("id", builder.Select("uid").From("team_user").Where(builder.In("team_id", teams))))).
+		Cols("id").
+		Find(&ids)
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues_test
+
+import (
+	"testing"
+	"time"
+
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewReminderValidate(t *testing.T) {
+	weekdays := 1<<time.Monday | 1<<time.Friday
+	assert.NoError(t, (&issues_model.ReviewReminder{Weekdays: weekdays, TimeOfDay: 9 * 60, Delivery: issues_model.ReviewReminderDeliveryMail}).Validate())
+	assert.NoError(t, (&issues_model.ReviewReminder{Weekdays: weekdays, TimeZone: "Asia/Tokyo", Delivery: issues_model.ReviewReminderDeliveryWebhook, WebhookURL: "https://chat.example.com/hooks/abc"}).Validate())
+	assert.ErrorIs(t, (&issues_model.ReviewReminder{Delivery: issues_model.ReviewReminderDeliveryMail}).Validate(), util.ErrInvalidArgument)
+	assert.ErrorIs(t, (&issues_model.ReviewReminder{Weekdays: weekdays, TimeOfDay: 24 * 60, Delivery: issues_model.ReviewReminderDeliveryMail}).Validate(), util.ErrInvalidArgument)
+	assert.ErrorIs(t, (&issues_model.ReviewReminder{Weekdays: weekdays, TimeZone: "Mars/Olympus", Delivery: issues_model.ReviewReminderDeliveryMail}).Validate(), util.ErrInvalidArgument)
+	assert.ErrorIs(t, (&issues_model.ReviewReminder{Weekdays: weekdays, Delivery: issues_model.ReviewReminderDeliveryWebhook, WebhookURL: "ftp://chat.example.com"}).Validate(), util.ErrInvalidArgument)
+	assert.ErrorIs(t, (&issues_model.ReviewReminder{Weekdays: weekdays, Delivery: "sms"}).Validate(), util.ErrInvalidArgument)
+}
+
+func TestReviewReminderIsDue(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	// every weekday at 9:00
+	r := &issues_model.ReviewReminder{Weekdays: 0b0111110, TimeOfDay: 9 * 60}
+	assert.Equal(t, "09:00", r.Clock())
+
+	// Monday 2026-05-11 00:30 UTC is 09:30 in Tokyo
+	now := time.Date(2026, 5, 11, 0, 30, 0, 0, time.UTC)
+	assert.True(t, r.IsDue(now, tokyo))
+	assert.False(t, r.IsDue(now, time.UTC))
+
+	// it's only sent once a day
+	r.LastSentUnix = timeutil.TimeStamp(now.Add(-10 * time.Minute).Unix())
+	assert.False(t, r.IsDue(now, tokyo))
+	r.LastSentUnix = timeutil.TimeStamp(now.Add(-24 * time.Hour).Unix())
+	assert.True(t, r.IsDue(now, tokyo))
+
+	// Sunday 2026-05-10 09:30 in Tokyo is not a weekday
+	assert.False(t, r.IsDue(now.Add(-24*time.Hour), tokyo))
+}
+
+func TestReviewReminders(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	r := &issues_model.ReviewReminder{
+		OwnerID:    3,
+		TeamID:     7,
+		Weekdays:   1 << time.Monday,
+		TimeOfDay:  8 * 60,
+		Delivery:   issues_model.ReviewReminderDeliveryMail,
+		WebhookURL: "https://chat.example.com/hooks/abc",
+	}
+	require.NoError(t, issues_model.CreateReviewReminder(t.Context(), r))
+	assert.Empty(t, r.WebhookURL)
+	assert.True(t, r.IsTeamReminder())
+	// it isn't sent right away if it's created after its time of day
+	assert.NotZero(t, r.LastSentUnix)
+	assert.False(t, r.IsDue(r.LastSentUnix.AsTime(), time.UTC))
+
+	reminders, err := issues_model.GetReviewRemindersByOwner(t.Context(), 3)
+	require.NoError(t, err)
+	require.Len(t, reminders, 1)
+	assert.Equal(t, r.ID, reminders[0].ID)
+
+	sent := timeutil.TimeStamp(time.Date(2026, 5, 11, 8, 0, 0, 0, time.UTC).Unix())
+	require.NoError(t, issues_model.UpdateReviewReminderLastSent(t.Context(), r, sent))
+	r, err = issues_model.GetReviewReminderByID(t.Context(), 3, r.ID)
+	require.NoError(t, err)
+	assert.Equal(t, sent, r.LastSentUnix)
+
+	_, err = issues_model.GetReviewReminderByID(t.Context(), 2, r.ID)
+	assert.ErrorIs(t, err, util.ErrNotExist)
+	assert.ErrorIs(t, issues_model.DeleteReviewReminder(t.Context(), 2, r.ID), util.ErrNotExist)
+	require.NoError(t, issues_model.DeleteReviewReminder(t.Context(), 3, r.ID))
+	unittest.AssertNotExistsBean(t, &issues_model.ReviewReminder{ID: r.ID})
+}
+
+func TestGetOpenPullIDsRequestingTeamReview(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	ids, err := issues_model.GetOpenPullIDsRequestingTeamReview(t.Context(), 7)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{12}, ids)
+
+	ids, err = issues_model.GetOpenPullIDsRequestingTeamReview(t.Context(), 1)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"context"
+
+	"gitea.dev/models/db"
+	repo_model "gitea.dev/models/repo"
+	"gitea.dev/modules/optional"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"xorm.io/builder"
+)
+
+var ErrSavedSearchNotExist = util.NewNotExistErrorf("saved search does not exist")
+
+func init() {
+	db.RegisterModel(new(SavedSearch))
+	db.RegisterModel(new(SavedSearchSubscription))
+}
+
+// SavedSearch is an issue search query saved by a user or an organization
+type SavedSearch struct {
+	ID          int64              `xorm:"pk autoincr"`
+	OwnerID     int64              `xorm:"INDEX NOT NULL"`
+	Name        string             `xorm:"NOT NULL"`
+	Query       string             `xorm:"TEXT NOT NULL"`
+	IsPinned    bool               `xorm:"NOT NULL DEFAULT false"`
+	CreatorID   int64              `xorm:"NOT NULL DEFAULT 0"`
+	CreatedUnix timeutil.TimeStamp `xorm:"created NOT NULL"`
+	UpdatedUnix timeutil.TimeStamp `xorm:"updated NOT NULL"`
+}
+
+// SavedSearchSubscription marks a saved search whose new matching issues and pull requests are notified to the user
+type SavedSearchSubscription struct {
+	ID            int64              `xorm:"pk autoincr"`
+	SavedSearchID int64              `xorm:"UNIQUE(s) INDEX NOT NULL"`
+	UserID        int64              `xorm:"UNIQUE(s) INDEX NOT NULL"`
+	CreatedUnix   timeutil.TimeStamp `xorm:"created NOT NULL"`
+}
+
+// FindSavedSearchesOptions represents the options to find saved searches
+type FindSavedSearchesOptions struct {
+	db.ListOptions
+	OwnerID  int64
+	IsPinned optional.Option[bool]
+}
+
+func (opts FindSavedSearchesOptions) ToConds() builder.Cond {
+	cond := builder.NewCond()
+	if opts.OwnerID != 0 {
+		cond = cond.And(builder.Eq{"owner_id": opts.OwnerID})
+	}
+	if opts.IsPinned.Has() {
+		cond = cond.And(builder.Eq{"is_pinned": opts.IsPinned.Value()})
+	}
+	return cond
+}
+
+func (opts FindSavedSearchesOptions) ToOrders() string {
+	return "name ASC, id ASC"
+}
+
+// CreateSavedSearch inserts a saved search
+func CreateSavedSearch(ctx context.Context, s *SavedSearch) error {
+	return db.Insert(ctx, s)
+}
+
+// GetSavedSearchByID returns the saved search of the owner
+func GetSavedSearchByID(ctx context.Context, ownerID, id int64) (*SavedSearch, error) {
+	s := &SavedSearch{}
+	has, err := db.GetEngine(ctx).Where(builder.Eq{"id": id, "owner_id": ownerID}).Get(s)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, ErrSavedSearchNotExist
+	}
+	return s, nil
+}
+
+// UpdateSavedSearch updates the name, the query and the pinned state of the saved search
+func UpdateSavedSearch(ctx context.Context, s *SavedSearch) error {
+	_, err := db.GetEngine(ctx).ID(s.ID).Cols("name", "query", "is_pinned").Update(s)
+	return err
+}
+
+// DeleteSavedSearch deletes the saved search and its subscriptions
+func DeleteSavedSearch(ctx context.Context, id int64) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.GetEngine(ctx).Where(builder.Eq{"saved_search_id": id}).Delete(&SavedSearchSubscription{}); err != nil {
+			return err
+		}
+		_, err := db.GetEngine(ctx).ID(id).Delete(&SavedSearch{})
+		return err
+	})
+}
+
+// DeleteSavedSearchesByOwner deletes all saved searches of the owner and their subscriptions
+func DeleteSavedSearchesByOwner(ctx context.Context, ownerID int64) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		subQuery := builder.Select("id").From("saved_search").Where(builder.Eq{"owner_id": ownerID})
+		if _, err := db.GetEngine(ctx).Where(builder.In("saved_search_id", subQuery)).Delete(&SavedSearchSubscription{}); err != nil {
+			return err
+		}
+		_, err := db.GetEngine(ctx).Where(builder.Eq{"owner_id": ownerID}).Delete(&SavedSearch{})
+		return err
+	})
+}
+
+// SetSavedSearchSubscription subscribes the user to the saved search or removes the subscription
+func SetSavedSearchSubscription(ctx context.Context, savedSearchID, userID int64, subscribe bool) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		sub := &SavedSearchSubscription{SavedSearchID: savedSearchID, UserID: userID}
+		has, err := db.GetEngine(ctx).Exist(sub)
+		if err != nil {
+			return err
+		}
+		switch {
+		case subscribe && !has:
+			return db.Insert(ctx, sub)
+		case !subscribe && has:
+			_, err = db.GetEngine(ctx).Delete(sub)
+			return err
+		}
+		return nil
+	})
+}
+
+// GetSubscribedSavedSearchIDs returns the IDs of the given saved searches the user is subscribed to
+func GetSubscribedSavedSearchIDs(ctx context.Context, userID int64, savedSearchIDs []int64) ([]int64, error) {
+	var ids []int64
+	if len(savedSearchIDs) == 0 {
+		return ids, nil
+	}
+	return ids, db.GetEngine(ctx).Table("saved_search_subscription").
+		Where(builder.Eq{"user_id": userID}.And(builder.In("saved_search_id", savedSearchIDs))).
+		Cols("saved_search_id").
+		Find(&ids)
+}
+
+// GetSavedSearchesByIDs returns the saved searches with the IDs
+func GetSavedSearchesByIDs(ctx context.Context, ids []int64) (map[int64]*SavedSearch, error) {
+	searches := make(map[int64]*SavedSearch, len(ids))
+	if len(ids) == 0 {
+		return searches, nil
+	}
+	return searches, db.GetEngine(ctx).In("id", ids).Find(&searches)
+}
+
+// GetSavedSearchSubscriptionsForRepo returns the subscriptions which can match the issues of the repository:
+// the subscriptions of the users involved in the repository to their own saved searches and,
+// if the owner is an organization, the subscriptions of its members to its saved searches.
+// The users involved in the repository are its owner, the members of its organization,
+// the users with an explicit access to it and its watchers.
+func GetSavedSearchSubscriptionsForRepo(ctx context.Context, repo *repo_model.Repository) ([]*SavedSearchSubscription, error) {
+	orgMembers := builder.Select("uid").From("org_user").Where(builder.Eq{"org_id": repo.OwnerID})
+	involved := builder.Or(
+		builder.Eq{"saved_search_subscription.user_id": repo.OwnerID},
+		builder.In("saved_search_subscription.user_id", orgMembers),
+		builder.In("saved_search_subscription.user_id", builder.Select("user_id").From("access").
+			Where(builder.Eq{"repo_id": repo.ID})),
+		builder.In("saved_search_subscription.user_id", builder.Select("user_id").From("watch").
+			Where(builder.Eq{"repo_id": repo.ID}.And(builder.Neq{"mode": repo_model.WatchModeDont}))),
+	)
+	subs := make([]*SavedSearchSubscription, 0, 10)
+	return subs, db.GetEngine(ctx).
+		Join("INNER", "saved_search", "saved_search.id = saved_search_subscription.saved_search_id").
+		Where(builder.Or(
+			builder.Expr("saved_search.owner_id = saved_search_subscription.user_id").And(involved),
+			builder.Eq{"saved_search.owner_id": repo.OwnerID}.And(
+				builder.In("saved_search_subscription.user_id", orgMembers),
+			),
+		)).
+		Find(&subs)
+}
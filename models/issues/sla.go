@@ -0,0 +1,404 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"gitea.dev/models/db"
+	"gitea.dev/modules/optional"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"xorm.io/builder"
+)
+
+// SLATarget represents a target of an SLA policy
+type SLATarget string
+
+const (
+	SLATargetFirstResponse SLATarget = "first_response"
+	SLATargetResolution    SLATarget = "resolution"
+)
+
+// ErrSLAPolicyNotExist represents a "SLAPolicyNotExist" kind of error.
+type ErrSLAPolicyNotExist struct {
+	ID int64
+}
+
+// IsErrSLAPolicyNotExist checks if an error is a ErrSLAPolicyNotExist.
+func IsErrSLAPolicyNotExist(err error) bool {
+	_, ok := err.(ErrSLAPolicyNotExist)
+	return ok
+}
+
+func (err ErrSLAPolicyNotExist) Error() string {
+	return fmt.Sprintf("SLA policy does not exist [id: %d]", err.ID)
+}
+
+func (err ErrSLAPolicyNotExist) Unwrap() error {
+	return util.ErrNotExist
+}
+
+// SLAPolicy represents the service level agreement of the issues and pull requests with a label,
+// the policies of an organization apply to all its repositories.
+// The targets are counted in the business hours of the instance since the label has been added to the issue.
+type SLAPolicy struct {
+	ID      int64  `xorm:"pk autoincr"`
+	OrgID   int64  `xorm:"INDEX NOT NULL DEFAULT 0"`
+	RepoID  int64  `xorm:"INDEX NOT NULL DEFAULT 0"`
+	Name    string `xorm:"NOT NULL"`
+	LabelID int64  `xorm:"INDEX NOT NULL"`
+	// FirstResponseHours is the number of business hours to the first response of someone other than the poster, no target if zero
+	FirstResponseHours int `xorm:"NOT NULL DEFAULT 0"`
+	// ResolutionDays is the number of business days to the closing of the issue, no target if zero
+	ResolutionDays int `xorm:"NOT NULL DEFAULT 0"`
+
+	CreatedUnix timeutil.TimeStamp `xorm:"INDEX created"`
+	UpdatedUnix timeutil.TimeStamp `xorm:"INDEX updated"`
+}
+
+// SLAReminder records the reminder of an SLA target of an issue, so that it is only sent once
+type SLAReminder struct {
+	ID          int64              `xorm:"pk autoincr"`
+	PolicyID    int64              `xorm:"UNIQUE(s) NOT NULL"`
+	IssueID     int64              `xorm:"UNIQUE(s) NOT NULL"`
+	Target      SLATarget          `xorm:"UNIQUE(s) VARCHAR(20) NOT NULL"`
+	CreatedUnix timeutil.TimeStamp `xorm:"created"`
+}
+
+func init() {
+	db.RegisterModel(new(SLAPolicy))
+	db.RegisterModel(new(SLAReminder))
+}
+
+// BelongsToOrg returns true if the SLA policy is defined by an organization
+func (p *SLAPolicy) BelongsToOrg() bool {
+	return p.OrgID > 0
+}
+
+// Validate checks the name and the targets of the SLA policy
+func (p *SLAPolicy) Validate() error {
+	if p.Name == "" {
+		return util.NewInvalidArgumentErrorf("the name of the SLA policy can't be empty")
+	}
+	if p.FirstResponseHours < 0 || p.ResolutionDays < 0 {
+		return util.NewInvalidArgumentErrorf("the targets of the SLA policy can't be negative")
+	}
+	if p.FirstResponseHours == 0 && p.ResolutionDays == 0 {
+		return util.NewInvalidArgumentErrorf("the SLA policy needs a first response or a resolution target")
+	}
+	return nil
+}
+
+func slaPolicyScopeCond(orgID, repoID int64) builder.Cond {
+	return builder.Eq{"org_id": orgID, "repo_id": repoID}
+}
+
+// CreateSLAPolicy inserts a new SLA policy
+func CreateSLAPolicy(ctx context.Context, p *SLAPolicy) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	return db.Insert(ctx, p)
+}
+
+// UpdateSLAPolicy updates the name, the label and the targets of an SLA policy
+func UpdateSLAPolicy(ctx context.Context, p *SLAPolicy) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	_, err := db.GetEngine(ctx).ID(p.ID).Cols("name", "label_id", "first_response_hours", "resolution_days").Update(p)
+	return err
+}
+
+// DeleteSLAPolicy deletes an SLA policy and its reminders
+func DeleteSLAPolicy(ctx context.Context, p *SLAPolicy) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.DeleteByBean(ctx, &SLAReminder{PolicyID: p.ID}); err != nil {
+			return err
+		}
+		_, err := db.DeleteByID[SLAPolicy](ctx, p.ID)
+		return err
+	})
+}
+
+// DeleteSLAPoliciesByScope deletes all the SLA policies of an organization or a repository, and their reminders
+func DeleteSLAPoliciesByScope(ctx context.Context, orgID, repoID int64) error {
+	policyIDs := builder.Select("id").From("sla_policy").Where(slaPolicyScopeCond(orgID, repoID))
+	if _, err := db.GetEngine(ctx).Where(builder.In("policy_id", policyIDs)).Delete(new(SLAReminder)); err != nil {
+		return err
+	}
+	_, err := db.GetEngine(ctx).Where(slaPolicyScopeCond(orgID, repoID)).Delete(new(SLAPolicy))
+	return err
+}
+
+// GetSLAPolicyByID returns an SLA policy by its ID
+func GetSLAPolicyByID(ctx context.Context, id int64) (*SLAPolicy, error) {
+	p, exist, err := db.GetByID[SLAPolicy](ctx, id)
+	if err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, ErrSLAPolicyNotExist{id}
+	}
+	return p, nil
+}
+
+// GetSLAPoliciesByScope returns the SLA policies defined by an organization or a repository
+func GetSLAPoliciesByScope(ctx context.Context, orgID, repoID int64) ([]*SLAPolicy, error) {
+	policies := make([]*SLAPolicy, 0, 5)
+	return policies, db.GetEngine(ctx).Where(slaPolicyScopeCond(orgID, repoID)).OrderBy("name").Find(&policies)
+}
+
+// GetAvailableSLAPolicies returns the SLA policies which apply to the issues of a repository,
+// the policies of the owner organization come first
+func GetAvailableSLAPolicies(ctx context.Context, ownerID, repoID int64) ([]*SLAPolicy, error) {
+	policies := make([]*SLAPolicy, 0, 5)
+	return policies, db.GetEngine(ctx).
+		Where(builder.Or(slaPolicyScopeCond(ownerID, 0), slaPolicyScopeCond(0, repoID))).
+		OrderBy("repo_id, name").
+		Find(&policies)
+}
+
+// GetAllSLAPolicies returns the SLA policies of all the organizations and repositories
+func GetAllSLAPolicies(ctx context.Context) ([]*SLAPolicy, error) {
+	policies := make([]*SLAPolicy, 0, 10)
+	return policies, db.GetEngine(ctx).OrderBy("id").Find(&policies)
+}
+
+// GetOpenIssueIDsBySLAPolicy returns the open issues and pull requests the SLA policy applies to
+func GetOpenIssueIDsBySLAPolicy(ctx context.Context, p *SLAPolicy) ([]int64, error) {
+	var cond builder.Cond = builder.Eq{"issue.is_closed": false, "issue_label.label_id": p.LabelID}
+	if p.RepoID > 0 {
+		cond = cond.And(builder.Eq{"issue.repo_id": p.RepoID})
+	} else {
+		cond = cond.And(builder.In("issue.repo_id", builder.Select("id").From("repository").Where(builder.Eq{"owner_id": p.OrgID})))
+	}
+	issueIDs := make([]int64, 0, 10)
+	return issueIDs, db.GetEngine(ctx).Table("issue").
+		Join("INNER", "issue_label", "issue_label.issue_id = issue.id").
+		Where(cond).
+		Cols("issue.id").
+		Find(&issueIDs)
+}
+
+// GetIssueIDsByRepoAndLabels returns the issues and pull requests of a repository with any of the labels, the newest first
+func GetIssueIDsByRepoAndLabels(ctx context.Context, repoID int64, labelIDs []int64, isClosed optional.Option[bool]) ([]int64, error) {
+	cond := builder.Eq{"issue.repo_id": repoID}.And(builder.In("issue_label.label_id", labelIDs))
+	if isClosed.Has() {
+		cond = cond.And(builder.Eq{"issue.is_closed": isClosed.Value()})
+	}
+	issueIDs := make([]int64, 0, 10)
+	return issueIDs, db.GetEngine(ctx).Table("issue").
+		Join("INNER", "issue_label", "issue_label.issue_id = issue.id").
+		Where(cond).
+		Distinct("issue.id").
+		OrderBy("issue.id DESC").
+		Find(&issueIDs)
+}
+
+// HasSLAReminder returns whether the reminder of the SLA target has been sent for the issue
+func HasSLAReminder(ctx context.Context, policyID, issueID int64, target SLATarget) (bool, error) {
+	return db.GetEngine(ctx).Exist(&SLAReminder{PolicyID: policyID, IssueID: issueID, Target: target})
+}
+
+// CreateSLAReminder records the reminder of the SLA target of the issue
+func CreateSLAReminder(ctx context.Context, policyID, issueID int64, target SLATarget) error {
+	return db.Insert(ctx, &SLAReminder{PolicyID: policyID, IssueID: issueID, Target: target})
+}
+
+// IssueSLA represents the state of the targets of an SLA policy for an issue
+type IssueSLA struct {
+	Policy *SLAPolicy
+	Issue  *Issue
+	// FirstResponseDue is zero if the policy has no first response target
+	FirstResponseDue timeutil.TimeStamp
+	// FirstResponseAt is zero until someone other than the poster responds
+	FirstResponseAt timeutil.TimeStamp
+	// ResolutionDue is zero if the policy has no resolution target
+	ResolutionDue timeutil.TimeStamp
+	// ResolvedAt is zero until the issue is closed
+	ResolvedAt timeutil.TimeStamp
+}
+
+func isTargetBreached(due, at timeutil.TimeStamp) bool {
+	if due == 0 {
+		return false
+	}
+	if at == 0 {
+		return timeutil.TimeStampNow() > due
+	}
+	return at > due
+}
+
+// IsFirstResponseBreached returns whether the first response is late, closing the issue ends the wait for a response
+func (s *IssueSLA) IsFirstResponseBreached() bool {
+	return isTargetBreached(s.FirstResponseDue, util.Iif(s.FirstResponseAt == 0, s.ResolvedAt, s.FirstResponseAt))
+}
+
+// IsResolutionBreached returns whether the resolution is late
+func (s *IssueSLA) IsResolutionBreached() bool {
+	return isTargetBreached(s.ResolutionDue, s.ResolvedAt)
+}
+
+// IsBreached returns whether any target of the policy is missed
+func (s *IssueSLA) IsBreached() bool {
+	return s.IsFirstResponseBreached() || s.IsResolutionBreached()
+}
+
+// NextTarget returns the pending target which is due first, or an empty target if all the targets are met
+func (s *IssueSLA) NextTarget() SLATarget {
+	if s.FirstResponseDue > 0 && s.FirstResponseAt == 0 && s.ResolvedAt == 0 {
+		return SLATargetFirstResponse
+	}
+	if s.ResolutionDue > 0 && s.ResolvedAt == 0 {
+		return SLATargetResolution
+	}
+	return ""
+}
+
+// NextDue returns the due time of the next pending target, zero if all the targets are met
+func (s *IssueSLA) NextDue() timeutil.TimeStamp {
+	switch s.NextTarget() {
+	case SLATargetFirstResponse:
+		return s.FirstResponseDue
+	case SLATargetResolution:
+		return s.ResolutionDue
+	}
+	return 0
+}
+
+// MostUrgentIssueSLA returns the breached SLA if any, or the SLA with the earliest pending target
+func MostUrgentIssueSLA(slas []*IssueSLA) *IssueSLA {
+	var urgent *IssueSLA
+	for _, s := range slas {
+		switch {
+		case urgent == nil:
+			urgent = s
+		case s.IsBreached() != urgent.IsBreached():
+			if s.IsBreached() {
+				urgent = s
+			}
+		case s.NextDue() > 0 && (urgent.NextDue() == 0 || s.NextDue() < urgent.NextDue()):
+			urgent = s
+		}
+	}
+	return urgent
+}
+
+// getFirstResponses returns the time of the first comment or review of someone other than the poster of each issue
+func getFirstResponses(ctx context.Context, issueIDs []int64) (map[int64]timeutil.TimeStamp, error) {
+	type firstResponse struct {
+		IssueID     int64
+		CreatedUnix timeutil.TimeStamp
+	}
+	rows := make([]firstResponse, 0, len(issueIDs))
+	if err := db.GetEngine(ctx).Table("comment").
+		Join("INNER", "issue", "issue.id = comment.issue_id").
+		Select("comment.issue_id, MIN(comment.created_unix) AS created_unix").
+		In("comment.issue_id", issueIDs).
+		In("comment.type", []CommentType{CommentTypeComment, CommentTypeCode, CommentTypeReview}).
+		Where("comment.poster_id <> issue.poster_id").
+		GroupBy("comment.issue_id").
+		Find(&rows); err != nil {
+		return nil, err
+	}
+	responses := make(map[int64]timeutil.TimeStamp, len(rows))
+	for _, row := range rows {
+		responses[row.IssueID] = row.CreatedUnix
+	}
+	return responses, nil
+}
+
+// getLabelsAddedTime returns the time when each label has been added to each issue for the last time,
+// by the issue ID and the label ID
+func getLabelsAddedTime(ctx context.Context, issueIDs []int64) (map[[2]int64]timeutil.TimeStamp, error) {
+	type labelAdded struct {
+		IssueID     int64
+		LabelID     int64
+		CreatedUnix timeutil.TimeStamp
+	}
+	rows := make([]labelAdded, 0, len(issueIDs))
+	if err := db.GetEngine(ctx).Table("comment").
+		Select("issue_id, label_id, MAX(created_unix) AS created_unix").
+		In("issue_id", issueIDs).
+		Where(builder.Eq{"type": CommentTypeLabel, "content": "1"}).
+		GroupBy("issue_id, label_id").
+		Find(&rows); err != nil {
+		return nil, err
+	}
+	added := make(map[[2]int64]timeutil.TimeStamp, len(rows))
+	for _, row := range rows {
+		added[[2]int64{row.IssueID, row.LabelID}] = row.CreatedUnix
+	}
+	return added, nil
+}
+
+// GetIssuesSLA returns the state of the SLA policies which apply to each issue by its labels
+func GetIssuesSLA(ctx context.Context, issues IssueList) (map[int64][]*IssueSLA, error) {
+	result := make(map[int64][]*IssueSLA)
+	if len(issues) == 0 {
+		return result, nil
+	}
+	if _, err := issues.LoadRepositories(ctx); err != nil {
+		return nil, err
+	}
+	if err := issues.LoadLabels(ctx); err != nil {
+		return nil, err
+	}
+
+	repoPolicies := make(map[int64][]*SLAPolicy)
+	var issueIDs []int64
+	for _, issue := range issues {
+		policies, ok := repoPolicies[issue.RepoID]
+		if !ok {
+			var err error
+			if policies, err = GetAvailableSLAPolicies(ctx, issue.Repo.OwnerID, issue.RepoID); err != nil {
+				return nil, err
+			}
+			repoPolicies[issue.RepoID] = policies
+		}
+		if len(policies) > 0 {
+			issueIDs = append(issueIDs, issue.ID)
+		}
+	}
+	if len(issueIDs) == 0 {
+		return result, nil
+	}
+	responses, err := getFirstResponses(ctx, issueIDs)
+	if err != nil {
+		return nil, err
+	}
+	labelsAdded, err := getLabelsAddedTime(ctx, issueIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := timeutil.DefaultBusinessCalendar()
+	for _, issue := range issues {
+		for _, policy := range repoPolicies[issue.RepoID] {
+			if !slices.ContainsFunc(issue.Labels, func(label *Label) bool { return label.ID == policy.LabelID }) {
+				continue
+			}
+			// the clock starts when the label has been added, the issues without a label comment (e.g. the migrated ones)
+			// have had it since their creation
+			start := max(issue.CreatedUnix, labelsAdded[[2]int64{issue.ID, policy.LabelID}]).AsTime()
+			s := &IssueSLA{Policy: policy, Issue: issue, FirstResponseAt: responses[issue.ID]}
+			if policy.FirstResponseHours > 0 {
+				s.FirstResponseDue = timeutil.TimeStamp(calendar.AddBusinessDuration(start, time.Duration(policy.FirstResponseHours)*time.Hour).Unix())
+			}
+			if policy.ResolutionDays > 0 {
+				s.ResolutionDue = timeutil.TimeStamp(calendar.AddBusinessDuration(start, time.Duration(policy.ResolutionDays)*calendar.HoursPerDay()).Unix())
+			}
+			if issue.IsClosed {
+				s.ResolvedAt = util.Iif(issue.ClosedUnix == 0, issue.UpdatedUnix, issue.ClosedUnix)
+			}
+			result[issue.ID] = append(result[issue.ID], s)
+		}
+	}
+	return result, nil
+}
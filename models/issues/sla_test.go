@@ -0,0 +1,81 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues_test
+
+import (
+	"testing"
+	"time"
+
+	"gitea.dev/models/db"
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/test"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSLAPolicyValidate(t *testing.T) {
+	assert.NoError(t, (&issues_model.SLAPolicy{Name: "Bugs", FirstResponseHours: 4}).Validate())
+	assert.ErrorIs(t, (&issues_model.SLAPolicy{FirstResponseHours: 4}).Validate(), util.ErrInvalidArgument)
+	assert.ErrorIs(t, (&issues_model.SLAPolicy{Name: "Bugs"}).Validate(), util.ErrInvalidArgument)
+	assert.ErrorIs(t, (&issues_model.SLAPolicy{Name: "Bugs", FirstResponseHours: 4, ResolutionDays: -1}).Validate(), util.ErrInvalidArgument)
+}
+
+func TestGetIssuesSLA(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	defer test.MockVariableValue(&setting.DefaultUILocation, time.UTC)()
+
+	bugs := &issues_model.SLAPolicy{RepoID: 1, Name: "Bugs", LabelID: 1, FirstResponseHours: 4, ResolutionDays: 2}
+	require.NoError(t, issues_model.CreateSLAPolicy(t.Context(), bugs))
+	features := &issues_model.SLAPolicy{RepoID: 1, Name: "Features", LabelID: 2, FirstResponseHours: 1}
+	require.NoError(t, issues_model.CreateSLAPolicy(t.Context(), features))
+
+	issues, err := issues_model.GetIssuesByIDs(t.Context(), []int64{1, 5, 4})
+	require.NoError(t, err)
+	issuesSLA, err := issues_model.GetIssuesSLA(t.Context(), issues)
+	require.NoError(t, err)
+	assert.Len(t, issuesSLA, 2)
+
+	// issue 1 is opened on Saturday 2000-01-01, the business hours begin on Monday at 9:00
+	require.Len(t, issuesSLA[1], 1)
+	s := issuesSLA[1][0]
+	assert.Equal(t, bugs.ID, s.Policy.ID)
+	assert.Equal(t, time.Date(2000, 1, 3, 13, 0, 0, 0, time.UTC).Unix(), int64(s.FirstResponseDue))
+	assert.Equal(t, timeutil.TimeStamp(946684811), s.FirstResponseAt)
+	assert.Equal(t, time.Date(2000, 1, 4, 17, 0, 0, 0, time.UTC).Unix(), int64(s.ResolutionDue))
+	assert.False(t, s.IsFirstResponseBreached())
+	assert.True(t, s.IsResolutionBreached())
+	assert.Equal(t, issues_model.SLATargetResolution, s.NextTarget())
+
+	// issue 5 is closed without any response
+	require.Len(t, issuesSLA[5], 1)
+	s = issuesSLA[5][0]
+	assert.Equal(t, features.ID, s.Policy.ID)
+	assert.Zero(t, s.FirstResponseAt)
+	assert.Zero(t, s.ResolutionDue)
+	assert.True(t, s.IsFirstResponseBreached())
+	assert.False(t, s.IsResolutionBreached())
+	assert.Empty(t, s.NextTarget())
+
+	// the clock starts when the label is added, on Monday at 15:00
+	_, err = db.GetEngine(t.Context()).NoAutoTime().Insert(&issues_model.Comment{
+		Type:        issues_model.CommentTypeLabel,
+		PosterID:    1,
+		IssueID:     1,
+		LabelID:     1,
+		Content:     "1",
+		CreatedUnix: timeutil.TimeStamp(time.Date(2000, 1, 3, 15, 0, 0, 0, time.UTC).Unix()),
+	})
+	require.NoError(t, err)
+	issuesSLA, err = issues_model.GetIssuesSLA(t.Context(), issues)
+	require.NoError(t, err)
+	require.Len(t, issuesSLA[1], 1)
+	s = issuesSLA[1][0]
+	assert.Equal(t, time.Date(2000, 1, 4, 11, 0, 0, 0, time.UTC).Unix(), int64(s.FirstResponseDue))
+	assert.Equal(t, time.Date(2000, 1, 5, 15, 0, 0, 0, time.UTC).Unix(), int64(s.ResolutionDue))
+}
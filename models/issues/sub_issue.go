@@ -0,0 +1,262 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"context"
+	"fmt"
+
+	"gitea.dev/models/db"
+	repo_model "gitea.dev/models/repo"
+	"gitea.dev/models/unit"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/container"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"xorm.io/builder"
+)
+
+// ErrSubIssueHasParent represents a "SubIssueHasParent" kind of error.
+type ErrSubIssueHasParent struct {
+	IssueID  int64
+	ParentID int64
+}
+
+// IsErrSubIssueHasParent checks if an error is a ErrSubIssueHasParent.
+func IsErrSubIssueHasParent(err error) bool {
+	_, ok := err.(ErrSubIssueHasParent)
+	return ok
+}
+
+func (err ErrSubIssueHasParent) Error() string {
+	return fmt.Sprintf("issue already has a parent issue [issue id: %d, parent id: %d]", err.IssueID, err.ParentID)
+}
+
+func (err ErrSubIssueHasParent) Unwrap() error {
+	return util.ErrAlreadyExist
+}
+
+// ErrSubIssueNotExist represents a "SubIssueNotExist" kind of error.
+type ErrSubIssueNotExist struct {
+	IssueID  int64
+	ParentID int64
+}
+
+// IsErrSubIssueNotExist checks if an error is a ErrSubIssueNotExist.
+func IsErrSubIssueNotExist(err error) bool {
+	_, ok := err.(ErrSubIssueNotExist)
+	return ok
+}
+
+func (err ErrSubIssueNotExist) Error() string {
+	return fmt.Sprintf("issue is not a sub-issue of the parent issue [issue id: %d, parent id: %d]", err.IssueID, err.ParentID)
+}
+
+func (err ErrSubIssueNotExist) Unwrap() error {
+	return util.ErrNotExist
+}
+
+// ErrCircularSubIssue represents a "SubIssueCircular" kind of error.
+type ErrCircularSubIssue struct {
+	IssueID  int64
+	ParentID int64
+}
+
+// IsErrCircularSubIssue checks if an error is a ErrCircularSubIssue.
+func IsErrCircularSubIssue(err error) bool {
+	_, ok := err.(ErrCircularSubIssue)
+	return ok
+}
+
+func (err ErrCircularSubIssue) Error() string {
+	return fmt.Sprintf("circular sub-issues exist (an issue would be its own ancestor) [issue id: %d, parent id: %d]", err.IssueID, err.ParentID)
+}
+
+func (err ErrCircularSubIssue) Unwrap() error {
+	return util.ErrInvalidArgument
+}
+
+// SubIssue represents a parent/child relation between two issues, an issue has at most one parent
+type SubIssue struct {
+	ID          int64              `xorm:"pk autoincr"`
+	ParentID    int64              `xorm:"INDEX NOT NULL"`
+	IssueID     int64              `xorm:"UNIQUE NOT NULL"`
+	Sort        int                `xorm:"NOT NULL DEFAULT 0"`
+	CreatorID   int64              `xorm:"NOT NULL"`
+	CreatedUnix timeutil.TimeStamp `xorm:"created"`
+}
+
+func init() {
+	db.RegisterModel(new(SubIssue))
+}
+
+// GetParentIssueID returns the ID of the parent issue, or 0 if the issue has no parent
+func GetParentIssueID(ctx context.Context, issueID int64) (int64, error) {
+	var parentID int64
+	if _, err := db.GetEngine(ctx).Table("sub_issue").Cols("parent_id").Where("issue_id = ?", issueID).Get(&parentID); err != nil {
+		return 0, err
+	}
+	return parentID, nil
+}
+
+// AddSubIssue makes the child issue a sub-issue of the parent issue
+func AddSubIssue(ctx context.Context, doerID int64, parent, child *Issue) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if parent.ID == child.ID {
+			return ErrCircularSubIssue{child.ID, parent.ID}
+		}
+
+		// An issue can only have one parent
+		currentParentID, err := GetParentIssueID(ctx, child.ID)
+		if err != nil {
+			return err
+		}
+		if currentParentID != 0 {
+			return ErrSubIssueHasParent{child.ID, currentParentID}
+		}
+
+		// And the child must not be an ancestor of the parent
+		visited := make(container.Set[int64])
+		for ancestorID := parent.ID; ancestorID != 0 && visited.Add(ancestorID); {
+			if ancestorID == child.ID {
+				return ErrCircularSubIssue{child.ID, parent.ID}
+			}
+			if ancestorID, err = GetParentIssueID(ctx, ancestorID); err != nil {
+				return err
+			}
+		}
+
+		var maxSort int
+		if _, err := db.GetEngine(ctx).Table("sub_issue").Select("MAX(sort)").Where("parent_id = ?", parent.ID).Get(&maxSort); err != nil {
+			return err
+		}
+
+		return db.Insert(ctx, &SubIssue{
+			ParentID:  parent.ID,
+			IssueID:   child.ID,
+			Sort:      maxSort + 1,
+			CreatorID: doerID,
+		})
+	})
+}
+
+// RemoveSubIssue removes the child issue from the sub-issues of the parent issue
+func RemoveSubIssue(ctx context.Context, parent, child *Issue) error {
+	affected, err := db.GetEngine(ctx).Delete(&SubIssue{ParentID: parent.ID, IssueID: child.ID})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrSubIssueNotExist{child.ID, parent.ID}
+	}
+	return nil
+}
+
+// DeleteCrossRepoSubIssues removes the relations between the issues of the repository and the issues of other repositories,
+// it's used when the repository is transferred because sub-issues must belong to repositories of the same owner
+func DeleteCrossRepoSubIssues(ctx context.Context, repoID int64) error {
+	repoIssueIDs := builder.Select("id").From("issue").Where(builder.Eq{"repo_id": repoID})
+	_, err := db.GetEngine(ctx).Where(builder.Or(
+		builder.In("parent_id", repoIssueIDs).And(builder.NotIn("issue_id", repoIssueIDs)),
+		builder.NotIn("parent_id", repoIssueIDs).And(builder.In("issue_id", repoIssueIDs)),
+	)).Delete(&SubIssue{})
+	return err
+}
+
+// ReorderSubIssues sorts the sub-issues of the parent issue in the order of the given issue IDs,
+// the IDs must contain all the sub-issues of the parent issue
+func ReorderSubIssues(ctx context.Context, parentID int64, issueIDs []int64) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		subIssues := make([]*SubIssue, 0, len(issueIDs))
+		if err := db.GetEngine(ctx).Where("parent_id = ?", parentID).Find(&subIssues); err != nil {
+			return err
+		}
+		if len(subIssues) != len(issueIDs) {
+			return util.NewInvalidArgumentErrorf("all %d sub-issues must be given to reorder them", len(subIssues))
+		}
+		sorts := make(map[int64]int, len(issueIDs))
+		for i, id := range issueIDs {
+			if _, ok := sorts[id]; ok {
+				return util.NewInvalidArgumentErrorf("issue %d is given more than once", id)
+			}
+			sorts[id] = i + 1
+		}
+		for _, subIssue := range subIssues {
+			sort, ok := sorts[subIssue.IssueID]
+			if !ok {
+				return util.NewInvalidArgumentErrorf("sub-issue %d is missing", subIssue.IssueID)
+			}
+			if _, err := db.GetEngine(ctx).ID(subIssue.ID).Cols("sort").Update(&SubIssue{Sort: sort}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetSubIssues returns the direct sub-issues of the parent issue in their order
+func GetSubIssues(ctx context.Context, parentID int64) (IssueList, error) {
+	issues := make(IssueList, 0, 10)
+	return issues, db.GetEngine(ctx).
+		Join("INNER", "sub_issue", "sub_issue.issue_id = issue.id").
+		Where("sub_issue.parent_id = ?", parentID).
+		OrderBy("sub_issue.sort, sub_issue.id").
+		Find(&issues)
+}
+
+// GetSubIssueIDs returns the IDs of the direct sub-issues of the parent issue
+func GetSubIssueIDs(ctx context.Context, parentID int64) ([]int64, error) {
+	ids := make([]int64, 0, 10)
+	return ids, db.GetEngine(ctx).Table("sub_issue").Cols("issue_id").
+		Where("parent_id = ?", parentID).
+		OrderBy("sort, id").
+		Find(&ids)
+}
+
+// SubIssueProgress represents the progress of an issue computed from the state of all its descendants
+type SubIssueProgress struct {
+	Total  int64
+	Closed int64
+}
+
+// Percent returns the percentage of closed descendants
+func (p *SubIssueProgress) Percent() int {
+	if p.Total == 0 {
+		return 0
+	}
+	return int(p.Closed * 100 / p.Total)
+}
+
+// GetSubIssueProgress rolls up the state of all sub-issues of the issue, including the nested ones.
+// Only the sub-issues in the repositories whose issues the doer can access are counted, the doer may be nil.
+func GetSubIssueProgress(ctx context.Context, issueID int64, doer *user_model.User) (*SubIssueProgress, error) {
+	accessibleRepoIDs := builder.Select("id").From("repository").Where(repo_model.AccessibleRepositoryCondition(doer, unit.TypeIssues))
+	progress := &SubIssueProgress{}
+	visited := make(container.Set[int64])
+	for parentIDs := []int64{issueID}; len(parentIDs) > 0; {
+		var children []struct {
+			ID       int64
+			IsClosed bool
+		}
+		if err := db.GetEngine(ctx).Table("issue").Select("issue.id, issue.is_closed").
+			Join("INNER", "sub_issue", "sub_issue.issue_id = issue.id").
+			Where(builder.In("sub_issue.parent_id", parentIDs).And(builder.In("issue.repo_id", accessibleRepoIDs))).
+			Find(&children); err != nil {
+			return nil, err
+		}
+		parentIDs = parentIDs[:0]
+		for _, child := range children {
+			if !visited.Add(child.ID) {
+				continue
+			}
+			progress.Total++
+			if child.IsClosed {
+				progress.Closed++
+			}
+			parentIDs = append(parentIDs, child.ID)
+		}
+	}
+	return progress, nil
+}
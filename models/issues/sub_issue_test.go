@@ -0,0 +1,90 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues_test
+
+import (
+	"testing"
+
+	"gitea.dev/models/db"
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/optional"
+	"gitea.dev/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubIssues(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	issue1 := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 1})
+	issue4 := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 4}) // closed, repo2
+	issue5 := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 5}) // closed
+	issue7 := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 7}) // repo2
+
+	require.NoError(t, issues_model.AddSubIssue(t.Context(), 2, issue1, issue5))
+	require.NoError(t, issues_model.AddSubIssue(t.Context(), 2, issue1, issue7))
+	require.NoError(t, issues_model.AddSubIssue(t.Context(), 2, issue7, issue4))
+
+	ids, err := issues_model.GetSubIssueIDs(t.Context(), issue1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{5, 7}, ids)
+	parentID, err := issues_model.GetParentIssueID(t.Context(), issue4.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 7, parentID)
+
+	// an issue has at most one parent and can't be its own ancestor
+	err = issues_model.AddSubIssue(t.Context(), 2, issue7, issue5)
+	assert.True(t, issues_model.IsErrSubIssueHasParent(err))
+	assert.ErrorIs(t, err, util.ErrAlreadyExist)
+	err = issues_model.AddSubIssue(t.Context(), 2, issue4, issue1)
+	assert.True(t, issues_model.IsErrCircularSubIssue(err))
+	err = issues_model.AddSubIssue(t.Context(), 2, issue1, issue1)
+	assert.True(t, issues_model.IsErrCircularSubIssue(err))
+
+	// the progress includes the nested sub-issues
+	progress, err := issues_model.GetSubIssueProgress(t.Context(), issue1.ID, user2)
+	require.NoError(t, err)
+	assert.Equal(t, &issues_model.SubIssueProgress{Total: 3, Closed: 2}, progress)
+	assert.Equal(t, 66, progress.Percent())
+	// but only the ones the doer can access, repo2 is private
+	progress, err = issues_model.GetSubIssueProgress(t.Context(), issue1.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &issues_model.SubIssueProgress{Total: 1, Closed: 1}, progress)
+
+	require.NoError(t, issues_model.ReorderSubIssues(t.Context(), issue1.ID, []int64{7, 5}))
+	subIssues, err := issues_model.GetSubIssues(t.Context(), issue1.ID)
+	require.NoError(t, err)
+	if assert.Len(t, subIssues, 2) {
+		assert.EqualValues(t, 7, subIssues[0].ID)
+		assert.EqualValues(t, 5, subIssues[1].ID)
+	}
+	assert.ErrorIs(t, issues_model.ReorderSubIssues(t.Context(), issue1.ID, []int64{7}), util.ErrInvalidArgument)
+	assert.ErrorIs(t, issues_model.ReorderSubIssues(t.Context(), issue1.ID, []int64{7, 4}), util.ErrInvalidArgument)
+
+	ids, _, err = issues_model.IssueIDs(t.Context(), &issues_model.IssuesOptions{RepoIDs: []int64{1, 2}, IsPull: optional.Some(false), ParentID: db.NoConditionID})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, ids)
+	ids, _, err = issues_model.IssueIDs(t.Context(), &issues_model.IssuesOptions{RepoIDs: []int64{1, 2}, ParentID: 7})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4}, ids)
+
+	require.NoError(t, issues_model.RemoveSubIssue(t.Context(), issue1, issue5))
+	assert.True(t, issues_model.IsErrSubIssueNotExist(issues_model.RemoveSubIssue(t.Context(), issue1, issue5)))
+	progress, err = issues_model.GetSubIssueProgress(t.Context(), issue1.ID, user2)
+	require.NoError(t, err)
+	assert.Equal(t, &issues_model.SubIssueProgress{Total: 2, Closed: 1}, progress)
+
+	// the relations with the issues of other repositories are removed, e.g. when repo2 is transferred
+	require.NoError(t, issues_model.DeleteCrossRepoSubIssues(t.Context(), 2))
+	ids, err = issues_model.GetSubIssueIDs(t.Context(), issue1.ID)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+	parentID, err = issues_model.GetParentIssueID(t.Context(), issue4.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 7, parentID)
+}
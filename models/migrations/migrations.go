@@ -420,6 +420,23 @@ func prepareMigrationTasks() []*migration {
 		newMigration(340, "Add ContinueOnError column to ActionRunJob", v1_27.AddContinueOnErrorToActionRunJob),
 		newMigration(341, "Convert legacy MSSQL DATETIME columns to DATETIME2", v1_27.FixLegacyMSSQLDateTimeColumns),
 		newMigration(342, "Add scoped workflows schema", v1_27.AddScopedWorkflowsSchema),
+		newMigration(343, "Add package protection rule table", v1_27.AddPackageProtectionRuleTable),
+		newMigration(344, "Add package download stat and audit event tables", v1_27.AddPackageDownloadStatAndAuditEventTables),
+		newMigration(345, "Add package vulnerability table", v1_27.AddPackageVulnerabilityTable),
+		newMigration(346, "Add package snapshot tables", v1_27.AddPackageSnapshotTables),
+		newMigration(347, "Add saved search tables", v1_27.AddSavedSearchTables),
+		newMigration(348, "Add sub-issue table", v1_27.AddSubIssueTable),
+		newMigration(349, "Add custom field tables", v1_27.AddCustomFieldTables),
+		newMigration(350, "Add issue types", v1_27.AddIssueTypes),
+		newMigration(351, "Add project views", v1_27.AddProjectViews),
+		newMigration(352, "Add automation rules", v1_27.AddAutomationRules),
+		newMigration(353, "Add SLA policies", v1_27.AddSLAPolicies),
+		newMigration(354, "Add webhook delivery retries", v1_27.AddWebhookDeliveryRetries),
+		newMigration(355, "Add payload template to webhook", v1_27.AddWebhookPayloadTemplate),
+		newMigration(356, "Add personal notification channels", v1_27.AddNotificationChannels),
+		newMigration(357, "Add mail preferences and mail digest", v1_27.AddMailPreferencesAndDigest),
+		newMigration(358, "Add push subscriptions", v1_27.AddPushSubscriptions),
+		newMigration(359, "Add review reminders", v1_27.AddReviewReminders),
 	}
 	return preparedMigrations
 }
@@ -0,0 +1,24 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddPackageProtectionRuleTable(x db.EngineMigration) error {
+	type PackageProtectionRule struct {
+		ID               int64              `xorm:"pk autoincr"`
+		OwnerID          int64              `xorm:"UNIQUE(s) INDEX NOT NULL DEFAULT 0"`
+		Type             string             `xorm:"UNIQUE(s) NOT NULL DEFAULT ''"`
+		PackageName      string             `xorm:"UNIQUE(s) NOT NULL DEFAULT ''"`
+		Immutable        bool               `xorm:"NOT NULL DEFAULT false"`
+		ProtectedPattern string             `xorm:"NOT NULL DEFAULT ''"`
+		CreatedUnix      timeutil.TimeStamp `xorm:"created NOT NULL DEFAULT 0"`
+		UpdatedUnix      timeutil.TimeStamp `xorm:"updated NOT NULL DEFAULT 0"`
+	}
+
+	return x.Sync(new(PackageProtectionRule))
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddPackageDownloadStatAndAuditEventTables(x db.EngineMigration) error {
+	type PackageDownloadStat struct {
+		ID        int64              `xorm:"pk autoincr"`
+		VersionID int64              `xorm:"UNIQUE(s) INDEX NOT NULL"`
+		Day       timeutil.TimeStamp `xorm:"UNIQUE(s) INDEX NOT NULL"`
+		Count     int64              `xorm:"NOT NULL DEFAULT 0"`
+	}
+
+	type PackageAuditEvent struct {
+		ID            int64              `xorm:"pk autoincr"`
+		OwnerID       int64              `xorm:"INDEX NOT NULL"`
+		PackageID     int64              `xorm:"INDEX NOT NULL"`
+		VersionID     int64              `xorm:"INDEX NOT NULL"`
+		Type          string             `xorm:"INDEX NOT NULL"`
+		Name          string             `xorm:"NOT NULL"`
+		LowerName     string             `xorm:"INDEX NOT NULL"`
+		Version       string             `xorm:"NOT NULL"`
+		LowerVersion  string             `xorm:"NOT NULL"`
+		Action        int                `xorm:"NOT NULL"`
+		DoerID        int64              `xorm:"NOT NULL DEFAULT 0"`
+		AccessTokenID int64              `xorm:"NOT NULL DEFAULT 0"`
+		ActionsTaskID int64              `xorm:"NOT NULL DEFAULT 0"`
+		CreatedUnix   timeutil.TimeStamp `xorm:"created INDEX NOT NULL"`
+	}
+
+	return x.Sync(new(PackageDownloadStat), new(PackageAuditEvent))
+}
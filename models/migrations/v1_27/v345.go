@@ -0,0 +1,24 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddPackageVulnerabilityTable(x db.EngineMigration) error {
+	type PackageVulnerability struct {
+		ID                int64              `xorm:"pk autoincr"`
+		VersionID         int64              `xorm:"INDEX NOT NULL"`
+		AdvisoryID        string             `xorm:"INDEX NOT NULL"`
+		Summary           string             `xorm:"TEXT"`
+		Severity          string             `xorm:"NOT NULL DEFAULT ''"`
+		DependencyName    string             `xorm:"NOT NULL DEFAULT ''"`
+		DependencyVersion string             `xorm:"NOT NULL DEFAULT ''"`
+		CreatedUnix       timeutil.TimeStamp `xorm:"created NOT NULL"`
+	}
+
+	return x.Sync(new(PackageVulnerability))
+}
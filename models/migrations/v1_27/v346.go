@@ -0,0 +1,33 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddPackageSnapshotTables(x db.EngineMigration) error {
+	type PackageSnapshot struct {
+		ID           int64              `xorm:"pk autoincr"`
+		OwnerID      int64              `xorm:"UNIQUE(s) INDEX NOT NULL"`
+		Type         string             `xorm:"UNIQUE(s) NOT NULL"`
+		Name         string             `xorm:"NOT NULL"`
+		LowerName    string             `xorm:"UNIQUE(s) NOT NULL"`
+		Distribution string             `xorm:"NOT NULL DEFAULT ''"`
+		Component    string             `xorm:"NOT NULL DEFAULT ''"`
+		GroupName    string             `xorm:"NOT NULL DEFAULT ''"`
+		CreatorID    int64              `xorm:"NOT NULL DEFAULT 0"`
+		CreatedUnix  timeutil.TimeStamp `xorm:"created NOT NULL"`
+	}
+
+	type PackageSnapshotFile struct {
+		ID         int64 `xorm:"pk autoincr"`
+		SnapshotID int64 `xorm:"UNIQUE(s) INDEX NOT NULL"`
+		FileID     int64 `xorm:"UNIQUE(s) NOT NULL"`
+		VersionID  int64 `xorm:"INDEX NOT NULL"`
+	}
+
+	return x.Sync(new(PackageSnapshot), new(PackageSnapshotFile))
+}
@@ -0,0 +1,31 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddSavedSearchTables(x db.EngineMigration) error {
+	type SavedSearch struct {
+		ID          int64              `xorm:"pk autoincr"`
+		OwnerID     int64              `xorm:"INDEX NOT NULL"`
+		Name        string             `xorm:"NOT NULL"`
+		Query       string             `xorm:"TEXT NOT NULL"`
+		IsPinned    bool               `xorm:"NOT NULL DEFAULT false"`
+		CreatorID   int64              `xorm:"NOT NULL DEFAULT 0"`
+		CreatedUnix timeutil.TimeStamp `xorm:"created NOT NULL"`
+		UpdatedUnix timeutil.TimeStamp `xorm:"updated NOT NULL"`
+	}
+
+	type SavedSearchSubscription struct {
+		ID            int64              `xorm:"pk autoincr"`
+		SavedSearchID int64              `xorm:"UNIQUE(s) INDEX NOT NULL"`
+		UserID        int64              `xorm:"UNIQUE(s) INDEX NOT NULL"`
+		CreatedUnix   timeutil.TimeStamp `xorm:"created NOT NULL"`
+	}
+
+	return x.Sync(new(SavedSearch), new(SavedSearchSubscription))
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddSubIssueTable(x db.EngineMigration) error {
+	type SubIssue struct {
+		ID          int64              `xorm:"pk autoincr"`
+		ParentID    int64              `xorm:"INDEX NOT NULL"`
+		IssueID     int64              `xorm:"UNIQUE NOT NULL"`
+		Sort        int                `xorm:"NOT NULL DEFAULT 0"`
+		CreatorID   int64              `xorm:"NOT NULL"`
+		CreatedUnix timeutil.TimeStamp `xorm:"created"`
+	}
+
+	return x.Sync(new(SubIssue))
+}
@@ -0,0 +1,34 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddCustomFieldTables(x db.EngineMigration) error {
+	type CustomField struct {
+		ID          int64    `xorm:"pk autoincr"`
+		OrgID       int64    `xorm:"INDEX NOT NULL DEFAULT 0"`
+		RepoID      int64    `xorm:"INDEX NOT NULL DEFAULT 0"`
+		Name        string   `xorm:"NOT NULL"`
+		Description string   `xorm:"TEXT"`
+		Type        string   `xorm:"VARCHAR(20) NOT NULL"`
+		Options     []string `xorm:"TEXT JSON"`
+		Sort        int      `xorm:"NOT NULL DEFAULT 0"`
+
+		CreatedUnix timeutil.TimeStamp `xorm:"INDEX created"`
+		UpdatedUnix timeutil.TimeStamp `xorm:"INDEX updated"`
+	}
+
+	type CustomFieldValue struct {
+		ID      int64  `xorm:"pk autoincr"`
+		IssueID int64  `xorm:"INDEX NOT NULL"`
+		FieldID int64  `xorm:"INDEX NOT NULL"`
+		Value   string `xorm:"VARCHAR(1024) NOT NULL"`
+	}
+
+	return x.Sync(new(CustomField), new(CustomFieldValue))
+}
@@ -0,0 +1,30 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddIssueTypes(x db.EngineMigration) error {
+	type IssueType struct {
+		ID          int64  `xorm:"pk autoincr"`
+		OrgID       int64  `xorm:"INDEX NOT NULL"`
+		Name        string `xorm:"NOT NULL"`
+		Description string `xorm:"TEXT"`
+		Color       string `xorm:"VARCHAR(7)"`
+		Icon        string `xorm:"VARCHAR(50)"`
+		Sort        int    `xorm:"NOT NULL DEFAULT 0"`
+
+		CreatedUnix timeutil.TimeStamp `xorm:"INDEX created"`
+		UpdatedUnix timeutil.TimeStamp `xorm:"INDEX updated"`
+	}
+
+	type Issue struct {
+		TypeID int64 `xorm:"INDEX NOT NULL DEFAULT 0"`
+	}
+
+	return x.Sync(new(IssueType), new(Issue))
+}
@@ -0,0 +1,29 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddProjectViews(x db.EngineMigration) error {
+	type ProjectView struct {
+		ID        int64    `xorm:"pk autoincr"`
+		ProjectID int64    `xorm:"INDEX NOT NULL"`
+		Name      string   `xorm:"NOT NULL"`
+		Layout    string   `xorm:"VARCHAR(20) NOT NULL"`
+		Fields    []string `xorm:"TEXT JSON"`
+		GroupBy   string   `xorm:"VARCHAR(50)"`
+		SortBy    string   `xorm:"VARCHAR(50)"`
+		SortDesc  bool     `xorm:"NOT NULL DEFAULT false"`
+		Filter    string   `xorm:"TEXT"`
+		Sort      int      `xorm:"NOT NULL DEFAULT 0"`
+
+		CreatedUnix timeutil.TimeStamp `xorm:"INDEX created"`
+		UpdatedUnix timeutil.TimeStamp `xorm:"INDEX updated"`
+	}
+
+	return x.Sync(new(ProjectView))
+}
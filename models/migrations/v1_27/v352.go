@@ -0,0 +1,43 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddAutomationRules(x db.EngineMigration) error {
+	type AutomationRule struct {
+		ID               int64  `xorm:"pk autoincr"`
+		RepoID           int64  `xorm:"INDEX NOT NULL"`
+		ProjectID        int64  `xorm:"INDEX NOT NULL DEFAULT 0"`
+		Name             string `xorm:"NOT NULL"`
+		TriggerEvent     string `xorm:"VARCHAR(32) INDEX NOT NULL"`
+		ConditionLabelID int64  `xorm:"NOT NULL DEFAULT 0"`
+		InactiveDays     int    `xorm:"NOT NULL DEFAULT 0"`
+		Action           string `xorm:"VARCHAR(32) NOT NULL"`
+		ColumnID         int64  `xorm:"NOT NULL DEFAULT 0"`
+		LabelID          int64  `xorm:"NOT NULL DEFAULT 0"`
+		AssigneeID       int64  `xorm:"NOT NULL DEFAULT 0"`
+		IsActive         bool   `xorm:"INDEX NOT NULL DEFAULT true"`
+		CreatorID        int64  `xorm:"NOT NULL"`
+
+		CreatedUnix timeutil.TimeStamp `xorm:"INDEX created"`
+		UpdatedUnix timeutil.TimeStamp `xorm:"INDEX updated"`
+	}
+
+	type AutomationRuleLog struct {
+		ID      int64  `xorm:"pk autoincr"`
+		RuleID  int64  `xorm:"INDEX NOT NULL"`
+		RepoID  int64  `xorm:"INDEX NOT NULL"`
+		IssueID int64  `xorm:"NOT NULL"`
+		Success bool   `xorm:"NOT NULL DEFAULT false"`
+		Message string `xorm:"TEXT"`
+
+		CreatedUnix timeutil.TimeStamp `xorm:"INDEX created"`
+	}
+
+	return x.Sync(new(AutomationRule), new(AutomationRuleLog))
+}
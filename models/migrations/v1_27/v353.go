@@ -0,0 +1,34 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddSLAPolicies(x db.EngineMigration) error {
+	type SLAPolicy struct {
+		ID                 int64  `xorm:"pk autoincr"`
+		OrgID              int64  `xorm:"INDEX NOT NULL DEFAULT 0"`
+		RepoID             int64  `xorm:"INDEX NOT NULL DEFAULT 0"`
+		Name               string `xorm:"NOT NULL"`
+		LabelID            int64  `xorm:"INDEX NOT NULL"`
+		FirstResponseHours int    `xorm:"NOT NULL DEFAULT 0"`
+		ResolutionDays     int    `xorm:"NOT NULL DEFAULT 0"`
+
+		CreatedUnix timeutil.TimeStamp `xorm:"INDEX created"`
+		UpdatedUnix timeutil.TimeStamp `xorm:"INDEX updated"`
+	}
+
+	type SLAReminder struct {
+		ID          int64              `xorm:"pk autoincr"`
+		PolicyID    int64              `xorm:"UNIQUE(s) NOT NULL"`
+		IssueID     int64              `xorm:"UNIQUE(s) NOT NULL"`
+		Target      string             `xorm:"UNIQUE(s) VARCHAR(20) NOT NULL"`
+		CreatedUnix timeutil.TimeStamp `xorm:"created"`
+	}
+
+	return x.Sync(new(SLAPolicy), new(SLAReminder))
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddWebhookDeliveryRetries(x db.EngineMigration) error {
+	type HookTask struct {
+		Attempts        int                `xorm:"NOT NULL DEFAULT 0"`
+		NextAttemptUnix timeutil.TimeStamp `xorm:"INDEX NOT NULL DEFAULT 0"`
+		IsDeadLetter    bool               `xorm:"NOT NULL DEFAULT false"`
+		IsTest          bool               `xorm:"NOT NULL DEFAULT false"`
+	}
+	type Webhook struct {
+		FailureCount int `xorm:"NOT NULL DEFAULT 0"`
+	}
+	return x.Sync(new(HookTask), new(Webhook))
+}
@@ -0,0 +1,15 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+)
+
+func AddWebhookPayloadTemplate(x db.EngineMigration) error {
+	type Webhook struct {
+		PayloadTemplate string `xorm:"TEXT"`
+	}
+	return x.Sync(new(Webhook))
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddNotificationChannels(x db.EngineMigration) error {
+	type NotificationChannel struct {
+		ID             int64  `xorm:"pk autoincr"`
+		UserID         int64  `xorm:"INDEX NOT NULL"`
+		Name           string `xorm:"NOT NULL"`
+		Type           string `xorm:"VARCHAR(16) NOT NULL"`
+		URL            string `xorm:"TEXT NOT NULL"`
+		RoomID         string
+		TokenEncrypted string             `xorm:"TEXT"`
+		Reasons        []string           `xorm:"TEXT JSON"`
+		IsActive       bool               `xorm:"NOT NULL DEFAULT true"`
+		CreatedUnix    timeutil.TimeStamp `xorm:"created NOT NULL"`
+		UpdatedUnix    timeutil.TimeStamp `xorm:"updated NOT NULL"`
+	}
+	return x.Sync(new(NotificationChannel))
+}
@@ -0,0 +1,35 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddMailPreferencesAndDigest(x db.EngineMigration) error {
+	type MailPreference struct {
+		ID          int64              `xorm:"pk autoincr"`
+		UserID      int64              `xorm:"UNIQUE(s) INDEX NOT NULL"`
+		OwnerID     int64              `xorm:"UNIQUE(s) NOT NULL DEFAULT 0"`
+		RepoID      int64              `xorm:"UNIQUE(s) NOT NULL DEFAULT 0"`
+		Level       string             `xorm:"VARCHAR(20) NOT NULL DEFAULT ''"`
+		Events      map[string]string  `xorm:"TEXT JSON"`
+		UpdatedUnix timeutil.TimeStamp `xorm:"updated NOT NULL"`
+	}
+
+	type MailDigestItem struct {
+		ID          int64              `xorm:"pk autoincr"`
+		UserID      int64              `xorm:"INDEX NOT NULL"`
+		RepoID      int64              `xorm:"NOT NULL DEFAULT 0"`
+		IssueID     int64              `xorm:"NOT NULL DEFAULT 0"`
+		EventType   string             `xorm:"VARCHAR(20) NOT NULL"`
+		Subject     string             `xorm:"TEXT NOT NULL"`
+		Link        string             `xorm:"TEXT"`
+		DoerID      int64              `xorm:"NOT NULL DEFAULT 0"`
+		CreatedUnix timeutil.TimeStamp `xorm:"created INDEX NOT NULL"`
+	}
+
+	return x.Sync(new(MailPreference), new(MailDigestItem))
+}
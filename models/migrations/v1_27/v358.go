@@ -0,0 +1,23 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddPushSubscriptions(x db.EngineMigration) error {
+	type PushSubscription struct {
+		ID          int64              `xorm:"pk autoincr"`
+		UserID      int64              `xorm:"INDEX NOT NULL"`
+		Endpoint    string             `xorm:"TEXT NOT NULL"`
+		P256DH      string             `xorm:"'p256dh' VARCHAR(255) NOT NULL"`
+		Auth        string             `xorm:"VARCHAR(255) NOT NULL"`
+		UserAgent   string             `xorm:"VARCHAR(255)"`
+		CreatedUnix timeutil.TimeStamp `xorm:"created NOT NULL"`
+		UpdatedUnix timeutil.TimeStamp `xorm:"updated NOT NULL"`
+	}
+	return x.Sync(new(PushSubscription))
+}
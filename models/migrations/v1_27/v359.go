@@ -0,0 +1,27 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_27
+
+import (
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+)
+
+func AddReviewReminders(x db.EngineMigration) error {
+	type ReviewReminder struct {
+		ID           int64              `xorm:"pk autoincr"`
+		OwnerID      int64              `xorm:"INDEX NOT NULL"`
+		TeamID       int64              `xorm:"INDEX NOT NULL DEFAULT 0"`
+		Weekdays     int                `xorm:"NOT NULL DEFAULT 0"`
+		TimeOfDay    int                `xorm:"NOT NULL DEFAULT 0"`
+		TimeZone     string             `xorm:"VARCHAR(64)"`
+		SkipDrafts   bool               `xorm:"NOT NULL DEFAULT false"`
+		Delivery     string             `xorm:"VARCHAR(16) NOT NULL"`
+		WebhookURL   string             `xorm:"TEXT"`
+		LastSentUnix timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+		CreatedUnix  timeutil.TimeStamp `xorm:"created"`
+		UpdatedUnix  timeutil.TimeStamp `xorm:"updated"`
+	}
+	return x.Sync(new(ReviewReminder))
+}
@@ -33,6 +33,7 @@ import (
 	"gitea.dev/modules/packages/rpm"
 	"gitea.dev/modules/packages/rubygems"
 	"gitea.dev/modules/packages/swift"
+	terraform_registry "gitea.dev/modules/packages/terraform/registry"
 	"gitea.dev/modules/packages/vagrant"
 	"gitea.dev/modules/util"
 
@@ -214,6 +215,10 @@ func GetPackageDescriptorWithCache(ctx context.Context, pv *PackageVersion, c *c
 		metadata = &swift.Metadata{}
 	case TypeTerraformState:
 		// terraform packages have no metadata
+	case TypeTerraformModule:
+		metadata = &terraform_registry.ModuleMetadata{}
+	case TypeTerraformProvider:
+		metadata = &terraform_registry.ProviderMetadata{}
 	case TypeVagrant:
 		metadata = &vagrant.Metadata{}
 	default:
@@ -30,29 +30,31 @@ type Type string
 
 // List of supported packages
 const (
-	TypeAlpine         Type = "alpine"
-	TypeArch           Type = "arch"
-	TypeCargo          Type = "cargo"
-	TypeChef           Type = "chef"
-	TypeComposer       Type = "composer"
-	TypeConan          Type = "conan"
-	TypeConda          Type = "conda"
-	TypeContainer      Type = "container"
-	TypeCran           Type = "cran"
-	TypeDebian         Type = "debian"
-	TypeGeneric        Type = "generic"
-	TypeGo             Type = "go"
-	TypeHelm           Type = "helm"
-	TypeMaven          Type = "maven"
-	TypeNpm            Type = "npm"
-	TypeNuGet          Type = "nuget"
-	TypePub            Type = "pub"
-	TypePyPI           Type = "pypi"
-	TypeRpm            Type = "rpm"
-	TypeRubyGems       Type = "rubygems"
-	TypeSwift          Type = "swift"
-	TypeTerraformState Type = "terraform"
-	TypeVagrant        Type = "vagrant"
+	TypeAlpine            Type = "alpine"
+	TypeArch              Type = "arch"
+	TypeCargo             Type = "cargo"
+	TypeChef              Type = "chef"
+	TypeComposer          Type = "composer"
+	TypeConan             Type = "conan"
+	TypeConda             Type = "conda"
+	TypeContainer         Type = "container"
+	TypeCran              Type = "cran"
+	TypeDebian            Type = "debian"
+	TypeGeneric           Type = "generic"
+	TypeGo                Type = "go"
+	TypeHelm              Type = "helm"
+	TypeMaven             Type = "maven"
+	TypeNpm               Type = "npm"
+	TypeNuGet             Type = "nuget"
+	TypePub               Type = "pub"
+	TypePyPI              Type = "pypi"
+	TypeRpm               Type = "rpm"
+	TypeRubyGems          Type = "rubygems"
+	TypeSwift             Type = "swift"
+	TypeTerraformState    Type = "terraform"
+	TypeTerraformModule   Type = "terraform_module"
+	TypeTerraformProvider Type = "terraform_provider"
+	TypeVagrant           Type = "vagrant"
 )
 
 var TypeList = []Type{
@@ -78,6 +80,8 @@ var TypeList = []Type{
 	TypeRubyGems,
 	TypeSwift,
 	TypeTerraformState,
+	TypeTerraformModule,
+	TypeTerraformProvider,
 	TypeVagrant,
 }
 
@@ -128,6 +132,10 @@ func (pt Type) Name() string {
 		return "Swift"
 	case TypeTerraformState:
 		return "Terraform State"
+	case TypeTerraformModule:
+		return "Terraform Module"
+	case TypeTerraformProvider:
+		return "Terraform Provider"
 	case TypeVagrant:
 		return "Vagrant"
 	}
@@ -179,7 +187,7 @@ func (pt Type) SVGName() string {
 		return "gitea-rubygems"
 	case TypeSwift:
 		return "gitea-swift"
-	case TypeTerraformState:
+	case TypeTerraformState, TypeTerraformModule, TypeTerraformProvider:
 		return "gitea-terraform"
 	case TypeVagrant:
 		return "gitea-vagrant"
@@ -0,0 +1,120 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package packages
+
+import (
+	"context"
+	"strings"
+
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+
+	"xorm.io/builder"
+)
+
+func init() {
+	db.RegisterModel(new(PackageAuditEvent))
+}
+
+// PackageAuditAction is the action recorded by a package audit event
+type PackageAuditAction int
+
+const (
+	PackageAuditActionPublish PackageAuditAction = iota + 1 // 1
+	PackageAuditActionDelete                                // 2
+)
+
+func (a PackageAuditAction) String() string {
+	switch a {
+	case PackageAuditActionPublish:
+		return "publish"
+	case PackageAuditActionDelete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// PackageAuditActionFromString returns the action with the name, 0 if there is none
+func PackageAuditActionFromString(s string) PackageAuditAction {
+	for _, a := range []PackageAuditAction{PackageAuditActionPublish, PackageAuditActionDelete} {
+		if a.String() == s {
+			return a
+		}
+	}
+	return 0
+}
+
+// PackageAuditEvent records who published or deleted a package version.
+// The package and version names are stored denormalized because the event outlives the package version.
+type PackageAuditEvent struct {
+	ID            int64              `xorm:"pk autoincr"`
+	OwnerID       int64              `xorm:"INDEX NOT NULL"`
+	PackageID     int64              `xorm:"INDEX NOT NULL"`
+	VersionID     int64              `xorm:"INDEX NOT NULL"`
+	Type          Type               `xorm:"INDEX NOT NULL"`
+	Name          string             `xorm:"NOT NULL"`
+	LowerName     string             `xorm:"INDEX NOT NULL"`
+	Version       string             `xorm:"NOT NULL"`
+	LowerVersion  string             `xorm:"NOT NULL"`
+	Action        PackageAuditAction `xorm:"NOT NULL"`
+	DoerID        int64              `xorm:"NOT NULL DEFAULT 0"`
+	AccessTokenID int64              `xorm:"NOT NULL DEFAULT 0"` // the personal access token used, 0 if none
+	ActionsTaskID int64              `xorm:"NOT NULL DEFAULT 0"` // the actions task which authenticated, 0 if none
+	CreatedUnix   timeutil.TimeStamp `xorm:"created INDEX NOT NULL"`
+}
+
+// InsertAuditEvent inserts a package audit event
+func InsertAuditEvent(ctx context.Context, pae *PackageAuditEvent) error {
+	return db.Insert(ctx, pae)
+}
+
+// PackageAuditEventSearchOptions are options for SearchAuditEvents
+type PackageAuditEventSearchOptions struct {
+	OwnerID   int64
+	PackageID int64
+	VersionID int64
+	Type      Type
+	Name      string // matched exactly, case-insensitive
+	Version   string // matched exactly, case-insensitive
+	Action    PackageAuditAction
+	Paginator db.Paginator
+}
+
+func (opts *PackageAuditEventSearchOptions) ToConds() builder.Cond {
+	cond := builder.NewCond()
+	if opts.OwnerID != 0 {
+		cond = cond.And(builder.Eq{"owner_id": opts.OwnerID})
+	}
+	if opts.PackageID != 0 {
+		cond = cond.And(builder.Eq{"package_id": opts.PackageID})
+	}
+	if opts.VersionID != 0 {
+		cond = cond.And(builder.Eq{"version_id": opts.VersionID})
+	}
+	if opts.Type != "" {
+		cond = cond.And(builder.Eq{"type": opts.Type})
+	}
+	if opts.Name != "" {
+		cond = cond.And(builder.Eq{"lower_name": strings.ToLower(opts.Name)})
+	}
+	if opts.Version != "" {
+		cond = cond.And(builder.Eq{"lower_version": strings.ToLower(opts.Version)})
+	}
+	if opts.Action != 0 {
+		cond = cond.And(builder.Eq{"action": opts.Action})
+	}
+	return cond
+}
+
+// SearchAuditEvents gets the audit events matching the search options, newest first
+func SearchAuditEvents(ctx context.Context, opts *PackageAuditEventSearchOptions) ([]*PackageAuditEvent, int64, error) {
+	sess := db.GetEngine(ctx).Where(opts.ToConds()).OrderBy("created_unix DESC, id DESC")
+	if opts.Paginator != nil {
+		db.SetSessionPagination(sess, opts.Paginator)
+	}
+
+	paes := make([]*PackageAuditEvent, 0, 10)
+	count, err := sess.FindAndCount(&paes)
+	return paes, count, err
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package packages
+
+import (
+	"context"
+	"time"
+
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+
+	"xorm.io/builder"
+)
+
+func init() {
+	db.RegisterModel(new(PackageDownloadStat))
+}
+
+// PackageDownloadStat represents the number of downloads of a package version on a single day (UTC)
+type PackageDownloadStat struct {
+	ID        int64              `xorm:"pk autoincr"`
+	VersionID int64              `xorm:"UNIQUE(s) INDEX NOT NULL"`
+	Day       timeutil.TimeStamp `xorm:"UNIQUE(s) INDEX NOT NULL"`
+	Count     int64              `xorm:"NOT NULL DEFAULT 0"`
+}
+
+// DownloadStatDay returns the start of the UTC day of the time
+func DownloadStatDay(t time.Time) timeutil.TimeStamp {
+	return timeutil.TimeStamp(t.UTC().Truncate(24 * time.Hour).Unix())
+}
+
+// IncrementDownloadStat increments the download counter of a version for the current day
+func IncrementDownloadStat(ctx context.Context, versionID int64) error {
+	day := DownloadStatDay(time.Now())
+
+	increment := func() (bool, error) {
+		res, err := db.GetEngine(ctx).Exec("UPDATE `package_download_stat` SET `count` = `count` + 1 WHERE `version_id` = ? AND `day` = ?", versionID, day)
+		if err != nil {
+			return false, err
+		}
+		n, err := res.RowsAffected()
+		return n != 0, err
+	}
+
+	if updated, err := increment(); err != nil || updated {
+		return err
+	}
+	if _, err := db.GetEngine(ctx).Insert(&PackageDownloadStat{VersionID: versionID, Day: day, Count: 1}); err != nil {
+		// a concurrent request may have inserted the row in the meantime
+		if updated, err2 := increment(); err2 != nil || !updated {
+			return err
+		}
+	}
+	return nil
+}
+
+// PackageDownloadStatSearchOptions are options for GetDownloadStats
+type PackageDownloadStatSearchOptions struct {
+	VersionIDs []int64
+	Since      timeutil.TimeStamp // inclusive
+	Before     timeutil.TimeStamp // exclusive
+}
+
+func (opts *PackageDownloadStatSearchOptions) ToConds() builder.Cond {
+	cond := builder.NewCond()
+	if len(opts.VersionIDs) != 0 {
+		cond = cond.And(builder.In("version_id", opts.VersionIDs))
+	}
+	if opts.Since != 0 {
+		cond = cond.And(builder.Gte{"day": opts.Since})
+	}
+	if opts.Before != 0 {
+		cond = cond.And(builder.Lt{"day": opts.Before})
+	}
+	return cond
+}
+
+// GetDownloadStats gets the daily download counts matching the search options ordered by day
+func GetDownloadStats(ctx context.Context, opts *PackageDownloadStatSearchOptions) ([]*PackageDownloadStat, error) {
+	stats := make([]*PackageDownloadStat, 0, 30)
+	return stats, db.GetEngine(ctx).Where(opts.ToConds()).OrderBy("day ASC, version_id ASC").Find(&stats)
+}
+
+// DeleteDownloadStatsByVersionID deletes all download stats of a version
+func DeleteDownloadStatsByVersionID(ctx context.Context, versionID int64) error {
+	_, err := db.GetEngine(ctx).Where(builder.Eq{"version_id": versionID}).Delete(&PackageDownloadStat{})
+	return err
+}
+
+// DeleteDownloadStatsByPackageID deletes all download stats of all versions of a package
+func DeleteDownloadStatsByPackageID(ctx context.Context, packageID int64) error {
+	in := builder.
+		Select("package_version.id").
+		From("package_version").
+		Where(builder.Eq{"package_version.package_id": packageID})
+
+	_, err := db.GetEngine(ctx).Where(builder.In("version_id", in)).Delete(&PackageDownloadStat{})
+	return err
+}
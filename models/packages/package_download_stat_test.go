@@ -0,0 +1,69 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package packages_test
+
+import (
+	"testing"
+	"time"
+
+	packages_model "gitea.dev/models/packages"
+	"gitea.dev/models/unittest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncrementDownloadStat(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	for range 3 {
+		assert.NoError(t, packages_model.IncrementDownloadStat(t.Context(), 1))
+	}
+	assert.NoError(t, packages_model.IncrementDownloadStat(t.Context(), 2))
+
+	stats, err := packages_model.GetDownloadStats(t.Context(), &packages_model.PackageDownloadStatSearchOptions{VersionIDs: []int64{1}})
+	assert.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.EqualValues(t, 3, stats[0].Count)
+	assert.Equal(t, packages_model.DownloadStatDay(time.Now()), stats[0].Day)
+
+	stats, err = packages_model.GetDownloadStats(t.Context(), &packages_model.PackageDownloadStatSearchOptions{
+		Before: packages_model.DownloadStatDay(time.Now()),
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, stats)
+
+	assert.NoError(t, packages_model.DeleteDownloadStatsByVersionID(t.Context(), 1))
+
+	stats, err = packages_model.GetDownloadStats(t.Context(), &packages_model.PackageDownloadStatSearchOptions{})
+	assert.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.EqualValues(t, 2, stats[0].VersionID)
+}
+
+func TestSearchAuditEvents(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	for _, pae := range []*packages_model.PackageAuditEvent{
+		{OwnerID: 2, PackageID: 1, VersionID: 1, Type: packages_model.TypeNpm, Name: "Lib", LowerName: "lib", Version: "1.0.0", LowerVersion: "1.0.0", Action: packages_model.PackageAuditActionPublish, DoerID: 2},
+		{OwnerID: 2, PackageID: 1, VersionID: 1, Type: packages_model.TypeNpm, Name: "Lib", LowerName: "lib", Version: "1.0.0", LowerVersion: "1.0.0", Action: packages_model.PackageAuditActionDelete, DoerID: 2},
+		{OwnerID: 3, PackageID: 2, VersionID: 2, Type: packages_model.TypeGeneric, Name: "other", LowerName: "other", Version: "1.0.0", LowerVersion: "1.0.0", Action: packages_model.PackageAuditActionPublish, DoerID: 3},
+	} {
+		assert.NoError(t, packages_model.InsertAuditEvent(t.Context(), pae))
+	}
+
+	paes, count, err := packages_model.SearchAuditEvents(t.Context(), &packages_model.PackageAuditEventSearchOptions{OwnerID: 2, Name: "LIB"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+	require.Len(t, paes, 2)
+	assert.Equal(t, packages_model.PackageAuditActionDelete, paes[0].Action)
+
+	_, count, err = packages_model.SearchAuditEvents(t.Context(), &packages_model.PackageAuditEventSearchOptions{OwnerID: 2, Action: packages_model.PackageAuditActionPublish})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+
+	_, count, err = packages_model.SearchAuditEvents(t.Context(), &packages_model.PackageAuditEventSearchOptions{OwnerID: 2, Type: packages_model.TypeGeneric})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, count)
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package packages
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"xorm.io/builder"
+)
+
+var (
+	ErrPackageProtectionRuleNotExist  = util.NewNotExistErrorf("package protection rule does not exist")
+	ErrDuplicatePackageProtectionRule = util.NewAlreadyExistErrorf("package protection rule already exists")
+)
+
+func init() {
+	db.RegisterModel(new(PackageProtectionRule))
+}
+
+// PackageProtectionRule represents a rule which describes which package versions of an owner must not be
+// overwritten or deleted. An empty Type or PackageName matches all package types or all packages.
+type PackageProtectionRule struct {
+	ID                      int64              `xorm:"pk autoincr"`
+	OwnerID                 int64              `xorm:"UNIQUE(s) INDEX NOT NULL DEFAULT 0"`
+	Type                    Type               `xorm:"UNIQUE(s) NOT NULL DEFAULT ''"`
+	PackageName             string             `xorm:"UNIQUE(s) NOT NULL DEFAULT ''"`
+	Immutable               bool               `xorm:"NOT NULL DEFAULT false"`
+	ProtectedPattern        string             `xorm:"NOT NULL DEFAULT ''"`
+	ProtectedPatternMatcher *regexp.Regexp     `xorm:"-"`
+	CreatedUnix             timeutil.TimeStamp `xorm:"created NOT NULL DEFAULT 0"`
+	UpdatedUnix             timeutil.TimeStamp `xorm:"updated NOT NULL DEFAULT 0"`
+}
+
+func (ppr *PackageProtectionRule) CompiledPattern() error {
+	if ppr.ProtectedPatternMatcher != nil || ppr.ProtectedPattern == "" {
+		return nil
+	}
+
+	var err error
+	ppr.ProtectedPatternMatcher, err = regexp.Compile(fmt.Sprintf(`(?i)\A%s\z`, ppr.ProtectedPattern))
+	return err
+}
+
+// AppliesTo checks if the rule is relevant for the package
+func (ppr *PackageProtectionRule) AppliesTo(p *Package) bool {
+	if p.IsInternal || ppr.OwnerID != p.OwnerID {
+		return false
+	}
+	if ppr.Type != "" && ppr.Type != p.Type {
+		return false
+	}
+	return ppr.PackageName == "" || ppr.PackageName == p.LowerName
+}
+
+// IsVersionProtected checks if the rule protects the package version.
+// CompiledPattern must have been called before.
+func (ppr *PackageProtectionRule) IsVersionProtected(p *Package, pv *PackageVersion) bool {
+	if pv.IsInternal || !ppr.AppliesTo(p) {
+		return false
+	}
+	if ppr.Immutable {
+		return true
+	}
+	return ppr.ProtectedPatternMatcher != nil && ppr.ProtectedPatternMatcher.MatchString(pv.LowerVersion)
+}
+
+func InsertProtectionRule(ctx context.Context, ppr *PackageProtectionRule) (*PackageProtectionRule, error) {
+	ppr.PackageName = strings.ToLower(ppr.PackageName)
+
+	has, err := db.GetEngine(ctx).Where(builder.Eq{
+		"owner_id":     ppr.OwnerID,
+		"type":         ppr.Type,
+		"package_name": ppr.PackageName,
+	}).Exist(&PackageProtectionRule{})
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, ErrDuplicatePackageProtectionRule
+	}
+	return ppr, db.Insert(ctx, ppr)
+}
+
+func GetProtectionRuleByID(ctx context.Context, id int64) (*PackageProtectionRule, error) {
+	ppr := &PackageProtectionRule{}
+
+	has, err := db.GetEngine(ctx).ID(id).Get(ppr)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, ErrPackageProtectionRuleNotExist
+	}
+	return ppr, nil
+}
+
+func UpdateProtectionRule(ctx context.Context, ppr *PackageProtectionRule) error {
+	_, err := db.GetEngine(ctx).ID(ppr.ID).Cols("immutable", "protected_pattern").Update(ppr)
+	return err
+}
+
+func GetProtectionRulesByOwner(ctx context.Context, ownerID int64) ([]*PackageProtectionRule, error) {
+	pprs := make([]*PackageProtectionRule, 0, 10)
+	return pprs, db.GetEngine(ctx).Where("owner_id = ?", ownerID).OrderBy("id").Find(&pprs)
+}
+
+// GetProtectionRulesForPackage returns all rules which apply to the package with compiled patterns
+func GetProtectionRulesForPackage(ctx context.Context, p *Package) ([]*PackageProtectionRule, error) {
+	if p.IsInternal {
+		return nil, nil
+	}
+
+	pprs := make([]*PackageProtectionRule, 0, 2)
+	err := db.GetEngine(ctx).
+		Where(builder.Eq{"owner_id": p.OwnerID}).
+		And(builder.In("type", "", p.Type)).
+		And(builder.In("package_name", "", p.LowerName)).
+		Find(&pprs)
+	if err != nil {
+		return nil, err
+	}
+	for _, ppr := range pprs {
+		if err := ppr.CompiledPattern(); err != nil {
+			return nil, fmt.Errorf("ProtectionRule [%d]: CompiledPattern failed: %w", ppr.ID, err)
+		}
+	}
+	return pprs, nil
+}
+
+// IsPackageVersionProtected checks if any protection rule of the package owner protects the version
+func IsPackageVersionProtected(ctx context.Context, p *Package, pv *PackageVersion) (bool, error) {
+	if pv.IsInternal {
+		return false, nil
+	}
+
+	pprs, err := GetProtectionRulesForPackage(ctx, p)
+	if err != nil {
+		return false, err
+	}
+	for _, ppr := range pprs {
+		if ppr.IsVersionProtected(p, pv) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func DeleteProtectionRuleByID(ctx context.Context, ruleID int64) error {
+	_, err := db.GetEngine(ctx).ID(ruleID).Delete(&PackageProtectionRule{})
+	return err
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package packages_test
+
+import (
+	"testing"
+
+	packages_model "gitea.dev/models/packages"
+	"gitea.dev/models/unittest"
+	"gitea.dev/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageProtectionRules(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	p := &packages_model.Package{OwnerID: 2, Type: packages_model.TypeMaven, LowerName: "com.example:lib"}
+
+	_, err := packages_model.InsertProtectionRule(t.Context(), &packages_model.PackageProtectionRule{
+		OwnerID:          2,
+		Type:             packages_model.TypeMaven,
+		ProtectedPattern: `\d+\.\d+\.\d+`,
+	})
+	require.NoError(t, err)
+
+	_, err = packages_model.InsertProtectionRule(t.Context(), &packages_model.PackageProtectionRule{
+		OwnerID: 2,
+		Type:    packages_model.TypeMaven,
+	})
+	assert.ErrorIs(t, err, util.ErrAlreadyExist)
+
+	protected, err := packages_model.IsPackageVersionProtected(t.Context(), p, &packages_model.PackageVersion{LowerVersion: "1.2.3"})
+	assert.NoError(t, err)
+	assert.True(t, protected)
+
+	protected, err = packages_model.IsPackageVersionProtected(t.Context(), p, &packages_model.PackageVersion{LowerVersion: "1.2.3-snapshot"})
+	assert.NoError(t, err)
+	assert.False(t, protected)
+
+	protected, err = packages_model.IsPackageVersionProtected(t.Context(), p, &packages_model.PackageVersion{LowerVersion: "1.2.3", IsInternal: true})
+	assert.NoError(t, err)
+	assert.False(t, protected)
+
+	other := &packages_model.Package{OwnerID: 2, Type: packages_model.TypeNpm, LowerName: "lib"}
+	protected, err = packages_model.IsPackageVersionProtected(t.Context(), other, &packages_model.PackageVersion{LowerVersion: "1.2.3"})
+	assert.NoError(t, err)
+	assert.False(t, protected)
+
+	ppr, err := packages_model.InsertProtectionRule(t.Context(), &packages_model.PackageProtectionRule{
+		OwnerID:     2,
+		PackageName: "LIB",
+		Immutable:   true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "lib", ppr.PackageName)
+
+	protected, err = packages_model.IsPackageVersionProtected(t.Context(), other, &packages_model.PackageVersion{LowerVersion: "anything"})
+	assert.NoError(t, err)
+	assert.True(t, protected)
+
+	pprs, err := packages_model.GetProtectionRulesByOwner(t.Context(), 2)
+	assert.NoError(t, err)
+	assert.Len(t, pprs, 2)
+
+	assert.NoError(t, packages_model.DeleteProtectionRuleByID(t.Context(), ppr.ID))
+
+	_, err = packages_model.GetProtectionRuleByID(t.Context(), ppr.ID)
+	assert.ErrorIs(t, err, util.ErrNotExist)
+}
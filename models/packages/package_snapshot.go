@@ -0,0 +1,149 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package packages
+
+import (
+	"context"
+	"strings"
+
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"xorm.io/builder"
+)
+
+var (
+	ErrPackageSnapshotNotExist  = util.NewNotExistErrorf("package snapshot does not exist")
+	ErrDuplicatePackageSnapshot = util.NewAlreadyExistErrorf("package snapshot already exists")
+)
+
+func init() {
+	db.RegisterModel(new(PackageSnapshot))
+	db.RegisterModel(new(PackageSnapshotFile))
+}
+
+// PackageSnapshot represents an immutable copy of the repository index of a Debian distribution
+// (optionally restricted to a single component) or of a RPM group
+type PackageSnapshot struct {
+	ID           int64              `xorm:"pk autoincr"`
+	OwnerID      int64              `xorm:"UNIQUE(s) INDEX NOT NULL"`
+	Type         Type               `xorm:"UNIQUE(s) NOT NULL"`
+	Name         string             `xorm:"NOT NULL"`
+	LowerName    string             `xorm:"UNIQUE(s) NOT NULL"`
+	Distribution string             `xorm:"NOT NULL DEFAULT ''"`
+	Component    string             `xorm:"NOT NULL DEFAULT ''"`
+	GroupName    string             `xorm:"NOT NULL DEFAULT ''"`
+	CreatorID    int64              `xorm:"NOT NULL DEFAULT 0"`
+	CreatedUnix  timeutil.TimeStamp `xorm:"created NOT NULL"`
+}
+
+// InternalVersion returns the name of the internal package version which stores the index files of the snapshot
+func (ps *PackageSnapshot) InternalVersion() string {
+	return "_snapshot_" + ps.LowerName
+}
+
+// PackageSnapshotFile references a package file contained in a snapshot
+type PackageSnapshotFile struct {
+	ID         int64 `xorm:"pk autoincr"`
+	SnapshotID int64 `xorm:"UNIQUE(s) INDEX NOT NULL"`
+	FileID     int64 `xorm:"UNIQUE(s) NOT NULL"`
+	VersionID  int64 `xorm:"INDEX NOT NULL"`
+}
+
+// InsertSnapshot inserts a snapshot. If a snapshot with the same name exists ErrDuplicatePackageSnapshot is returned
+func InsertSnapshot(ctx context.Context, ps *PackageSnapshot) error {
+	ps.LowerName = strings.ToLower(ps.Name)
+
+	has, err := db.GetEngine(ctx).Exist(&PackageSnapshot{
+		OwnerID:   ps.OwnerID,
+		Type:      ps.Type,
+		LowerName: ps.LowerName,
+	})
+	if err != nil {
+		return err
+	}
+	if has {
+		return ErrDuplicatePackageSnapshot
+	}
+
+	_, err = db.GetEngine(ctx).Insert(ps)
+	return err
+}
+
+// InsertSnapshotFiles records the package files contained in the snapshot
+func InsertSnapshotFiles(ctx context.Context, snapshotID int64, pfs []*PackageFile) error {
+	psfs := make([]*PackageSnapshotFile, 0, len(pfs))
+	for _, pf := range pfs {
+		psfs = append(psfs, &PackageSnapshotFile{
+			SnapshotID: snapshotID,
+			FileID:     pf.ID,
+			VersionID:  pf.VersionID,
+		})
+	}
+	return db.Insert(ctx, psfs)
+}
+
+func GetSnapshotByID(ctx context.Context, snapshotID int64) (*PackageSnapshot, error) {
+	ps := &PackageSnapshot{}
+
+	has, err := db.GetEngine(ctx).ID(snapshotID).Get(ps)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, ErrPackageSnapshotNotExist
+	}
+	return ps, nil
+}
+
+func GetSnapshotByName(ctx context.Context, ownerID int64, packageType Type, name string) (*PackageSnapshot, error) {
+	ps := &PackageSnapshot{}
+
+	has, err := db.GetEngine(ctx).Where(builder.Eq{
+		"owner_id":   ownerID,
+		"type":       packageType,
+		"lower_name": strings.ToLower(name),
+	}).Get(ps)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, ErrPackageSnapshotNotExist
+	}
+	return ps, nil
+}
+
+// GetSnapshotsByOwner gets the snapshots of the owner, optionally filtered by package type
+func GetSnapshotsByOwner(ctx context.Context, ownerID int64, packageType Type) ([]*PackageSnapshot, error) {
+	cond := builder.NewCond().And(builder.Eq{"owner_id": ownerID})
+	if packageType != "" {
+		cond = cond.And(builder.Eq{"type": packageType})
+	}
+
+	snapshots := make([]*PackageSnapshot, 0, 10)
+	return snapshots, db.GetEngine(ctx).Where(cond).OrderBy("type ASC, lower_name ASC").Find(&snapshots)
+}
+
+// GetSnapshotFileIDs gets the ids of the package files contained in the snapshot
+func GetSnapshotFileIDs(ctx context.Context, snapshotID int64) ([]int64, error) {
+	ids := make([]int64, 0, 10)
+	return ids, db.GetEngine(ctx).Table("package_snapshot_file").Where("snapshot_id = ?", snapshotID).Cols("file_id").Find(&ids)
+}
+
+// IsVersionInSnapshot checks if a file of the package version is contained in a snapshot
+func IsVersionInSnapshot(ctx context.Context, versionID int64) (bool, error) {
+	return db.GetEngine(ctx).Exist(&PackageSnapshotFile{VersionID: versionID})
+}
+
+// DeleteSnapshotByID deletes the snapshot and its file references
+func DeleteSnapshotByID(ctx context.Context, snapshotID int64) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.GetEngine(ctx).Where("snapshot_id = ?", snapshotID).Delete(&PackageSnapshotFile{}); err != nil {
+			return err
+		}
+		_, err := db.GetEngine(ctx).ID(snapshotID).Delete(&PackageSnapshot{})
+		return err
+	})
+}
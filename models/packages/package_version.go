@@ -150,6 +150,36 @@ func GetVersionsByPackageName(ctx context.Context, ownerID int64, packageType Ty
 	return pvs, err
 }
 
+// IterateVersionsByType calls f with the non-internal versions of the package type in batches ordered by id
+func IterateVersionsByType(ctx context.Context, packageType Type, batchSize int, f func(ctx context.Context, pvs []*PackageVersion) error) error {
+	var lastID int64
+	for {
+		pvs := make([]*PackageVersion, 0, batchSize)
+		err := db.GetEngine(ctx).
+			Select("package_version.*").
+			Table("package_version").
+			Join("INNER", "package", "package.id = package_version.package_id").
+			Where(builder.Eq{"package.type": packageType, "package_version.is_internal": false}).
+			And(builder.Gt{"package_version.id": lastID}).
+			Asc("package_version.id").
+			Limit(batchSize).
+			Find(&pvs)
+		if err != nil {
+			return err
+		}
+		if len(pvs) == 0 {
+			return nil
+		}
+		if err := f(ctx, pvs); err != nil {
+			return err
+		}
+		if len(pvs) < batchSize {
+			return nil
+		}
+		lastID = pvs[len(pvs)-1].ID
+	}
+}
+
 // DeleteVersionByID deletes a version by id
 func DeleteVersionByID(ctx context.Context, versionID int64) error {
 	_, err := db.GetEngine(ctx).ID(versionID).Delete(&PackageVersion{})
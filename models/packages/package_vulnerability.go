@@ -0,0 +1,73 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package packages
+
+import (
+	"context"
+
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+
+	"xorm.io/builder"
+)
+
+func init() {
+	db.RegisterModel(new(PackageVulnerability))
+}
+
+// PackageVulnerability represents an advisory which affects a package version itself or one of its dependencies
+type PackageVulnerability struct {
+	ID                int64              `xorm:"pk autoincr"`
+	VersionID         int64              `xorm:"INDEX NOT NULL"`
+	AdvisoryID        string             `xorm:"INDEX NOT NULL"`
+	Summary           string             `xorm:"TEXT"`
+	Severity          string             `xorm:"NOT NULL DEFAULT ''"`
+	DependencyName    string             `xorm:"NOT NULL DEFAULT ''"` // empty if the package version itself is affected
+	DependencyVersion string             `xorm:"NOT NULL DEFAULT ''"`
+	CreatedUnix       timeutil.TimeStamp `xorm:"created NOT NULL"`
+}
+
+// IsDirect returns true if the package version itself is affected
+func (pv *PackageVulnerability) IsDirect() bool {
+	return pv.DependencyName == ""
+}
+
+// GetVulnerabilitiesByVersionID gets all vulnerabilities of a package version
+func GetVulnerabilitiesByVersionID(ctx context.Context, versionID int64) ([]*PackageVulnerability, error) {
+	pvs := make([]*PackageVulnerability, 0, 5)
+	return pvs, db.GetEngine(ctx).Where(builder.Eq{"version_id": versionID}).OrderBy("advisory_id, dependency_name").Find(&pvs)
+}
+
+// ReplaceVulnerabilitiesOfVersion replaces the stored vulnerabilities of a package version
+func ReplaceVulnerabilitiesOfVersion(ctx context.Context, versionID int64, pvs []*PackageVulnerability) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if err := DeleteVulnerabilitiesByVersionID(ctx, versionID); err != nil {
+			return err
+		}
+		if len(pvs) == 0 {
+			return nil
+		}
+		for _, pv := range pvs {
+			pv.VersionID = versionID
+		}
+		return db.Insert(ctx, pvs)
+	})
+}
+
+// DeleteVulnerabilitiesByVersionID deletes all vulnerabilities of a package version
+func DeleteVulnerabilitiesByVersionID(ctx context.Context, versionID int64) error {
+	_, err := db.GetEngine(ctx).Where(builder.Eq{"version_id": versionID}).Delete(&PackageVulnerability{})
+	return err
+}
+
+// DeleteVulnerabilitiesByPackageID deletes all vulnerabilities of all versions of a package
+func DeleteVulnerabilitiesByPackageID(ctx context.Context, packageID int64) error {
+	in := builder.
+		Select("package_version.id").
+		From("package_version").
+		Where(builder.Eq{"package_version.package_id": packageID})
+
+	_, err := db.GetEngine(ctx).Where(builder.In("version_id", in)).Delete(&PackageVulnerability{})
+	return err
+}
@@ -33,6 +33,19 @@ func deleteProjectIssuesByProjectID(ctx context.Context, projectID int64) error
 	return err
 }
 
+// GetProjectIssueMap returns the column and the sorting of the issues of a project, the key is the ID of the issue
+func GetProjectIssueMap(ctx context.Context, projectID int64) (map[int64]*ProjectIssue, error) {
+	projectIssues := make([]*ProjectIssue, 0, 10)
+	if err := db.GetEngine(ctx).Where("project_id=?", projectID).Find(&projectIssues); err != nil {
+		return nil, err
+	}
+	result := make(map[int64]*ProjectIssue, len(projectIssues))
+	for _, projectIssue := range projectIssues {
+		result[projectIssue.IssueID] = projectIssue
+	}
+	return result, nil
+}
+
 // GetColumnIssueNextSorting returns the sorting value to append an issue at the end of the column.
 func GetColumnIssueNextSorting(ctx context.Context, projectID, columnID int64) (int64, error) {
 	res := struct {
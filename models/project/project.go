@@ -341,6 +341,23 @@ func GetAllProjectsIDsByOwnerIDAndType(ctx context.Context, ownerID int64, proje
 	return projects, db.GetEngine(ctx).Table(&Project{}).Where("owner_id=? AND type=?", ownerID, projectType).Cols("id").Find(&projects)
 }
 
+// GetProjectIDsInReposByTitle returns the IDs of the projects with the given title which belong to the repositories or to their owners.
+// The title is compared case-insensitively, all projects with the title are returned if no repository is given.
+func GetProjectIDsInReposByTitle(ctx context.Context, repoIDs []int64, title string) ([]int64, error) {
+	cond := db.BuildCaseInsensitiveIn("title", []string{title})
+	if len(repoIDs) > 0 {
+		cond = cond.And(builder.Or(
+			builder.In("repo_id", repoIDs),
+			builder.Eq{"repo_id": 0}.And(builder.In("owner_id", builder.Select("owner_id").From("repository").Where(builder.In("id", repoIDs)))),
+		))
+	}
+	var ids []int64
+	return ids, db.GetEngine(ctx).Table("project").
+		Where(cond).
+		Cols("id").
+		Find(&ids)
+}
+
 // UpdateProject updates project properties
 func UpdateProject(ctx context.Context, p *Project) error {
 	if !IsCardTypeValid(p.CardType) {
@@ -435,6 +452,10 @@ func DeleteProjectByID(ctx context.Context, id int64) error {
 			return err
 		}
 
+		if err := deleteViewsByProjectID(ctx, id); err != nil {
+			return err
+		}
+
 		if _, err = db.GetEngine(ctx).ID(p.ID).Delete(new(Project)); err != nil {
 			return err
 		}
@@ -444,6 +465,10 @@ func DeleteProjectByID(ctx context.Context, id int64) error {
 }
 
 func DeleteProjectByRepoID(ctx context.Context, repoID int64) error {
+	if err := deleteViewsByRepoID(ctx, repoID); err != nil {
+		return err
+	}
+
 	switch {
 	case setting.Database.Type.IsSQLite3():
 		if _, err := db.GetEngine(ctx).Exec("DELETE FROM project_issue WHERE project_issue.id IN (SELECT project_issue.id FROM project_issue INNER JOIN project WHERE project.id = project_issue.project_id AND project.repo_id = ?)", repoID); err != nil {
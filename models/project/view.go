@@ -0,0 +1,236 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"xorm.io/builder"
+)
+
+// ViewLayout is the layout of a project view, the board of the project's columns is always available and not stored as a view
+type ViewLayout string
+
+const (
+	// ViewLayoutTable lists the items of the project in a table with selectable columns
+	ViewLayoutTable ViewLayout = "table"
+	// ViewLayoutRoadmap lists the items of the project by milestone or by deadline
+	ViewLayoutRoadmap ViewLayout = "roadmap"
+)
+
+// The fields of the items which can be shown, grouped or sorted by a project view.
+// Custom fields are referred to by ViewCustomFieldKey.
+const (
+	ViewFieldStatus     = "status" // the column of the item on the project board
+	ViewFieldAssignees  = "assignees"
+	ViewFieldLabels     = "labels"
+	ViewFieldMilestone  = "milestone"
+	ViewFieldType       = "type"
+	ViewFieldDeadline   = "deadline"
+	ViewFieldRepository = "repository"
+	ViewFieldCreated    = "created"
+	ViewFieldUpdated    = "updated"
+	ViewFieldTitle      = "title"
+	ViewFieldNumber     = "number"
+)
+
+const viewCustomFieldPrefix = "field:"
+
+// ViewFields are the fields which can be shown as columns of a table view
+var ViewFields = []string{ViewFieldStatus, ViewFieldAssignees, ViewFieldLabels, ViewFieldMilestone, ViewFieldType, ViewFieldDeadline, ViewFieldRepository, ViewFieldUpdated}
+
+// ViewGroupFields are the fields which can be used to group the items of a table view, a roadmap is grouped by milestone or deadline
+var ViewGroupFields = []string{ViewFieldStatus, ViewFieldAssignees, ViewFieldMilestone, ViewFieldType, ViewFieldRepository}
+
+// ViewSortFields are the fields which can be used to sort the items of a view, they are in the order of the board by default
+var ViewSortFields = []string{ViewFieldNumber, ViewFieldTitle, ViewFieldCreated, ViewFieldUpdated, ViewFieldDeadline}
+
+// ViewCustomFieldKey returns the key of a custom field used by the fields, the grouping and the sorting of views
+func ViewCustomFieldKey(fieldID int64) string {
+	return viewCustomFieldPrefix + strconv.FormatInt(fieldID, 10)
+}
+
+// ParseViewCustomFieldKey returns the ID of the custom field referred to by the key, or zero if it doesn't refer to a custom field
+func ParseViewCustomFieldKey(key string) int64 {
+	s, ok := strings.CutPrefix(key, viewCustomFieldPrefix)
+	if !ok {
+		return 0
+	}
+	id, _ := strconv.ParseInt(s, 10, 64)
+	return max(id, 0)
+}
+
+// ErrProjectViewNotExist represents a "ProjectViewNotExist" kind of error.
+type ErrProjectViewNotExist struct {
+	ID int64
+}
+
+// IsErrProjectViewNotExist checks if an error is a ErrProjectViewNotExist.
+func IsErrProjectViewNotExist(err error) bool {
+	_, ok := err.(ErrProjectViewNotExist)
+	return ok
+}
+
+func (err ErrProjectViewNotExist) Error() string {
+	return fmt.Sprintf("project view does not exist [id: %d]", err.ID)
+}
+
+func (err ErrProjectViewNotExist) Unwrap() error {
+	return util.ErrNotExist
+}
+
+// View is a saved way to show the items of a project besides its board
+type View struct {
+	ID        int64      `xorm:"pk autoincr"`
+	ProjectID int64      `xorm:"INDEX NOT NULL"`
+	Name      string     `xorm:"NOT NULL"`
+	Layout    ViewLayout `xorm:"VARCHAR(20) NOT NULL"`
+	// Fields are the columns of a table view
+	Fields   []string `xorm:"TEXT JSON"`
+	GroupBy  string   `xorm:"VARCHAR(50)"`
+	SortBy   string   `xorm:"VARCHAR(50)"`
+	SortDesc bool     `xorm:"NOT NULL DEFAULT false"`
+	// Filter is an issue search query like "is:open label:bug", the items of the view have to match it
+	Filter string `xorm:"TEXT"`
+	Sort   int    `xorm:"NOT NULL DEFAULT 0"`
+
+	CreatedUnix timeutil.TimeStamp `xorm:"INDEX created"`
+	UpdatedUnix timeutil.TimeStamp `xorm:"INDEX updated"`
+}
+
+// TableName return the real table name
+func (View) TableName() string {
+	return "project_view"
+}
+
+func init() {
+	db.RegisterModel(new(View))
+}
+
+// IsTable returns whether the view lists the items in a table
+func (v *View) IsTable() bool {
+	return v.Layout == ViewLayoutTable
+}
+
+// IsRoadmap returns whether the view lists the items by milestone or by deadline
+func (v *View) IsRoadmap() bool {
+	return v.Layout == ViewLayoutRoadmap
+}
+
+// HasField returns whether the table view shows the field
+func (v *View) HasField(key string) bool {
+	return slices.Contains(v.Fields, key)
+}
+
+// Validate checks and normalizes the settings of the view, the custom fields it refers to are checked by the caller
+func (v *View) Validate() error {
+	v.Name = strings.TrimSpace(v.Name)
+	if v.Name == "" || len(v.Name) > 255 {
+		return util.NewInvalidArgumentErrorf("project view name must be between 1 and 255 characters")
+	}
+	v.Filter = strings.TrimSpace(v.Filter)
+
+	isKnown := func(known []string, key string) bool {
+		return slices.Contains(known, key) || ParseViewCustomFieldKey(key) > 0
+	}
+	switch v.Layout {
+	case ViewLayoutTable:
+		fields := make([]string, 0, len(v.Fields))
+		for _, field := range v.Fields {
+			if !isKnown(ViewFields, field) {
+				return util.NewInvalidArgumentErrorf("unsupported project view field %q", field)
+			}
+			if !slices.Contains(fields, field) {
+				fields = append(fields, field)
+			}
+		}
+		v.Fields = fields
+		if v.GroupBy != "" && !isKnown(ViewGroupFields, v.GroupBy) {
+			return util.NewInvalidArgumentErrorf("project views can't be grouped by %q", v.GroupBy)
+		}
+	case ViewLayoutRoadmap:
+		v.Fields = nil
+		if v.GroupBy == "" {
+			v.GroupBy = ViewFieldMilestone
+		} else if v.GroupBy != ViewFieldMilestone && v.GroupBy != ViewFieldDeadline {
+			return util.NewInvalidArgumentErrorf("roadmaps can only be grouped by milestone or deadline")
+		}
+	default:
+		return util.NewInvalidArgumentErrorf("unsupported project view layout %q", v.Layout)
+	}
+	if v.SortBy != "" && !isKnown(ViewSortFields, v.SortBy) {
+		return util.NewInvalidArgumentErrorf("project views can't be sorted by %q", v.SortBy)
+	}
+	return nil
+}
+
+// CustomFieldIDs returns the IDs of the custom fields the view refers to
+func (v *View) CustomFieldIDs() []int64 {
+	var ids []int64
+	for _, key := range append(slices.Clone(v.Fields), v.GroupBy, v.SortBy) {
+		if id := ParseViewCustomFieldKey(key); id > 0 && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// NewView creates a view of a project
+func NewView(ctx context.Context, v *View) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	return db.Insert(ctx, v)
+}
+
+// UpdateView updates the settings of a view
+func UpdateView(ctx context.Context, v *View) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	_, err := db.GetEngine(ctx).ID(v.ID).Cols("name", "layout", "fields", "group_by", "sort_by", "sort_desc", "filter", "sort").Update(v)
+	return err
+}
+
+// DeleteViewByID deletes a view
+func DeleteViewByID(ctx context.Context, id int64) error {
+	_, err := db.DeleteByID[View](ctx, id)
+	return err
+}
+
+func deleteViewsByProjectID(ctx context.Context, projectID int64) error {
+	_, err := db.GetEngine(ctx).Where("project_id = ?", projectID).Delete(new(View))
+	return err
+}
+
+func deleteViewsByRepoID(ctx context.Context, repoID int64) error {
+	_, err := db.GetEngine(ctx).Where(builder.In("project_id", builder.Select("id").From("project").Where(builder.Eq{"repo_id": repoID}))).Delete(new(View))
+	return err
+}
+
+// GetViewByIDAndProjectID returns a view of a project
+func GetViewByIDAndProjectID(ctx context.Context, id, projectID int64) (*View, error) {
+	v := new(View)
+	has, err := db.GetEngine(ctx).Where("id = ? AND project_id = ?", id, projectID).Get(v)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrProjectViewNotExist{ID: id}
+	}
+	return v, nil
+}
+
+// GetViewsByProjectID returns the views of a project
+func GetViewsByProjectID(ctx context.Context, projectID int64) ([]*View, error) {
+	views := make([]*View, 0, 2)
+	return views, db.GetEngine(ctx).Where("project_id = ?", projectID).OrderBy("sort, id").Find(&views)
+}
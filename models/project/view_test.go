@@ -0,0 +1,74 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	"testing"
+
+	"gitea.dev/models/unittest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewValidate(t *testing.T) {
+	v := &View{Name: " Table ", Layout: ViewLayoutTable, Fields: []string{ViewFieldStatus, "field:3", ViewFieldStatus}, GroupBy: ViewFieldMilestone, SortBy: "field:4"}
+	require.NoError(t, v.Validate())
+	assert.Equal(t, "Table", v.Name)
+	assert.Equal(t, []string{ViewFieldStatus, "field:3"}, v.Fields)
+	assert.Equal(t, []int64{3, 4}, v.CustomFieldIDs())
+
+	v = &View{Name: "Roadmap", Layout: ViewLayoutRoadmap, Fields: []string{ViewFieldStatus}}
+	require.NoError(t, v.Validate())
+	assert.Equal(t, ViewFieldMilestone, v.GroupBy)
+	assert.Nil(t, v.Fields)
+
+	for _, v := range []*View{
+		{Name: "", Layout: ViewLayoutTable},
+		{Name: "Board", Layout: "board"},
+		{Name: "Table", Layout: ViewLayoutTable, Fields: []string{"unknown"}},
+		{Name: "Table", Layout: ViewLayoutTable, Fields: []string{"field:x"}},
+		{Name: "Table", Layout: ViewLayoutTable, GroupBy: ViewFieldLabels},
+		{Name: "Table", Layout: ViewLayoutTable, SortBy: ViewFieldStatus},
+		{Name: "Roadmap", Layout: ViewLayoutRoadmap, GroupBy: ViewFieldAssignees},
+	} {
+		assert.Error(t, v.Validate(), "%+v", v)
+	}
+}
+
+func TestViews(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	v1 := &View{ProjectID: 1, Name: "Table", Layout: ViewLayoutTable, Fields: []string{ViewFieldAssignees}, Sort: 2}
+	require.NoError(t, NewView(t.Context(), v1))
+	v2 := &View{ProjectID: 1, Name: "Roadmap", Layout: ViewLayoutRoadmap, Sort: 1}
+	require.NoError(t, NewView(t.Context(), v2))
+	require.NoError(t, NewView(t.Context(), &View{ProjectID: 2, Name: "Other", Layout: ViewLayoutTable}))
+
+	views, err := GetViewsByProjectID(t.Context(), 1)
+	require.NoError(t, err)
+	if assert.Len(t, views, 2) {
+		assert.Equal(t, v2.ID, views[0].ID)
+		assert.Equal(t, []string{ViewFieldAssignees}, views[1].Fields)
+	}
+
+	v1.Filter = "is:open"
+	v1.SortDesc = true
+	require.NoError(t, UpdateView(t.Context(), v1))
+	v, err := GetViewByIDAndProjectID(t.Context(), v1.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "is:open", v.Filter)
+	assert.True(t, v.SortDesc)
+
+	_, err = GetViewByIDAndProjectID(t.Context(), v1.ID, 2)
+	assert.True(t, IsErrProjectViewNotExist(err))
+
+	require.NoError(t, DeleteViewByID(t.Context(), v2.ID))
+	unittest.AssertNotExistsBean(t, &View{ID: v2.ID})
+
+	require.NoError(t, DeleteProjectByID(t.Context(), 1))
+	unittest.AssertNotExistsBean(t, &View{ProjectID: 1})
+	require.NoError(t, DeleteProjectByRepoID(t.Context(), 3))
+	unittest.AssertNotExistsBean(t, &View{ProjectID: 2})
+}
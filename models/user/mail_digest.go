@@ -0,0 +1,76 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package user
+
+import (
+	"context"
+
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+
+	"xorm.io/builder"
+)
+
+// MailDigestItem is a notification waiting to be sent with the next mail digest of the user
+type MailDigestItem struct {
+	ID          int64              `xorm:"pk autoincr"`
+	UserID      int64              `xorm:"INDEX NOT NULL"`
+	RepoID      int64              `xorm:"NOT NULL DEFAULT 0"`
+	IssueID     int64              `xorm:"NOT NULL DEFAULT 0"` // the issue or pull request of the issue and pull review events
+	EventType   MailEventType      `xorm:"VARCHAR(20) NOT NULL"`
+	Subject     string             `xorm:"TEXT NOT NULL"`
+	Link        string             `xorm:"TEXT"`
+	DoerID      int64              `xorm:"NOT NULL DEFAULT 0"`
+	CreatedUnix timeutil.TimeStamp `xorm:"created INDEX NOT NULL"`
+
+	Doer *User `xorm:"-"`
+}
+
+func init() {
+	db.RegisterModel(new(MailDigestItem))
+}
+
+// AddMailDigestItems queues the items for the next mail digest of their users
+func AddMailDigestItems(ctx context.Context, items ...*MailDigestItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	return db.Insert(ctx, items)
+}
+
+// GetMailDigestUserIDs returns the IDs of the users who have pending mail digest items
+func GetMailDigestUserIDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	return ids, db.GetEngine(ctx).Table("mail_digest_item").Distinct("user_id").Find(&ids)
+}
+
+// GetMailDigestItems returns the pending mail digest items of the user in the order they have been queued
+func GetMailDigestItems(ctx context.Context, userID int64) ([]*MailDigestItem, error) {
+	items := make([]*MailDigestItem, 0, 10)
+	return items, db.GetEngine(ctx).Where(builder.Eq{"user_id": userID}).OrderBy("id ASC").Find(&items)
+}
+
+// DeleteMailDigestItems deletes the mail digest items of the user up to and including maxID
+func DeleteMailDigestItems(ctx context.Context, userID, maxID int64) error {
+	_, err := db.GetEngine(ctx).Where(builder.Eq{"user_id": userID}.And(builder.Lte{"id": maxID})).Delete(&MailDigestItem{})
+	return err
+}
+
+// LoadMailDigestItemDoers loads the doers of the mail digest items
+func LoadMailDigestItemDoers(ctx context.Context, items []*MailDigestItem) error {
+	doerIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		if item.DoerID != 0 {
+			doerIDs = append(doerIDs, item.DoerID)
+		}
+	}
+	doers, err := GetUsersMapByIDs(ctx, doerIDs)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		item.Doer = doers[item.DoerID]
+	}
+	return nil
+}
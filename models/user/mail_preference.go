@@ -0,0 +1,222 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package user
+
+import (
+	"context"
+	"slices"
+
+	"gitea.dev/models/db"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"xorm.io/builder"
+)
+
+// ErrMailPreferenceNotExist represents a "mail preference does not exist" error
+var ErrMailPreferenceNotExist = util.NewNotExistErrorf("mail preference does not exist")
+
+// MailEventType is a group of events a user can choose the mail delivery of
+type MailEventType string
+
+const (
+	// MailEventIssues covers the activity on issues and pull requests
+	MailEventIssues MailEventType = "issues"
+	// MailEventPullReviews covers the reviews and the review requests of pull requests
+	MailEventPullReviews MailEventType = "pull_reviews"
+	// MailEventActions covers the results of workflow runs
+	MailEventActions MailEventType = "actions"
+	// MailEventReleases covers the published releases
+	MailEventReleases MailEventType = "releases"
+)
+
+// MailEventTypes returns all event types a mail delivery can be chosen for
+func MailEventTypes() []MailEventType {
+	return []MailEventType{MailEventIssues, MailEventPullReviews, MailEventActions, MailEventReleases}
+}
+
+// MailDelivery is how the mails of an event type are delivered
+type MailDelivery string
+
+const (
+	// MailDeliveryInstant sends a mail for every event
+	MailDeliveryInstant MailDelivery = "instant"
+	// MailDeliveryDigest batches the events into the daily or weekly digest
+	MailDeliveryDigest MailDelivery = "digest"
+	// MailDeliveryOff sends no mail
+	MailDeliveryOff MailDelivery = "off"
+)
+
+// IsValid reports whether the delivery is known
+func (d MailDelivery) IsValid() bool {
+	return d == MailDeliveryInstant || d == MailDeliveryDigest || d == MailDeliveryOff
+}
+
+const (
+	// SettingsKeyEmailDigestFrequency is the setting key for how often the mail digest is sent
+	SettingsKeyEmailDigestFrequency = "email_notification.digest_frequency"
+	SettingEmailDigestDaily         = "daily" // Default for the digest frequency
+	SettingEmailDigestWeekly        = "weekly"
+	// SettingsKeyEmailDigestLastSent is the setting key for the unix time the last digest has been sent
+	SettingsKeyEmailDigestLastSent = "email_notification.digest_last_sent"
+)
+
+// MailPreference overrides the mail notifications of a user: with OwnerID and RepoID both 0 it is
+// the global preference of the user, otherwise it applies to all repositories of an owner or to one repository.
+type MailPreference struct {
+	ID      int64 `xorm:"pk autoincr"`
+	UserID  int64 `xorm:"UNIQUE(s) INDEX NOT NULL"`
+	OwnerID int64 `xorm:"UNIQUE(s) NOT NULL DEFAULT 0"`
+	RepoID  int64 `xorm:"UNIQUE(s) NOT NULL DEFAULT 0"`
+	// Level overrides User.EmailNotificationsPreference, an empty level inherits it
+	Level string `xorm:"VARCHAR(20) NOT NULL DEFAULT ''"`
+	// Events overrides the delivery of the event types, missing event types are inherited
+	Events      map[MailEventType]MailDelivery `xorm:"TEXT JSON"`
+	UpdatedUnix timeutil.TimeStamp             `xorm:"updated NOT NULL"`
+}
+
+func init() {
+	db.RegisterModel(new(MailPreference))
+}
+
+// IsGlobal reports whether the preference applies to all repositories
+func (p *MailPreference) IsGlobal() bool {
+	return p.OwnerID == 0 && p.RepoID == 0
+}
+
+// IsEmpty reports whether the preference doesn't override anything
+func (p *MailPreference) IsEmpty() bool {
+	return p.Level == "" && len(p.Events) == 0
+}
+
+// GetMailPreference returns the preference of the user for the scope, an empty preference is returned if none is stored
+func GetMailPreference(ctx context.Context, userID, ownerID, repoID int64) (*MailPreference, error) {
+	p := &MailPreference{UserID: userID, OwnerID: ownerID, RepoID: repoID}
+	if _, err := db.GetEngine(ctx).Where(builder.Eq{"user_id": userID, "owner_id": ownerID, "repo_id": repoID}).Get(p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// GetMailPreferenceOverrides returns the per-owner and per-repository preferences of the user
+func GetMailPreferenceOverrides(ctx context.Context, userID int64) ([]*MailPreference, error) {
+	prefs := make([]*MailPreference, 0, 10)
+	return prefs, db.GetEngine(ctx).
+		Where(builder.Eq{"user_id": userID}.And(builder.Neq{"owner_id": 0}.Or(builder.Neq{"repo_id": 0}))).
+		OrderBy("owner_id ASC, repo_id ASC").
+		Find(&prefs)
+}
+
+// SetMailPreference inserts or updates the preference of its scope, empty preferences are deleted
+func SetMailPreference(ctx context.Context, p *MailPreference) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		existing, err := GetMailPreference(ctx, p.UserID, p.OwnerID, p.RepoID)
+		if err != nil {
+			return err
+		}
+		switch {
+		case existing.ID == 0 && p.IsEmpty():
+			return nil
+		case existing.ID == 0:
+			return db.Insert(ctx, p)
+		case p.IsEmpty():
+			_, err = db.GetEngine(ctx).ID(existing.ID).Delete(&MailPreference{})
+			return err
+		}
+		p.ID = existing.ID
+		_, err = db.GetEngine(ctx).ID(p.ID).Cols("level", "events").Update(p)
+		return err
+	})
+}
+
+// DeleteMailPreference deletes a per-owner or per-repository preference of the user
+func DeleteMailPreference(ctx context.Context, userID, id int64) error {
+	n, err := db.GetEngine(ctx).Where(builder.Eq{"id": id, "user_id": userID}).Delete(&MailPreference{})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrMailPreferenceNotExist
+	}
+	return nil
+}
+
+// EffectiveMailPreference is the mail preference of a user after all overrides of a repository have been applied
+type EffectiveMailPreference struct {
+	Level  string
+	Events map[MailEventType]MailDelivery
+}
+
+// Delivery returns how the mails of the event type should be delivered
+func (p *EffectiveMailPreference) Delivery(event MailEventType) MailDelivery {
+	if p.Level == EmailNotificationsDisabled {
+		return MailDeliveryOff
+	}
+	if d, ok := p.Events[event]; ok {
+		return d
+	}
+	return MailDeliveryInstant
+}
+
+// GetEffectiveMailPreferences returns the effective mail preferences of the users for a repository of the owner,
+// the global preference is overridden by the owner preference which is overridden by the repository preference.
+func GetEffectiveMailPreferences(ctx context.Context, users []*User, ownerID, repoID int64) (map[int64]*EffectiveMailPreference, error) {
+	effective := make(map[int64]*EffectiveMailPreference, len(users))
+	userIDs := make([]int64, 0, len(users))
+	for _, u := range users {
+		effective[u.ID] = &EffectiveMailPreference{Level: u.EmailNotificationsPreference, Events: map[MailEventType]MailDelivery{}}
+		userIDs = append(userIDs, u.ID)
+	}
+	if len(userIDs) == 0 {
+		return effective, nil
+	}
+
+	scopeCond := builder.Eq{"owner_id": 0, "repo_id": 0}.Or(builder.Eq{"owner_id": ownerID, "repo_id": 0})
+	if repoID != 0 {
+		scopeCond = scopeCond.Or(builder.Eq{"repo_id": repoID})
+	}
+	var prefs []*MailPreference
+	if err := db.GetEngine(ctx).Where(builder.In("user_id", userIDs).And(scopeCond)).Find(&prefs); err != nil {
+		return nil, err
+	}
+	// apply the global preferences first and the repository preferences last
+	slices.SortFunc(prefs, func(a, b *MailPreference) int {
+		return mailPreferenceRank(a) - mailPreferenceRank(b)
+	})
+	for _, p := range prefs {
+		e := effective[p.UserID]
+		if p.Level != "" {
+			e.Level = p.Level
+		}
+		for event, delivery := range p.Events {
+			e.Events[event] = delivery
+		}
+	}
+	return effective, nil
+}
+
+func mailPreferenceRank(p *MailPreference) int {
+	switch {
+	case p.RepoID != 0:
+		return 2
+	case p.OwnerID != 0:
+		return 1
+	}
+	return 0
+}
+
+// GetMailCandidatesByIDs returns the active individual users who may receive mails. Unlike GetMailableUsersByIDs
+// it doesn't check the global mail preference, the caller has to check the effective mail preference instead.
+func GetMailCandidatesByIDs(ctx context.Context, ids []int64) ([]*User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	ous := make([]*User, 0, len(ids))
+	return ous, db.GetEngine(ctx).
+		In("id", ids).
+		Where("`type` = ?", UserTypeIndividual).
+		And("`prohibit_login` = ?", false).
+		And("`is_active` = ?", true).
+		Find(&ous)
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package user_test
+
+import (
+	"testing"
+
+	"gitea.dev/models/unittest"
+	user_model "gitea.dev/models/user"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMailPreference(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	ctx := t.Context()
+
+	pref := &user_model.MailPreference{UserID: 2, RepoID: 1, Level: user_model.EmailNotificationsDisabled}
+	require.NoError(t, user_model.SetMailPreference(ctx, pref))
+	assert.NotZero(t, pref.ID)
+
+	// the preference of the same scope is replaced
+	require.NoError(t, user_model.SetMailPreference(ctx, &user_model.MailPreference{
+		UserID: 2,
+		RepoID: 1,
+		Events: map[user_model.MailEventType]user_model.MailDelivery{user_model.MailEventReleases: user_model.MailDeliveryOff},
+	}))
+	overrides, err := user_model.GetMailPreferenceOverrides(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, overrides, 1)
+	assert.Equal(t, pref.ID, overrides[0].ID)
+	assert.Empty(t, overrides[0].Level)
+	assert.Equal(t, user_model.MailDeliveryOff, overrides[0].Events[user_model.MailEventReleases])
+
+	// an empty preference is deleted
+	require.NoError(t, user_model.SetMailPreference(ctx, &user_model.MailPreference{UserID: 2, RepoID: 1}))
+	overrides, err = user_model.GetMailPreferenceOverrides(ctx, 2)
+	require.NoError(t, err)
+	assert.Empty(t, overrides)
+
+	assert.ErrorIs(t, user_model.DeleteMailPreference(ctx, 2, pref.ID), user_model.ErrMailPreferenceNotExist)
+}
+
+func TestGetEffectiveMailPreferences(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	ctx := t.Context()
+
+	user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	user4 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 4})
+
+	require.NoError(t, user_model.SetMailPreference(ctx, &user_model.MailPreference{
+		UserID: 2,
+		Events: map[user_model.MailEventType]user_model.MailDelivery{
+			user_model.MailEventIssues:   user_model.MailDeliveryDigest,
+			user_model.MailEventReleases: user_model.MailDeliveryOff,
+		},
+	}))
+	require.NoError(t, user_model.SetMailPreference(ctx, &user_model.MailPreference{
+		UserID:  2,
+		OwnerID: 3,
+		Level:   user_model.EmailNotificationsDisabled,
+	}))
+	require.NoError(t, user_model.SetMailPreference(ctx, &user_model.MailPreference{
+		UserID: 2,
+		RepoID: 5,
+		Level:  user_model.EmailNotificationsEnabled,
+		Events: map[user_model.MailEventType]user_model.MailDelivery{user_model.MailEventIssues: user_model.MailDeliveryInstant},
+	}))
+
+	prefs, err := user_model.GetEffectiveMailPreferences(ctx, []*user_model.User{user2, user4}, 3, 5)
+	require.NoError(t, err)
+	assert.Equal(t, user_model.EmailNotificationsEnabled, prefs[2].Level)
+	assert.Equal(t, user_model.MailDeliveryInstant, prefs[2].Delivery(user_model.MailEventIssues))
+	assert.Equal(t, user_model.MailDeliveryOff, prefs[2].Delivery(user_model.MailEventReleases))
+	assert.Equal(t, user_model.MailDeliveryInstant, prefs[2].Delivery(user_model.MailEventActions))
+	assert.Equal(t, user_model.EmailNotificationsOnMention, prefs[4].Level)
+	assert.Equal(t, user_model.MailDeliveryInstant, prefs[4].Delivery(user_model.MailEventIssues))
+
+	// another repository of the owner only gets the owner preference
+	prefs, err = user_model.GetEffectiveMailPreferences(ctx, []*user_model.User{user2}, 3, 32)
+	require.NoError(t, err)
+	assert.Equal(t, user_model.MailDeliveryOff, prefs[2].Delivery(user_model.MailEventIssues))
+
+	// a repository of another owner only gets the global preference
+	prefs, err = user_model.GetEffectiveMailPreferences(ctx, []*user_model.User{user2}, 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, user_model.MailDeliveryDigest, prefs[2].Delivery(user_model.MailEventIssues))
+	assert.Equal(t, user_model.MailDeliveryOff, prefs[2].Delivery(user_model.MailEventReleases))
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"gitea.dev/models/db"
 	"gitea.dev/modules/cache"
@@ -247,3 +248,17 @@ func SetUserSettingJSON[T any](ctx context.Context, userID int64, key string, va
 	}
 	return SetUserSetting(ctx, userID, key, util.UnsafeBytesToString(bs))
 }
+
+// GetUserLocation returns the time zone of the user, the default time zone of the UI if the user hasn't set one
+func GetUserLocation(ctx context.Context, userID int64) (*time.Location, error) {
+	zone, err := GetUserSetting(ctx, userID, SettingsKeyTimeZone)
+	if err != nil || zone == "" {
+		return setting_module.DefaultUILocation, err
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		// the time zone database of the server may have changed since the time zone has been set
+		return setting_module.DefaultUILocation, nil
+	}
+	return loc, nil
+}
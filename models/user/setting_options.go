@@ -24,4 +24,7 @@ const (
 	SettingEmailNotificationGiteaActionsDisabled    = "disabled"
 
 	SettingsKeyActionsConfig = "actions.config"
+
+	// SettingsKeyTimeZone is the setting key for the time zone of the user, e.g. the time zone of the review reminders
+	SettingsKeyTimeZone = "ui.time_zone"
 )
@@ -56,6 +56,15 @@ type HookTask struct {
 	IsDelivered bool
 	Delivered   timeutil.TimeStampNano
 
+	// Retry info: a failed task is not delivered again until NextAttemptUnix,
+	// it becomes a dead letter if the last attempt fails
+	Attempts        int                `xorm:"NOT NULL DEFAULT 0"`
+	NextAttemptUnix timeutil.TimeStamp `xorm:"INDEX NOT NULL DEFAULT 0"`
+	IsDeadLetter    bool               `xorm:"NOT NULL DEFAULT false"`
+
+	// IsTest marks the deliveries triggered by the test button, their failures don't count against the webhook
+	IsTest bool `xorm:"NOT NULL DEFAULT false"`
+
 	// History info.
 	IsSucceed       bool
 	RequestContent  string        `xorm:"LONGTEXT"`
@@ -167,6 +176,7 @@ func ReplayHookTask(ctx context.Context, hookID int64, uuid string) (*HookTask,
 		PayloadContent: task.PayloadContent,
 		EventType:      task.EventType,
 		PayloadVersion: task.PayloadVersion,
+		IsTest:         task.IsTest,
 	})
 }
 
@@ -185,6 +195,22 @@ func FindUndeliveredHookTaskIDs(ctx context.Context, lowerID int64) ([]int64, er
 		Find(&tasks)
 }
 
+// FindDueRetryHookTaskIDs will find the next 100 hook tasks whose retry is due with ID greater than the provided lowerID
+func FindDueRetryHookTaskIDs(ctx context.Context, now timeutil.TimeStamp, lowerID int64) ([]int64, error) {
+	const batchSize = 100
+
+	tasks := make([]int64, 0, batchSize)
+	return tasks, db.GetEngine(ctx).
+		Select("id").
+		Table(new(HookTask)).
+		Where("is_delivered=?", false).
+		And("next_attempt_unix > 0 AND next_attempt_unix <= ?", now).
+		And("id > ?", lowerID).
+		Asc("id").
+		Limit(batchSize).
+		Find(&tasks)
+}
+
 func MarkTaskDelivered(ctx context.Context, task *HookTask) (bool, error) {
 	count, err := db.GetEngine(ctx).ID(task.ID).Where("is_delivered = ?", false).Cols("is_delivered").Update(&HookTask{
 		ID:          task.ID,
@@ -70,11 +70,20 @@ const (
 	ContentTypeJSON HookContentType = iota + 1
 	// ContentTypeForm is an url-encoded form payload for web hook
 	ContentTypeForm
+	// ContentTypeTemplate is a JSON payload rendered by the payload template of the web hook
+	ContentTypeTemplate
+	// ContentTypeCloudEvents is a CloudEvents event in the structured content mode
+	ContentTypeCloudEvents
+	// ContentTypeCloudEventsBinary is a CloudEvents event in the binary content mode, the attributes are headers
+	ContentTypeCloudEventsBinary
 )
 
 var hookContentTypes = map[string]HookContentType{
-	"json": ContentTypeJSON,
-	"form": ContentTypeForm,
+	"json":               ContentTypeJSON,
+	"form":               ContentTypeForm,
+	"template":           ContentTypeTemplate,
+	"cloudevents":        ContentTypeCloudEvents,
+	"cloudevents_binary": ContentTypeCloudEventsBinary,
 }
 
 // ToHookContentType returns HookContentType by given name.
@@ -109,6 +118,12 @@ func (t HookContentType) Name() string {
 		return "json"
 	case ContentTypeForm:
 		return "form"
+	case ContentTypeTemplate:
+		return "template"
+	case ContentTypeCloudEvents:
+		return "cloudevents"
+	case ContentTypeCloudEventsBinary:
+		return "cloudevents_binary"
 	}
 	return ""
 }
@@ -129,6 +144,7 @@ type Webhook struct {
 	Name                      string `xorm:"VARCHAR(255) NOT NULL DEFAULT ''"`
 	HTTPMethod                string `xorm:"http_method"`
 	ContentType               HookContentType
+	PayloadTemplate           string `xorm:"TEXT"` // text/template of the body of the ContentTypeTemplate webhooks
 	Secret                    string `xorm:"TEXT"`
 	Events                    string `xorm:"TEXT"`
 	*webhook_module.HookEvent `xorm:"-"`
@@ -136,6 +152,7 @@ type Webhook struct {
 	Type                      webhook_module.HookType   `xorm:"VARCHAR(16) 'type'"`
 	Meta                      string                    `xorm:"TEXT"` // store hook-specific attributes
 	LastStatus                webhook_module.HookStatus // Last delivery status
+	FailureCount              int                       `xorm:"NOT NULL DEFAULT 0"` // Consecutive failed delivery attempts
 
 	// HeaderAuthorizationEncrypted should be accessed using HeaderAuthorization() and SetHeaderAuthorization()
 	HeaderAuthorizationEncrypted string `xorm:"TEXT"`
@@ -317,6 +334,30 @@ func UpdateWebhookLastStatus(ctx context.Context, w *Webhook) error {
 	return err
 }
 
+// IncreaseWebhookFailureCount counts a failed delivery attempt and reloads the number of consecutive failures
+func IncreaseWebhookFailureCount(ctx context.Context, w *Webhook) error {
+	if _, err := db.GetEngine(ctx).ID(w.ID).Incr("failure_count").NoAutoTime().Update(new(Webhook)); err != nil {
+		return err
+	}
+	_, err := db.GetEngine(ctx).Table("webhook").Where("id = ?", w.ID).Cols("failure_count").Get(&w.FailureCount)
+	return err
+}
+
+// ResetWebhookFailureCount resets the consecutive failed delivery attempts after a successful delivery
+func ResetWebhookFailureCount(ctx context.Context, w *Webhook) error {
+	w.FailureCount = 0
+	_, err := db.GetEngine(ctx).ID(w.ID).Cols("failure_count").NoAutoTime().Update(w)
+	return err
+}
+
+// DeactivateWebhook deactivates a webhook which keeps failing, the failures are counted again once it is activated
+func DeactivateWebhook(ctx context.Context, w *Webhook) error {
+	w.IsActive = false
+	w.FailureCount = 0
+	_, err := db.GetEngine(ctx).ID(w.ID).Cols("is_active", "failure_count").Update(w)
+	return err
+}
+
 // DeleteWebhookByID uses argument bean as query condition,
 // ID must be specified and do not assign unnecessary fields.
 func DeleteWebhookByID(ctx context.Context, id int64) (err error) {
@@ -0,0 +1,196 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package codenav
+
+import (
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+// IndexVersion must be increased if the index format or the extraction changes, older indexes are rebuilt then
+const IndexVersion = 1
+
+var identifierPattern = regexp.MustCompile(`[\p{L}_][\p{L}\p{N}_]*`)
+
+// Location is a position in a file of the indexed commit.
+// Lines start at 1, columns are counted in UTF-16 code units starting at 0 like in LSIF.
+type Location struct {
+	Path   string `json:"path"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+// Result contains the navigation information of a symbol
+type Result struct {
+	Hover       string     `json:"hover,omitempty"`
+	Definitions []Location `json:"definitions"`
+	References  []Location `json:"references"`
+}
+
+// Range is a part of a line which refers to a result, it is only used by precise indexes
+type Range struct {
+	Line   int `json:"l"`
+	Start  int `json:"s"`
+	End    int `json:"e"`
+	Result int `json:"r"`
+}
+
+// Index contains the navigation information of a commit.
+// Indexes generated from the sources resolve symbols by name,
+// precise indexes uploaded from LSIF dumps or SCIP indexes resolve them by position.
+type Index struct {
+	Version  int    `json:"version"`
+	CommitID string `json:"commit_id"`
+	Precise  bool   `json:"precise"`
+	// Files maps the paths of the indexed files to their languages
+	Files map[string]string `json:"files,omitempty"`
+	// Symbols maps "language:name" to the index of the result
+	Symbols map[string]int `json:"symbols,omitempty"`
+	// Ranges maps the paths to the ranges of the file sorted by position
+	Ranges  map[string][]Range `json:"ranges,omitempty"`
+	Results []*Result          `json:"results"`
+}
+
+func symbolKey(language, name string) string {
+	return language + ":" + name
+}
+
+// Lookup returns the result for the symbol at the position or nil if nothing is known about it
+func (idx *Index) Lookup(path string, line, column int, name string) *Result {
+	if ranges := idx.Ranges[path]; len(ranges) > 0 {
+		i := sort.Search(len(ranges), func(i int) bool {
+			return ranges[i].Line >= line
+		})
+		for ; i < len(ranges) && ranges[i].Line == line; i++ {
+			if ranges[i].Start <= column && column < ranges[i].End {
+				return idx.Results[ranges[i].Result]
+			}
+		}
+	}
+
+	if name == "" {
+		return nil
+	}
+	language, ok := idx.Files[path]
+	if !ok {
+		return nil
+	}
+	if i, ok := idx.Symbols[symbolKey(language, name)]; ok {
+		return idx.Results[i]
+	}
+	return nil
+}
+
+type builderFile struct {
+	path     string
+	language string
+	content  string
+	lines    []int
+}
+
+// location converts a byte offset to a location
+func (f *builderFile) location(offset int) Location {
+	line := sort.Search(len(f.lines), func(i int) bool {
+		return f.lines[i] > offset
+	}) - 1
+	return Location{
+		Path:   f.path,
+		Line:   line + 1,
+		Column: len(utf16.Encode([]rune(f.content[f.lines[line]:offset]))),
+	}
+}
+
+func (f *builderFile) lineText(offset int) string {
+	line := f.location(offset).Line - 1
+	end := len(f.content)
+	if line+1 < len(f.lines) {
+		end = f.lines[line+1]
+	}
+	return strings.TrimSpace(f.content[f.lines[line]:end])
+}
+
+// Builder creates an index by extracting the definitions of the added files
+// and collecting all occurrences of their names as references.
+type Builder struct {
+	commitID      string
+	maxReferences int
+	files         []*builderFile
+}
+
+// NewBuilder creates a new builder, maxReferences limits the number of references stored per symbol
+func NewBuilder(commitID string, maxReferences int) *Builder {
+	return &Builder{
+		commitID:      commitID,
+		maxReferences: maxReferences,
+	}
+}
+
+// AddFile adds a file to the index, files of unsupported languages are ignored
+func (b *Builder) AddFile(path, language, content string) {
+	if !IsSupportedLanguage(language) {
+		return
+	}
+
+	lines := []int{0}
+	for i := 0; i < len(content); i++ {
+		if content[i] == '\n' {
+			lines = append(lines, i+1)
+		}
+	}
+	b.files = append(b.files, &builderFile{
+		path:     path,
+		language: language,
+		content:  content,
+		lines:    lines,
+	})
+}
+
+// Build creates the index of all added files
+func (b *Builder) Build() *Index {
+	idx := &Index{
+		Version:  IndexVersion,
+		CommitID: b.commitID,
+		Files:    make(map[string]string, len(b.files)),
+		Symbols:  make(map[string]int),
+	}
+
+	slices.SortFunc(b.files, func(a, b *builderFile) int {
+		return strings.Compare(a.path, b.path)
+	})
+
+	for _, f := range b.files {
+		idx.Files[f.path] = f.language
+		for _, sym := range ExtractSymbols(f.language, f.content) {
+			key := symbolKey(f.language, sym.Name)
+			i, ok := idx.Symbols[key]
+			if !ok {
+				i = len(idx.Results)
+				idx.Symbols[key] = i
+				idx.Results = append(idx.Results, &Result{
+					Hover: f.lineText(sym.Start),
+				})
+			}
+			idx.Results[i].Definitions = append(idx.Results[i].Definitions, f.location(sym.Start))
+		}
+	}
+
+	for _, f := range b.files {
+		for _, match := range identifierPattern.FindAllStringIndex(f.content, -1) {
+			i, ok := idx.Symbols[symbolKey(f.language, f.content[match[0]:match[1]])]
+			if !ok {
+				continue
+			}
+			result := idx.Results[i]
+			if b.maxReferences > 0 && len(result.References) >= b.maxReferences {
+				continue
+			}
+			result.References = append(result.References, f.location(match[0]))
+		}
+	}
+
+	return idx
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package codenav
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"gitea.dev/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder("sha", 0)
+	b.AddFile("lib/lib.go", "Go", "package lib\n\n// Greet returns a greeting\nfunc Greet(name string) string {\n\treturn \"hi \" + name\n}\n")
+	b.AddFile("main.go", "Go", "package main\n\nfunc main() {\n\t_ = /* ü */ lib.Greet(\"x\")\n}\n")
+	b.AddFile("greet.py", "Python", "def Greet():\n    pass\n")
+	b.AddFile("README.md", "Markdown", "Greet")
+	idx := b.Build()
+
+	assert.Equal(t, "sha", idx.CommitID)
+	assert.Len(t, idx.Files, 3)
+
+	result := idx.Lookup("main.go", 4, 17, "Greet")
+	require.NotNil(t, result)
+	assert.Equal(t, "func Greet(name string) string {", result.Hover)
+	assert.Equal(t, []Location{{Path: "lib/lib.go", Line: 4, Column: 5}}, result.Definitions)
+	assert.Equal(t, []Location{
+		{Path: "lib/lib.go", Line: 3, Column: 3},
+		{Path: "lib/lib.go", Line: 4, Column: 5},
+		{Path: "main.go", Line: 4, Column: 17},
+	}, result.References)
+
+	result = idx.Lookup("greet.py", 1, 4, "Greet")
+	require.NotNil(t, result)
+	assert.Equal(t, []Location{{Path: "greet.py", Line: 1, Column: 4}}, result.Definitions)
+
+	assert.Nil(t, idx.Lookup("README.md", 1, 0, "Greet"))
+	assert.Nil(t, idx.Lookup("main.go", 4, 0, "unknown"))
+
+	b = NewBuilder("sha", 1)
+	b.AddFile("a.go", "Go", "package a\n\nfunc A() { A(); A() }\n")
+	idx = b.Build()
+	assert.Len(t, idx.Lookup("a.go", 3, 5, "A").References, 1)
+}
+
+const testLSIF = `{"id":1,"type":"vertex","label":"metaData","version":"0.4.3","projectRoot":"file:///src/project"}
+{"id":2,"type":"vertex","label":"document","uri":"file:///src/project/lib/lib.go","languageId":"go"}
+{"id":3,"type":"vertex","label":"document","uri":"file:///src/project/main.go","languageId":"go"}
+{"id":4,"type":"vertex","label":"document","uri":"file:///usr/lib/go/src/fmt/print.go","languageId":"go"}
+{"id":5,"type":"vertex","label":"resultSet"}
+{"id":6,"type":"vertex","label":"range","start":{"line":3,"character":5},"end":{"line":3,"character":10}}
+{"id":7,"type":"vertex","label":"range","start":{"line":3,"character":7},"end":{"line":3,"character":12}}
+{"id":8,"type":"vertex","label":"range","start":{"line":0,"character":0},"end":{"line":0,"character":7}}
+{"id":9,"type":"edge","label":"contains","outV":2,"inVs":[6]}
+{"id":10,"type":"edge","label":"contains","outV":3,"inVs":[7]}
+{"id":11,"type":"edge","label":"contains","outV":4,"inVs":[8]}
+{"id":12,"type":"edge","label":"next","outV":6,"inV":5}
+{"id":13,"type":"edge","label":"next","outV":7,"inV":5}
+{"id":14,"type":"vertex","label":"definitionResult"}
+{"id":15,"type":"edge","label":"textDocument/definition","outV":5,"inV":14}
+{"id":16,"type":"edge","label":"item","outV":14,"inVs":[6],"document":2}
+{"id":17,"type":"vertex","label":"referenceResult"}
+{"id":18,"type":"edge","label":"textDocument/references","outV":5,"inV":17}
+{"id":19,"type":"edge","label":"item","outV":17,"inVs":[6],"document":2,"property":"definitions"}
+{"id":20,"type":"edge","label":"item","outV":17,"inVs":[7,8],"document":3,"property":"references"}
+{"id":21,"type":"vertex","label":"hoverResult","result":{"contents":[{"language":"go","value":"func Greet(name string) string"},"Greet returns a greeting"]}}
+{"id":22,"type":"edge","label":"textDocument/hover","outV":5,"inV":21}
+`
+
+func TestParseLSIF(t *testing.T) {
+	idx, err := ParseLSIF(strings.NewReader(testLSIF), "sha", 0)
+	require.NoError(t, err)
+	assert.True(t, idx.Precise)
+	assert.Len(t, idx.Results, 1)
+
+	result := idx.Lookup("main.go", 4, 9, "")
+	require.NotNil(t, result)
+	assert.Equal(t, "func Greet(name string) string\n\nGreet returns a greeting", result.Hover)
+	assert.Equal(t, []Location{{Path: "lib/lib.go", Line: 4, Column: 5}}, result.Definitions)
+	assert.Equal(t, []Location{
+		{Path: "lib/lib.go", Line: 4, Column: 5},
+		{Path: "main.go", Line: 4, Column: 7},
+	}, result.References)
+
+	assert.Same(t, result, idx.Lookup("lib/lib.go", 4, 5, ""))
+	assert.Nil(t, idx.Lookup("main.go", 4, 12, ""))
+	assert.Nil(t, idx.Lookup("main.go", 3, 9, ""))
+
+	_, err = ParseLSIF(strings.NewReader("{invalid"), "sha", 0)
+	assert.Error(t, err)
+	_, err = ParseLSIF(strings.NewReader(""), "sha", 0)
+	assert.Error(t, err)
+}
+
+func appendSCIPMessage(b []byte, num protowire.Number, fields ...[]byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, bytes.Join(fields, nil))
+}
+
+func appendSCIPString(b []byte, num protowire.Number, s string) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+func scipOccurrenceField(symbol string, definition bool, rng ...uint64) []byte {
+	var packed []byte
+	for _, v := range rng {
+		packed = protowire.AppendVarint(packed, v)
+	}
+	b := protowire.AppendTag(nil, scipOccurrenceRange, protowire.BytesType)
+	b = protowire.AppendBytes(b, packed)
+	b = appendSCIPString(b, scipOccurrenceSymbol, symbol)
+	if definition {
+		b = protowire.AppendTag(b, scipOccurrenceSymbolRoles, protowire.VarintType)
+		b = protowire.AppendVarint(b, scipSymbolRoleDefinition)
+	}
+	return appendSCIPMessage(nil, scipDocumentOccurrences, b)
+}
+
+func TestParseSCIP(t *testing.T) {
+	const greet = "scip-go gomod example.com/lib v1 `example.com/lib`/Greet()."
+
+	var index []byte
+	// metadata, which isn't used
+	index = appendSCIPMessage(index, 1, appendSCIPString(nil, 3, "file:///src"))
+	index = appendSCIPMessage(index, scipIndexDocuments,
+		appendSCIPString(nil, scipDocumentRelativePath, "lib/lib.go"),
+		scipOccurrenceField(greet, true, 3, 5, 10),
+		scipOccurrenceField("local 0", true, 3, 11, 15),
+		appendSCIPMessage(nil, scipDocumentSymbols,
+			appendSCIPString(nil, scipSymbolInformationSymbol, greet),
+			appendSCIPString(nil, scipSymbolInformationDocumentation, "func Greet(name string) string"),
+			appendSCIPString(nil, scipSymbolInformationDocumentation, "Greet returns a greeting"),
+		),
+	)
+	index = appendSCIPMessage(index, scipIndexDocuments,
+		appendSCIPString(nil, scipDocumentRelativePath, "main.go"),
+		scipOccurrenceField(greet, false, 3, 7, 3, 12),
+		scipOccurrenceField("local 0", true, 5, 1, 5),
+	)
+
+	idx, err := ParseSCIP(bytes.NewReader(index), "sha", 0)
+	require.NoError(t, err)
+	assert.True(t, idx.Precise)
+	assert.Len(t, idx.Results, 3)
+
+	result := idx.Lookup("main.go", 4, 9, "")
+	require.NotNil(t, result)
+	assert.Equal(t, "func Greet(name string) string\n\nGreet returns a greeting", result.Hover)
+	assert.Equal(t, []Location{{Path: "lib/lib.go", Line: 4, Column: 5}}, result.Definitions)
+	assert.Equal(t, []Location{
+		{Path: "lib/lib.go", Line: 4, Column: 5},
+		{Path: "main.go", Line: 4, Column: 7},
+	}, result.References)
+	assert.Same(t, result, idx.Lookup("lib/lib.go", 4, 5, ""))
+	assert.Nil(t, idx.Lookup("main.go", 4, 12, ""))
+
+	// the local symbols of different documents are different symbols
+	local := idx.Lookup("main.go", 6, 1, "")
+	require.NotNil(t, local)
+	assert.Equal(t, []Location{{Path: "main.go", Line: 6, Column: 1}}, local.Definitions)
+	assert.NotSame(t, local, idx.Lookup("lib/lib.go", 4, 11, ""))
+
+	_, err = ParseSCIP(bytes.NewReader(index[:len(index)-3]), "sha", 0)
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+	_, err = ParseSCIP(bytes.NewReader(nil), "sha", 0)
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+
+	// the errors of the reader are returned as they are
+	readErr := errors.New("read error")
+	_, err = ParseSCIP(io.MultiReader(bytes.NewReader(index[:10]), iotest.ErrReader(readErr)), "sha", 0)
+	assert.ErrorIs(t, err, readErr)
+}
@@ -0,0 +1,324 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package codenav
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"slices"
+	"strings"
+
+	"gitea.dev/modules/util"
+)
+
+// maxResultChain limits the number of "next" edges followed from a range to its result set
+const maxResultChain = 16
+
+type lsifPosition struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type lsifElement struct {
+	ID    json.RawMessage `json:"id"`
+	Type  string          `json:"type"`
+	Label string          `json:"label"`
+
+	// vertices
+	URI         string          `json:"uri"`
+	ProjectRoot string          `json:"projectRoot"`
+	Start       *lsifPosition   `json:"start"`
+	End         *lsifPosition   `json:"end"`
+	Result      json.RawMessage `json:"result"`
+
+	// edges
+	OutV     json.RawMessage   `json:"outV"`
+	InV      json.RawMessage   `json:"inV"`
+	InVs     []json.RawMessage `json:"inVs"`
+	Document json.RawMessage   `json:"document"`
+}
+
+type lsifRange struct {
+	start lsifPosition
+	end   lsifPosition
+}
+
+type lsifParser struct {
+	projectRoot string
+
+	documents map[string]string // document id => uri
+	ranges    map[string]*lsifRange
+	rangeDocs map[string]string // range id => document id
+
+	next        map[string]string
+	definitions map[string]string // range or result set id => definition result id
+	references  map[string]string // range or result set id => reference result id
+	hovers      map[string]string // range or result set id => hover result id
+
+	hoverTexts map[string]string
+	items      map[string][]string // definition or reference result id => range ids
+}
+
+// lsifID normalizes ids which may be numbers or strings
+func lsifID(raw json.RawMessage) string {
+	return string(bytes.Trim(raw, `"`))
+}
+
+// ParseLSIF creates a precise index from a LSIF dump in the JSON lines format
+func ParseLSIF(r io.Reader, commitID string, maxReferences int) (*Index, error) {
+	p := &lsifParser{
+		documents:   make(map[string]string),
+		ranges:      make(map[string]*lsifRange),
+		rangeDocs:   make(map[string]string),
+		next:        make(map[string]string),
+		definitions: make(map[string]string),
+		references:  make(map[string]string),
+		hovers:      make(map[string]string),
+		hoverTexts:  make(map[string]string),
+		items:       make(map[string][]string),
+	}
+
+	dec := json.NewDecoder(r)
+	for {
+		var e lsifElement
+		if err := dec.Decode(&e); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			var syntaxErr *json.SyntaxError
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &syntaxErr) || errors.As(err, &typeErr) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil, util.NewInvalidArgumentErrorf("invalid LSIF dump: %v", err)
+			}
+			return nil, err
+		}
+		p.add(&e)
+	}
+
+	if len(p.documents) == 0 {
+		return nil, util.NewInvalidArgumentErrorf("LSIF dump contains no documents")
+	}
+
+	return p.build(commitID, maxReferences), nil
+}
+
+func (p *lsifParser) add(e *lsifElement) {
+	id := lsifID(e.ID)
+	switch e.Type {
+	case "vertex":
+		switch e.Label {
+		case "metaData":
+			p.projectRoot = e.ProjectRoot
+		case "document":
+			p.documents[id] = e.URI
+		case "range":
+			if e.Start != nil && e.End != nil {
+				p.ranges[id] = &lsifRange{start: *e.Start, end: *e.End}
+			}
+		case "hoverResult":
+			p.hoverTexts[id] = hoverText(e.Result)
+		}
+	case "edge":
+		outV := lsifID(e.OutV)
+		switch e.Label {
+		case "contains":
+			if _, ok := p.documents[outV]; ok {
+				for _, inV := range e.InVs {
+					p.rangeDocs[lsifID(inV)] = outV
+				}
+			}
+		case "next":
+			p.next[outV] = lsifID(e.InV)
+		case "textDocument/definition":
+			p.definitions[outV] = lsifID(e.InV)
+		case "textDocument/references":
+			p.references[outV] = lsifID(e.InV)
+		case "textDocument/hover":
+			p.hovers[outV] = lsifID(e.InV)
+		case "item":
+			for _, inV := range e.InVs {
+				rangeID := lsifID(inV)
+				p.items[outV] = append(p.items[outV], rangeID)
+				if len(e.Document) > 0 {
+					if _, ok := p.rangeDocs[rangeID]; !ok {
+						p.rangeDocs[rangeID] = lsifID(e.Document)
+					}
+				}
+			}
+		}
+	}
+}
+
+// hoverText extracts the text of the hover result, contents may be a string, a MarkupContent, a MarkedString or a list of them
+func hoverText(raw json.RawMessage) string {
+	var result struct {
+		Contents json.RawMessage `json:"contents"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil || len(result.Contents) == 0 {
+		return ""
+	}
+
+	var parts []json.RawMessage
+	if err := json.Unmarshal(result.Contents, &parts); err != nil {
+		parts = []json.RawMessage{result.Contents}
+	}
+
+	texts := make([]string, 0, len(parts))
+	for _, part := range parts {
+		var s string
+		if err := json.Unmarshal(part, &s); err == nil {
+			texts = append(texts, s)
+			continue
+		}
+		var content struct {
+			Value string `json:"value"`
+		}
+		if err := json.Unmarshal(part, &content); err == nil && content.Value != "" {
+			texts = append(texts, content.Value)
+		}
+	}
+	return strings.TrimSpace(strings.Join(texts, "\n\n"))
+}
+
+// path returns the path of the document relative to the project root or false if it is outside the project
+func (p *lsifParser) path(documentID string) (string, bool) {
+	uri, ok := p.documents[documentID]
+	if !ok {
+		return "", false
+	}
+	if p.projectRoot != "" {
+		root := strings.TrimSuffix(p.projectRoot, "/") + "/"
+		if !strings.HasPrefix(uri, root) {
+			return "", false
+		}
+		uri = uri[len(root):]
+	} else {
+		uri = strings.TrimPrefix(uri, "file://")
+	}
+	path, err := url.PathUnescape(strings.TrimPrefix(uri, "/"))
+	if err != nil || path == "" {
+		return "", false
+	}
+	return util.PathJoinRelX(path), true
+}
+
+func (p *lsifParser) resolve(id string, edges map[string]string) string {
+	for range maxResultChain {
+		if result, ok := edges[id]; ok {
+			return result
+		}
+		next, ok := p.next[id]
+		if !ok {
+			return ""
+		}
+		id = next
+	}
+	return ""
+}
+
+func (p *lsifParser) locations(resultID string, limit int) []Location {
+	var locations []Location
+	for _, rangeID := range p.items[resultID] {
+		if limit > 0 && len(locations) >= limit {
+			break
+		}
+		r, ok := p.ranges[rangeID]
+		if !ok {
+			continue
+		}
+		path, ok := p.path(p.rangeDocs[rangeID])
+		if !ok {
+			continue
+		}
+		locations = append(locations, Location{
+			Path:   path,
+			Line:   r.start.Line + 1,
+			Column: r.start.Character,
+		})
+	}
+	return sortLocations(locations)
+}
+
+// sortLocations sorts the locations by path and position and removes the duplicates
+func sortLocations(locations []Location) []Location {
+	slices.SortFunc(locations, func(a, b Location) int {
+		if a.Path != b.Path {
+			return strings.Compare(a.Path, b.Path)
+		}
+		if a.Line != b.Line {
+			return a.Line - b.Line
+		}
+		return a.Column - b.Column
+	})
+	return slices.Compact(locations)
+}
+
+func (p *lsifParser) build(commitID string, maxReferences int) *Index {
+	idx := &Index{
+		Version:  IndexVersion,
+		CommitID: commitID,
+		Precise:  true,
+		Ranges:   make(map[string][]Range),
+	}
+
+	type resultKey struct {
+		definition, reference, hover string
+	}
+	results := make(map[resultKey]int)
+
+	for rangeID, r := range p.ranges {
+		path, ok := p.path(p.rangeDocs[rangeID])
+		if !ok {
+			continue
+		}
+		key := resultKey{
+			definition: p.resolve(rangeID, p.definitions),
+			reference:  p.resolve(rangeID, p.references),
+			hover:      p.resolve(rangeID, p.hovers),
+		}
+		if key == (resultKey{}) {
+			continue
+		}
+
+		i, ok := results[key]
+		if !ok {
+			i = len(idx.Results)
+			results[key] = i
+			idx.Results = append(idx.Results, &Result{
+				Hover:       p.hoverTexts[key.hover],
+				Definitions: p.locations(key.definition, 0),
+				References:  p.locations(key.reference, maxReferences),
+			})
+		}
+
+		end := r.end.Character
+		if r.end.Line != r.start.Line {
+			end = r.start.Character + 1
+		}
+		idx.Ranges[path] = append(idx.Ranges[path], Range{
+			Line:   r.start.Line + 1,
+			Start:  r.start.Character,
+			End:    end,
+			Result: i,
+		})
+	}
+
+	sortRanges(idx)
+	return idx
+}
+
+// sortRanges sorts the ranges of every file by position, Lookup depends on it
+func sortRanges(idx *Index) {
+	for _, ranges := range idx.Ranges {
+		slices.SortFunc(ranges, func(a, b Range) int {
+			if a.Line != b.Line {
+				return a.Line - b.Line
+			}
+			return a.Start - b.Start
+		})
+	}
+}
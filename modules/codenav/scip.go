@@ -0,0 +1,339 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package codenav
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"gitea.dev/modules/util"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The field numbers of the SCIP messages, see https://github.com/sourcegraph/scip/blob/main/scip.proto
+const (
+	scipIndexDocuments       protowire.Number = 2
+	scipIndexExternalSymbols protowire.Number = 3
+
+	scipDocumentRelativePath protowire.Number = 1
+	scipDocumentOccurrences  protowire.Number = 2
+	scipDocumentSymbols      protowire.Number = 3
+
+	scipOccurrenceRange       protowire.Number = 1
+	scipOccurrenceSymbol      protowire.Number = 2
+	scipOccurrenceSymbolRoles protowire.Number = 3
+
+	scipSymbolInformationSymbol        protowire.Number = 1
+	scipSymbolInformationDocumentation protowire.Number = 3
+
+	scipSymbolRoleDefinition = 0x1
+)
+
+// scipLocalSymbolPrefix marks the symbols which are only visible in their document
+const scipLocalSymbolPrefix = "local "
+
+type scipOccurrence struct {
+	line, start, end int
+	symbol           string
+	definition       bool
+}
+
+type scipParser struct {
+	occurrences   map[string][]scipOccurrence // path => occurrences
+	documentation map[string]string           // symbol => hover
+}
+
+// scipReader remembers the errors of the underlying reader to tell them apart from a malformed index
+type scipReader struct {
+	r   io.Reader
+	err error
+}
+
+func (r *scipReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		r.err = err
+	}
+	return n, err
+}
+
+// ParseSCIP creates a precise index from a SCIP index in the protobuf format.
+// The documents are read one by one, so the whole index never has to be kept in memory.
+func ParseSCIP(r io.Reader, commitID string, maxReferences int) (*Index, error) {
+	p := &scipParser{
+		occurrences:   make(map[string][]scipOccurrence),
+		documentation: make(map[string]string),
+	}
+
+	sr := &scipReader{r: r}
+	br := bufio.NewReader(sr)
+	for {
+		num, value, err := readSCIPField(br)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err == nil {
+			switch num {
+			case scipIndexDocuments:
+				err = p.addDocument(value)
+			case scipIndexExternalSymbols:
+				var symbol, hover string
+				if symbol, hover, err = parseSCIPSymbol(value); err == nil && symbol != "" {
+					p.documentation[symbol] = hover
+				}
+			}
+		}
+		if err != nil {
+			if sr.err != nil {
+				return nil, sr.err
+			}
+			return nil, util.NewInvalidArgumentErrorf("invalid SCIP index: %v", err)
+		}
+	}
+
+	if len(p.occurrences) == 0 {
+		return nil, util.NewInvalidArgumentErrorf("SCIP index contains no documents")
+	}
+
+	return p.build(commitID, maxReferences), nil
+}
+
+// readSCIPField reads the next field of the index message, the value is only returned for length-delimited fields.
+// io.EOF is only returned at the end of the message.
+func readSCIPField(br *bufio.Reader) (protowire.Number, []byte, error) {
+	tag, err := binary.ReadUvarint(br)
+	if err != nil {
+		return 0, nil, err
+	}
+	num, typ := protowire.DecodeTag(tag)
+	if num < protowire.MinValidNumber {
+		return 0, nil, errors.New("invalid field number")
+	}
+
+	switch typ {
+	case protowire.VarintType:
+		_, err = binary.ReadUvarint(br)
+	case protowire.Fixed32Type:
+		_, err = br.Discard(4)
+	case protowire.Fixed64Type:
+		_, err = br.Discard(8)
+	case protowire.BytesType:
+		var size uint64
+		if size, err = binary.ReadUvarint(br); err != nil {
+			break
+		}
+		// the value isn't allocated by its declared size, a truncated index must not allocate more than its size
+		var value []byte
+		if value, err = io.ReadAll(io.LimitReader(br, int64(min(size, 1<<62)))); err == nil && uint64(len(value)) != size {
+			err = io.ErrUnexpectedEOF
+		}
+		if err == nil {
+			return num, value, nil
+		}
+	default:
+		err = errors.New("unsupported wire type")
+	}
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return num, nil, err
+}
+
+// consumeSCIPFields calls fn for every field of the message,
+// value is only set for length-delimited fields and varint only for varint fields
+func consumeSCIPFields(b []byte, fn func(num protowire.Number, typ protowire.Type, value []byte, varint uint64)) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		var value []byte
+		var varint uint64
+		switch typ {
+		case protowire.BytesType:
+			value, n = protowire.ConsumeBytes(b)
+		case protowire.VarintType:
+			varint, n = protowire.ConsumeVarint(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		fn(num, typ, value, varint)
+	}
+	return nil
+}
+
+// scipSymbolKey scopes the local symbols to their document
+func scipSymbolKey(path, symbol string) string {
+	if strings.HasPrefix(symbol, scipLocalSymbolPrefix) {
+		return path + "#" + symbol
+	}
+	return symbol
+}
+
+func (p *scipParser) addDocument(b []byte) error {
+	var path string
+	var occurrences, symbols [][]byte
+	err := consumeSCIPFields(b, func(num protowire.Number, typ protowire.Type, value []byte, _ uint64) {
+		if typ != protowire.BytesType {
+			return
+		}
+		switch num {
+		case scipDocumentRelativePath:
+			path = string(value)
+		case scipDocumentOccurrences:
+			occurrences = append(occurrences, value)
+		case scipDocumentSymbols:
+			symbols = append(symbols, value)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	path = util.PathJoinRelX(path)
+	if path == "" {
+		return nil
+	}
+
+	for _, value := range symbols {
+		symbol, hover, err := parseSCIPSymbol(value)
+		if err != nil {
+			return err
+		}
+		if symbol != "" {
+			p.documentation[scipSymbolKey(path, symbol)] = hover
+		}
+	}
+
+	// the documents are always added, a document without occurrences is still a part of the index
+	docOccurrences := p.occurrences[path]
+	for _, value := range occurrences {
+		occ, ok, err := parseSCIPOccurrence(value)
+		if err != nil {
+			return err
+		}
+		if ok {
+			occ.symbol = scipSymbolKey(path, occ.symbol)
+			docOccurrences = append(docOccurrences, occ)
+		}
+	}
+	p.occurrences[path] = docOccurrences
+	return nil
+}
+
+// parseSCIPOccurrence returns false for occurrences which don't refer to a symbol or have an invalid range
+func parseSCIPOccurrence(b []byte) (occ scipOccurrence, ok bool, err error) {
+	var rng []int64
+	var roles uint64
+	err = consumeSCIPFields(b, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) {
+		switch {
+		case num == scipOccurrenceRange && typ == protowire.BytesType:
+			// packed, which is the default of proto3
+			for len(value) > 0 {
+				v, n := protowire.ConsumeVarint(value)
+				if n < 0 {
+					return
+				}
+				rng = append(rng, int64(int32(v)))
+				value = value[n:]
+			}
+		case num == scipOccurrenceRange && typ == protowire.VarintType:
+			rng = append(rng, int64(int32(varint)))
+		case num == scipOccurrenceSymbol && typ == protowire.BytesType:
+			occ.symbol = string(value)
+		case num == scipOccurrenceSymbolRoles && typ == protowire.VarintType:
+			roles = varint
+		}
+	})
+	if err != nil || occ.symbol == "" || slices.ContainsFunc(rng, func(v int64) bool { return v < 0 }) {
+		return occ, false, err
+	}
+
+	// the range is [startLine, startCharacter, endCharacter] or [startLine, startCharacter, endLine, endCharacter]
+	switch len(rng) {
+	case 3:
+		occ.line, occ.start, occ.end = int(rng[0]), int(rng[1]), int(rng[2])
+	case 4:
+		occ.line, occ.start, occ.end = int(rng[0]), int(rng[1]), int(rng[3])
+		if rng[2] != rng[0] {
+			occ.end = occ.start + 1
+		}
+	default:
+		return occ, false, nil
+	}
+	occ.definition = roles&scipSymbolRoleDefinition != 0
+	return occ, true, nil
+}
+
+func parseSCIPSymbol(b []byte) (symbol, hover string, err error) {
+	var texts []string
+	err = consumeSCIPFields(b, func(num protowire.Number, typ protowire.Type, value []byte, _ uint64) {
+		if typ != protowire.BytesType {
+			return
+		}
+		switch num {
+		case scipSymbolInformationSymbol:
+			symbol = string(value)
+		case scipSymbolInformationDocumentation:
+			texts = append(texts, string(value))
+		}
+	})
+	return symbol, strings.TrimSpace(strings.Join(texts, "\n\n")), err
+}
+
+// build creates a result for every symbol, the columns are used as they are
+// because SCIP indexers use UTF-16 offsets like LSIF by default
+func (p *scipParser) build(commitID string, maxReferences int) *Index {
+	idx := &Index{
+		Version:  IndexVersion,
+		CommitID: commitID,
+		Precise:  true,
+		Ranges:   make(map[string][]Range),
+	}
+
+	results := make(map[string]int)
+	for _, path := range slices.Sorted(maps.Keys(p.occurrences)) {
+		for _, occ := range p.occurrences[path] {
+			i, ok := results[occ.symbol]
+			if !ok {
+				i = len(idx.Results)
+				results[occ.symbol] = i
+				idx.Results = append(idx.Results, &Result{Hover: p.documentation[occ.symbol]})
+			}
+
+			result := idx.Results[i]
+			loc := Location{Path: path, Line: occ.line + 1, Column: occ.start}
+			if occ.definition {
+				result.Definitions = append(result.Definitions, loc)
+			}
+			if maxReferences <= 0 || len(result.References) < maxReferences {
+				result.References = append(result.References, loc)
+			}
+			idx.Ranges[path] = append(idx.Ranges[path], Range{
+				Line:   occ.line + 1,
+				Start:  occ.start,
+				End:    occ.end,
+				Result: i,
+			})
+		}
+	}
+
+	for _, result := range idx.Results {
+		result.Definitions = sortLocations(result.Definitions)
+		result.References = sortLocations(result.References)
+	}
+	sortRanges(idx)
+	return idx
+}
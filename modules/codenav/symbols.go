@@ -0,0 +1,105 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package codenav
+
+import (
+	"regexp"
+	"slices"
+
+	"gitea.dev/modules/container"
+)
+
+// symbolPatterns are used to find definitions in source files, the first submatch of every pattern is the symbol name.
+// This is not a real parser but it is good enough to rank definitions above usages.
+var symbolPatterns = map[string][]*regexp.Regexp{
+	"Go": {
+		regexp.MustCompile(`(?m)^func\s+(?:\([^)]*\)\s*)?([A-Za-z_]\w*)`),
+		regexp.MustCompile(`(?m)^(?:type|const|var)\s+([A-Za-z_]\w*)`),
+		regexp.MustCompile(`(?m)^\t([A-Za-z_]\w*)\s+(?:struct|interface)\b`),
+	},
+	"Python": {
+		regexp.MustCompile(`(?m)^\s*(?:async\s+)?(?:def|class)\s+([A-Za-z_]\w*)`),
+	},
+	"JavaScript": {
+		regexp.MustCompile(`(?m)^\s*(?:export\s+)?(?:default\s+)?(?:async\s+)?(?:function\*?|class)\s+([A-Za-z_$][\w$]*)`),
+		regexp.MustCompile(`(?m)^\s*(?:export\s+)?(?:const|let|var)\s+([A-Za-z_$][\w$]*)\s*=`),
+	},
+	"TypeScript": {
+		regexp.MustCompile(`(?m)^\s*(?:export\s+)?(?:default\s+)?(?:abstract\s+)?(?:async\s+)?(?:function\*?|class|interface|type|enum)\s+([A-Za-z_$][\w$]*)`),
+		regexp.MustCompile(`(?m)^\s*(?:export\s+)?(?:const|let|var)\s+([A-Za-z_$][\w$]*)\s*[:=]`),
+	},
+	"Java": {
+		regexp.MustCompile(`(?m)^\s*(?:(?:public|protected|private|static|final|abstract)\s+)*(?:class|interface|enum|record)\s+([A-Za-z_]\w*)`),
+		regexp.MustCompile(`(?m)^\s*(?:(?:public|protected|private|static|final|abstract|synchronized)\s+)+[\w<>\[\],\s]+\s+([A-Za-z_]\w*)\s*\(`),
+	},
+	"Kotlin": {
+		regexp.MustCompile(`(?m)^\s*(?:\w+\s+)*(?:fun|class|interface|object|val|var)\s+(?:<[^>]*>\s*)?(?:\w+\.)?([A-Za-z_]\w*)`),
+	},
+	"Rust": {
+		regexp.MustCompile(`(?m)^\s*(?:pub(?:\([^)]*\))?\s+)?(?:async\s+)?(?:unsafe\s+)?(?:fn|struct|enum|trait|type|mod|const|static)\s+([A-Za-z_]\w*)`),
+	},
+	"Ruby": {
+		regexp.MustCompile(`(?m)^\s*(?:def\s+(?:self\.)?|class\s+|module\s+)([A-Za-z_]\w*[?!]?)`),
+	},
+	"PHP": {
+		regexp.MustCompile(`(?m)^\s*(?:(?:abstract|final|public|protected|private|static)\s+)*(?:function|class|interface|trait|enum)\s+([A-Za-z_]\w*)`),
+	},
+	"C": {
+		regexp.MustCompile(`(?m)^[A-Za-z_][\w\s\*]*?\b([A-Za-z_]\w*)\s*\([^;]*$`),
+		regexp.MustCompile(`(?m)^\s*#define\s+([A-Za-z_]\w*)`),
+		regexp.MustCompile(`(?m)^\s*(?:typedef\s+)?(?:struct|union|enum)\s+([A-Za-z_]\w*)`),
+	},
+	"C++": {
+		regexp.MustCompile(`(?m)^[A-Za-z_][\w\s\*&:<>]*?\b([A-Za-z_]\w*)\s*\([^;]*$`),
+		regexp.MustCompile(`(?m)^\s*#define\s+([A-Za-z_]\w*)`),
+		regexp.MustCompile(`(?m)^\s*(?:template\s*<[^>]*>\s*)?(?:class|struct|union|enum(?:\s+class)?|namespace)\s+([A-Za-z_]\w*)`),
+	},
+	"C#": {
+		regexp.MustCompile(`(?m)^\s*(?:(?:public|protected|private|internal|static|sealed|abstract|partial)\s+)*(?:class|interface|struct|enum|record)\s+([A-Za-z_]\w*)`),
+		regexp.MustCompile(`(?m)^\s*(?:(?:public|protected|private|internal|static|virtual|override|async)\s+)+[\w<>\[\],\s]+\s+([A-Za-z_]\w*)\s*\(`),
+	},
+	"Swift": {
+		regexp.MustCompile(`(?m)^\s*(?:\w+\s+)*(?:func|class|struct|enum|protocol|extension)\s+([A-Za-z_]\w*)`),
+	},
+}
+
+// Symbol is a definition in a file, Start and End are byte offsets in the content
+type Symbol struct {
+	Name  string
+	Start int
+	End   int
+}
+
+// IsSupportedLanguage returns true if definitions can be extracted from files of the language
+func IsSupportedLanguage(language string) bool {
+	return len(symbolPatterns[language]) > 0
+}
+
+// ExtractSymbols returns the definitions found in the content, ordered by position
+func ExtractSymbols(language, content string) []Symbol {
+	patterns := symbolPatterns[language]
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	seen := make(container.Set[int])
+	var symbols []Symbol
+	for _, pattern := range patterns {
+		for _, match := range pattern.FindAllStringSubmatchIndex(content, -1) {
+			start, end := match[2], match[3]
+			if start < 0 || !seen.Add(start) {
+				continue
+			}
+			symbols = append(symbols, Symbol{
+				Name:  content[start:end],
+				Start: start,
+				End:   end,
+			})
+		}
+	}
+	slices.SortFunc(symbols, func(a, b Symbol) int {
+		return a.Start - b.Start
+	})
+	return symbols
+}
@@ -0,0 +1,27 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package codenav
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractSymbols(t *testing.T) {
+	symbols := ExtractSymbols("Go", "package main\n\ntype Indexer struct{}\n\nfunc (i *Indexer) Search() {}\n\nfunc NewIndexer() *Indexer {}\n")
+	names := make([]string, 0, len(symbols))
+	for _, sym := range symbols {
+		names = append(names, sym.Name)
+	}
+	assert.Equal(t, []string{"Indexer", "Search", "NewIndexer"}, names)
+
+	symbols = ExtractSymbols("Python", "class Foo:\n    def bar(self):\n        pass\n")
+	require.Len(t, symbols, 2)
+	assert.Equal(t, "Foo", symbols[0].Name)
+	assert.Equal(t, "bar", symbols[1].Name)
+
+	assert.Empty(t, ExtractSymbols("Markdown", "# Title"))
+}
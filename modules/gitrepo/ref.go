@@ -17,3 +17,14 @@ func RemoveRef(ctx context.Context, repo Repository, refName string) error {
 	return RunCmd(ctx, repo, gitcmd.NewCommand("update-ref", "--no-deref", "-d").
 		AddDynamicArguments(refName))
 }
+
+// GetRefCommitID returns the commit ID the reference points to
+func GetRefCommitID(ctx context.Context, repo Repository, refName string) (string, error) {
+	gitRepo, err := OpenRepository(ctx, repo)
+	if err != nil {
+		return "", err
+	}
+	defer gitRepo.Close()
+
+	return gitRepo.GetRefCommitID(refName)
+}
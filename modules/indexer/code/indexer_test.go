@@ -16,6 +16,7 @@ import (
 	"gitea.dev/modules/indexer/code/bleve"
 	"gitea.dev/modules/indexer/code/elasticsearch"
 	"gitea.dev/modules/indexer/code/internal"
+	"gitea.dev/modules/indexer/code/trigram"
 	"gitea.dev/modules/setting"
 	"gitea.dev/modules/test"
 	"gitea.dev/modules/util"
@@ -307,6 +308,19 @@ func TestBleveIndexAndSearch(t *testing.T) {
 	testIndexer("bleve", t, idx)
 }
 
+func TestTrigramIndexAndSearch(t *testing.T) {
+	unittest.PrepareTestEnv(t)
+	dir := t.TempDir()
+
+	idx := trigram.NewIndexer(dir)
+	defer idx.Close()
+
+	_, err := idx.Init(t.Context())
+	require.NoError(t, err)
+
+	testIndexer("trigram", t, idx)
+}
+
 func TestESIndexAndSearch(t *testing.T) {
 	unittest.PrepareTestEnv(t)
 
@@ -0,0 +1,227 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package trigram
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"strings"
+
+	"gitea.dev/modules/indexer"
+	"gitea.dev/modules/util"
+)
+
+// contentAtom must match the content or the file name of a document
+type contentAtom struct {
+	re *regexp.Regexp
+	// literals are lowercased strings which must appear in a matching document, used to look up the trigram index
+	literals []string
+}
+
+// searchQuery is a parsed query in the Zoekt query syntax:
+//
+//	file:REGEX (or f:) restricts the file names
+//	repo:REGEX (or r:) restricts the repository names
+//	lang:NAME restricts the language of the files
+//	sym:REGEX matches symbol definitions
+//	case:yes enables case-sensitive matching
+//
+// The remaining terms are matched against the file contents and names depending on the search mode.
+type searchQuery struct {
+	atoms    []*contentAtom
+	files    []*regexp.Regexp
+	repos    []*regexp.Regexp
+	symbols  []*regexp.Regexp
+	language string
+	// phrase is the query without modifiers and is used to rank exact matches higher
+	phrase        string
+	caseSensitive bool
+}
+
+func invalidQueryError(format string, args ...any) error {
+	return util.NewInvalidArgumentErrorf("invalid code search query: "+format, args...)
+}
+
+func parseQuery(keyword string, mode indexer.SearchModeType) (*searchQuery, error) {
+	type modifier struct{ name, value string }
+
+	var modifiers []modifier
+	var terms []string
+	q := &searchQuery{}
+
+	for _, token := range tokenize(keyword) {
+		name, value, ok := strings.Cut(token, ":")
+		if ok {
+			switch name {
+			case "f", "file", "r", "repo", "lang", "sym", "case":
+				modifiers = append(modifiers, modifier{name, value})
+				continue
+			}
+		}
+		terms = append(terms, token)
+	}
+
+	// the case modifier affects all other parts of the query
+	for _, m := range modifiers {
+		if m.name != "case" {
+			continue
+		}
+		switch m.value {
+		case "yes":
+			q.caseSensitive = true
+		case "no", "auto":
+			q.caseSensitive = false
+		default:
+			return nil, invalidQueryError("case must be yes or no")
+		}
+	}
+
+	for _, m := range modifiers {
+		if m.value == "" && m.name != "case" {
+			return nil, invalidQueryError("%s: requires a value", m.name)
+		}
+
+		var err error
+		var re *regexp.Regexp
+		switch m.name {
+		case "f", "file":
+			re, err = q.compile(m.value)
+			q.files = append(q.files, re)
+		case "r", "repo":
+			re, err = q.compile(m.value)
+			q.repos = append(q.repos, re)
+		case "sym":
+			re, err = q.compile(m.value)
+			q.symbols = append(q.symbols, re)
+		case "lang":
+			q.language = m.value
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	q.phrase = strings.Join(terms, " ")
+	if q.phrase == "" {
+		return q, nil
+	}
+
+	switch mode {
+	case indexer.SearchModeRegexp:
+		atom, err := q.regexpAtom(q.phrase)
+		if err != nil {
+			return nil, err
+		}
+		q.atoms = append(q.atoms, atom)
+	case indexer.SearchModeExact:
+		q.atoms = append(q.atoms, q.literalAtom(q.phrase))
+	default:
+		for _, term := range terms {
+			q.atoms = append(q.atoms, q.literalAtom(term))
+		}
+	}
+	return q, nil
+}
+
+// tokenize splits the keyword at whitespace, double quotes group words into a single token
+func tokenize(keyword string) []string {
+	var tokens []string
+	var current strings.Builder
+	inQuotes := false
+	hasToken := false
+	for _, r := range keyword {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			hasToken = true
+		case !inQuotes && (r == ' ' || r == '\t' || r == '\n'):
+			if hasToken {
+				tokens = append(tokens, current.String())
+				current.Reset()
+				hasToken = false
+			}
+		default:
+			current.WriteRune(r)
+			hasToken = true
+		}
+	}
+	if hasToken && current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+	return tokens
+}
+
+func (q *searchQuery) compile(pattern string) (*regexp.Regexp, error) {
+	if !q.caseSensitive {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, invalidQueryError("%v", err)
+	}
+	return re, nil
+}
+
+func (q *searchQuery) literalAtom(literal string) *contentAtom {
+	re, _ := q.compile(regexp.QuoteMeta(literal)) // a quoted literal is always a valid pattern
+	return &contentAtom{
+		re:       re,
+		literals: []string{strings.ToLower(literal)},
+	}
+}
+
+func (q *searchQuery) regexpAtom(pattern string) (*contentAtom, error) {
+	re, err := q.compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, invalidQueryError("%v", err)
+	}
+	return &contentAtom{
+		re:       re,
+		literals: requiredLiterals(parsed.Simplify()),
+	}, nil
+}
+
+// requiredLiterals returns lowercased strings which must be contained in every text matched by the expression.
+// The result is conservative: expressions like alternations don't contribute any literal.
+func requiredLiterals(re *syntax.Regexp) []string {
+	switch re.Op {
+	case syntax.OpLiteral:
+		return []string{strings.ToLower(string(re.Rune))}
+	case syntax.OpCapture, syntax.OpPlus:
+		return requiredLiterals(re.Sub[0])
+	case syntax.OpConcat:
+		var literals []string
+		var current strings.Builder
+		flush := func() {
+			if current.Len() > 0 {
+				literals = append(literals, strings.ToLower(current.String()))
+				current.Reset()
+			}
+		}
+		for _, sub := range re.Sub {
+			if sub.Op == syntax.OpLiteral {
+				current.WriteString(string(sub.Rune))
+				continue
+			}
+			flush()
+			literals = append(literals, requiredLiterals(sub)...)
+		}
+		flush()
+		return literals
+	}
+	return nil
+}
+
+func matchesAll(res []*regexp.Regexp, s string) bool {
+	for _, re := range res {
+		if !re.MatchString(s) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package trigram
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gitea.dev/modules/codenav"
+	"gitea.dev/modules/indexer"
+	"gitea.dev/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuery(t *testing.T) {
+	q, err := parseQuery(`file:\.go$ lang:Go repo:^org/ sym:^New "hello world" foo`, indexer.SearchModeWords)
+	require.NoError(t, err)
+	assert.Len(t, q.files, 1)
+	assert.True(t, q.files[0].MatchString("main.GO"))
+	assert.Len(t, q.repos, 1)
+	assert.Len(t, q.symbols, 1)
+	assert.Equal(t, "Go", q.language)
+	assert.Equal(t, "hello world foo", q.phrase)
+	require.Len(t, q.atoms, 2)
+	assert.Equal(t, []string{"hello world"}, q.atoms[0].literals)
+	assert.Equal(t, []string{"foo"}, q.atoms[1].literals)
+
+	q, err = parseQuery("case:yes Foo", indexer.SearchModeExact)
+	require.NoError(t, err)
+	require.Len(t, q.atoms, 1)
+	assert.True(t, q.atoms[0].re.MatchString("Foo"))
+	assert.False(t, q.atoms[0].re.MatchString("foo"))
+
+	q, err = parseQuery("a b", indexer.SearchModeExact)
+	require.NoError(t, err)
+	require.Len(t, q.atoms, 1)
+	assert.True(t, q.atoms[0].re.MatchString("x a b y"))
+	assert.False(t, q.atoms[0].re.MatchString("a  b"))
+
+	q, err = parseQuery(`func\s+New(Indexer|Queue)`, indexer.SearchModeRegexp)
+	require.NoError(t, err)
+	require.Len(t, q.atoms, 1)
+	assert.Equal(t, []string{"func", "new"}, q.atoms[0].literals)
+
+	_, err = parseQuery("foo(", indexer.SearchModeRegexp)
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+	_, err = parseQuery("file:", indexer.SearchModeWords)
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+	_, err = parseQuery("case:maybe foo", indexer.SearchModeWords)
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+}
+
+// writeTestShard writes a base shard if base is nil, else a delta shard of it which replaces the files
+func writeTestShard(t *testing.T, dir string, base *shard, replaced []string, docs ...*document) *shard {
+	var baseGeneration int64
+	if base != nil {
+		baseGeneration = base.base
+	}
+	sw, err := newShardWriter(dir, 1, baseGeneration, "org/repo", "sha", replaced)
+	require.NoError(t, err)
+	for _, doc := range docs {
+		require.NoError(t, sw.add(doc, doc.content))
+	}
+	path, err := sw.finish()
+	require.NoError(t, err)
+	s, err := openShard(path)
+	require.NoError(t, err)
+	return s
+}
+
+func TestShardCandidates(t *testing.T) {
+	s := writeTestShard(t, t.TempDir(), nil, nil,
+		&document{Name: "a.go", content: []byte("package main\nfunc NewIndexer() {}")},
+		&document{Name: "b.go", content: []byte("package main\nfunc helper() {}")},
+	)
+	defer s.release()
+
+	assert.Equal(t, []uint32{0}, s.candidates([]string{"newindexer"}))
+	assert.Equal(t, []uint32{0, 1}, s.candidates([]string{"package"}))
+	assert.Equal(t, []uint32{1}, s.candidates([]string{"b.go"}))
+	assert.Empty(t, s.candidates([]string{"missing"}))
+	assert.Equal(t, []uint32{0, 1}, s.candidates([]string{"ab"}))
+}
+
+func TestShardFile(t *testing.T) {
+	dir := t.TempDir()
+	s := writeTestShard(t, dir, nil, nil,
+		&document{Name: "a.go", Language: "Go", Symbols: []codenav.Symbol{{Name: "main", Start: 18, End: 22}}, UpdatedUnix: 1, content: []byte("package main\nfunc main() {}")},
+		&document{Name: "image.png"},
+	)
+	assert.EqualValues(t, 1, s.RepoID)
+	assert.Equal(t, "org/repo", s.RepoName)
+	assert.Equal(t, "sha", s.CommitID)
+	require.Equal(t, 2, s.docCount)
+
+	doc, err := s.document(0)
+	require.NoError(t, err)
+	assert.Equal(t, "a.go", doc.Name)
+	assert.Equal(t, "Go", doc.Language)
+	assert.Equal(t, "main", doc.Symbols[0].Name)
+	assert.Equal(t, "package main\nfunc main() {}", string(doc.content))
+	doc, err = s.document(1)
+	require.NoError(t, err)
+	assert.Equal(t, "image.png", doc.Name)
+	assert.Empty(t, doc.content)
+
+	// a retired shard is removed when the last search using it has finished
+	s.acquire()
+	s.retire()
+	assert.FileExists(t, s.path)
+	s.release()
+	assert.NoFileExists(t, s.path)
+
+	_, err = openShard(filepath.Join(dir, "missing.shard"))
+	assert.Error(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1-1-1.shard"), []byte("GTZS"), 0o644))
+	_, err = openShard(filepath.Join(dir, "1-1-1.shard"))
+	assert.Error(t, err)
+}
+
+func TestMatchSymbols(t *testing.T) {
+	content := "package main\n\ntype Indexer struct{}\n\nfunc NewIndexer() *Indexer {}\n"
+	doc := &document{Name: "main.go", Language: "Go", Symbols: codenav.ExtractSymbols("Go", content), content: []byte(content)}
+	pyContent := "class NewIndexer(Indexer):\n    pass\n"
+	pyDoc := &document{Name: "main.py", Language: "Python", Symbols: codenav.ExtractSymbols("Python", pyContent), content: []byte(pyContent)}
+
+	q, err := parseQuery("sym:^New", indexer.SearchModeWords)
+	require.NoError(t, err)
+	h := q.match(nil, doc)
+	require.NotNil(t, h)
+	assert.Equal(t, "NewIndexer", content[h.startIndex:h.endIndex])
+	h = q.match(nil, pyDoc)
+	require.NotNil(t, h)
+	assert.Equal(t, "NewIndexer", pyContent[h.startIndex:h.endIndex])
+
+	// the symbols are only definitions, not references
+	q, err = parseQuery("sym:^Indexer$", indexer.SearchModeWords)
+	require.NoError(t, err)
+	assert.NotNil(t, q.match(nil, doc))
+	assert.Nil(t, q.match(nil, pyDoc))
+
+	q, err = parseQuery("sym:^Missing", indexer.SearchModeWords)
+	require.NoError(t, err)
+	assert.Nil(t, q.match(nil, doc))
+}
@@ -0,0 +1,251 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package trigram
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"path"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+
+	"gitea.dev/modules/indexer/code/internal"
+	"gitea.dev/modules/util"
+
+	"github.com/go-enry/go-enry/v2"
+	"golang.org/x/sync/errgroup"
+)
+
+const maxLanguageFacets = 10
+
+// score weights, a match in the file name is more relevant than a match in the content
+const (
+	scoreFilenameMatch = 10
+	scoreFilenameExact = 20
+	scoreSymbolMatch   = 15
+	scoreContentPhrase = 5
+	scoreContentMatch  = 1
+)
+
+type hit struct {
+	shard *shard
+	doc   *document
+	// commitID is the newest indexed commit of the repository, the unchanged files of older shards are the same in it
+	commitID   string
+	score      int
+	startIndex int
+	endIndex   int
+}
+
+// Search searches for files in the specified repos using a subset of the Zoekt query syntax
+func (b *Indexer) Search(ctx context.Context, opts *internal.SearchOptions) (int64, []*internal.SearchResult, []*internal.SearchResultLanguages, error) {
+	searchMode := util.IfZero(opts.SearchMode, b.SupportedSearchModes()[0].ModeValue)
+	q, err := parseQuery(opts.Keyword, searchMode)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	var literals []string
+	for _, atom := range q.atoms {
+		literals = append(literals, atom.literals...)
+	}
+
+	// the contents of the hits point into the mapped shards which must be used until the results are built
+	repoShards := b.getRepoShards(opts.RepoIDs, q.repos)
+	defer func() {
+		for _, shards := range repoShards {
+			releaseShards(shards)
+		}
+	}()
+
+	var (
+		hits           []*hit
+		languageCounts = make(map[string]int)
+		resultsLock    sync.Mutex
+	)
+	// the repositories are searched in parallel, only the shards of the repositories are searched one after another
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(runtime.GOMAXPROCS(0))
+	for _, shards := range repoShards {
+		eg.Go(func() error {
+			repoHits, repoLanguageCounts, err := q.searchRepo(egCtx, shards, literals, opts.Language)
+			if err != nil {
+				return err
+			}
+			resultsLock.Lock()
+			defer resultsLock.Unlock()
+			hits = append(hits, repoHits...)
+			for language, count := range repoLanguageCounts {
+				languageCounts[language] += count
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return 0, nil, nil, err
+	}
+
+	slices.SortFunc(hits, func(a, b *hit) int {
+		if a.score != b.score {
+			return b.score - a.score
+		}
+		if len(a.doc.Name) != len(b.doc.Name) {
+			return len(a.doc.Name) - len(b.doc.Name)
+		}
+		if a.shard.RepoID != b.shard.RepoID {
+			return cmp.Compare(a.shard.RepoID, b.shard.RepoID)
+		}
+		return strings.Compare(a.doc.Name, b.doc.Name)
+	})
+
+	total := int64(len(hits))
+	skip, take := opts.GetSkipTake()
+	if skip > len(hits) {
+		skip = len(hits)
+	}
+	hits = hits[skip:]
+	if take > 0 && take < len(hits) {
+		hits = hits[:take]
+	}
+
+	searchResults := make([]*internal.SearchResult, 0, len(hits))
+	for _, h := range hits {
+		searchResults = append(searchResults, &internal.SearchResult{
+			RepoID:      h.shard.RepoID,
+			StartIndex:  h.startIndex,
+			EndIndex:    h.endIndex,
+			Filename:    h.doc.Name,
+			Content:     string(h.doc.content),
+			CommitID:    h.commitID,
+			UpdatedUnix: h.doc.UpdatedUnix,
+			Language:    h.doc.Language,
+			Color:       enry.GetColor(h.doc.Language),
+		})
+	}
+
+	searchResultLanguages := make([]*internal.SearchResultLanguages, 0, len(languageCounts))
+	for language, count := range languageCounts {
+		searchResultLanguages = append(searchResultLanguages, &internal.SearchResultLanguages{
+			Language: language,
+			Color:    enry.GetColor(language),
+			Count:    count,
+		})
+	}
+	slices.SortFunc(searchResultLanguages, func(a, b *internal.SearchResultLanguages) int {
+		if a.Count != b.Count {
+			return b.Count - a.Count
+		}
+		return strings.Compare(a.Language, b.Language)
+	})
+	if len(searchResultLanguages) > maxLanguageFacets {
+		searchResultLanguages = searchResultLanguages[:maxLanguageFacets]
+	}
+
+	return total, searchResults, searchResultLanguages, nil
+}
+
+// searchRepo searches the shards of a repository, the files replaced by a newer shard are skipped
+func (q *searchQuery) searchRepo(ctx context.Context, shards []*shard, literals []string, language string) ([]*hit, map[string]int, error) {
+	var hits []*hit
+	languageCounts := make(map[string]int)
+	commitID := shards[len(shards)-1].CommitID
+	for i, s := range shards {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		for _, idx := range s.candidates(literals) {
+			doc, err := s.document(int(idx))
+			if err != nil {
+				return nil, nil, err
+			}
+			if hiddenBy(shards[i+1:], doc.Name) {
+				continue
+			}
+			h := q.match(s, doc)
+			if h == nil {
+				continue
+			}
+			if doc.Language != "" {
+				languageCounts[doc.Language]++
+			}
+			if language != "" && doc.Language != language {
+				continue
+			}
+			h.commitID = commitID
+			hits = append(hits, h)
+		}
+	}
+	return hits, languageCounts, nil
+}
+
+// match checks whether the document matches all parts of the query and returns the scored hit
+func (q *searchQuery) match(s *shard, doc *document) *hit {
+	if !matchesAll(q.files, doc.Name) {
+		return nil
+	}
+	if q.language != "" && !strings.EqualFold(q.language, doc.Language) {
+		return nil
+	}
+
+	h := &hit{shard: s, doc: doc, startIndex: -1, endIndex: -1}
+
+	for _, re := range q.symbols {
+		found := false
+		for _, sym := range doc.Symbols {
+			if re.MatchString(sym.Name) {
+				if !found {
+					h.score += scoreSymbolMatch
+				}
+				found = true
+				if h.startIndex < 0 || sym.Start < h.startIndex {
+					h.startIndex, h.endIndex = sym.Start, sym.End
+				}
+			}
+		}
+		if !found {
+			return nil
+		}
+	}
+
+	contentStart, contentEnd := -1, -1
+	for _, atom := range q.atoms {
+		inName := atom.re.MatchString(doc.Name)
+		loc := atom.re.FindIndex(doc.content)
+		if !inName && loc == nil {
+			return nil
+		}
+		if inName {
+			h.score += scoreFilenameMatch
+		}
+		if loc != nil {
+			h.score += scoreContentMatch
+			if contentStart < 0 || loc[0] < contentStart {
+				contentStart, contentEnd = loc[0], loc[1]
+			}
+		}
+	}
+
+	if q.phrase != "" {
+		base := path.Base(doc.Name)
+		if strings.EqualFold(base, q.phrase) || strings.EqualFold(strings.TrimSuffix(base, path.Ext(base)), q.phrase) {
+			h.score += scoreFilenameExact
+		}
+		if len(q.atoms) > 1 && bytes.Contains(bytes.ToLower(doc.content), []byte(strings.ToLower(q.phrase))) {
+			h.score += scoreContentPhrase
+		}
+	}
+
+	switch {
+	case contentStart >= 0:
+		h.startIndex, h.endIndex = contentStart, contentEnd
+	case h.startIndex >= 0:
+		// highlight the symbol definition
+	default:
+		h.startIndex, h.endIndex = internal.FilenameMatchIndexPos(util.UnsafeBytesToString(doc.content))
+	}
+	return h
+}
@@ -0,0 +1,486 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package trigram
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"gitea.dev/modules/codenav"
+	"gitea.dev/modules/container"
+	"gitea.dev/modules/json"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"github.com/blevesearch/mmap-go"
+)
+
+const (
+	// shardVersion must be increased if the shard format changes, the index is rebuilt then
+	shardVersion    = 3
+	shardExtension  = ".shard"
+	tempFilePrefix  = "tmp-"
+	versionFilename = "VERSION"
+)
+
+// A shard file consists of (all integers are little endian):
+//
+//	header       shardHeaderSize bytes, see shardWriter.finish
+//	repo name    the full name of the repository
+//	commit id    the indexed commit
+//	replaced     the JSON encoded names of the files which are replaced or removed by a delta shard
+//	documents    the content of every document followed by its JSON encoded metadata
+//	doc table    docRecordSize bytes per document: content offset and length, metadata offset and length
+//	postings     the sorted uint32 indices of the documents containing a trigram
+//	trigrams     trigramRecordSize bytes per trigram sorted by trigram: trigram, number of postings, postings offset
+//
+// The files are memory-mapped, only the header is decoded when a shard is opened.
+const (
+	shardMagic        = "GTZS"
+	shardHeaderSize   = 64
+	docRecordSize     = 32
+	trigramRecordSize = 16
+)
+
+type document struct {
+	Name        string             `json:"name"`
+	Language    string             `json:"language,omitempty"`
+	Symbols     []codenav.Symbol   `json:"symbols,omitempty"`
+	UpdatedUnix timeutil.TimeStamp `json:"updated"`
+
+	// content points into the mapped shard, it must not be used after the shard has been released
+	content []byte
+}
+
+// shard contains indexed files of the default branch of a repository and a trigram index of them.
+// A shard is immutable once built. A base shard contains all files of the repository, a delta shard
+// contains the files updated by a later commit and hides the replaced ones of the older shards.
+type shard struct {
+	RepoID   int64
+	RepoName string
+	CommitID string
+
+	// base is the generation of the base shard which a delta shard belongs to, a base shard has its own generation
+	base       int64
+	generation int64
+	replaced   container.Set[string]
+
+	path     string
+	data     mmap.MMap
+	docCount int
+	docTable []byte
+	trigrams []byte
+
+	// refs counts the users of the shard, the indexer holds one reference as long as the shard is current.
+	// The file is unmapped when the last reference is released, so that running searches can finish.
+	refs    atomic.Int64
+	retired atomic.Bool
+}
+
+// shardPath returns the path of a new shard of the repository, the path of a retired shard stays valid
+// until it's no longer used by a running search. A new base shard is given the base generation 0.
+func shardPath(dir string, repoID, base int64) string {
+	generation := time.Now().UnixNano()
+	if base == 0 {
+		base = generation
+	}
+	return filepath.Join(dir, fmt.Sprintf("%d-%d-%d%s", repoID, base, generation, shardExtension))
+}
+
+// parseShardPath returns the repository id, the base generation and the generation of a shard file
+func parseShardPath(path string) (repoID, base, generation int64, err error) {
+	fields := strings.Split(strings.TrimSuffix(filepath.Base(path), shardExtension), "-")
+	if len(fields) != 3 {
+		return 0, 0, 0, fmt.Errorf("invalid shard file name %s", path)
+	}
+	values := make([]int64, len(fields))
+	for i, field := range fields {
+		if values[i], err = strconv.ParseInt(field, 10, 64); err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid shard file name %s", path)
+		}
+	}
+	return values[0], values[1], values[2], nil
+}
+
+// openShard maps the shard file and checks the bounds of its tables
+func openShard(path string) (*shard, error) {
+	_, base, generation, err := parseShardPath(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	// the mapping stays valid after the file is closed
+	data, err := mmap.Map(f, mmap.RDONLY, 0)
+	f.Close()
+	if err != nil {
+		return nil, fmt.Errorf("map shard %s: %w", path, err)
+	}
+
+	s, err := parseShard(data)
+	if err != nil {
+		_ = data.Unmap()
+		return nil, fmt.Errorf("invalid shard %s: %w", path, err)
+	}
+	s.path = path
+	s.base = base
+	s.generation = generation
+	s.refs.Store(1)
+	return s, nil
+}
+
+func parseShard(data []byte) (*shard, error) {
+	if len(data) < shardHeaderSize || string(data[:4]) != shardMagic || binary.LittleEndian.Uint32(data[4:8]) != shardVersion {
+		return nil, errors.New("unknown format")
+	}
+	s := &shard{
+		RepoID: int64(binary.LittleEndian.Uint64(data[8:16])),
+		data:   data,
+	}
+
+	docCount := binary.LittleEndian.Uint64(data[16:24])
+	docTableOffset := binary.LittleEndian.Uint64(data[24:32])
+	trigramCount := binary.LittleEndian.Uint64(data[32:40])
+	trigramTableOffset := binary.LittleEndian.Uint64(data[40:48])
+	nameLen := uint64(binary.LittleEndian.Uint32(data[48:52]))
+	commitLen := uint64(binary.LittleEndian.Uint32(data[52:56]))
+	replacedLen := uint64(binary.LittleEndian.Uint32(data[56:60]))
+
+	size := uint64(len(data))
+	if shardHeaderSize+nameLen+commitLen+replacedLen > size ||
+		docCount > size/docRecordSize || docTableOffset > size-docCount*docRecordSize ||
+		trigramCount > size/trigramRecordSize || trigramTableOffset > size-trigramCount*trigramRecordSize {
+		return nil, errors.New("truncated file")
+	}
+	s.RepoName = string(data[shardHeaderSize : shardHeaderSize+nameLen])
+	s.CommitID = string(data[shardHeaderSize+nameLen : shardHeaderSize+nameLen+commitLen])
+	if replacedLen > 0 {
+		var replaced []string
+		if err := json.Unmarshal(data[shardHeaderSize+nameLen+commitLen:shardHeaderSize+nameLen+commitLen+replacedLen], &replaced); err != nil {
+			return nil, fmt.Errorf("decode replaced files: %w", err)
+		}
+		s.replaced = container.SetOf(replaced...)
+	}
+	s.docCount = int(docCount)
+	s.docTable = data[docTableOffset : docTableOffset+docCount*docRecordSize]
+	s.trigrams = data[trigramTableOffset : trigramTableOffset+trigramCount*trigramRecordSize]
+
+	for i := range s.docCount {
+		record := s.docTable[i*docRecordSize:]
+		for _, r := range [][2]uint64{
+			{binary.LittleEndian.Uint64(record[0:8]), binary.LittleEndian.Uint64(record[8:16])},
+			{binary.LittleEndian.Uint64(record[16:24]), binary.LittleEndian.Uint64(record[24:32])},
+		} {
+			if r[0] > size || r[1] > size-r[0] {
+				return nil, fmt.Errorf("document %d is out of bounds", i)
+			}
+		}
+	}
+	for i := range int(trigramCount) {
+		record := s.trigrams[i*trigramRecordSize:]
+		count := uint64(binary.LittleEndian.Uint32(record[4:8]))
+		offset := binary.LittleEndian.Uint64(record[8:16])
+		if offset > size || count*4 > size-offset {
+			return nil, fmt.Errorf("postings of trigram %d are out of bounds", i)
+		}
+	}
+	return s, nil
+}
+
+// acquire adds a reference to the shard, it must only be called while the shard is current
+func (s *shard) acquire() {
+	s.refs.Add(1)
+}
+
+// release removes a reference from the shard and unmaps it if it was the last one.
+// The file is removed too if the shard has been retired.
+func (s *shard) release() {
+	if s.refs.Add(-1) != 0 {
+		return
+	}
+	if err := s.data.Unmap(); err != nil {
+		log.Error("Unable to unmap the code search shard %s: %v", s.path, err)
+	}
+	if s.retired.Load() {
+		if err := util.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Error("Unable to remove the code search shard %s: %v", s.path, err)
+		}
+	}
+}
+
+// retire releases the reference of the indexer to a shard which has been replaced or deleted
+func (s *shard) retire() {
+	s.retired.Store(true)
+	s.release()
+}
+
+// document decodes the metadata of the document, the content is not copied
+func (s *shard) document(idx int) (*document, error) {
+	record := s.docTable[idx*docRecordSize:]
+	contentOffset := binary.LittleEndian.Uint64(record[0:8])
+	contentLen := binary.LittleEndian.Uint64(record[8:16])
+	metaOffset := binary.LittleEndian.Uint64(record[16:24])
+	metaLen := binary.LittleEndian.Uint64(record[24:32])
+
+	doc := &document{}
+	if err := json.Unmarshal(s.data[metaOffset:metaOffset+metaLen], doc); err != nil {
+		return nil, fmt.Errorf("decode document %d of shard %s: %w", idx, s.path, err)
+	}
+	doc.content = s.data[contentOffset : contentOffset+contentLen]
+	return doc, nil
+}
+
+// postings returns the sorted indices of the documents containing the trigram
+func (s *shard) postings(trigram uint32) []uint32 {
+	n := len(s.trigrams) / trigramRecordSize
+	i := sort.Search(n, func(i int) bool {
+		return binary.LittleEndian.Uint32(s.trigrams[i*trigramRecordSize:]) >= trigram
+	})
+	if i == n {
+		return nil
+	}
+	record := s.trigrams[i*trigramRecordSize:]
+	if binary.LittleEndian.Uint32(record[0:4]) != trigram {
+		return nil
+	}
+	count := int(binary.LittleEndian.Uint32(record[4:8]))
+	offset := binary.LittleEndian.Uint64(record[8:16])
+	postings := make([]uint32, count)
+	for j := range postings {
+		postings[j] = binary.LittleEndian.Uint32(s.data[offset+uint64(j)*4:])
+	}
+	return postings
+}
+
+func addTrigrams(set container.Set[uint32], s string) {
+	for i := 0; i+3 <= len(s); i++ {
+		set.Add(trigramOf(s[i : i+3]))
+	}
+}
+
+func trigramOf(s string) uint32 {
+	return uint32(s[0])<<16 | uint32(s[1])<<8 | uint32(s[2])
+}
+
+// candidates returns the indices of the documents which may contain all literals.
+// If no literal is long enough to be looked up, all documents are candidates.
+func (s *shard) candidates(literals []string) []uint32 {
+	var result []uint32
+	filtered := false
+	for _, literal := range literals {
+		for i := 0; i+3 <= len(literal); i++ {
+			postings := s.postings(trigramOf(literal[i : i+3]))
+			if !filtered {
+				result = postings
+				filtered = true
+			} else {
+				result = intersect(result, postings)
+			}
+			if len(result) == 0 {
+				return nil
+			}
+		}
+	}
+	if !filtered {
+		result = make([]uint32, s.docCount)
+		for i := range result {
+			result[i] = uint32(i)
+		}
+	}
+	return result
+}
+
+func intersect(a, b []uint32) []uint32 {
+	result := a[:0]
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			result = append(result, a[i])
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+// shardWriter streams the documents of a new shard to a temporary file,
+// only the document table and the postings are kept in memory until the shard is finished
+type shardWriter struct {
+	dir         string
+	repoID      int64
+	base        int64
+	f           *os.File
+	w           *bufio.Writer
+	offset      uint64
+	docs        []uint64
+	trigrams    map[uint32][]uint32
+	nameLen     int
+	commitLen   int
+	replacedLen int
+}
+
+// newShardWriter starts a new base shard if base is 0, else a delta shard of the base shard which hides the replaced files
+func newShardWriter(dir string, repoID, base int64, repoName, commitID string, replaced []string) (*shardWriter, error) {
+	var replacedJSON []byte
+	if len(replaced) > 0 {
+		var err error
+		if replacedJSON, err = json.Marshal(replaced); err != nil {
+			return nil, err
+		}
+	}
+	if len(repoName) > math.MaxUint32 || len(commitID) > math.MaxUint32 || len(replacedJSON) > math.MaxUint32 {
+		return nil, errors.New("repository name, commit id or replaced files are too long")
+	}
+	f, err := os.CreateTemp(dir, tempFilePrefix+"*")
+	if err != nil {
+		return nil, err
+	}
+	sw := &shardWriter{
+		dir:         dir,
+		repoID:      repoID,
+		base:        base,
+		f:           f,
+		w:           bufio.NewWriter(f),
+		trigrams:    make(map[uint32][]uint32),
+		nameLen:     len(repoName),
+		commitLen:   len(commitID),
+		replacedLen: len(replacedJSON),
+	}
+	// the header is written when the shard is finished
+	if err := sw.write(make([]byte, shardHeaderSize)); err != nil {
+		sw.abort()
+		return nil, err
+	}
+	if err := sw.write(append([]byte(repoName+commitID), replacedJSON...)); err != nil {
+		sw.abort()
+		return nil, err
+	}
+	return sw, nil
+}
+
+func (sw *shardWriter) write(p []byte) error {
+	n, err := sw.w.Write(p)
+	sw.offset += uint64(n)
+	return err
+}
+
+func (sw *shardWriter) writeUint64(v uint64) error {
+	return sw.write(binary.LittleEndian.AppendUint64(nil, v))
+}
+
+// add appends the document with its content to the shard
+func (sw *shardWriter) add(doc *document, content []byte) error {
+	if len(sw.docs)/4 >= math.MaxUint32 {
+		return errors.New("too many documents")
+	}
+	meta, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	contentOffset := sw.offset
+	if err := sw.write(content); err != nil {
+		return err
+	}
+	metaOffset := sw.offset
+	if err := sw.write(meta); err != nil {
+		return err
+	}
+
+	idx := uint32(len(sw.docs) / 4)
+	sw.docs = append(sw.docs, contentOffset, uint64(len(content)), metaOffset, uint64(len(meta)))
+
+	trigrams := make(container.Set[uint32])
+	addTrigrams(trigrams, strings.ToLower(doc.Name))
+	addTrigrams(trigrams, strings.ToLower(util.UnsafeBytesToString(content)))
+	for t := range trigrams {
+		sw.trigrams[t] = append(sw.trigrams[t], idx)
+	}
+	return nil
+}
+
+// finish writes the tables and moves the shard file to its final path
+func (sw *shardWriter) finish() (string, error) {
+	defer sw.abort()
+
+	docTableOffset := sw.offset
+	for _, v := range sw.docs {
+		if err := sw.writeUint64(v); err != nil {
+			return "", err
+		}
+	}
+
+	trigrams := slices.Sorted(maps.Keys(sw.trigrams))
+	postingOffsets := make([]uint64, len(trigrams))
+	for i, t := range trigrams {
+		postingOffsets[i] = sw.offset
+		for _, idx := range sw.trigrams[t] {
+			if err := sw.write(binary.LittleEndian.AppendUint32(nil, idx)); err != nil {
+				return "", err
+			}
+		}
+	}
+	trigramTableOffset := sw.offset
+	for i, t := range trigrams {
+		record := binary.LittleEndian.AppendUint32(nil, t)
+		record = binary.LittleEndian.AppendUint32(record, uint32(len(sw.trigrams[t])))
+		record = binary.LittleEndian.AppendUint64(record, postingOffsets[i])
+		if err := sw.write(record); err != nil {
+			return "", err
+		}
+	}
+	if err := sw.w.Flush(); err != nil {
+		return "", err
+	}
+
+	header := make([]byte, 0, shardHeaderSize)
+	header = append(header, shardMagic...)
+	header = binary.LittleEndian.AppendUint32(header, shardVersion)
+	header = binary.LittleEndian.AppendUint64(header, uint64(sw.repoID))
+	header = binary.LittleEndian.AppendUint64(header, uint64(len(sw.docs)/4))
+	header = binary.LittleEndian.AppendUint64(header, docTableOffset)
+	header = binary.LittleEndian.AppendUint64(header, uint64(len(trigrams)))
+	header = binary.LittleEndian.AppendUint64(header, trigramTableOffset)
+	header = binary.LittleEndian.AppendUint32(header, uint32(sw.nameLen))
+	header = binary.LittleEndian.AppendUint32(header, uint32(sw.commitLen))
+	header = binary.LittleEndian.AppendUint32(header, uint32(sw.replacedLen))
+	if _, err := sw.f.WriteAt(header, 0); err != nil {
+		return "", err
+	}
+	if err := sw.f.Close(); err != nil {
+		return "", err
+	}
+
+	path := shardPath(sw.dir, sw.repoID, sw.base)
+	if err := os.Rename(sw.f.Name(), path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// abort removes the temporary file, it does nothing if the shard has been finished
+func (sw *shardWriter) abort() {
+	_ = sw.f.Close()
+	_ = os.Remove(sw.f.Name())
+}
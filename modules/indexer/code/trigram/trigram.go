@@ -0,0 +1,414 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+// Package trigram implements a code indexer which keeps trigram index shards on the local disk.
+//
+// The design follows Zoekt, but it doesn't use the Zoekt library: the shards have their own format, see shard.go,
+// and the queries use a subset of the Zoekt query syntax, see query.go. Unlike bleve the index can answer regular
+// expression queries, the trigrams of their literals select the candidate files which are then matched.
+//
+// Every repository has a base shard with all its indexed files. An update writes a delta shard with only the
+// changed files, which hides the replaced and removed files of the older shards of the repository. When a
+// repository has maxShardsPerRepo shards, the next update merges them into a new base shard.
+// The shards are memory-mapped, so the operating system decides which parts of them are kept in memory.
+package trigram
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	repo_model "gitea.dev/models/repo"
+	"gitea.dev/modules/analyze"
+	"gitea.dev/modules/charset"
+	"gitea.dev/modules/codenav"
+	"gitea.dev/modules/container"
+	"gitea.dev/modules/git"
+	"gitea.dev/modules/git/gitcmd"
+	"gitea.dev/modules/gitrepo"
+	"gitea.dev/modules/globallock"
+	"gitea.dev/modules/indexer"
+	"gitea.dev/modules/indexer/code/internal"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/typesniffer"
+	"gitea.dev/modules/util"
+)
+
+// maxShardsPerRepo limits the delta shards which have to be searched for a repository
+const maxShardsPerRepo = 8
+
+var _ internal.Indexer = &Indexer{}
+
+// Indexer implements Indexer interface with trigram index shards
+type Indexer struct {
+	dir string
+
+	lock sync.RWMutex
+	// shards are the current shards of every repository, the base shard first
+	shards map[int64][]*shard
+}
+
+// NewIndexer creates a new trigram indexer
+func NewIndexer(indexDir string) *Indexer {
+	return &Indexer{
+		dir:    indexDir,
+		shards: make(map[int64][]*shard),
+	}
+}
+
+// Init initializes the indexer and loads all shards
+func (b *Indexer) Init(_ context.Context) (bool, error) {
+	versionPath := filepath.Join(b.dir, versionFilename)
+	existed := true
+
+	data, err := os.ReadFile(versionPath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return false, err
+		}
+		existed = false
+	} else if strings.TrimSpace(string(data)) != strconv.Itoa(shardVersion) {
+		log.Warn("Repository indexer version of %s has changed, the index will be rebuilt", b.dir)
+		existed = false
+	}
+
+	// the temporary files of shards which haven't been finished are left over by a crash
+	if err := removeFiles(filepath.Join(b.dir, tempFilePrefix+"*")); err != nil {
+		return false, err
+	}
+
+	if !existed {
+		if err := removeFiles(filepath.Join(b.dir, "*"+shardExtension)); err != nil {
+			return false, err
+		}
+		if err := os.MkdirAll(b.dir, os.ModePerm); err != nil {
+			return false, err
+		}
+		if err := os.WriteFile(versionPath, []byte(strconv.Itoa(shardVersion)), 0o644); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(b.dir, "*"+shardExtension))
+	if err != nil {
+		return false, err
+	}
+	// the shards of an older base may have been retired while a search was using them, only the newest base is used
+	type shardFile struct {
+		path             string
+		base, generation int64
+	}
+	files := make(map[int64][]shardFile)
+	for _, path := range paths {
+		repoID, base, generation, err := parseShardPath(path)
+		if err != nil {
+			return false, err
+		}
+		files[repoID] = append(files[repoID], shardFile{path, base, generation})
+	}
+
+	shards := make(map[int64][]*shard, len(files))
+	closeShards := func() {
+		for _, repoShards := range shards {
+			for _, s := range repoShards {
+				s.release()
+			}
+		}
+	}
+	for repoID, repoFiles := range files {
+		slices.SortFunc(repoFiles, func(a, b shardFile) int {
+			return cmp.Or(cmp.Compare(a.base, b.base), cmp.Compare(a.generation, b.generation))
+		})
+		base := repoFiles[len(repoFiles)-1].base
+		for _, f := range repoFiles {
+			if f.base != base {
+				if err := util.Remove(f.path); err != nil {
+					closeShards()
+					return false, err
+				}
+				continue
+			}
+			s, err := openShard(f.path)
+			if err != nil {
+				closeShards()
+				return false, err
+			}
+			shards[repoID] = append(shards[repoID], s)
+		}
+	}
+
+	b.lock.Lock()
+	b.shards = shards
+	b.lock.Unlock()
+
+	return true, nil
+}
+
+func removeFiles(pattern string) error {
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if err := util.Remove(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ping checks if the indexer is available
+func (b *Indexer) Ping(_ context.Context) error {
+	return nil
+}
+
+// Close closes the indexer, the shards are unmapped when the running searches have finished
+func (b *Indexer) Close() {
+	b.lock.Lock()
+	shards := b.shards
+	b.shards = make(map[int64][]*shard)
+	b.lock.Unlock()
+
+	for _, repoShards := range shards {
+		for _, s := range repoShards {
+			s.release()
+		}
+	}
+}
+
+// getRepoShards returns the shards of the repositories, or of all repositories if none is given,
+// whose current names match the regular expressions. The caller must release the shards.
+func (b *Indexer) getRepoShards(repoIDs []int64, repoNames []*regexp.Regexp) [][]*shard {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	var result [][]*shard
+	add := func(repoShards []*shard) {
+		if len(repoShards) == 0 || !matchesAll(repoNames, repoShards[len(repoShards)-1].RepoName) {
+			return
+		}
+		for _, s := range repoShards {
+			s.acquire()
+		}
+		result = append(result, repoShards)
+	}
+	if len(repoIDs) > 0 {
+		for _, repoID := range repoIDs {
+			add(b.shards[repoID])
+		}
+	} else {
+		for _, repoShards := range b.shards {
+			add(repoShards)
+		}
+	}
+	return result
+}
+
+// setRepoShards makes the shards the current ones of the repository, the replaced shards which aren't kept are retired
+func (b *Indexer) setRepoShards(repoID int64, shards []*shard) {
+	b.lock.Lock()
+	old := b.shards[repoID]
+	if len(shards) > 0 {
+		b.shards[repoID] = shards
+	} else {
+		delete(b.shards, repoID)
+	}
+	b.lock.Unlock()
+
+	for _, s := range old {
+		if !slices.Contains(shards, s) {
+			s.retire()
+		}
+	}
+}
+
+func releaseShards(shards []*shard) {
+	for _, s := range shards {
+		s.release()
+	}
+}
+
+// hiddenBy returns whether the file has been replaced or removed by one of the newer delta shards
+func hiddenBy(newer []*shard, filename string) bool {
+	for _, s := range newer {
+		if s.replaced.Contains(filename) {
+			return true
+		}
+	}
+	return false
+}
+
+func getRepoLockKey(repoID int64) string {
+	return fmt.Sprintf("code_indexer_trigram_%d", repoID)
+}
+
+// SupportedSearchModes returns the supported search modes
+func (b *Indexer) SupportedSearchModes() []indexer.SearchMode {
+	return indexer.GitGrepSupportedSearchModes()
+}
+
+func (b *Indexer) readDocument(ctx context.Context, catFileBatch git.CatFileBatch, update internal.FileUpdate, repo *repo_model.Repository) (*document, error) {
+	size := update.Size
+
+	var err error
+	if !update.Sized {
+		var stdout string
+		stdout, _, err = gitrepo.RunCmdString(ctx, repo, gitcmd.NewCommand("cat-file", "-s").AddDynamicArguments(update.BlobSha))
+		if err != nil {
+			return nil, err
+		}
+		if size, err = strconv.ParseInt(strings.TrimSpace(stdout), 10, 64); err != nil {
+			return nil, fmt.Errorf("misformatted git cat-file output: %w", err)
+		}
+	}
+
+	if size > setting.Indexer.MaxIndexerFileSize {
+		return nil, nil
+	}
+
+	info, batchReader, err := catFileBatch.QueryContent(update.BlobSha)
+	if err != nil {
+		return nil, err
+	}
+	fileContents, err := io.ReadAll(io.LimitReader(batchReader, info.Size))
+	if err != nil {
+		return nil, err
+	} else if !typesniffer.DetectContentType(fileContents).IsText() {
+		// keep binary files in the index to make their names searchable
+		fileContents = nil
+	}
+
+	if _, err = batchReader.Discard(1); err != nil {
+		return nil, err
+	}
+
+	content := charset.ToUTF8DropErrors(fileContents)
+	language := analyze.GetCodeLanguage(update.Filename, fileContents)
+	return &document{
+		Name:        update.Filename,
+		Language:    language,
+		Symbols:     codenav.ExtractSymbols(language, string(content)),
+		UpdatedUnix: timeutil.TimeStampNow(),
+		content:     content,
+	}, nil
+}
+
+// Index writes a delta shard of the repository with the updated files,
+// or a new base shard with the current files if the repository has too many shards
+func (b *Indexer) Index(ctx context.Context, repo *repo_model.Repository, sha string, changes *internal.RepoChanges) error {
+	release, err := globallock.Lock(ctx, getRepoLockKey(repo.ID))
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	var current []*shard
+	if repoShards := b.getRepoShards([]int64{repo.ID}, nil); len(repoShards) > 0 {
+		current = repoShards[0]
+	}
+	defer releaseShards(current)
+
+	replaced := make([]string, 0, len(changes.RemovedFilenames)+len(changes.Updates))
+	replaced = append(replaced, changes.RemovedFilenames...)
+	for _, update := range changes.Updates {
+		replaced = append(replaced, update.Filename)
+	}
+
+	var sw *shardWriter
+	isDelta := len(current) > 0 && len(current) < maxShardsPerRepo
+	if isDelta {
+		sw, err = newShardWriter(b.dir, repo.ID, current[0].base, repo.FullName(), sha, replaced)
+	} else {
+		sw, err = newShardWriter(b.dir, repo.ID, 0, repo.FullName(), sha, nil)
+	}
+	if err != nil {
+		return err
+	}
+	defer sw.abort()
+
+	if !isDelta && len(current) > 0 {
+		if err := copyDocuments(sw, current, container.SetOf(replaced...)); err != nil {
+			return err
+		}
+	}
+
+	if len(changes.Updates) > 0 {
+		catFileBatch, err := gitrepo.NewBatch(ctx, repo)
+		if err != nil {
+			return err
+		}
+		defer catFileBatch.Close()
+
+		for _, update := range changes.Updates {
+			// Ignore vendored files in code search
+			if setting.Indexer.ExcludeVendored && analyze.IsVendor(update.Filename) {
+				continue
+			}
+
+			doc, err := b.readDocument(ctx, catFileBatch, update, repo)
+			if err != nil {
+				return err
+			}
+			if doc == nil {
+				continue
+			}
+			if err := sw.add(doc, doc.content); err != nil {
+				return err
+			}
+		}
+	}
+
+	path, err := sw.finish()
+	if err != nil {
+		return err
+	}
+	s, err := openShard(path)
+	if err != nil {
+		return err
+	}
+	if isDelta {
+		b.setRepoShards(repo.ID, append(slices.Clone(current), s))
+	} else {
+		b.setRepoShards(repo.ID, []*shard{s})
+	}
+	return nil
+}
+
+// copyDocuments adds the current documents of the shards of a repository to the new base shard, except for the replaced ones
+func copyDocuments(sw *shardWriter, shards []*shard, replaced container.Set[string]) error {
+	for i, s := range shards {
+		for idx := range s.docCount {
+			doc, err := s.document(idx)
+			if err != nil {
+				return err
+			}
+			if replaced.Contains(doc.Name) || hiddenBy(shards[i+1:], doc.Name) {
+				continue
+			}
+			if err := sw.add(doc, doc.content); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Delete retires the shards of the repository, their files are removed when no search is using them anymore
+func (b *Indexer) Delete(ctx context.Context, repoID int64) error {
+	return globallock.LockAndDo(ctx, getRepoLockKey(repoID), func(ctx context.Context) error {
+		b.setRepoShards(repoID, nil)
+		return nil
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package trigram
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"gitea.dev/models/db"
+	"gitea.dev/modules/container"
+	"gitea.dev/modules/indexer"
+	"gitea.dev/modules/indexer/code/internal"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func searchFilenames(t *testing.T, b *Indexer, keyword string) []string {
+	_, results, _, err := b.Search(t.Context(), &internal.SearchOptions{
+		Keyword:    keyword,
+		SearchMode: indexer.SearchModeExact,
+		Paginator:  &db.ListOptions{Page: 1, PageSize: 10},
+	})
+	require.NoError(t, err)
+	filenames := make([]string, 0, len(results))
+	for _, result := range results {
+		filenames = append(filenames, result.Filename)
+	}
+	return filenames
+}
+
+func TestDeltaShards(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, versionFilename), []byte(strconv.Itoa(shardVersion)), 0o644))
+
+	// a shard of an older base which has been retired while a search was using it
+	stale := writeTestShard(t, dir, nil, nil, &document{Name: "stale.go", content: []byte("func Helper() {}")})
+	stale.release()
+
+	base := writeTestShard(t, dir, nil, nil,
+		&document{Name: "a.go", content: []byte("func Helper() {}")},
+		&document{Name: "b.go", content: []byte("func Helper() {}")},
+		&document{Name: "c.go", content: []byte("func Helper() {}")},
+	)
+	// a.go is updated and c.go is removed
+	delta := writeTestShard(t, dir, base, []string{"a.go", "c.go"},
+		&document{Name: "a.go", content: []byte("func Renamed() {}")},
+	)
+	assert.Equal(t, base.base, delta.base)
+	assert.True(t, delta.replaced.Contains("c.go"))
+	base.release()
+	delta.release()
+
+	b := NewIndexer(dir)
+	existed, err := b.Init(t.Context())
+	require.NoError(t, err)
+	defer b.Close()
+	assert.True(t, existed)
+	require.Len(t, b.shards[1], 2)
+	assert.NoFileExists(t, stale.path)
+
+	assert.Equal(t, []string{"b.go"}, searchFilenames(t, b, "Helper"))
+	assert.Equal(t, []string{"a.go"}, searchFilenames(t, b, "Renamed"))
+
+	// a new base shard contains the current files of all shards
+	sw, err := newShardWriter(dir, 1, 0, "org/repo", "sha", nil)
+	require.NoError(t, err)
+	require.NoError(t, copyDocuments(sw, b.shards[1], container.SetOf("b.go")))
+	path, err := sw.finish()
+	require.NoError(t, err)
+	merged, err := openShard(path)
+	require.NoError(t, err)
+	assert.Equal(t, merged.generation, merged.base)
+	assert.Empty(t, merged.replaced)
+	require.Equal(t, 1, merged.docCount)
+	doc, err := merged.document(0)
+	require.NoError(t, err)
+	assert.Equal(t, "a.go", doc.Name)
+	assert.Equal(t, "func Renamed() {}", string(doc.content))
+
+	b.setRepoShards(1, []*shard{merged})
+	assert.NoFileExists(t, base.path)
+	assert.NoFileExists(t, delta.path)
+	assert.Empty(t, searchFilenames(t, b, "Helper"))
+
+	require.NoError(t, b.Delete(t.Context(), 1))
+	assert.NoFileExists(t, merged.path)
+	assert.Empty(t, searchFilenames(t, b, "Renamed"))
+}
@@ -68,6 +68,20 @@ func NewFilterIn[T int64](field string, values ...T) FilterIn {
 	return FilterIn(fmt.Sprintf("%s IN [%v]", field, strings.Join(vs, ", ")))
 }
 
+// NewFilterInStrings creates a new FilterIn for string values, the values are quoted and escaped.
+func NewFilterInStrings(field string, values ...string) FilterIn {
+	if len(values) == 0 {
+		return ""
+	}
+	vs := make([]string, len(values))
+	for i, v := range values {
+		vs[i] = `"` + filterStringEscaper.Replace(v) + `"`
+	}
+	return FilterIn(fmt.Sprintf("%s IN [%v]", field, strings.Join(vs, ", ")))
+}
+
+var filterStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
 func (f FilterIn) Statement() string {
 	return string(f)
 }
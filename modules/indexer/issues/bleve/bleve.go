@@ -26,7 +26,7 @@ import (
 const (
 	issueIndexerAnalyzer      = "issueIndexer"
 	issueIndexerDocType       = "issueIndexerDocType"
-	issueIndexerLatestVersion = 7
+	issueIndexerLatestVersion = 10
 )
 
 const unicodeNormalizeName = "unicodeNormalize"
@@ -70,6 +70,10 @@ func generateIssueIndexMapping() (mapping.IndexMapping, error) {
 	numberFieldMapping.Store = false
 	numberFieldMapping.IncludeInAll = false
 
+	keywordFieldMapping := bleve.NewKeywordFieldMapping()
+	keywordFieldMapping.Store = false
+	keywordFieldMapping.IncludeInAll = false
+
 	docMapping.AddFieldMappingsAt("is_public", boolFieldMapping)
 
 	docMapping.AddFieldMappingsAt("title", textFieldMapping)
@@ -82,6 +86,7 @@ func generateIssueIndexMapping() (mapping.IndexMapping, error) {
 	docMapping.AddFieldMappingsAt("label_ids", numberFieldMapping)
 	docMapping.AddFieldMappingsAt("no_label", boolFieldMapping)
 	docMapping.AddFieldMappingsAt("milestone_id", numberFieldMapping)
+	docMapping.AddFieldMappingsAt("type_id", numberFieldMapping)
 	docMapping.AddFieldMappingsAt("project_ids", numberFieldMapping)
 	docMapping.AddFieldMappingsAt("no_project", boolFieldMapping)
 	docMapping.AddFieldMappingsAt("poster_id", numberFieldMapping)
@@ -91,6 +96,8 @@ func generateIssueIndexMapping() (mapping.IndexMapping, error) {
 	docMapping.AddFieldMappingsAt("reviewed_ids", numberFieldMapping)
 	docMapping.AddFieldMappingsAt("review_requested_ids", numberFieldMapping)
 	docMapping.AddFieldMappingsAt("subscriber_ids", numberFieldMapping)
+	docMapping.AddFieldMappingsAt("parent_id", numberFieldMapping)
+	docMapping.AddFieldMappingsAt("custom_fields", keywordFieldMapping)
 	docMapping.AddFieldMappingsAt("updated_unix", numberFieldMapping)
 
 	docMapping.AddFieldMappingsAt("created_unix", numberFieldMapping)
@@ -241,6 +248,14 @@ func (b *Indexer) Search(ctx context.Context, options *internal.SearchOptions) (
 		queries = append(queries, bleve.NewDisjunctionQuery(milestoneQueries...))
 	}
 
+	if len(options.TypeIDs) > 0 {
+		var typeQueries []query.Query
+		for _, typeID := range options.TypeIDs {
+			typeQueries = append(typeQueries, inner_bleve.NumericEqualityQuery(typeID, "type_id"))
+		}
+		queries = append(queries, bleve.NewDisjunctionQuery(typeQueries...))
+	}
+
 	if options.NoProjectOnly {
 		queries = append(queries, inner_bleve.BoolFieldQuery(true, "no_project"))
 	} else if len(options.ProjectIDs) > 0 {
@@ -284,6 +299,20 @@ func (b *Indexer) Search(ctx context.Context, options *internal.SearchOptions) (
 		queries = append(queries, inner_bleve.NumericEqualityQuery(options.SubscriberID.Value(), "subscriber_ids"))
 	}
 
+	if options.ParentID.Has() {
+		queries = append(queries, inner_bleve.NumericEqualityQuery(options.ParentID.Value(), "parent_id"))
+	}
+
+	for _, filter := range options.CustomFields {
+		var tokenQueries []query.Query
+		for _, token := range filter.Tokens() {
+			q := bleve.NewTermQuery(token)
+			q.SetField("custom_fields")
+			tokenQueries = append(tokenQueries, q)
+		}
+		queries = append(queries, bleve.NewDisjunctionQuery(tokenQueries...))
+	}
+
 	if options.UpdatedAfterUnix.Has() || options.UpdatedBeforeUnix.Has() {
 		queries = append(queries, inner_bleve.NumericRangeInclusiveQuery(
 			options.UpdatedAfterUnix,
@@ -66,6 +66,7 @@ func ToDBOptions(ctx context.Context, options *internal.SearchOptions) (*issue_m
 		ReviewRequestedID:  convertID(options.ReviewRequestedID),
 		ReviewedID:         convertID(options.ReviewedID),
 		SubscriberID:       convertID(options.SubscriberID),
+		ParentID:           convertID(options.ParentID),
 		ProjectIDs:         util.Iif(options.NoProjectOnly, []int64{db.NoConditionID}, options.ProjectIDs),
 		IsClosed:           options.IsClosed,
 		IsPull:             options.IsPull,
@@ -82,12 +83,22 @@ func ToDBOptions(ctx context.Context, options *internal.SearchOptions) (*issue_m
 		Doer:               nil,
 	}
 
+	for _, filter := range options.CustomFields {
+		opts.CustomFields = append(opts.CustomFields, issue_model.CustomFieldFilter{FieldIDs: filter.FieldIDs, Value: filter.Value})
+	}
+
 	if len(options.MilestoneIDs) == 1 && options.MilestoneIDs[0] == 0 {
 		opts.MilestoneIDs = []int64{db.NoConditionID}
 	} else {
 		opts.MilestoneIDs = options.MilestoneIDs
 	}
 
+	if len(options.TypeIDs) == 1 && options.TypeIDs[0] == 0 {
+		opts.TypeIDs = []int64{db.NoConditionID}
+	} else {
+		opts.TypeIDs = options.TypeIDs
+	}
+
 	if options.NoLabelOnly {
 		opts.LabelIDs = []int64{0} // Be careful, it's zero, not db.NoConditionID
 	} else {
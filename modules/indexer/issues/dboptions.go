@@ -80,33 +80,37 @@ func ToSearchOptions(keyword string, opts *issues_model.IssuesOptions) *SearchOp
 
 	searchOpt.Paginator = opts.Paginator
 
-	switch opts.SortType {
+	searchOpt.SortBy = ToSortBy(opts.SortType)
+
+	return searchOpt
+}
+
+// ToSortBy converts a sort type of issues_model.IssuesOptions to the sort field of the indexer
+func ToSortBy(sortType string) internal.SortBy {
+	switch sortType {
 	case "", "latest":
-		searchOpt.SortBy = SortByCreatedDesc
+		return SortByCreatedDesc
 	case "oldest":
-		searchOpt.SortBy = SortByCreatedAsc
+		return SortByCreatedAsc
 	case "recentupdate":
-		searchOpt.SortBy = SortByUpdatedDesc
+		return SortByUpdatedDesc
 	case "leastupdate":
-		searchOpt.SortBy = SortByUpdatedAsc
+		return SortByUpdatedAsc
 	case "mostcomment":
-		searchOpt.SortBy = SortByCommentsDesc
+		return SortByCommentsDesc
 	case "leastcomment":
-		searchOpt.SortBy = SortByCommentsAsc
+		return SortByCommentsAsc
 	case "nearduedate":
-		searchOpt.SortBy = SortByDeadlineAsc
+		return SortByDeadlineAsc
 	case "farduedate":
-		searchOpt.SortBy = SortByDeadlineDesc
+		return SortByDeadlineDesc
 	case "priority", "priorityrepo", "project-column-sorting":
 		// Unsupported sort type for search
 		fallthrough
 	default:
-		if strings.HasPrefix(opts.SortType, issues_model.ScopeSortPrefix) {
-			searchOpt.SortBy = internal.SortBy(opts.SortType)
-		} else {
-			searchOpt.SortBy = SortByUpdatedDesc
+		if strings.HasPrefix(sortType, issues_model.ScopeSortPrefix) {
+			return internal.SortBy(sortType)
 		}
+		return SortByUpdatedDesc
 	}
-
-	return searchOpt
 }
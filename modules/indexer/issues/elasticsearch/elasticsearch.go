@@ -16,7 +16,7 @@ import (
 	"gitea.dev/modules/util"
 )
 
-const issueIndexerLatestVersion = 4
+const issueIndexerLatestVersion = 7
 
 var _ internal.Indexer = &Indexer{}
 
@@ -54,6 +54,7 @@ const (
 			"label_ids": { "type": "integer", "index": true },
 			"no_label": { "type": "boolean", "index": true },
 			"milestone_id": { "type": "integer", "index": true },
+			"type_id": { "type": "integer", "index": true },
 			"project_ids": { "type": "integer", "index": true },
 			"no_project": { "type": "boolean", "index": true },
 			"poster_id": { "type": "integer", "index": true },
@@ -63,6 +64,8 @@ const (
 			"reviewed_ids": { "type": "integer", "index": true },
 			"review_requested_ids": { "type": "integer", "index": true },
 			"subscriber_ids": { "type": "integer", "index": true },
+			"parent_id": { "type": "integer", "index": true },
+			"custom_fields": { "type": "keyword", "index": true },
 			"updated_unix": { "type": "integer", "index": true },
 
 			"created_unix": { "type": "integer", "index": true },
@@ -165,6 +168,10 @@ func (b *Indexer) Search(ctx context.Context, options *internal.SearchOptions) (
 		query.Must(es.TermsQuery("milestone_id", es.ToAnySlice(options.MilestoneIDs)...))
 	}
 
+	if len(options.TypeIDs) > 0 {
+		query.Must(es.TermsQuery("type_id", es.ToAnySlice(options.TypeIDs)...))
+	}
+
 	if options.NoProjectOnly {
 		query.Must(es.TermQuery("no_project", true))
 	} else if len(options.ProjectIDs) > 0 {
@@ -204,6 +211,14 @@ func (b *Indexer) Search(ctx context.Context, options *internal.SearchOptions) (
 		query.Must(es.TermQuery("subscriber_ids", options.SubscriberID.Value()))
 	}
 
+	if options.ParentID.Has() {
+		query.Must(es.TermQuery("parent_id", options.ParentID.Value()))
+	}
+
+	for _, filter := range options.CustomFields {
+		query.Must(es.TermsQuery("custom_fields", es.ToAnySlice(filter.Tokens())...))
+	}
+
 	if options.UpdatedAfterUnix.Has() || options.UpdatedBeforeUnix.Has() {
 		q := es.NewRangeQuery("updated_unix")
 		if options.UpdatedAfterUnix.Has() {
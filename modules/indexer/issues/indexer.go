@@ -270,6 +270,9 @@ func IsAvailable(ctx context.Context) bool {
 // SearchOptions indicates the options for searching issues
 type SearchOptions = internal.SearchOptions
 
+// CustomFieldFilter indicates a custom field value filter of the search options
+type CustomFieldFilter = internal.CustomFieldFilter
+
 const (
 	SortByCreatedDesc  = internal.SortByCreatedDesc
 	SortByUpdatedDesc  = internal.SortByUpdatedDesc
@@ -30,6 +30,7 @@ type IndexerData struct {
 	LabelIDs           []int64            `json:"label_ids"`
 	NoLabel            bool               `json:"no_label"` // True if LabelIDs is empty
 	MilestoneID        int64              `json:"milestone_id"`
+	TypeID             int64              `json:"type_id"` // 0 if the issue has no type
 	ProjectIDs         []int64            `json:"project_ids"`
 	NoProject          bool               `json:"no_project"`                   // True if ProjectIDs is empty
 	ProjectColumnMap   map[int64]int64    `json:"project_column_map,omitempty"` // Maps project ID to column ID for each project the issue is in
@@ -40,6 +41,8 @@ type IndexerData struct {
 	ReviewedIDs        []int64            `json:"reviewed_ids"`
 	ReviewRequestedIDs []int64            `json:"review_requested_ids"`
 	SubscriberIDs      []int64            `json:"subscriber_ids"`
+	ParentID           int64              `json:"parent_id"`     // 0 if the issue is not a sub-issue
+	CustomFields       []string           `json:"custom_fields"` // tokens of the custom field values, see CustomFieldToken
 	UpdatedUnix        timeutil.TimeStamp `json:"updated_unix"`
 
 	// Fields used for sorting
@@ -96,6 +99,8 @@ type SearchOptions struct {
 
 	MilestoneIDs []int64 // milestones the issues have
 
+	TypeIDs []int64 // types the issues have, zero means the issues without type
+
 	ProjectIDs    []int64 // project the issues belong to. FIXME: ISSUE-MULTIPLE-PROJECTS-FILTER: no multiple project filter support yet. Search logic is wrong.
 	NoProjectOnly bool    // if the issues have no project, if true, ProjectIDs will be ignored
 
@@ -109,6 +114,10 @@ type SearchOptions struct {
 
 	SubscriberID optional.Option[int64] // subscriber of the issues
 
+	ParentID optional.Option[int64] // parent issue of the issues, zero means the issues without parent
+
+	CustomFields []CustomFieldFilter // custom field values the issues have, all the filters have to match
+
 	UpdatedAfterUnix  optional.Option[int64]
 	UpdatedBeforeUnix optional.Option[int64]
 
@@ -130,6 +139,26 @@ func (o *SearchOptions) Copy(edit ...func(options *SearchOptions)) *SearchOption
 	return &v
 }
 
+// CustomFieldFilter matches the issues which have the value for any of the custom fields
+type CustomFieldFilter struct {
+	FieldIDs []int64
+	Value    string
+}
+
+// Tokens returns the indexed tokens matched by the filter
+func (f CustomFieldFilter) Tokens() []string {
+	tokens := make([]string, 0, len(f.FieldIDs))
+	for _, fieldID := range f.FieldIDs {
+		tokens = append(tokens, CustomFieldToken(fieldID, f.Value))
+	}
+	return tokens
+}
+
+// CustomFieldToken returns the token indexed for a value of a custom field
+func CustomFieldToken(fieldID int64, value string) string {
+	return strconv.FormatInt(fieldID, 10) + ":" + value
+}
+
 // used for optimized issue index based search
 func (o *SearchOptions) IsKeywordNumeric() bool {
 	_, err := strconv.Atoi(o.Keyword)
@@ -310,6 +310,42 @@ var cases = []*testIndexerCase{
 			}), result.Total)
 		},
 	},
+	{
+		Name: "TypeIDs",
+		SearchOptions: &internal.SearchOptions{
+			Paginator: &db.ListOptions{
+				PageSize: 5,
+			},
+			TypeIDs: []int64{1, 2},
+		},
+		Expected: func(t *testing.T, data map[int64]*internal.IndexerData, result *internal.SearchResult) {
+			assert.Len(t, result.Hits, 5)
+			for _, v := range result.Hits {
+				assert.Contains(t, []int64{1, 2}, data[v.ID].TypeID)
+			}
+			assert.Equal(t, countIndexerData(data, func(v *internal.IndexerData) bool {
+				return v.TypeID == 1 || v.TypeID == 2
+			}), result.Total)
+		},
+	},
+	{
+		Name: "no TypeIDs",
+		SearchOptions: &internal.SearchOptions{
+			Paginator: &db.ListOptions{
+				PageSize: 5,
+			},
+			TypeIDs: []int64{0},
+		},
+		Expected: func(t *testing.T, data map[int64]*internal.IndexerData, result *internal.SearchResult) {
+			assert.Len(t, result.Hits, 5)
+			for _, v := range result.Hits {
+				assert.Equal(t, int64(0), data[v.ID].TypeID)
+			}
+			assert.Equal(t, countIndexerData(data, func(v *internal.IndexerData) bool {
+				return v.TypeID == 0
+			}), result.Total)
+		},
+	},
 	{
 		Name: "ProjectIDs",
 		SearchOptions: &internal.SearchOptions{
@@ -474,6 +510,65 @@ var cases = []*testIndexerCase{
 			}), result.Total)
 		},
 	},
+	{
+		Name: "ParentID",
+		SearchOptions: &internal.SearchOptions{
+			Paginator: &db.ListOptions{
+				PageSize: 5,
+			},
+			ParentID: optional.Some(int64(1)),
+		},
+		Expected: func(t *testing.T, data map[int64]*internal.IndexerData, result *internal.SearchResult) {
+			assert.Len(t, result.Hits, 5)
+			for _, v := range result.Hits {
+				assert.Equal(t, int64(1), data[v.ID].ParentID)
+			}
+			assert.Equal(t, countIndexerData(data, func(v *internal.IndexerData) bool {
+				return v.ParentID == 1
+			}), result.Total)
+		},
+	},
+	{
+		Name: "NoParent",
+		SearchOptions: &internal.SearchOptions{
+			Paginator: &db.ListOptions{
+				PageSize: 5,
+			},
+			ParentID: optional.Some(int64(0)),
+		},
+		Expected: func(t *testing.T, data map[int64]*internal.IndexerData, result *internal.SearchResult) {
+			assert.Len(t, result.Hits, 5)
+			for _, v := range result.Hits {
+				assert.Zero(t, data[v.ID].ParentID)
+			}
+			assert.Equal(t, countIndexerData(data, func(v *internal.IndexerData) bool {
+				return v.ParentID == 0
+			}), result.Total)
+		},
+	},
+	{
+		Name: "CustomFields",
+		SearchOptions: &internal.SearchOptions{
+			Paginator: &db.ListOptions{
+				PageSize: 5,
+			},
+			CustomFields: []internal.CustomFieldFilter{
+				{FieldIDs: []int64{1}, Value: "P1"},
+				{FieldIDs: []int64{2, 3}, Value: `with "quote"`},
+			},
+		},
+		Expected: func(t *testing.T, data map[int64]*internal.IndexerData, result *internal.SearchResult) {
+			match := func(v *internal.IndexerData) bool {
+				return slices.Contains(v.CustomFields, internal.CustomFieldToken(1, "P1")) &&
+					slices.Contains(v.CustomFields, internal.CustomFieldToken(3, `with "quote"`))
+			}
+			assert.Len(t, result.Hits, 5)
+			for _, v := range result.Hits {
+				assert.True(t, match(data[v.ID]))
+			}
+			assert.Equal(t, countIndexerData(data, match), result.Total)
+		},
+	},
 	{
 		Name: "updated",
 		SearchOptions: &internal.SearchOptions{
@@ -682,6 +777,10 @@ func generateDefaultIndexerData() []*internal.IndexerData {
 			for i := range subscriberIDs {
 				subscriberIDs[i] = int64(i) + 1 // SubscriberID should not be 0
 			}
+			customFields := []string{internal.CustomFieldToken(1, fmt.Sprintf("P%d", id%3))}
+			if id%2 == 0 {
+				customFields = append(customFields, internal.CustomFieldToken(3, `with "quote"`))
+			}
 			projectIDs := make([]int64, id%5)
 			for i := range projectIDs {
 				projectIDs[i] = int64(i) + 1 // projectID should not be 0
@@ -711,6 +810,7 @@ func generateDefaultIndexerData() []*internal.IndexerData {
 				LabelIDs:           labelIDs,
 				NoLabel:            len(labelIDs) == 0,
 				MilestoneID:        issueIndex % 4,
+				TypeID:             id % 3,
 				ProjectIDs:         projectIDs,
 				NoProject:          len(projectIDs) == 0,
 				PosterID:           id%10 + 1, // PosterID should not be 0
@@ -720,6 +820,8 @@ func generateDefaultIndexerData() []*internal.IndexerData {
 				ReviewedIDs:        reviewedIDs,
 				ReviewRequestedIDs: reviewRequestedIDs,
 				SubscriberIDs:      subscriberIDs,
+				ParentID:           id % 4,
+				CustomFields:       customFields,
 				UpdatedUnix:        timeutil.TimeStamp(id + issueIndex),
 				CreatedUnix:        timeutil.TimeStamp(id),
 				DeadlineUnix:       timeutil.TimeStamp(id + issueIndex + repoID),
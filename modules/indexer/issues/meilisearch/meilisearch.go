@@ -20,7 +20,7 @@ import (
 )
 
 const (
-	issueIndexerLatestVersion = 6
+	issueIndexerLatestVersion = 9
 
 	// TODO: make this configurable if necessary
 	maxTotalHits = 10000
@@ -71,6 +71,7 @@ func NewIndexer(url, apiKey, indexerName string) *Indexer {
 			"label_ids",
 			"no_label",
 			"milestone_id",
+			"type_id",
 			"project_ids",
 			"no_project",
 			"poster_id",
@@ -80,6 +81,8 @@ func NewIndexer(url, apiKey, indexerName string) *Indexer {
 			"reviewed_ids",
 			"review_requested_ids",
 			"subscriber_ids",
+			"parent_id",
+			"custom_fields",
 			"updated_unix",
 		},
 		SortableAttributes: []string{
@@ -183,6 +186,10 @@ func (b *Indexer) Search(ctx context.Context, options *internal.SearchOptions) (
 		query.And(inner_meilisearch.NewFilterIn("milestone_id", options.MilestoneIDs...))
 	}
 
+	if len(options.TypeIDs) > 0 {
+		query.And(inner_meilisearch.NewFilterIn("type_id", options.TypeIDs...))
+	}
+
 	if options.NoProjectOnly {
 		query.And(inner_meilisearch.NewFilterEq("no_project", true))
 	} else if len(options.ProjectIDs) > 0 {
@@ -222,6 +229,14 @@ func (b *Indexer) Search(ctx context.Context, options *internal.SearchOptions) (
 		query.And(inner_meilisearch.NewFilterEq("subscriber_ids", options.SubscriberID.Value()))
 	}
 
+	if options.ParentID.Has() {
+		query.And(inner_meilisearch.NewFilterEq("parent_id", options.ParentID.Value()))
+	}
+
+	for _, filter := range options.CustomFields {
+		query.And(inner_meilisearch.NewFilterInStrings("custom_fields", filter.Tokens()...))
+	}
+
 	if options.UpdatedAfterUnix.Has() {
 		query.And(inner_meilisearch.NewFilterGte("updated_unix", options.UpdatedAfterUnix.Value()))
 	}
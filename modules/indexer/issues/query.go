@@ -0,0 +1,301 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"strings"
+	"time"
+
+	"gitea.dev/modules/optional"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/util"
+)
+
+// QueryCurrentUser can be used instead of a username to refer to the user searching
+const QueryCurrentUser = "@me"
+
+// Query is a parsed issue search query like `is:open label:bug -label:wontfix assignee:@me sort:updated-desc`.
+// The qualifiers refer to labels, milestones, projects, issue types and users by name, they have to be resolved to IDs before searching.
+type Query struct {
+	Keyword string // the remaining text which is not a qualifier
+
+	IsPull     optional.Option[bool] // is:pr, is:issue
+	IsClosed   optional.Option[bool] // is:open, is:closed
+	IsArchived optional.Option[bool] // archived:true, archived:false
+
+	Labels         []string // label:name
+	ExcludedLabels []string // -label:name
+	NoLabel        bool     // no:label
+
+	Milestones  []string // milestone:name, any of them
+	NoMilestone bool     // no:milestone
+
+	Projects  []string // project:name, any of them
+	NoProject bool     // no:project
+
+	Types  []string // type:name, any of them
+	NoType bool     // no:type
+
+	Author          string // author:name
+	Assignee        string // assignee:name
+	NoAssignee      bool   // no:assignee
+	Mentions        string // mentions:name
+	ReviewRequested string // review-requested:name
+	ReviewedBy      string // reviewed-by:name
+
+	NoParent bool // no:parent
+
+	CustomFields []QueryCustomField // field:name=value
+
+	Repo string // repo:owner/name
+
+	UpdatedAfter  optional.Option[int64] // updated:>=2024-01-01, updated:2024-01-01..2024-02-01
+	UpdatedBefore optional.Option[int64] // updated:<2024-01-01
+
+	SortType string // sort:created-desc, the value is converted to an issues_model.IssuesOptions sort type
+}
+
+// QueryCustomField is a custom field qualifier of a query, the field is referred to by name
+type QueryCustomField struct {
+	Name  string
+	Value string
+}
+
+var querySortTypes = map[string]string{
+	"created-desc":  "latest",
+	"created-asc":   "oldest",
+	"updated-desc":  "recentupdate",
+	"updated-asc":   "leastupdate",
+	"comments-desc": "mostcomment",
+	"comments-asc":  "leastcomment",
+	"deadline-asc":  "nearduedate",
+	"deadline-desc": "farduedate",
+}
+
+// HasQualifiers returns whether the query contains anything besides the keyword
+func (q *Query) HasQualifiers() bool {
+	return q.IsPull.Has() || q.IsClosed.Has() || q.IsArchived.Has() ||
+		len(q.Labels) > 0 || len(q.ExcludedLabels) > 0 || q.NoLabel ||
+		len(q.Milestones) > 0 || q.NoMilestone || len(q.Projects) > 0 || q.NoProject || len(q.Types) > 0 || q.NoType ||
+		q.Author != "" || q.Assignee != "" || q.NoAssignee || q.Mentions != "" || q.ReviewRequested != "" || q.ReviewedBy != "" ||
+		q.NoParent || len(q.CustomFields) > 0 || q.Repo != "" || q.UpdatedAfter.Has() || q.UpdatedBefore.Has() || q.SortType != ""
+}
+
+// splitQuery splits the query at whitespaces which are not enclosed by double quotes
+func splitQuery(s string) ([]string, error) {
+	var tokens []string
+	var token strings.Builder
+	inQuotes := false
+	for _, r := range s {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			token.WriteRune(r)
+		case !inQuotes && (r == ' ' || r == '\t' || r == '\n' || r == '\r'):
+			if token.Len() > 0 {
+				tokens = append(tokens, token.String())
+				token.Reset()
+			}
+		default:
+			token.WriteRune(r)
+		}
+	}
+	if inQuotes {
+		return nil, util.NewInvalidArgumentErrorf("unterminated quote")
+	}
+	if token.Len() > 0 {
+		tokens = append(tokens, token.String())
+	}
+	return tokens, nil
+}
+
+// ParseQuery parses an issue search query, words which are no known qualifiers are kept as keyword
+func ParseQuery(s string) (*Query, error) {
+	tokens, err := splitQuery(s)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Query{}
+	var keywords []string
+	for _, token := range tokens {
+		negated := strings.HasPrefix(token, "-")
+		key, value, ok := strings.Cut(strings.TrimPrefix(token, "-"), ":")
+		key = strings.ToLower(key)
+		if !ok || !isQueryQualifier(key) {
+			keywords = append(keywords, token)
+			continue
+		}
+		value = strings.Trim(value, `"`)
+		if value == "" {
+			return nil, util.NewInvalidArgumentErrorf("qualifier %q needs a value", key)
+		}
+		if negated && key != "label" {
+			return nil, util.NewInvalidArgumentErrorf("qualifier %q cannot be negated", key)
+		}
+		if err := q.setQualifier(key, value, negated); err != nil {
+			return nil, err
+		}
+	}
+	q.Keyword = strings.Join(keywords, " ")
+	return q, nil
+}
+
+func isQueryQualifier(key string) bool {
+	switch key {
+	case "is", "no", "archived", "label", "milestone", "project", "type",
+		"author", "assignee", "mentions", "review-requested", "reviewed-by",
+		"field", "repo", "updated", "sort":
+		return true
+	}
+	return false
+}
+
+func (q *Query) setQualifier(key, value string, negated bool) error {
+	switch key {
+	case "is":
+		switch strings.ToLower(value) {
+		case "open":
+			q.IsClosed = optional.Some(false)
+		case "closed":
+			q.IsClosed = optional.Some(true)
+		case "issue":
+			q.IsPull = optional.Some(false)
+		case "pr", "pull":
+			q.IsPull = optional.Some(true)
+		default:
+			return util.NewInvalidArgumentErrorf("unknown value %q of qualifier %q", value, key)
+		}
+	case "no":
+		switch strings.ToLower(value) {
+		case "label":
+			q.NoLabel = true
+		case "milestone":
+			q.NoMilestone = true
+		case "project":
+			q.NoProject = true
+		case "type":
+			q.NoType = true
+		case "assignee":
+			q.NoAssignee = true
+		case "parent":
+			q.NoParent = true
+		default:
+			return util.NewInvalidArgumentErrorf("unknown value %q of qualifier %q", value, key)
+		}
+	case "archived":
+		switch strings.ToLower(value) {
+		case "true":
+			q.IsArchived = optional.Some(true)
+		case "false":
+			q.IsArchived = optional.Some(false)
+		default:
+			return util.NewInvalidArgumentErrorf("unknown value %q of qualifier %q", value, key)
+		}
+	case "label":
+		if negated {
+			q.ExcludedLabels = append(q.ExcludedLabels, value)
+		} else {
+			q.Labels = append(q.Labels, value)
+		}
+	case "milestone":
+		q.Milestones = append(q.Milestones, value)
+	case "project":
+		q.Projects = append(q.Projects, value)
+	case "type":
+		q.Types = append(q.Types, value)
+	case "author":
+		q.Author = value
+	case "assignee":
+		q.Assignee = value
+	case "mentions":
+		q.Mentions = value
+	case "review-requested":
+		q.ReviewRequested = value
+	case "reviewed-by":
+		q.ReviewedBy = value
+	case "field":
+		name, fieldValue, ok := strings.Cut(value, "=")
+		name, fieldValue = strings.Trim(name, `"`), strings.Trim(fieldValue, `"`)
+		if !ok || name == "" || fieldValue == "" {
+			return util.NewInvalidArgumentErrorf("qualifier %q needs a value like name=value", key)
+		}
+		q.CustomFields = append(q.CustomFields, QueryCustomField{Name: name, Value: fieldValue})
+	case "repo":
+		if owner, name, ok := strings.Cut(value, "/"); !ok || owner == "" || name == "" {
+			return util.NewInvalidArgumentErrorf("qualifier %q needs a value like owner/name", key)
+		}
+		q.Repo = value
+	case "updated":
+		return q.setUpdated(value)
+	case "sort":
+		sortType, ok := querySortTypes[strings.ToLower(value)]
+		if !ok {
+			return util.NewInvalidArgumentErrorf("unknown value %q of qualifier %q", value, key)
+		}
+		q.SortType = sortType
+	}
+	return nil
+}
+
+// parseQueryDate returns the start of the day or the exact time if a RFC 3339 timestamp is given
+func parseQueryDate(value string) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation(time.DateOnly, value, setting.DefaultUILocation); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, nil
+	}
+	return time.Time{}, false, util.NewInvalidArgumentErrorf("invalid date %q, use YYYY-MM-DD", value)
+}
+
+// setUpdated parses the ranges ">D", ">=D", "<D", "<=D", "A..B" and "D", a date without time covers the whole day
+func (q *Query) setUpdated(value string) error {
+	start := func(s string) (int64, error) {
+		t, _, err := parseQueryDate(s)
+		return t.Unix(), err
+	}
+	// end returns the last second which is still part of the date
+	end := func(s string) (int64, error) {
+		t, isDate, err := parseQueryDate(s)
+		if isDate {
+			t = t.AddDate(0, 0, 1).Add(-time.Second)
+		}
+		return t.Unix(), err
+	}
+
+	var after, before int64
+	var err error
+	switch {
+	case strings.HasPrefix(value, ">="):
+		after, err = start(value[2:])
+	case strings.HasPrefix(value, ">"):
+		after, err = end(value[1:])
+		after++
+	case strings.HasPrefix(value, "<="):
+		before, err = end(value[2:])
+	case strings.HasPrefix(value, "<"):
+		before, err = start(value[1:])
+		before--
+	case strings.Contains(value, ".."):
+		from, to, _ := strings.Cut(value, "..")
+		if after, err = start(from); err == nil {
+			before, err = end(to)
+		}
+	default:
+		if after, err = start(value); err == nil {
+			before, err = end(value)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if after != 0 {
+		q.UpdatedAfter = optional.Some(after)
+	}
+	if before != 0 {
+		q.UpdatedBefore = optional.Some(before)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"testing"
+	"time"
+
+	"gitea.dev/modules/optional"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/test"
+	"gitea.dev/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuery(t *testing.T) {
+	defer test.MockVariableValue(&setting.DefaultUILocation, time.UTC)()
+
+	day := func(s string) int64 {
+		d, err := time.Parse(time.DateOnly, s)
+		require.NoError(t, err)
+		return d.Unix()
+	}
+
+	cases := []struct {
+		query    string
+		expected *Query
+	}{
+		{
+			query:    "crash on start",
+			expected: &Query{Keyword: "crash on start"},
+		},
+		{
+			query: `is:open label:bug -label:wontfix label:"good first issue" assignee:@me review-requested:@me sort:updated-desc`,
+			expected: &Query{
+				IsClosed:        optional.Some(false),
+				Labels:          []string{"bug", "good first issue"},
+				ExcludedLabels:  []string{"wontfix"},
+				Assignee:        QueryCurrentUser,
+				ReviewRequested: QueryCurrentUser,
+				SortType:        "recentupdate",
+			},
+		},
+		{
+			query: `"exact words" is:PR author:user2 repo:user2/repo1 no:milestone no:assignee no:parent error:42`,
+			expected: &Query{
+				Keyword:     `"exact words" error:42`,
+				IsPull:      optional.Some(true),
+				Author:      "user2",
+				Repo:        "user2/repo1",
+				NoMilestone: true,
+				NoAssignee:  true,
+				NoParent:    true,
+			},
+		},
+		{
+			query: `milestone:v1.0 milestone:"v 2" project:Roadmap archived:false mentions:user5 reviewed-by:user1`,
+			expected: &Query{
+				Milestones: []string{"v1.0", "v 2"},
+				Projects:   []string{"Roadmap"},
+				IsArchived: optional.Some(false),
+				Mentions:   "user5",
+				ReviewedBy: "user1",
+			},
+		},
+		{
+			query:    `type:Bug type:"Feature request"`,
+			expected: &Query{Types: []string{"Bug", "Feature request"}},
+		},
+		{
+			query:    "no:type",
+			expected: &Query{NoType: true},
+		},
+		{
+			query: `field:Priority=High field:"Story points=3" field:Customer="ACME Inc"`,
+			expected: &Query{
+				CustomFields: []QueryCustomField{
+					{Name: "Priority", Value: "High"},
+					{Name: "Story points", Value: "3"},
+					{Name: "Customer", Value: "ACME Inc"},
+				},
+			},
+		},
+		{
+			query:    "updated:>=2024-01-02",
+			expected: &Query{UpdatedAfter: optional.Some(day("2024-01-02"))},
+		},
+		{
+			query:    "updated:>2024-01-02",
+			expected: &Query{UpdatedAfter: optional.Some(day("2024-01-03"))},
+		},
+		{
+			query:    "updated:<2024-01-02",
+			expected: &Query{UpdatedBefore: optional.Some(day("2024-01-02") - 1)},
+		},
+		{
+			query:    "updated:<=2024-01-02",
+			expected: &Query{UpdatedBefore: optional.Some(day("2024-01-03") - 1)},
+		},
+		{
+			query:    "updated:2024-01-02..2024-01-05",
+			expected: &Query{UpdatedAfter: optional.Some(day("2024-01-02")), UpdatedBefore: optional.Some(day("2024-01-06") - 1)},
+		},
+		{
+			query:    "updated:2024-01-02",
+			expected: &Query{UpdatedAfter: optional.Some(day("2024-01-02")), UpdatedBefore: optional.Some(day("2024-01-03") - 1)},
+		},
+		{
+			query:    "updated:>=2024-01-02T10:00:00Z",
+			expected: &Query{UpdatedAfter: optional.Some(day("2024-01-02") + 10*3600)},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.query, func(t *testing.T) {
+			q, err := ParseQuery(c.query)
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, q)
+			assert.Equal(t, c.expected.Keyword != c.query, q.HasQualifiers())
+		})
+	}
+
+	for _, query := range []string{
+		`label:"bug`,
+		"label:",
+		"is:unknown",
+		"no:reviewer",
+		"-author:user2",
+		"repo:user2",
+		"field:Priority",
+		"field:=High",
+		"updated:yesterday",
+		"sort:random",
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := ParseQuery(query)
+			assert.ErrorIs(t, err, util.ErrInvalidArgument)
+		})
+	}
+}
@@ -87,6 +87,22 @@ func getIssueIndexerData(ctx context.Context, issueID int64) (*internal.IndexerD
 		return nil, false, err
 	}
 
+	parentID, err := issue_model.GetParentIssueID(ctx, issue.ID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	customFieldValues, err := issue_model.GetIssueCustomFieldValues(ctx, issue.ID)
+	if err != nil {
+		return nil, false, err
+	}
+	customFields := make([]string, 0, len(customFieldValues))
+	for fieldID, values := range customFieldValues {
+		for _, value := range values {
+			customFields = append(customFields, internal.CustomFieldToken(fieldID, value))
+		}
+	}
+
 	assigneeIDs := make([]int64, 0, len(issue.Assignees))
 	for _, a := range issue.Assignees {
 		assigneeIDs = append(assigneeIDs, a.ID)
@@ -114,6 +130,7 @@ func getIssueIndexerData(ctx context.Context, issueID int64) (*internal.IndexerD
 		LabelIDs:           labels,
 		NoLabel:            len(labels) == 0,
 		MilestoneID:        issue.MilestoneID,
+		TypeID:             issue.TypeID,
 		ProjectIDs:         projectIDs,
 		NoProject:          len(projectIDs) == 0,
 		PosterID:           issue.PosterID,
@@ -123,6 +140,8 @@ func getIssueIndexerData(ctx context.Context, issueID int64) (*internal.IndexerD
 		ReviewedIDs:        reviewedIDs,
 		ReviewRequestedIDs: reviewRequestedIDs,
 		SubscriberIDs:      subscriberIDs,
+		ParentID:           parentID,
+		CustomFields:       customFields,
 		UpdatedUnix:        issue.UpdatedUnix,
 		CreatedUnix:        issue.CreatedUnix,
 		DeadlineUnix:       issue.DeadlineUnix,
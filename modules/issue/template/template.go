@@ -38,6 +38,11 @@ func validateMetadata(template *api.IssueTemplate) error {
 	if strings.TrimSpace(template.About) == "" {
 		return errors.New("'about' is required")
 	}
+	for name := range template.CustomFields {
+		if strings.TrimSpace(name) == "" {
+			return errors.New("'custom_fields' should not contain empty names")
+		}
+	}
 	return nil
 }
 
@@ -724,6 +724,59 @@ body:
 			},
 			wantErr: "",
 		},
+		{
+			name: "custom field with empty name",
+			content: `
+name: "test"
+about: "this is about"
+custom_fields:
+  "": value
+`,
+			wantErr: "'custom_fields' should not contain empty names",
+		},
+		{
+			name:     "custom fields in markdown",
+			filename: "test.md",
+			content: `---
+name: Name
+about: About
+custom_fields:
+  Priority: High
+  Components: [Backend, API]
+---
+Content
+`,
+			want: &api.IssueTemplate{
+				Name:  "Name",
+				About: "About",
+				CustomFields: map[string]api.IssueTemplateStringSlice{
+					"Priority":   {"High"},
+					"Components": {"Backend", "API"},
+				},
+				Content:  "Content\n",
+				FileName: "test.md",
+			},
+			wantErr: "",
+		},
+		{
+			name:     "issue type in markdown",
+			filename: "test.md",
+			content: `---
+name: Name
+about: About
+type: Bug
+---
+Content
+`,
+			want: &api.IssueTemplate{
+				Name:      "Name",
+				About:     "About",
+				IssueType: "Bug",
+				Content:   "Content\n",
+				FileName:  "test.md",
+			},
+			wantErr: "",
+		},
 		{
 			name:     "comma delimited labels in markdown",
 			filename: "test.md",
@@ -16,6 +16,7 @@ type Issue struct {
 	Content      string            `json:"content"`
 	Ref          string            `json:"ref"`
 	Milestone    string            `json:"milestone"`
+	Type         *IssueType        `yaml:"type" json:"type,omitempty"`
 	State        string            `json:"state"` // closed, open
 	IsLocked     bool              `yaml:"is_locked" json:"is_locked"`
 	Created      time.Time         `json:"created"`
@@ -28,6 +29,13 @@ type Issue struct {
 	Context      DownloaderContext `yaml:"-"`
 }
 
+// IssueType defines the type of an issue like bug, feature or task
+type IssueType struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Color       string `json:"color"`
+}
+
 // GetExternalName ExternalUserMigrated interface
 func (issue *Issue) GetExternalName() string { return issue.PosterName }
 
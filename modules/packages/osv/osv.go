@@ -0,0 +1,219 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package osv
+
+import (
+	"archive/zip"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gitea.dev/modules/json"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/util"
+
+	"github.com/hashicorp/go-version"
+)
+
+// https://ossf.github.io/osv-schema/
+
+var ErrInvalidAdvisory = util.NewInvalidArgumentErrorf("advisory is invalid")
+
+const (
+	RangeTypeSemver    = "SEMVER"
+	RangeTypeEcosystem = "ECOSYSTEM"
+)
+
+// Advisory represents a single OSV vulnerability entry
+type Advisory struct {
+	ID               string            `json:"id"`
+	Summary          string            `json:"summary"`
+	Aliases          []string          `json:"aliases"`
+	Withdrawn        string            `json:"withdrawn"`
+	Affected         []*Affected       `json:"affected"`
+	DatabaseSpecific *DatabaseSpecific `json:"database_specific"`
+}
+
+// DatabaseSpecific contains the fields of database_specific used by Gitea
+type DatabaseSpecific struct {
+	Severity string `json:"severity"`
+}
+
+// Affected describes which versions of a package are affected
+type Affected struct {
+	Package  AffectedPackage `json:"package"`
+	Ranges   []*Range        `json:"ranges"`
+	Versions []string        `json:"versions"`
+}
+
+// AffectedPackage identifies the affected package
+type AffectedPackage struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+}
+
+// Range is a list of events which describe the affected versions
+type Range struct {
+	Type   string   `json:"type"`
+	Events []*Event `json:"events"`
+}
+
+// Event marks the begin or the end of an affected range
+type Event struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+	Limit        string `json:"limit,omitempty"`
+}
+
+// Severity returns the severity of the advisory if known
+func (a *Advisory) Severity() string {
+	if a.DatabaseSpecific == nil {
+		return ""
+	}
+	return strings.ToLower(a.DatabaseSpecific.Severity)
+}
+
+// ParseAdvisory parses a single OSV JSON document
+func ParseAdvisory(r io.Reader) (*Advisory, error) {
+	var a Advisory
+	if err := json.NewDecoder(r).Decode(&a); err != nil {
+		return nil, err
+	}
+	if a.ID == "" {
+		return nil, ErrInvalidAdvisory
+	}
+	return &a, nil
+}
+
+// IsVersionAffected checks if the version is listed or contained in one of the ranges.
+// GIT ranges can not be evaluated and are ignored.
+func (a *Affected) IsVersionAffected(v string) bool {
+	if slices.Contains(a.Versions, v) {
+		return true
+	}
+
+	parsed, err := version.NewVersion(v)
+	if err != nil {
+		return false
+	}
+
+	for _, r := range a.Ranges {
+		if r.Type != RangeTypeSemver && r.Type != RangeTypeEcosystem {
+			continue
+		}
+		if r.contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// contains evaluates the events of the range in order as described by the OSV schema
+func (r *Range) contains(v *version.Version) bool {
+	affected := false
+	for _, e := range r.Events {
+		switch {
+		case e.Introduced != "":
+			if e.Introduced == "0" || compare(v, e.Introduced) >= 0 {
+				affected = true
+			}
+		case e.Fixed != "":
+			if compare(v, e.Fixed) >= 0 {
+				affected = false
+			}
+		case e.LastAffected != "":
+			if compare(v, e.LastAffected) > 0 {
+				affected = false
+			}
+		case e.Limit != "":
+			if e.Limit != "*" && compare(v, e.Limit) >= 0 {
+				affected = false
+			}
+		}
+	}
+	return affected
+}
+
+// compare returns -1, 0 or 1. An unparsable other version is treated as greater than v.
+func compare(v *version.Version, other string) int {
+	o, err := version.NewVersion(other)
+	if err != nil {
+		return -1
+	}
+	return v.Compare(o)
+}
+
+// ReadDatabase reads all advisories of an OSV export. The path may be a single JSON file,
+// a zip archive like the ones provided by osv.dev or a directory containing these files.
+func ReadDatabase(path string, fn func(*Advisory) error) error {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !fi.IsDir() {
+		return readDatabaseFile(path, fn)
+	}
+
+	return filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		return readDatabaseFile(p, fn)
+	})
+}
+
+func readDatabaseFile(path string, fn func(*Advisory) error) error {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		return readAdvisory(f, fn)
+	case ".zip":
+		zr, err := zip.OpenReader(path)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+
+		for _, zf := range zr.File {
+			if zf.FileInfo().IsDir() || !strings.EqualFold(filepath.Ext(zf.Name), ".json") {
+				continue
+			}
+			f, err := zf.Open()
+			if err != nil {
+				return err
+			}
+			err = readAdvisory(f, fn)
+			f.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func readAdvisory(r io.Reader, fn func(*Advisory) error) error {
+	a, err := ParseAdvisory(r)
+	if err != nil {
+		// skip broken entries instead of failing the whole import
+		log.Warn("Skipping invalid OSV advisory: %v", err)
+		return nil
+	}
+	if a.Withdrawn != "" {
+		return nil
+	}
+	return fn(a)
+}
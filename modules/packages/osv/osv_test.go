@@ -0,0 +1,68 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package osv
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const advisoryContent = `{
+  "id": "GHSA-test-1234",
+  "summary": "Prototype pollution",
+  "aliases": ["CVE-2026-0001"],
+  "affected": [
+    {
+      "package": {"ecosystem": "npm", "name": "lib"},
+      "ranges": [
+        {"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "1.2.0"}, {"introduced": "2.0.0"}, {"last_affected": "2.1.0"}]},
+        {"type": "GIT", "events": [{"introduced": "0"}]}
+      ],
+      "versions": ["3.0.0-broken"]
+    }
+  ],
+  "database_specific": {"severity": "HIGH"}
+}`
+
+func TestParseAdvisory(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		a, err := ParseAdvisory(strings.NewReader(advisoryContent))
+		require.NoError(t, err)
+		assert.Equal(t, "GHSA-test-1234", a.ID)
+		assert.Equal(t, "Prototype pollution", a.Summary)
+		assert.Equal(t, "high", a.Severity())
+		require.Len(t, a.Affected, 1)
+		assert.Equal(t, "npm", a.Affected[0].Package.Ecosystem)
+		assert.Equal(t, "lib", a.Affected[0].Package.Name)
+	})
+
+	t.Run("MissingID", func(t *testing.T) {
+		a, err := ParseAdvisory(strings.NewReader(`{"summary":"test"}`))
+		assert.ErrorIs(t, err, ErrInvalidAdvisory)
+		assert.Nil(t, a)
+	})
+}
+
+func TestIsVersionAffected(t *testing.T) {
+	a, err := ParseAdvisory(strings.NewReader(advisoryContent))
+	require.NoError(t, err)
+
+	cases := map[string]bool{
+		"0.1.0":        true,
+		"1.1.9":        true,
+		"1.2.0":        false,
+		"1.9.0":        false,
+		"2.0.0":        true,
+		"2.1.0":        true,
+		"2.1.1":        false,
+		"3.0.0-broken": true,
+		"invalid":      false,
+	}
+	for v, expected := range cases {
+		assert.Equal(t, expected, a.Affected[0].IsVersionAffected(v), "version %s", v)
+	}
+}
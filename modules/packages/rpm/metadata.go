@@ -6,6 +6,7 @@ package rpm
 import (
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 
 	"gitea.dev/modules/timeutil"
@@ -26,6 +27,21 @@ const (
 	RepositoryVersion = "_repository"
 )
 
+var groupPartPattern = regexp.MustCompile(`\A[a-zA-Z0-9][a-zA-Z0-9.~+_-]*\z`)
+
+// IsValidGroup checks if the group can be used in the repository urls, the empty group is the default one
+func IsValidGroup(group string) bool {
+	if group == "" {
+		return true
+	}
+	for part := range strings.SplitSeq(group, "/") {
+		if !groupPartPattern.MatchString(part) {
+			return false
+		}
+	}
+	return true
+}
+
 const (
 	// Can't use the syscall constants because they are not available for windows build.
 	sIFMT  = 0xf000
@@ -161,3 +161,12 @@ Mu0UFYgZ/bYnuvn/vz4wtCz8qMwsHUvP0PX3tbYFUctAPdrY6tiiDtcCddDECahx7SuVNP5dpmb5
 		p.FileMetadata.Changelogs,
 	)
 }
+
+func TestIsValidGroup(t *testing.T) {
+	for _, group := range []string{"", "el9", "el9/stable", "fedora-40/x86_64", "a.b_c~d+e"} {
+		assert.True(t, IsValidGroup(group), "good=%q", group)
+	}
+	for _, group := range []string{"/", "el9/", "/el9", "el9//stable", "..", "el9/../stable", "-", "-/snapshots", ".hidden", "a b", "el9\nstable"} {
+		assert.False(t, IsValidGroup(group), "bad=%q", group)
+	}
+}
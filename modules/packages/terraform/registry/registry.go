@@ -0,0 +1,272 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package registry
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"gitea.dev/modules/util"
+)
+
+const (
+	PropertyProviderOS   = "terraform.provider.os"
+	PropertyProviderArch = "terraform.provider.arch"
+
+	SettingKeyPrivate = "terraform.key.private"
+	SettingKeyPublic  = "terraform.key.public"
+
+	// DefaultProviderProtocol is used if the uploader does not specify the supported plugin protocols
+	DefaultProviderProtocol = "5.0"
+
+	maxReadmeSize = 1 << 20
+
+	// binaryHeaderSize is the size of the start of the provider binary which is read to detect its platform
+	binaryHeaderSize = 4096
+)
+
+var (
+	ErrInvalidModuleName   = util.NewInvalidArgumentErrorf("module name is invalid")
+	ErrInvalidModuleSystem = util.NewInvalidArgumentErrorf("module system is invalid")
+	ErrInvalidProviderType = util.NewInvalidArgumentErrorf("provider type is invalid")
+	ErrInvalidPlatform     = util.NewInvalidArgumentErrorf("provider os or arch is invalid")
+	ErrInvalidProtocol     = util.NewInvalidArgumentErrorf("provider protocol is invalid")
+	ErrInvalidArchive      = util.NewInvalidArgumentErrorf("provider archive is not a zip archive")
+	ErrMissingBinary       = util.NewInvalidArgumentErrorf("provider archive does not contain the provider binary")
+	ErrPlatformMismatch    = util.NewInvalidArgumentErrorf("provider binary does not match the os or arch")
+
+	// https://developer.hashicorp.com/terraform/internals/module-registry-protocol#module-addresses
+	moduleNamePattern = regexp.MustCompile(`\A[0-9A-Za-z](?:[0-9A-Za-z_-]{0,62}[0-9A-Za-z])?\z`)
+	// the system (target provider) and the provider type must be lowercase
+	providerTypePattern = regexp.MustCompile(`\A[0-9a-z](?:[0-9a-z_-]{0,62}[0-9a-z])?\z`)
+	platformPattern     = regexp.MustCompile(`\A[0-9a-z_]{1,64}\z`)
+	protocolPattern     = regexp.MustCompile(`\A\d+\.\d+\z`)
+)
+
+// ModuleMetadata represents the metadata of a Terraform module
+type ModuleMetadata struct {
+	Readme string `json:"readme,omitempty"`
+}
+
+// ProviderMetadata represents the metadata of a Terraform provider version
+type ProviderMetadata struct {
+	Protocols []string `json:"protocols"`
+}
+
+// ModulePackageName returns the name of the package which stores the versions of the module
+func ModulePackageName(name, system string) (string, error) {
+	if !moduleNamePattern.MatchString(name) {
+		return "", ErrInvalidModuleName
+	}
+	if !providerTypePattern.MatchString(system) {
+		return "", ErrInvalidModuleSystem
+	}
+	return name + "/" + system, nil
+}
+
+// ModuleFilename returns the name of the archive of a module version
+func ModuleFilename(name, system, version string) string {
+	return fmt.Sprintf("%s-%s-%s.tar.gz", name, system, version)
+}
+
+// IsValidProviderType checks if the provider type can be used as package name
+func IsValidProviderType(providerType string) bool {
+	return providerTypePattern.MatchString(providerType)
+}
+
+// IsValidPlatform checks if os and arch form a valid provider platform
+func IsValidPlatform(os, arch string) bool {
+	return platformPattern.MatchString(os) && platformPattern.MatchString(arch)
+}
+
+// ProviderFilename returns the name of the provider archive as expected by Terraform
+func ProviderFilename(providerType, version, os, arch string) string {
+	return fmt.Sprintf("terraform-provider-%s_%s_%s_%s.zip", providerType, version, os, arch)
+}
+
+// ParseProtocols parses a comma separated list of plugin protocol versions
+func ParseProtocols(s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return []string{DefaultProviderProtocol}, nil
+	}
+
+	protocols := make([]string, 0, 2)
+	for p := range strings.SplitSeq(s, ",") {
+		p = strings.TrimSpace(p)
+		if !protocolPattern.MatchString(p) {
+			return nil, ErrInvalidProtocol
+		}
+		protocols = append(protocols, p)
+	}
+	return protocols, nil
+}
+
+// ValidateProviderArchive checks that the zip archive contains the binary of the provider built for the platform.
+// The binary must be named terraform-provider-<type> followed by an optional _<suffix> like _v1.0.0 and .exe on windows.
+// The executable format must match the os, the architecture is checked if the binary declares a known one.
+func ValidateProviderArchive(r io.ReaderAt, size int64, providerType, os, arch string) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return ErrInvalidArchive
+	}
+
+	binaryName := "terraform-provider-" + providerType
+	for _, f := range zr.File {
+		name := f.Name
+		if os == "windows" {
+			var ok bool
+			if name, ok = strings.CutSuffix(name, ".exe"); !ok {
+				continue
+			}
+		}
+		if f.FileInfo().IsDir() || (name != binaryName && !strings.HasPrefix(name, binaryName+"_")) || strings.Contains(name, "/") {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return ErrInvalidArchive
+		}
+		header := make([]byte, binaryHeaderSize)
+		n, err := io.ReadFull(rc, header)
+		rc.Close()
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return ErrInvalidArchive
+		}
+
+		binaryOS, binaryArch := detectBinaryPlatform(header[:n])
+		if binaryOS == "" || binaryOS != executableFormatOfOS(os) || (binaryArch != "" && binaryArch != arch) {
+			return ErrPlatformMismatch
+		}
+		return nil
+	}
+	return ErrMissingBinary
+}
+
+func executableFormatOfOS(os string) string {
+	switch os {
+	case "darwin":
+		return "macho"
+	case "windows":
+		return "pe"
+	default:
+		return "elf"
+	}
+}
+
+// detectBinaryPlatform returns the executable format and the Go architecture of a binary from its header.
+// The architecture is empty if it's unknown or if the binary contains several architectures.
+func detectBinaryPlatform(header []byte) (format, arch string) {
+	switch {
+	case len(header) >= 20 && bytes.HasPrefix(header, []byte(elf.ELFMAG)):
+		var bo binary.ByteOrder = binary.LittleEndian
+		if elf.Data(header[elf.EI_DATA]) == elf.ELFDATA2MSB {
+			bo = binary.BigEndian
+		}
+		switch elf.Machine(bo.Uint16(header[18:20])) {
+		case elf.EM_386:
+			arch = "386"
+		case elf.EM_X86_64:
+			arch = "amd64"
+		case elf.EM_ARM:
+			arch = "arm"
+		case elf.EM_AARCH64:
+			arch = "arm64"
+		}
+		return "elf", arch
+	case len(header) >= 8 && (binary.LittleEndian.Uint32(header) == macho.Magic32 || binary.LittleEndian.Uint32(header) == macho.Magic64):
+		switch macho.Cpu(binary.LittleEndian.Uint32(header[4:8])) {
+		case macho.Cpu386:
+			arch = "386"
+		case macho.CpuAmd64:
+			arch = "amd64"
+		case macho.CpuArm:
+			arch = "arm"
+		case macho.CpuArm64:
+			arch = "arm64"
+		}
+		return "macho", arch
+	case len(header) >= 4 && binary.BigEndian.Uint32(header) == macho.MagicFat:
+		return "macho", ""
+	case len(header) >= 0x40 && bytes.HasPrefix(header, []byte("MZ")):
+		offset := int(binary.LittleEndian.Uint32(header[0x3c:0x40]))
+		if offset < 0 || offset+6 > len(header) || !bytes.Equal(header[offset:offset+4], []byte("PE\x00\x00")) {
+			return "", ""
+		}
+		switch binary.LittleEndian.Uint16(header[offset+4 : offset+6]) {
+		case pe.IMAGE_FILE_MACHINE_I386:
+			arch = "386"
+		case pe.IMAGE_FILE_MACHINE_AMD64:
+			arch = "amd64"
+		case pe.IMAGE_FILE_MACHINE_ARMNT:
+			arch = "arm"
+		case pe.IMAGE_FILE_MACHINE_ARM64:
+			arch = "arm64"
+		}
+		return "pe", arch
+	}
+	return "", ""
+}
+
+// ParseModuleMetadata parses the metadata of a module from its tar.gz archive
+func ParseModuleMetadata(r io.Reader) (*ModuleMetadata, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gzr.Close()
+
+	tr := tar.NewReader(gzr)
+	for {
+		hd, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if hd.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if strings.EqualFold(path.Clean(hd.Name), "README.md") {
+			readme, err := io.ReadAll(io.LimitReader(tr, maxReadmeSize))
+			if err != nil {
+				return nil, err
+			}
+			return &ModuleMetadata{Readme: string(readme)}, nil
+		}
+	}
+
+	return &ModuleMetadata{}, nil
+}
+
+// ShasumsEntry is a single line of a SHA256SUMS file
+type ShasumsEntry struct {
+	Filename string
+	SHA256   string
+}
+
+// WriteShasums writes the entries in the format of the sha256sum tool
+func WriteShasums(w io.Writer, entries []ShasumsEntry) error {
+	for _, e := range entries {
+		if _, err := fmt.Fprintf(w, "%s  %s\n", e.SHA256, e.Filename); err != nil {
+			return err
+		}
+	}
+	return nil
+}
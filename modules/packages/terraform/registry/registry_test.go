@@ -0,0 +1,138 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package registry
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseModuleMetadata(t *testing.T) {
+	createArchive := func(files map[string][]byte) io.Reader {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(zw)
+		for filename, content := range files {
+			tw.WriteHeader(&tar.Header{
+				Name: filename,
+				Mode: 0o600,
+				Size: int64(len(content)),
+			})
+			tw.Write(content)
+		}
+		tw.Close()
+		zw.Close()
+		return &buf
+	}
+
+	t.Run("MissingReadme", func(t *testing.T) {
+		metadata, err := ParseModuleMetadata(createArchive(map[string][]byte{"main.tf": {}}))
+		assert.NoError(t, err)
+		assert.NotNil(t, metadata)
+		assert.Empty(t, metadata.Readme)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		metadata, err := ParseModuleMetadata(createArchive(map[string][]byte{
+			"main.tf":     {},
+			"./README.md": []byte("# Module"),
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, "# Module", metadata.Readme)
+	})
+
+	t.Run("InvalidArchive", func(t *testing.T) {
+		_, err := ParseModuleMetadata(bytes.NewReader([]byte("not an archive")))
+		assert.Error(t, err)
+	})
+}
+
+func TestModulePackageName(t *testing.T) {
+	name, err := ModulePackageName("consul", "aws")
+	assert.NoError(t, err)
+	assert.Equal(t, "consul/aws", name)
+
+	_, err = ModulePackageName("-consul", "aws")
+	assert.ErrorIs(t, err, ErrInvalidModuleName)
+
+	_, err = ModulePackageName("consul", "AWS")
+	assert.ErrorIs(t, err, ErrInvalidModuleSystem)
+}
+
+func TestParseProtocols(t *testing.T) {
+	protocols, err := ParseProtocols("")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{DefaultProviderProtocol}, protocols)
+
+	protocols, err = ParseProtocols("5.0, 6.0")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"5.0", "6.0"}, protocols)
+
+	_, err = ParseProtocols("5")
+	assert.ErrorIs(t, err, ErrInvalidProtocol)
+}
+
+func TestWriteShasums(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, WriteShasums(&buf, []ShasumsEntry{
+		{Filename: "terraform-provider-test_1.0.0_linux_amd64.zip", SHA256: "abc"},
+		{Filename: "terraform-provider-test_1.0.0_darwin_arm64.zip", SHA256: "def"},
+	}))
+	assert.Equal(t, "abc  terraform-provider-test_1.0.0_linux_amd64.zip\ndef  terraform-provider-test_1.0.0_darwin_arm64.zip\n", buf.String())
+}
+
+func TestValidateProviderArchive(t *testing.T) {
+	createArchive := func(name string, content []byte) *bytes.Reader {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		w, _ := zw.Create(name)
+		w.Write(content)
+		zw.Close()
+		return bytes.NewReader(buf.Bytes())
+	}
+
+	elfAmd64 := append([]byte("\x7fELF\x02\x01\x01"), make([]byte, 57)...)
+	elfAmd64[18] = 0x3e
+	machoArm64 := append([]byte{0xcf, 0xfa, 0xed, 0xfe, 0x0c, 0x00, 0x00, 0x01}, make([]byte, 24)...)
+	peAmd64 := append([]byte("MZ"), make([]byte, 0x3e)...)
+	peAmd64[0x3c] = 0x40
+	peAmd64 = append(peAmd64, 'P', 'E', 0x00, 0x00, 0x64, 0x86)
+
+	cases := []struct {
+		Name     string
+		Content  []byte
+		OS       string
+		Arch     string
+		Expected error
+	}{
+		{"terraform-provider-test_v1.0.0", elfAmd64, "linux", "amd64", nil},
+		{"terraform-provider-test", elfAmd64, "freebsd", "amd64", nil},
+		{"terraform-provider-test_v1.0.0", machoArm64, "darwin", "arm64", nil},
+		{"terraform-provider-test_v1.0.0.exe", peAmd64, "windows", "amd64", nil},
+		{"terraform-provider-test_v1.0.0", elfAmd64, "linux", "arm64", ErrPlatformMismatch},
+		{"terraform-provider-test_v1.0.0", elfAmd64, "darwin", "amd64", ErrPlatformMismatch},
+		{"terraform-provider-test_v1.0.0", []byte("#!/bin/sh"), "linux", "amd64", ErrPlatformMismatch},
+		{"terraform-provider-test_v1.0.0", peAmd64, "windows", "amd64", ErrMissingBinary},
+		{"terraform-provider-other_v1.0.0", elfAmd64, "linux", "amd64", ErrMissingBinary},
+		{"bin/terraform-provider-test_v1.0.0", elfAmd64, "linux", "amd64", ErrMissingBinary},
+	}
+	for _, c := range cases {
+		r := createArchive(c.Name, c.Content)
+		err := ValidateProviderArchive(r, r.Size(), "test", c.OS, c.Arch)
+		if c.Expected == nil {
+			assert.NoError(t, err, "name=%s os=%s arch=%s", c.Name, c.OS, c.Arch)
+		} else {
+			assert.ErrorIs(t, err, c.Expected, "name=%s os=%s arch=%s", c.Name, c.OS, c.Arch)
+		}
+	}
+
+	r := bytes.NewReader([]byte("not a zip"))
+	assert.ErrorIs(t, ValidateProviderArchive(r, r.Size(), "test", "linux", "amd64"), ErrInvalidArchive)
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package setting
+
+// CodeNavigation settings
+var CodeNavigation = struct {
+	Storage *Storage
+	Enabled bool
+
+	MaxFileSize   int64
+	MaxFiles      int
+	MaxReferences int
+	MaxUploadSize int64
+}{
+	Enabled:       true,
+	MaxFileSize:   512 * 1024,
+	MaxFiles:      5000,
+	MaxReferences: 500,
+	MaxUploadSize: 256 * 1024 * 1024,
+}
+
+func loadCodeNavigationFrom(rootCfg ConfigProvider) (err error) {
+	sec, _ := rootCfg.GetSection("code-navigation")
+	if sec == nil {
+		CodeNavigation.Storage, err = getStorage(rootCfg, "code-navigation", "", nil)
+		return err
+	}
+
+	CodeNavigation.Enabled = sec.Key("ENABLED").MustBool(CodeNavigation.Enabled)
+	CodeNavigation.MaxFiles = sec.Key("MAX_FILES").MustInt(CodeNavigation.MaxFiles)
+	CodeNavigation.MaxReferences = sec.Key("MAX_REFERENCES").MustInt(CodeNavigation.MaxReferences)
+	CodeNavigation.MaxFileSize = sec.Key("MAX_FILE_SIZE").MustInt64(CodeNavigation.MaxFileSize)
+	CodeNavigation.MaxUploadSize = sec.Key("MAX_UPLOAD_SIZE").MustInt64(CodeNavigation.MaxUploadSize)
+
+	CodeNavigation.Storage, err = getStorage(rootCfg, "code-navigation", "", sec)
+	return err
+}
@@ -135,6 +135,8 @@ func loadCommonSettingsFrom(cfg ConfigProvider) error {
 		return err
 	}
 	loadTimeFrom(cfg)
+	loadSLAFrom(cfg)
+	loadWebPushFrom(cfg)
 	loadRepositoryFrom(cfg)
 	if err := loadAvatarsFrom(cfg); err != nil {
 		return err
@@ -148,6 +150,9 @@ func loadCommonSettingsFrom(cfg ConfigProvider) error {
 	if err := loadActionsFrom(cfg); err != nil {
 		return err
 	}
+	if err := loadCodeNavigationFrom(cfg); err != nil {
+		return err
+	}
 	loadUIFrom(cfg)
 	loadAdminFrom(cfg)
 	loadAPIFrom(cfg)
@@ -0,0 +1,85 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package setting
+
+import (
+	"strings"
+	"time"
+
+	"gitea.dev/modules/log"
+)
+
+// SLA settings, the business hours are in the DefaultUILocation time zone
+var SLA = struct {
+	BusinessDays       []time.Weekday
+	BusinessHoursStart time.Duration
+	BusinessHoursEnd   time.Duration
+	// Holidays are dates in the YYYY-MM-DD format
+	Holidays       []string
+	ReminderBefore time.Duration
+}{
+	BusinessDays:       []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	BusinessHoursStart: 9 * time.Hour,
+	BusinessHoursEnd:   17 * time.Hour,
+	ReminderBefore:     2 * time.Hour,
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// parseClock parses a time of the day in the HH:MM format to its duration since midnight
+func parseClock(s string) (time.Duration, bool) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, false
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, true
+}
+
+func loadSLAFrom(rootCfg ConfigProvider) {
+	sec := rootCfg.Section("sla")
+
+	if days := sec.Key("BUSINESS_DAYS").Strings(","); len(days) > 0 {
+		SLA.BusinessDays = SLA.BusinessDays[:0]
+		for _, day := range days {
+			weekday, ok := weekdays[strings.ToLower(day)[:min(3, len(day))]]
+			if !ok {
+				log.Fatal("Invalid day %q in [sla].BUSINESS_DAYS", day)
+			}
+			SLA.BusinessDays = append(SLA.BusinessDays, weekday)
+		}
+	}
+
+	var ok bool
+	if start := sec.Key("BUSINESS_HOURS_START").String(); start != "" {
+		if SLA.BusinessHoursStart, ok = parseClock(start); !ok {
+			log.Fatal("Invalid [sla].BUSINESS_HOURS_START %q, the format is HH:MM", start)
+		}
+	}
+	if end := sec.Key("BUSINESS_HOURS_END").String(); end != "" {
+		if SLA.BusinessHoursEnd, ok = parseClock(end); !ok {
+			log.Fatal("Invalid [sla].BUSINESS_HOURS_END %q, the format is HH:MM", end)
+		}
+	}
+	if SLA.BusinessHoursEnd <= SLA.BusinessHoursStart {
+		log.Fatal("[sla].BUSINESS_HOURS_END has to be after [sla].BUSINESS_HOURS_START")
+	}
+
+	SLA.Holidays = SLA.Holidays[:0]
+	for _, holiday := range sec.Key("HOLIDAYS").Strings(",") {
+		if _, err := time.Parse(time.DateOnly, holiday); err != nil {
+			log.Fatal("Invalid date %q in [sla].HOLIDAYS, the format is YYYY-MM-DD", holiday)
+		}
+		SLA.Holidays = append(SLA.Holidays, holiday)
+	}
+
+	SLA.ReminderBefore = sec.Key("REMINDER_BEFORE").MustDuration(SLA.ReminderBefore)
+}
@@ -0,0 +1,25 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package setting
+
+import "time"
+
+// WebPush settings
+var WebPush = struct {
+	Enabled bool
+	// Subject is the contact of the instance sent to the push services, a mailto: or https: URL
+	Subject string
+	// TTL is how long the push services keep a notification for an offline browser
+	TTL time.Duration
+}{
+	Enabled: true,
+	TTL:     24 * time.Hour,
+}
+
+func loadWebPushFrom(rootCfg ConfigProvider) {
+	sec := rootCfg.Section("web_push")
+	WebPush.Enabled = sec.Key("ENABLED").MustBool(WebPush.Enabled)
+	WebPush.Subject = sec.Key("SUBJECT").MustString(AppURL)
+	WebPush.TTL = sec.Key("TTL").MustDuration(WebPush.TTL)
+}
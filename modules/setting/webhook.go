@@ -5,6 +5,7 @@ package setting
 
 import (
 	"net/url"
+	"time"
 
 	"gitea.dev/modules/log"
 )
@@ -20,13 +21,21 @@ var Webhook = struct {
 	ProxyURL        string
 	ProxyURLFixed   *url.URL
 	ProxyHosts      []string
+
+	MaxRetries           int
+	RetryBackoff         time.Duration
+	MaxRetryBackoff      time.Duration
+	DisableAfterFailures int // consecutive failed attempts before the webhook is deactivated, zero means never
 }{
-	QueueLength:    1000,
-	DeliverTimeout: 5,
-	SkipTLSVerify:  false,
-	PagingNum:      10,
-	ProxyURL:       "",
-	ProxyHosts:     []string{},
+	QueueLength:     1000,
+	DeliverTimeout:  5,
+	SkipTLSVerify:   false,
+	PagingNum:       10,
+	ProxyURL:        "",
+	ProxyHosts:      []string{},
+	MaxRetries:      3,
+	RetryBackoff:    time.Minute,
+	MaxRetryBackoff: time.Hour,
 }
 
 func loadWebhookFrom(rootCfg ConfigProvider) {
@@ -35,7 +44,7 @@ func loadWebhookFrom(rootCfg ConfigProvider) {
 	Webhook.DeliverTimeout = sec.Key("DELIVER_TIMEOUT").MustInt(5)
 	Webhook.SkipTLSVerify = sec.Key("SKIP_TLS_VERIFY").MustBool()
 	Webhook.AllowedHostList = sec.Key("ALLOWED_HOST_LIST").MustString("")
-	Webhook.Types = []string{"gitea", "gogs", "slack", "discord", "dingtalk", "telegram", "msteams", "feishu", "matrix", "wechatwork", "packagist"}
+	Webhook.Types = []string{"gitea", "gogs", "slack", "discord", "dingtalk", "telegram", "msteams", "feishu", "matrix", "wechatwork", "packagist", "googlechat", "mattermost", "amqp", "kafka", "nats", "redis"}
 	Webhook.PagingNum = sec.Key("PAGING_NUM").MustInt(10)
 	Webhook.ProxyURL = sec.Key("PROXY_URL").MustString("")
 	if Webhook.ProxyURL != "" {
@@ -47,4 +56,8 @@ func loadWebhookFrom(rootCfg ConfigProvider) {
 		}
 	}
 	Webhook.ProxyHosts = sec.Key("PROXY_HOSTS").Strings(",")
+	Webhook.MaxRetries = max(sec.Key("MAX_RETRIES").MustInt(3), 0)
+	Webhook.RetryBackoff = sec.Key("RETRY_BACKOFF").MustDuration(time.Minute)
+	Webhook.MaxRetryBackoff = sec.Key("MAX_RETRY_BACKOFF").MustDuration(time.Hour)
+	Webhook.DisableAfterFailures = max(sec.Key("DISABLE_AFTER_FAILURES").MustInt(0), 0)
 }
@@ -161,6 +161,9 @@ var (
 	Actions ObjectStorage = uninitializedStorage
 	// ActionsArtifacts Artifacts represents actions artifacts storage
 	ActionsArtifacts ObjectStorage = uninitializedStorage
+
+	// CodeNavigation represents code navigation index storage
+	CodeNavigation ObjectStorage = uninitializedStorage
 )
 
 // Init init the storage
@@ -173,6 +176,7 @@ func Init() error {
 		initRepoArchives,
 		initPackages,
 		initActions,
+		initCodeNavigation,
 	} {
 		if err := f(); err != nil {
 			return err
@@ -256,3 +260,13 @@ func initActions() (err error) {
 	ActionsArtifacts, err = NewStorage(setting.Actions.ArtifactStorage.Type, setting.Actions.ArtifactStorage)
 	return err
 }
+
+func initCodeNavigation() (err error) {
+	if !setting.CodeNavigation.Enabled {
+		CodeNavigation = discardStorage("Code navigation isn't enabled")
+		return nil
+	}
+	log.Info("Initialising Code navigation storage with type: %s", setting.CodeNavigation.Storage.Type)
+	CodeNavigation, err = NewStorage(setting.CodeNavigation.Storage.Type, setting.CodeNavigation.Storage)
+	return err
+}
@@ -25,6 +25,7 @@ type Hook struct {
 	Type string `json:"type"`
 	// Branch filter pattern to determine which branches trigger the webhook
 	BranchFilter string `json:"branch_filter"`
+	HookFilters
 	// The URL of the webhook endpoint (hidden in JSON)
 	URL string `json:"-"`
 	// Configuration settings for the webhook
@@ -46,6 +47,20 @@ type Hook struct {
 // HookList represents a list of API hook.
 type HookList []*Hook
 
+// HookFilters are the conditions on the events checked in addition to the branch filter
+type HookFilters struct {
+	// Glob pattern of the changed files of the push and pull request events, "*" doesn't match "/"
+	PathFilter string `json:"path_filter,omitempty"`
+	// Only trigger the issue and pull request events of the issues with one of these labels
+	LabelFilter []string `json:"label_filter,omitempty"`
+	// Only trigger the events with an action, like "opened" or "closed", for these actions
+	ActionFilter []string `json:"action_filter,omitempty"`
+	// Only trigger the events sent by these users or "org/team" teams
+	IncludeSenders []string `json:"include_senders,omitempty"`
+	// Don't trigger the events sent by these users or "org/team" teams
+	ExcludeSenders []string `json:"exclude_senders,omitempty"`
+}
+
 // CreateHookOptionConfig has all config options in it
 // required are "content_type" and "url" Required
 type CreateHookOptionConfig map[string]string
@@ -53,7 +68,7 @@ type CreateHookOptionConfig map[string]string
 // CreateHookOption options when create a hook
 type CreateHookOption struct {
 	// required: true
-	// enum: ["dingtalk","discord","gitea","gogs","msteams","slack","telegram","feishu","wechatwork","packagist"]
+	// enum: ["dingtalk","discord","gitea","gogs","msteams","slack","telegram","feishu","wechatwork","packagist","googlechat","mattermost","amqp","kafka","nats","redis"]
 	// The type of the webhook to create
 	Type string `json:"type" binding:"Required"`
 	// required: true
@@ -63,6 +78,7 @@ type CreateHookOption struct {
 	Events []string `json:"events"`
 	// Branch filter pattern to determine which branches trigger the webhook
 	BranchFilter string `json:"branch_filter" binding:"GlobPattern"`
+	HookFilters
 	// Authorization header to include in webhook requests
 	AuthorizationHeader string `json:"authorization_header"`
 	// default: false
@@ -80,6 +96,16 @@ type EditHookOption struct {
 	Events []string `json:"events"`
 	// Branch filter pattern to determine which branches trigger the webhook
 	BranchFilter string `json:"branch_filter" binding:"GlobPattern"`
+	// Glob pattern of the changed files of the push and pull request events, "*" doesn't match "/"
+	PathFilter *string `json:"path_filter"`
+	// Only trigger the issue and pull request events of the issues with one of these labels
+	LabelFilter []string `json:"label_filter"`
+	// Only trigger the events with an action, like "opened" or "closed", for these actions
+	ActionFilter []string `json:"action_filter"`
+	// Only trigger the events sent by these users or "org/team" teams
+	IncludeSenders []string `json:"include_senders"`
+	// Don't trigger the events sent by these users or "org/team" teams
+	ExcludeSenders []string `json:"exclude_senders"`
 	// Authorization header to include in webhook requests
 	AuthorizationHeader string `json:"authorization_header"`
 	// Whether the webhook is active and will be triggered
@@ -156,6 +182,7 @@ var (
 	_ Payloader = &RepositoryPayload{}
 	_ Payloader = &ReleasePayload{}
 	_ Payloader = &PackagePayload{}
+	_ Payloader = &ProjectColumnPayload{}
 )
 
 // CreatePayload represents a payload information of create event.
@@ -556,6 +583,45 @@ func (p *PackagePayload) JSONPayload() ([]byte, error) {
 	return json.MarshalIndent(p, "", "  ")
 }
 
+// HookProjectColumnAction an action that happens to a project column
+type HookProjectColumnAction string
+
+const (
+	// HookProjectColumnCreated created
+	HookProjectColumnCreated HookProjectColumnAction = "created"
+	// HookProjectColumnEdited edited
+	HookProjectColumnEdited HookProjectColumnAction = "edited"
+	// HookProjectColumnDeleted deleted
+	HookProjectColumnDeleted HookProjectColumnAction = "deleted"
+	// HookProjectColumnItemMoved an issue or a pull request was moved to the column
+	HookProjectColumnItemMoved HookProjectColumnAction = "item_moved"
+)
+
+// ProjectColumnPayload represents a project column payload
+type ProjectColumnPayload struct {
+	// The action performed on the column
+	Action HookProjectColumnAction `json:"action"`
+	// The project the column belongs to
+	Project *Project `json:"project"`
+	// The column that was acted upon
+	Column *ProjectColumn `json:"column"`
+	// The column the item was moved from (for item_moved)
+	FromColumn *ProjectColumn `json:"from_column,omitempty"`
+	// The issue or pull request that was moved (for item_moved)
+	Issue *Issue `json:"issue,omitempty"`
+	// The repository of the project (for repository projects)
+	Repository *Repository `json:"repository,omitempty"`
+	// The organization that owns the project (if applicable)
+	Organization *Organization `json:"organization,omitempty"`
+	// The user who performed the action
+	Sender *User `json:"sender"`
+}
+
+// JSONPayload implements Payload
+func (p *ProjectColumnPayload) JSONPayload() ([]byte, error) {
+	return json.MarshalIndent(p, "", "  ")
+}
+
 // WorkflowDispatchPayload represents a workflow dispatch payload
 type WorkflowDispatchPayload struct {
 	// The name or path of the workflow file
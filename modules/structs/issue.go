@@ -60,6 +60,7 @@ type Issue struct {
 	Attachments      []*Attachment `json:"assets"`
 	Labels           []*Label      `json:"labels"`
 	Milestone        *Milestone    `json:"milestone"`
+	Type             *IssueType    `json:"type"`
 	Projects         []*Project    `json:"projects"`
 	// deprecated
 	Assignee  *User     `json:"assignee"`
@@ -104,6 +105,8 @@ type CreateIssueOption struct {
 	// list of project ids
 	Projects []int64 `json:"projects"`
 	Closed   bool    `json:"closed"`
+	// name of the issue type, the types are defined by the organization owning the repository
+	Type string `json:"type"`
 }
 
 // EditIssueOption options for editing an issue
@@ -115,6 +118,8 @@ type EditIssueOption struct {
 	Assignee  *string  `json:"assignee"`
 	Assignees []string `json:"assignees"`
 	Milestone *int64   `json:"milestone"`
+	// name of the issue type, empty removes the type
+	Type *string `json:"type"`
 	// list of project ids to set (replaces existing projects)
 	Projects *[]int64 `json:"projects"`
 	State    *string  `json:"state"`
@@ -201,9 +206,13 @@ type IssueTemplate struct {
 	Labels    IssueTemplateStringSlice `json:"labels" yaml:"labels"`
 	Assignees IssueTemplateStringSlice `json:"assignees" yaml:"assignees"`
 	Ref       string                   `json:"ref" yaml:"ref"`
-	Content   string                   `json:"content" yaml:"-"`
-	Fields    []*IssueFormField        `json:"body" yaml:"body"`
-	FileName  string                   `json:"file_name" yaml:"-"`
+	// IssueType is the name of the issue type set on the new issues
+	IssueType string `json:"type,omitempty" yaml:"type"`
+	// CustomFields maps the names of custom fields to the values they are set to
+	CustomFields map[string]IssueTemplateStringSlice `json:"custom_fields,omitempty" yaml:"custom_fields"`
+	Content      string                              `json:"content" yaml:"-"`
+	Fields       []*IssueFormField                   `json:"body" yaml:"body"`
+	FileName     string                              `json:"file_name" yaml:"-"`
 }
 
 type IssueTemplateStringSlice []string
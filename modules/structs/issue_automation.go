@@ -0,0 +1,127 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package structs
+
+import (
+	"time"
+)
+
+// AutomationTrigger is the event which runs an automation rule
+//
+// swagger:enum AutomationTrigger
+type AutomationTrigger string
+
+const (
+	// AutomationTriggerIssueOpened an issue is opened
+	AutomationTriggerIssueOpened AutomationTrigger = "issue_opened"
+	// AutomationTriggerIssueClosed an issue or a pull request is closed
+	AutomationTriggerIssueClosed AutomationTrigger = "issue_closed"
+	// AutomationTriggerIssueReopened an issue or a pull request is reopened
+	AutomationTriggerIssueReopened AutomationTrigger = "issue_reopened"
+	// AutomationTriggerPullRequestOpened a pull request which closes the issue is opened
+	AutomationTriggerPullRequestOpened AutomationTrigger = "pull_request_opened"
+	// AutomationTriggerLabelAdded a label is added
+	AutomationTriggerLabelAdded AutomationTrigger = "label_added"
+	// AutomationTriggerLabelRemoved a label is removed
+	AutomationTriggerLabelRemoved AutomationTrigger = "label_removed"
+	// AutomationTriggerInactive an open issue or pull request hasn't been updated for some days
+	AutomationTriggerInactive AutomationTrigger = "inactive"
+)
+
+// AutomationAction is the change an automation rule makes
+//
+// swagger:enum AutomationAction
+type AutomationAction string
+
+const (
+	// AutomationActionMoveToColumn moves the item to a column of the project of the rule
+	AutomationActionMoveToColumn AutomationAction = "move_to_column"
+	// AutomationActionClose closes the issue or pull request
+	AutomationActionClose AutomationAction = "close"
+	// AutomationActionReopen reopens the issue or pull request
+	AutomationActionReopen AutomationAction = "reopen"
+	// AutomationActionAddLabel adds a label
+	AutomationActionAddLabel AutomationAction = "add_label"
+	// AutomationActionRemoveLabel removes a label
+	AutomationActionRemoveLabel AutomationAction = "remove_label"
+	// AutomationActionAssign assigns a user
+	AutomationActionAssign AutomationAction = "assign"
+)
+
+// AutomationRule represents an automation rule of a repository
+// swagger:model
+type AutomationRule struct {
+	ID int64 `json:"id"`
+	// ProjectID is the project the rule is limited to, zero for all the issues of the repository
+	ProjectID int64  `json:"project_id"`
+	Name      string `json:"name"`
+	// Trigger is the event which runs the rule
+	Trigger AutomationTrigger `json:"trigger"`
+	// ConditionLabelID is the label which is added or removed for the label triggers,
+	// the label the issue has to have for the other triggers
+	ConditionLabelID int64 `json:"condition_label_id"`
+	// InactiveDays is the number of days without update of the inactive trigger
+	InactiveDays int `json:"inactive_days"`
+	// Action is the change the rule makes
+	Action AutomationAction `json:"action"`
+	// ColumnID is the project column of the move_to_column action
+	ColumnID int64 `json:"column_id"`
+	// LabelID is the label of the add_label and remove_label actions
+	LabelID int64 `json:"label_id"`
+	// AssigneeID is the user of the assign action
+	AssigneeID int64 `json:"assignee_id"`
+	Active     bool  `json:"active"`
+	// CreatorID is the user the rule runs as
+	CreatorID int64 `json:"creator_id"`
+	// swagger:strfmt date-time
+	Created time.Time `json:"created_at"`
+	// swagger:strfmt date-time
+	Updated time.Time `json:"updated_at"`
+}
+
+// CreateAutomationRuleOption options for creating an automation rule
+type CreateAutomationRuleOption struct {
+	// required: true
+	Name string `json:"name" binding:"Required;MaxSize(255)"`
+	// ProjectID limits the rule to the items of a project
+	ProjectID int64 `json:"project_id"`
+	// required: true
+	Trigger          AutomationTrigger `json:"trigger" binding:"Required"`
+	ConditionLabelID int64             `json:"condition_label_id"`
+	InactiveDays     int               `json:"inactive_days"`
+	// required: true
+	Action     AutomationAction `json:"action" binding:"Required"`
+	ColumnID   int64            `json:"column_id"`
+	LabelID    int64            `json:"label_id"`
+	AssigneeID int64            `json:"assignee_id"`
+	// Active is true by default
+	Active *bool `json:"active"`
+}
+
+// EditAutomationRuleOption options for editing an automation rule
+type EditAutomationRuleOption struct {
+	Name             *string            `json:"name" binding:"MaxSize(255)"`
+	ProjectID        *int64             `json:"project_id"`
+	Trigger          *AutomationTrigger `json:"trigger"`
+	ConditionLabelID *int64             `json:"condition_label_id"`
+	InactiveDays     *int               `json:"inactive_days"`
+	Action           *AutomationAction  `json:"action"`
+	ColumnID         *int64             `json:"column_id"`
+	LabelID          *int64             `json:"label_id"`
+	AssigneeID       *int64             `json:"assignee_id"`
+	Active           *bool              `json:"active"`
+}
+
+// AutomationRuleLog represents a run of an automation rule which changed an issue or failed
+// swagger:model
+type AutomationRuleLog struct {
+	ID      int64 `json:"id"`
+	RuleID  int64 `json:"rule_id"`
+	IssueID int64 `json:"issue_id"`
+	Success bool  `json:"success"`
+	// Message describes the change or the error
+	Message string `json:"message"`
+	// swagger:strfmt date-time
+	Created time.Time `json:"created_at"`
+}
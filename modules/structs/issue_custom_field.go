@@ -0,0 +1,97 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package structs
+
+// CustomFieldType is the type of the values of a custom field
+//
+// swagger:enum CustomFieldType
+type CustomFieldType string
+
+const (
+	// CustomFieldTypeSingleSelect one of the options
+	CustomFieldTypeSingleSelect CustomFieldType = "single_select"
+	// CustomFieldTypeMultiSelect any of the options
+	CustomFieldTypeMultiSelect CustomFieldType = "multi_select"
+	// CustomFieldTypeNumber a decimal number
+	CustomFieldTypeNumber CustomFieldType = "number"
+	// CustomFieldTypeDate a date formatted as YYYY-MM-DD
+	CustomFieldTypeDate CustomFieldType = "date"
+	// CustomFieldTypeText a single line of text
+	CustomFieldTypeText CustomFieldType = "text"
+	// CustomFieldTypeUser a username
+	CustomFieldTypeUser CustomFieldType = "user"
+)
+
+// CustomField represents a custom field which can be set on issues and pull requests
+// swagger:model
+type CustomField struct {
+	// ID is the unique identifier for the custom field
+	ID int64 `json:"id"`
+	// Name is the display name of the custom field
+	Name string `json:"name"`
+	// Description provides additional context about the custom field's purpose
+	Description string `json:"description"`
+	// Type is the type of the values of the custom field
+	Type CustomFieldType `json:"type"`
+	// Options are the values which can be selected, only used by select fields
+	Options []string `json:"options"`
+	// Sort is the position of the custom field in the list of fields
+	Sort int `json:"sort"`
+	// Scope is where the custom field is defined, either "organization" or "repository"
+	Scope string `json:"scope"`
+}
+
+// CreateCustomFieldOption options for creating a custom field
+type CreateCustomFieldOption struct {
+	// required:true
+	// Name is the display name for the new custom field
+	Name string `json:"name" binding:"Required;MaxSize(50)"`
+	// Description provides additional context about the custom field's purpose
+	Description string `json:"description"`
+	// required:true
+	Type CustomFieldType `json:"type" binding:"Required"`
+	// Options are the values which can be selected, required by select fields
+	Options []string `json:"options"`
+	// Sort is the position of the custom field in the list of fields
+	Sort int `json:"sort"`
+}
+
+// EditCustomFieldOption options for editing a custom field, the type of a custom field can't be changed
+type EditCustomFieldOption struct {
+	// Name is the new display name for the custom field
+	Name *string `json:"name"`
+	// Description provides additional context about the custom field's purpose
+	Description *string `json:"description"`
+	// Options replace the values which can be selected, values of removed options are unset on all issues
+	Options []string `json:"options"`
+	// Sort is the position of the custom field in the list of fields
+	Sort *int `json:"sort"`
+}
+
+// IssueCustomFieldValue represents the values of a custom field of an issue
+type IssueCustomFieldValue struct {
+	// FieldID is the ID of the custom field
+	FieldID int64 `json:"field_id"`
+	// Name is the display name of the custom field
+	Name string `json:"name"`
+	// Type is the type of the values of the custom field
+	Type CustomFieldType `json:"type"`
+	// Values are the values of the custom field, usernames for user fields and dates formatted as YYYY-MM-DD
+	Values []string `json:"values"`
+}
+
+// SetIssueCustomFieldOption sets the values of a custom field of an issue
+type SetIssueCustomFieldOption struct {
+	// required:true
+	// FieldID is the ID of the custom field
+	FieldID int64 `json:"field_id" binding:"Required"`
+	// Values replace the values of the custom field, an empty list unsets it
+	Values []string `json:"values"`
+}
+
+// SetIssueCustomFieldsOption options for setting the custom fields of an issue, fields which are not listed are kept
+type SetIssueCustomFieldsOption struct {
+	// required:true
+	Fields []SetIssueCustomFieldOption `json:"fields" binding:"Required"`
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package structs
+
+import (
+	"time"
+)
+
+// SavedSearch is an issue search query saved by a user or an organization
+type SavedSearch struct {
+	// ID is the unique identifier for the saved search
+	ID int64 `json:"id"`
+	// Name is the display name of the saved search
+	Name string `json:"name"`
+	// Query is the issue search query, e.g. `is:open label:bug assignee:@me`
+	Query string `json:"query"`
+	// Pinned indicates if the saved search is shown on the dashboard
+	Pinned bool `json:"pinned"`
+	// Subscribed indicates if the authenticated user is notified about new matching issues and pull requests
+	Subscribed bool `json:"subscribed"`
+	// swagger:strfmt date-time
+	Created time.Time `json:"created_at"`
+	// swagger:strfmt date-time
+	Updated time.Time `json:"updated_at"`
+}
+
+// CreateSavedSearchOption options for creating a saved search
+type CreateSavedSearchOption struct {
+	// Name is the display name of the saved search
+	// required: true
+	Name string `json:"name" binding:"Required;MaxSize(255)"`
+	// Query is the issue search query, e.g. `is:open label:bug assignee:@me`
+	// required: true
+	Query string `json:"query" binding:"Required"`
+	// Pinned indicates if the saved search is shown on the dashboard
+	Pinned bool `json:"pinned"`
+}
+
+// EditSavedSearchOption options for editing a saved search
+type EditSavedSearchOption struct {
+	// Name is the new display name of the saved search
+	Name *string `json:"name" binding:"MaxSize(255)"`
+	// Query is the new issue search query
+	Query *string `json:"query"`
+	// Pinned indicates if the saved search is shown on the dashboard
+	Pinned *bool `json:"pinned"`
+}
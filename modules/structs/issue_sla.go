@@ -0,0 +1,80 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package structs
+
+import (
+	"time"
+)
+
+// SLAPolicy represents the service level agreement of the issues and pull requests with a label
+// swagger:model
+type SLAPolicy struct {
+	// ID is the unique identifier for the SLA policy
+	ID int64 `json:"id"`
+	// Name is the display name of the SLA policy
+	Name string `json:"name"`
+	// LabelID is the label of the issues and pull requests the policy applies to
+	LabelID int64 `json:"label_id"`
+	// FirstResponseHours is the number of business hours to the first response, no target if zero
+	FirstResponseHours int `json:"first_response_hours"`
+	// ResolutionDays is the number of business days to the closing, no target if zero
+	ResolutionDays int `json:"resolution_days"`
+	// Scope is where the SLA policy is defined, either "organization" or "repository"
+	Scope string `json:"scope"`
+}
+
+// CreateSLAPolicyOption options for creating an SLA policy
+type CreateSLAPolicyOption struct {
+	// required:true
+	// Name is the display name for the new SLA policy
+	Name string `json:"name" binding:"Required;MaxSize(50)"`
+	// required:true
+	// LabelID is the label of the issues and pull requests the policy applies to
+	LabelID int64 `json:"label_id" binding:"Required"`
+	// FirstResponseHours is the number of business hours to the first response of someone other than the poster
+	FirstResponseHours int `json:"first_response_hours"`
+	// ResolutionDays is the number of business days to the closing
+	ResolutionDays int `json:"resolution_days"`
+}
+
+// EditSLAPolicyOption options for editing an SLA policy
+type EditSLAPolicyOption struct {
+	// Name is the new display name for the SLA policy
+	Name *string `json:"name" binding:"MaxSize(50)"`
+	// LabelID is the label of the issues and pull requests the policy applies to
+	LabelID *int64 `json:"label_id"`
+	// FirstResponseHours is the number of business hours to the first response, zero removes the target
+	FirstResponseHours *int `json:"first_response_hours"`
+	// ResolutionDays is the number of business days to the closing, zero removes the target
+	ResolutionDays *int `json:"resolution_days"`
+}
+
+// IssueSLA represents the state of the targets of an SLA policy for an issue or a pull request
+// swagger:model
+type IssueSLA struct {
+	// PolicyID is the SLA policy which applies to the issue
+	PolicyID int64 `json:"policy_id"`
+	// PolicyName is the display name of the SLA policy
+	PolicyName string `json:"policy_name"`
+	// IssueIndex is the index of the issue or pull request in its repository
+	IssueIndex int64 `json:"issue_index"`
+	// HTMLURL is the web page of the issue or pull request
+	HTMLURL string `json:"html_url"`
+	// FirstResponseDue is the due time of the first response, if the policy has this target
+	// swagger:strfmt date-time
+	FirstResponseDue *time.Time `json:"first_response_due,omitempty"`
+	// FirstResponseAt is the time of the first response, if anyone other than the poster responded
+	// swagger:strfmt date-time
+	FirstResponseAt *time.Time `json:"first_response_at,omitempty"`
+	// FirstResponseBreached is true if the first response is late
+	FirstResponseBreached bool `json:"first_response_breached"`
+	// ResolutionDue is the due time of the resolution, if the policy has this target
+	// swagger:strfmt date-time
+	ResolutionDue *time.Time `json:"resolution_due,omitempty"`
+	// ResolvedAt is the time the issue was closed, if it is closed
+	// swagger:strfmt date-time
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// ResolutionBreached is true if the resolution is late
+	ResolutionBreached bool `json:"resolution_breached"`
+}
@@ -0,0 +1,32 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package structs
+
+// AddSubIssueOption options for adding a sub-issue to an issue
+type AddSubIssueOption struct {
+	// Index is the index of the sub-issue in its repository
+	Index int64 `json:"index" binding:"Required"`
+	// Owner is the owner of the sub-issue's repository, it must be the same as the parent issue's
+	Owner string `json:"owner"`
+	// Name is the name of the sub-issue's repository
+	Name string `json:"repo"`
+	// Inherit adds the sub-issue to the milestone and the projects of the parent issue
+	Inherit bool `json:"inherit"`
+}
+
+// ReorderSubIssuesOption options for reordering the sub-issues of an issue
+type ReorderSubIssuesOption struct {
+	// IssueIDs are the IDs of all sub-issues in their new order
+	IssueIDs []int64 `json:"issue_ids" binding:"Required"`
+}
+
+// SubIssueProgress represents the progress of an issue computed from all its sub-issues, including the nested ones
+type SubIssueProgress struct {
+	// Total is the number of sub-issues
+	Total int64 `json:"total"`
+	// Closed is the number of closed sub-issues
+	Closed int64 `json:"closed"`
+	// Percent is the percentage of closed sub-issues
+	Percent int `json:"percent"`
+}
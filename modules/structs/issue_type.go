@@ -0,0 +1,53 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package structs
+
+// IssueType represents a type of issues like bug, feature or task, defined by an organization
+// swagger:model
+type IssueType struct {
+	// ID is the unique identifier for the issue type
+	ID int64 `json:"id"`
+	// Name is the display name of the issue type
+	Name string `json:"name"`
+	// Description provides additional context about the issue type's purpose
+	Description string `json:"description"`
+	// example: #d73a4a
+	Color string `json:"color"`
+	// Icon is the name of the octicon shown for the issue type
+	// example: octicon-bug
+	Icon string `json:"icon"`
+	// Sort is the position of the issue type in the list of types
+	Sort int `json:"sort"`
+}
+
+// CreateIssueTypeOption options for creating an issue type
+type CreateIssueTypeOption struct {
+	// required:true
+	// Name is the display name for the new issue type
+	Name string `json:"name" binding:"Required;MaxSize(50)"`
+	// Description provides additional context about the issue type's purpose
+	Description string `json:"description"`
+	// example: #d73a4a
+	Color string `json:"color"`
+	// Icon is the name of the octicon shown for the issue type, "octicon-issue-opened" if empty
+	// example: octicon-bug
+	Icon string `json:"icon"`
+	// Sort is the position of the issue type in the list of types
+	Sort int `json:"sort"`
+}
+
+// EditIssueTypeOption options for editing an issue type
+type EditIssueTypeOption struct {
+	// Name is the new display name for the issue type
+	Name *string `json:"name"`
+	// Description provides additional context about the issue type's purpose
+	Description *string `json:"description"`
+	// example: #d73a4a
+	Color *string `json:"color"`
+	// Icon is the name of the octicon shown for the issue type
+	// example: octicon-bug
+	Icon *string `json:"icon"`
+	// Sort is the position of the issue type in the list of types
+	Sort *int `json:"sort"`
+}
@@ -47,3 +47,164 @@ type PackageFile struct {
 	// The SHA512 hash of the package file
 	HashSHA512 string `json:"sha512"`
 }
+
+// PackageProtectionRule represents a rule which protects package versions from being overwritten or deleted
+type PackageProtectionRule struct {
+	// The unique identifier of the protection rule
+	ID int64 `json:"id"`
+	// The package type the rule applies to, empty for all types
+	Type string `json:"type"`
+	// The package name the rule applies to, empty for all packages
+	PackageName string `json:"package_name"`
+	// Whether all versions are protected
+	Immutable bool `json:"immutable"`
+	// Regular expression of the protected versions
+	ProtectedPattern string `json:"protected_pattern"`
+	// swagger:strfmt date-time
+	// The date and time when the rule was created
+	Created time.Time `json:"created_at"`
+	// swagger:strfmt date-time
+	// The date and time when the rule was last updated
+	Updated time.Time `json:"updated_at"`
+}
+
+// CreatePackageProtectionRuleOption options for creating a package protection rule
+type CreatePackageProtectionRuleOption struct {
+	// The package type the rule applies to, empty for all types
+	Type string `json:"type"`
+	// The package name the rule applies to, empty for all packages
+	PackageName string `json:"package_name"`
+	// Whether all versions are protected
+	Immutable bool `json:"immutable"`
+	// Regular expression of the protected versions
+	ProtectedPattern string `json:"protected_pattern"`
+}
+
+// EditPackageProtectionRuleOption options for editing a package protection rule
+type EditPackageProtectionRuleOption struct {
+	// Whether all versions are protected
+	Immutable *bool `json:"immutable"`
+	// Regular expression of the protected versions
+	ProtectedPattern *string `json:"protected_pattern"`
+}
+
+// PackageDownloadStat represents the number of downloads of a package version on a day
+type PackageDownloadStat struct {
+	// swagger:strfmt date-time
+	// The start of the day (UTC) of the downloads
+	Date time.Time `json:"date"`
+	// The number of downloads on that day
+	Count int64 `json:"count"`
+}
+
+// PackageAuditEvent represents a recorded publish or delete of a package version
+type PackageAuditEvent struct {
+	// The unique identifier of the event
+	ID int64 `json:"id"`
+	// The type of the package
+	Type string `json:"type"`
+	// The name of the package
+	Name string `json:"name"`
+	// The version of the package
+	Version string `json:"version"`
+	// The recorded action
+	// enum: ["publish","delete"]
+	Action string `json:"action"`
+	// The user who triggered the action
+	Actor *User `json:"actor"`
+	// The personal access token used for the action, 0 if none
+	AccessTokenID int64 `json:"access_token_id"`
+	// The actions task which triggered the action, 0 if none
+	ActionsTaskID int64 `json:"actions_task_id"`
+	// swagger:strfmt date-time
+	// The date and time of the action
+	Created time.Time `json:"created_at"`
+}
+
+// PackageDependency represents a dependency declared by a package version
+type PackageDependency struct {
+	// The OSV ecosystem of the dependency
+	Ecosystem string `json:"ecosystem"`
+	// The name of the dependency
+	Name string `json:"name"`
+	// The version requirement as declared
+	Requirement string `json:"requirement"`
+	// The lowest version satisfying the requirement, empty if unknown
+	Version string `json:"version"`
+}
+
+// PackageVulnerability represents a known vulnerability affecting a package version or one of its dependencies
+type PackageVulnerability struct {
+	// The identifier of the advisory
+	AdvisoryID string `json:"advisory_id"`
+	// The summary of the advisory
+	Summary string `json:"summary"`
+	// The severity of the advisory if known
+	Severity string `json:"severity"`
+	// The name of the affected dependency, empty if the package version itself is affected
+	DependencyName string `json:"dependency_name"`
+	// The resolved version of the affected dependency
+	DependencyVersion string `json:"dependency_version"`
+	// swagger:strfmt date-time
+	// The date and time the vulnerability was detected
+	Created time.Time `json:"created_at"`
+}
+
+// PackageSnapshot represents an immutable snapshot of a Debian distribution or a RPM group
+type PackageSnapshot struct {
+	// The unique identifier of the snapshot
+	ID int64 `json:"id"`
+	// The package type of the snapshot, debian or rpm
+	Type string `json:"type"`
+	// The name of the snapshot
+	Name string `json:"name"`
+	// The Debian distribution of the snapshot
+	Distribution string `json:"distribution"`
+	// The Debian component of the snapshot, empty if all components are included
+	Component string `json:"component"`
+	// The RPM group of the snapshot
+	Group string `json:"group"`
+	// swagger:strfmt date-time
+	// The date and time when the snapshot was created
+	Created time.Time `json:"created_at"`
+}
+
+// CreatePackageSnapshotOption options for creating a package snapshot
+type CreatePackageSnapshotOption struct {
+	// The package type of the snapshot, debian or rpm
+	// required: true
+	Type string `json:"type" binding:"Required;In(debian,rpm)"`
+	// The name of the snapshot
+	// required: true
+	Name string `json:"name" binding:"Required;MaxSize(100)"`
+	// The Debian distribution to snapshot
+	Distribution string `json:"distribution"`
+	// The Debian component to snapshot, empty to include all components
+	Component string `json:"component"`
+	// The RPM group to snapshot
+	Group string `json:"group"`
+}
+
+// PromotePackagesOption options for promoting package versions to another Debian distribution or RPM group
+type PromotePackagesOption struct {
+	// The package type of the package versions, debian or rpm
+	// required: true
+	Type string `json:"type" binding:"Required;In(debian,rpm)"`
+	// The source Debian distribution or RPM group
+	From string `json:"from"`
+	// The target Debian distribution or RPM group
+	To string `json:"to"`
+	// The Debian component to promote, empty to promote all components
+	Component string `json:"component"`
+	// The package versions to promote
+	// required: true
+	Packages []*PromotePackageVersion `json:"packages" binding:"Required"`
+}
+
+// PromotePackageVersion identifies a package version to promote
+type PromotePackageVersion struct {
+	// The name of the package
+	Name string `json:"name"`
+	// The version of the package
+	Version string `json:"version"`
+}
@@ -7,6 +7,32 @@ import (
 	"time"
 )
 
+// ProjectCardType is how the items of a project are shown on its board
+//
+// swagger:enum ProjectCardType
+type ProjectCardType string
+
+const (
+	// ProjectCardTypeTextOnly the cards show only the titles of the items
+	ProjectCardTypeTextOnly ProjectCardType = "text_only"
+	// ProjectCardTypeImagesAndText the cards show the first image attachment of the items too
+	ProjectCardTypeImagesAndText ProjectCardType = "images_and_text"
+)
+
+// ProjectTemplate defines the columns a new project is created with
+//
+// swagger:enum ProjectTemplate
+type ProjectTemplate string
+
+const (
+	// ProjectTemplateNone the project is created without columns
+	ProjectTemplateNone ProjectTemplate = "none"
+	// ProjectTemplateBasicKanban the project is created with the columns of a basic kanban board
+	ProjectTemplateBasicKanban ProjectTemplate = "basic_kanban"
+	// ProjectTemplateBugTriage the project is created with the columns of a bug triage
+	ProjectTemplateBugTriage ProjectTemplate = "bug_triage"
+)
+
 // Project represents a project
 // swagger:model
 type Project struct {
@@ -24,6 +50,10 @@ type Project struct {
 	CreatorID int64 `json:"creator_id"`
 	// IsClosed indicates if the project is closed
 	IsClosed bool `json:"is_closed"`
+	// CardType is how the items are shown on the board
+	CardType ProjectCardType `json:"card_type"`
+	// HTMLURL is the web page of the project
+	HTMLURL string `json:"html_url"`
 	// swagger:strfmt date-time
 	Created time.Time `json:"created_at"`
 	// swagger:strfmt date-time
@@ -31,3 +61,181 @@ type Project struct {
 	// swagger:strfmt date-time
 	Closed *time.Time `json:"closed_at,omitempty"`
 }
+
+// CreateProjectOption options for creating a project
+type CreateProjectOption struct {
+	// Title is the title of the new project
+	// required: true
+	Title string `json:"title" binding:"Required;MaxSize(100)"`
+	// Description provides details about the project
+	Description string `json:"description"`
+	// Template defines the columns the project is created with
+	Template ProjectTemplate `json:"template"`
+	// CardType is how the items are shown on the board
+	CardType ProjectCardType `json:"card_type"`
+}
+
+// EditProjectOption options for editing a project
+type EditProjectOption struct {
+	// Title is the new title of the project
+	Title *string `json:"title" binding:"MaxSize(100)"`
+	// Description provides updated details about the project
+	Description *string `json:"description"`
+	// CardType is how the items are shown on the board
+	CardType *ProjectCardType `json:"card_type"`
+	// State indicates the updated state of the project
+	// enum: ["open","closed"]
+	State *string `json:"state"`
+}
+
+// ProjectColumn represents a column of the board of a project
+// swagger:model
+type ProjectColumn struct {
+	// ID is the unique identifier for the column
+	ID int64 `json:"id"`
+	// ProjectID is the project the column belongs to
+	ProjectID int64 `json:"project_id"`
+	// Title is the title of the column
+	Title string `json:"title"`
+	// Color is the color of the column
+	// example: #00aabb
+	Color string `json:"color"`
+	// Default indicates the issues which aren't assigned to a column are shown in this column
+	Default bool `json:"default"`
+	// Sorting is the position of the column on the board
+	Sorting int `json:"sorting"`
+	// swagger:strfmt date-time
+	Created time.Time `json:"created_at"`
+	// swagger:strfmt date-time
+	Updated time.Time `json:"updated_at"`
+}
+
+// CreateProjectColumnOption options for creating a project column
+type CreateProjectColumnOption struct {
+	// Title is the title of the new column
+	// required: true
+	Title string `json:"title" binding:"Required;MaxSize(100)"`
+	// Color is the color of the column
+	// example: #00aabb
+	Color string `json:"color"`
+}
+
+// EditProjectColumnOption options for editing a project column
+type EditProjectColumnOption struct {
+	// Title is the new title of the column
+	Title *string `json:"title" binding:"MaxSize(100)"`
+	// Color is the new color of the column, an empty string removes the color
+	// example: #00aabb
+	Color *string `json:"color"`
+	// Sorting is the new position of the column on the board
+	Sorting *int `json:"sorting"`
+	// Default makes the column the default column of the project, it can't be unset
+	Default *bool `json:"default"`
+}
+
+// ProjectViewLayout is the layout of a project view
+//
+// swagger:enum ProjectViewLayout
+type ProjectViewLayout string
+
+const (
+	// ProjectViewLayoutTable the items are listed in a table
+	ProjectViewLayoutTable ProjectViewLayout = "table"
+	// ProjectViewLayoutRoadmap the items are listed by milestone or by deadline
+	ProjectViewLayoutRoadmap ProjectViewLayout = "roadmap"
+)
+
+// ProjectView represents a saved view of the items of a project besides its board
+// swagger:model
+type ProjectView struct {
+	// ID is the unique identifier for the view
+	ID int64 `json:"id"`
+	// ProjectID is the project the view belongs to
+	ProjectID int64 `json:"project_id"`
+	// Name is the display name of the view
+	Name string `json:"name"`
+	// Layout of the view
+	Layout ProjectViewLayout `json:"layout"`
+	// Fields are the columns of a table view: status, assignees, labels, milestone, type, deadline, repository, updated or "field:{id}" for custom fields
+	Fields []string `json:"fields"`
+	// GroupBy is the field the items are grouped by, roadmaps are grouped by milestone or deadline
+	GroupBy string `json:"group_by"`
+	// SortBy is the field the items are sorted by: number, title, created, updated, deadline or "field:{id}", the order of the board if empty
+	SortBy string `json:"sort_by"`
+	// SortDesc sorts the items in descending order
+	SortDesc bool `json:"sort_desc"`
+	// Filter is an issue search query the items of the view have to match
+	// example: is:open label:bug
+	Filter string `json:"filter"`
+	// Sort is the position of the view in the list of views
+	Sort int `json:"sort"`
+	// swagger:strfmt date-time
+	Created time.Time `json:"created_at"`
+	// swagger:strfmt date-time
+	Updated time.Time `json:"updated_at"`
+}
+
+// CreateProjectViewOption options for creating a project view
+type CreateProjectViewOption struct {
+	// Name is the display name for the new view
+	// required: true
+	Name string `json:"name" binding:"Required;MaxSize(255)"`
+	// Layout of the view
+	// required: true
+	Layout ProjectViewLayout `json:"layout" binding:"Required"`
+	// Fields are the columns of a table view
+	Fields []string `json:"fields"`
+	// GroupBy is the field the items are grouped by
+	GroupBy string `json:"group_by"`
+	// SortBy is the field the items are sorted by
+	SortBy string `json:"sort_by"`
+	// SortDesc sorts the items in descending order
+	SortDesc bool `json:"sort_desc"`
+	// Filter is an issue search query the items of the view have to match
+	Filter string `json:"filter"`
+	// Sort is the position of the view in the list of views
+	Sort int `json:"sort"`
+}
+
+// EditProjectViewOption options for editing a project view
+type EditProjectViewOption struct {
+	// Name is the new display name for the view
+	Name *string `json:"name"`
+	// Layout of the view
+	Layout *ProjectViewLayout `json:"layout"`
+	// Fields are the columns of a table view
+	Fields []string `json:"fields"`
+	// GroupBy is the field the items are grouped by
+	GroupBy *string `json:"group_by"`
+	// SortBy is the field the items are sorted by
+	SortBy *string `json:"sort_by"`
+	// SortDesc sorts the items in descending order
+	SortDesc *bool `json:"sort_desc"`
+	// Filter is an issue search query the items of the view have to match
+	Filter *string `json:"filter"`
+	// Sort is the position of the view in the list of views
+	Sort *int `json:"sort"`
+}
+
+// ProjectItem represents an issue or a pull request of a project
+// swagger:model
+type ProjectItem struct {
+	// Issue is the issue or pull request
+	Issue *Issue `json:"issue"`
+	// ColumnID is the column of the board containing the item
+	ColumnID int64 `json:"column_id"`
+	// Sorting is the position of the item in its column
+	Sorting int64 `json:"sorting"`
+}
+
+// AddProjectItemOption options for adding an issue or a pull request to a project
+type AddProjectItemOption struct {
+	// IssueID is the ID (not the index) of the issue or pull request
+	// required: true
+	IssueID int64 `json:"issue_id" binding:"Required"`
+	// ColumnID is the column the item is put into, the default column if zero.
+	// An item which is already in the project is moved to the column.
+	ColumnID int64 `json:"column_id"`
+	// Sorting is the position of the item in the column, the item is put at the end of the column if empty
+	Sorting *int64 `json:"sorting"`
+}
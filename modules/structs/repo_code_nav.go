@@ -0,0 +1,16 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package structs
+
+// CodeNavigationIndex represents the code navigation index of a commit
+type CodeNavigationIndex struct {
+	// The commit the index belongs to
+	CommitID string `json:"commit_id"`
+	// Whether the index was created from an uploaded LSIF dump
+	Precise bool `json:"precise"`
+	// The number of files which contain indexed ranges
+	Files int `json:"files"`
+	// The number of distinct symbols in the index
+	Symbols int `json:"symbols"`
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package timeutil
+
+import (
+	"slices"
+	"time"
+
+	"gitea.dev/modules/container"
+	"gitea.dev/modules/setting"
+)
+
+// BusinessCalendar represents the business hours of the working days, without the holidays
+type BusinessCalendar struct {
+	Days     [7]bool
+	Start    time.Duration
+	End      time.Duration
+	Holidays container.Set[string]
+	Location *time.Location
+}
+
+// DefaultBusinessCalendar returns the business calendar of the SLA settings in the time zone of the instance
+func DefaultBusinessCalendar() *BusinessCalendar {
+	calendar := &BusinessCalendar{
+		Start:    setting.SLA.BusinessHoursStart,
+		End:      setting.SLA.BusinessHoursEnd,
+		Holidays: container.SetOf(setting.SLA.Holidays...),
+		Location: setting.DefaultUILocation,
+	}
+	for _, day := range setting.SLA.BusinessDays {
+		calendar.Days[day] = true
+	}
+	return calendar
+}
+
+// HoursPerDay returns the business hours of a working day
+func (c *BusinessCalendar) HoursPerDay() time.Duration {
+	return c.End - c.Start
+}
+
+func (c *BusinessCalendar) isWorkingDay(day time.Time) bool {
+	return c.Days[day.Weekday()] && !c.Holidays.Contains(day.Format(time.DateOnly))
+}
+
+// clockTime returns the time of the day given as the duration since midnight, on the wall clock:
+// the business hours don't move on the days the clocks change
+func (c *BusinessCalendar) clockTime(day time.Time, sinceMidnight time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(),
+		int(sinceMidnight/time.Hour), int(sinceMidnight%time.Hour/time.Minute), int(sinceMidnight%time.Minute/time.Second), 0, c.Location)
+}
+
+// maxCalendarDays limits the search of working days if the calendar has too few of them
+const maxCalendarDays = 3660
+
+// AddBusinessDuration returns the time when the business duration d has elapsed since t,
+// only the business hours of the working days count
+func (c *BusinessCalendar) AddBusinessDuration(t time.Time, d time.Duration) time.Time {
+	if d <= 0 || c.HoursPerDay() <= 0 || !slices.Contains(c.Days[:], true) {
+		return t.Add(max(d, 0))
+	}
+	t = t.In(c.Location)
+	for range maxCalendarDays {
+		midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, c.Location)
+		if c.isWorkingDay(midnight) {
+			start, end := c.clockTime(midnight, c.Start), c.clockTime(midnight, c.End)
+			if t.Before(start) {
+				t = start
+			}
+			if t.Before(end) {
+				available := end.Sub(t)
+				if d <= available {
+					return t.Add(d)
+				}
+				d -= available
+			}
+		}
+		t = midnight.AddDate(0, 0, 1)
+	}
+	return t.Add(d)
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package timeutil
+
+import (
+	"testing"
+	"time"
+
+	"gitea.dev/modules/container"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBusinessCalendarAddBusinessDuration(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	calendar := &BusinessCalendar{
+		Start:    9 * time.Hour,
+		End:      17 * time.Hour,
+		Holidays: container.SetOf("2026-12-25"),
+		Location: loc,
+	}
+	for _, day := range []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday} {
+		calendar.Days[day] = true
+	}
+	date := func(day, hour, minute int) time.Time {
+		return time.Date(2026, time.December, day, hour, minute, 0, 0, loc)
+	}
+
+	cases := []struct {
+		from     time.Time
+		duration time.Duration
+		expected time.Time
+	}{
+		// within the business hours of a day
+		{date(14, 10, 0), 2 * time.Hour, date(14, 12, 0)},
+		// before and after the business hours
+		{date(14, 7, 30), time.Hour, date(14, 10, 0)},
+		{date(14, 18, 0), time.Hour, date(15, 10, 0)},
+		// the end of the business hours
+		{date(14, 16, 0), time.Hour, date(14, 17, 0)},
+		{date(14, 16, 0), 90 * time.Minute, date(15, 9, 30)},
+		// the weekend
+		{date(18, 16, 0), 2 * time.Hour, date(21, 10, 0)},
+		{date(19, 12, 0), time.Hour, date(21, 10, 0)},
+		// the holiday
+		{date(24, 16, 0), 2 * time.Hour, date(28, 10, 0)},
+		// several days
+		{date(14, 9, 0), 3 * calendar.HoursPerDay(), date(16, 17, 0)},
+		// in another time zone
+		{date(14, 10, 0).UTC(), 2 * time.Hour, date(14, 12, 0)},
+		{date(14, 10, 0), 0, date(14, 10, 0)},
+	}
+	for _, c := range cases {
+		assert.True(t, c.expected.Equal(calendar.AddBusinessDuration(c.from, c.duration)), "%v + %v", c.from, c.duration)
+	}
+
+	// all the time counts without working days
+	assert.True(t, date(15, 10, 0).Equal((&BusinessCalendar{Start: 9 * time.Hour, End: 17 * time.Hour, Location: loc}).AddBusinessDuration(date(14, 10, 0), 24*time.Hour)))
+}
+
+func TestBusinessCalendarAddBusinessDurationDST(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	calendar := &BusinessCalendar{
+		Days:     [7]bool{true, true, true, true, true, true, true},
+		Start:    9 * time.Hour,
+		End:      17 * time.Hour,
+		Location: loc,
+	}
+	date := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2026, month, day, hour, minute, 0, 0, loc)
+	}
+
+	cases := []struct {
+		from     time.Time
+		duration time.Duration
+		expected time.Time
+	}{
+		// the clocks move forward on March 29th and back on October 25th, the business hours stay the same
+		{date(time.March, 28, 16, 0), 2 * time.Hour, date(time.March, 29, 10, 0)},
+		{date(time.March, 29, 16, 30), time.Hour, date(time.March, 30, 9, 30)},
+		{date(time.October, 25, 7, 30), time.Hour, date(time.October, 25, 10, 0)},
+		{date(time.October, 24, 16, 0), 10 * time.Hour, date(time.October, 26, 10, 0)},
+	}
+	for _, c := range cases {
+		assert.True(t, c.expected.Equal(calendar.AddBusinessDuration(c.from, c.duration)), "%v + %v", c.from, c.duration)
+	}
+}
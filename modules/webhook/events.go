@@ -16,5 +16,15 @@ type HookEvent struct {
 	ChooseEvents   bool   `json:"choose_events"`
 	BranchFilter   string `json:"branch_filter"`
 
+	// PathFilter is a glob of the changed files of the push and pull request events
+	PathFilter string `json:"path_filter,omitempty"`
+	// LabelFilter restricts the issue and pull request events to the issues with one of the labels
+	LabelFilter []string `json:"label_filter,omitempty"`
+	// ActionFilter restricts the events with an action, like "opened" or "closed", to these actions
+	ActionFilter []string `json:"action_filter,omitempty"`
+	// IncludeSenders and ExcludeSenders are user names or "org/team" teams the sender of the events is checked against
+	IncludeSenders []string `json:"include_senders,omitempty"`
+	ExcludeSenders []string `json:"exclude_senders,omitempty"`
+
 	HookEvents `json:"events"`
 }
@@ -32,6 +32,7 @@ const (
 	HookEventRelease                   HookEventType = "release"
 	HookEventPackage                   HookEventType = "package"
 	HookEventStatus                    HookEventType = "status"
+	HookEventProjectColumn             HookEventType = "project_column"
 	// once a new event added here, please also added to AllEvents() function
 
 	// FIXME: This event should be a group of pull_request_review_xxx events
@@ -68,6 +69,7 @@ func AllEvents() []HookEventType {
 		HookEventRelease,
 		HookEventPackage,
 		HookEventStatus,
+		HookEventProjectColumn,
 		HookEventWorkflowRun,
 		HookEventWorkflowJob,
 	}
@@ -128,6 +130,14 @@ const (
 	MATRIX     HookType = "matrix"
 	WECHATWORK HookType = "wechatwork"
 	PACKAGIST  HookType = "packagist"
+	GOOGLECHAT HookType = "googlechat"
+	MATTERMOST HookType = "mattermost"
+
+	// message brokers, the payload is published as a message instead of being sent over HTTP
+	AMQP  HookType = "amqp"
+	KAFKA HookType = "kafka"
+	NATS  HookType = "nats"
+	REDIS HookType = "redis"
 )
 
 // HookStatus is the status of a web hook
@@ -0,0 +1,212 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+// Package webpush implements the sending side of the Web Push protocol: the message encryption
+// of RFC 8291 and the VAPID authentication of RFC 8292.
+package webpush
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// recordSize is the record size of the aes128gcm content encoding, a push message always fits into a single record
+const recordSize = 4096
+
+// maxPayloadSize is the largest payload push services must accept: the record size without the header,
+// the padding delimiter and the authentication tag
+const maxPayloadSize = recordSize - 86 - 1 - 16
+
+// vapidTokenLifetime is the lifetime of the VAPID JWT, push services reject tokens valid for more than 24 hours
+const vapidTokenLifetime = 12 * time.Hour
+
+// ErrPayloadTooLarge is returned if the payload doesn't fit into a push message
+var ErrPayloadTooLarge = errors.New("web push payload is too large")
+
+// Subscription is the push subscription of a browser as returned by PushSubscription.toJSON()
+type Subscription struct {
+	Endpoint string
+	// P256DH is the base64url encoded public key of the user agent
+	P256DH string
+	// Auth is the base64url encoded authentication secret of the user agent
+	Auth string
+}
+
+// VAPIDKeys is the application server key pair identifying the instance to the push services
+type VAPIDKeys struct {
+	// PrivateKey is the base64url encoded P-256 private key
+	PrivateKey string
+	// PublicKey is the base64url encoded uncompressed P-256 public key, it's the applicationServerKey of the browser API
+	PublicKey string
+}
+
+// GenerateVAPIDKeys generates a new application server key pair
+func GenerateVAPIDKeys() (*VAPIDKeys, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := key.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := key.PublicKey.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return &VAPIDKeys{
+		PrivateKey: base64.RawURLEncoding.EncodeToString(privateKey),
+		PublicKey:  base64.RawURLEncoding.EncodeToString(publicKey),
+	}, nil
+}
+
+// Options are the delivery options of a push message
+type Options struct {
+	// Subject is the contact of the application server, a mailto: or https: URL
+	Subject string
+	// TTL is how long the push service keeps the message if the user agent is offline
+	TTL time.Duration
+}
+
+// NewRequest creates the request delivering the encrypted payload to the push service of the subscription
+func NewRequest(sub *Subscription, keys *VAPIDKeys, payload []byte, opts Options) (*http.Request, error) {
+	if len(payload) > maxPayloadSize {
+		return nil, ErrPayloadTooLarge
+	}
+	endpoint, err := url.Parse(sub.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint: %w", err)
+	}
+	if endpoint.Scheme != "https" {
+		return nil, errors.New("invalid endpoint: only https is supported")
+	}
+
+	uaPublic, err := decodeBase64(sub.P256DH)
+	if err != nil {
+		return nil, fmt.Errorf("invalid p256dh key: %w", err)
+	}
+	authSecret, err := decodeBase64(sub.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth secret: %w", err)
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	body, err := encrypt(payload, uaPublic, authSecret, salt, asPrivate)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := vapidToken(endpoint, keys, opts.Subject, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, sub.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("vapid t=%s, k=%s", token, keys.PublicKey))
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(opts.TTL.Seconds())))
+	return req, nil
+}
+
+// decodeBase64 decodes the base64url keys of a subscription, browsers may or may not pad them
+func decodeBase64(s string) ([]byte, error) {
+	if b, err := base64.RawURLEncoding.DecodeString(s); err == nil {
+		return b, nil
+	}
+	return base64.URLEncoding.DecodeString(s)
+}
+
+// encrypt encrypts the plaintext for the user agent with the aes128gcm content encoding as specified by RFC 8291
+func encrypt(plaintext, uaPublic, authSecret, salt []byte, asPrivate *ecdh.PrivateKey) ([]byte, error) {
+	uaKey, err := ecdh.P256().NewPublicKey(uaPublic)
+	if err != nil {
+		return nil, fmt.Errorf("invalid p256dh key: %w", err)
+	}
+	ecdhSecret, err := asPrivate.ECDH(uaKey)
+	if err != nil {
+		return nil, err
+	}
+	asPublic := asPrivate.PublicKey().Bytes()
+
+	keyInfo := "WebPush: info\x00" + string(uaPublic) + string(asPublic)
+	ikm, err := hkdf.Key(sha256.New, ecdhSecret, authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, err
+	}
+	cek, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// header: salt | record size | key id length | key id (the public key of the application server)
+	header := make([]byte, 0, 16+4+1+len(asPublic))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, recordSize)
+	header = append(header, byte(len(asPublic)))
+	header = append(header, asPublic...)
+
+	// a single record which is terminated by the padding delimiter of the last record
+	record := append(append(make([]byte, 0, len(plaintext)+1), plaintext...), 2)
+	return gcm.Seal(header, nonce, record, nil), nil
+}
+
+// vapidToken creates the JWT which authenticates the application server to the push service of the endpoint
+func vapidToken(endpoint *url.URL, keys *VAPIDKeys, subject string, now time.Time) (string, error) {
+	rawPrivateKey, err := decodeBase64(keys.PrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("invalid VAPID private key: %w", err)
+	}
+	privateKey, err := ecdsa.ParseRawPrivateKey(elliptic.P256(), rawPrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("invalid VAPID private key: %w", err)
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"aud": endpoint.Scheme + "://" + endpoint.Host,
+		"exp": now.Add(vapidTokenLifetime).Unix(),
+		"sub": subject,
+	}).SignedString(privateKey)
+}
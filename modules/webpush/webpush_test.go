@@ -0,0 +1,83 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package webpush
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustDecode(t *testing.T, s string) []byte {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+// TestEncrypt uses the example of RFC 8291 Appendix A
+func TestEncrypt(t *testing.T) {
+	asPrivate, err := ecdh.P256().NewPrivateKey(mustDecode(t, "yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw"))
+	require.NoError(t, err)
+
+	body, err := encrypt(
+		[]byte("When I grow up, I want to be a watermelon"),
+		mustDecode(t, "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"),
+		mustDecode(t, "BTBZMqHH6r4Tts7J_aSIgg"),
+		mustDecode(t, "DGv6ra1nlYgDCS1FRnbzlw"),
+		asPrivate,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN",
+		base64.RawURLEncoding.EncodeToString(body))
+}
+
+func TestNewRequest(t *testing.T) {
+	keys, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+
+	ua, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sub := &Subscription{
+		Endpoint: "https://push.example.com/send/abc",
+		P256DH:   base64.RawURLEncoding.EncodeToString(ua.PublicKey().Bytes()),
+		Auth:     "BTBZMqHH6r4Tts7J_aSIgg",
+	}
+
+	req, err := NewRequest(sub, keys, []byte(`{"title":"test"}`), Options{Subject: "mailto:admin@example.com", TTL: time.Hour})
+	require.NoError(t, err)
+	assert.Equal(t, "aes128gcm", req.Header.Get("Content-Encoding"))
+	assert.Equal(t, "3600", req.Header.Get("TTL"))
+
+	auth, ok := strings.CutPrefix(req.Header.Get("Authorization"), "vapid t=")
+	require.True(t, ok)
+	token, publicKey, ok := strings.Cut(auth, ", k=")
+	require.True(t, ok)
+	assert.Equal(t, keys.PublicKey, publicKey)
+
+	x, y := elliptic.Unmarshal(elliptic.P256(), mustDecode(t, publicKey)) //nolint:staticcheck // the key is in the uncompressed form
+	require.NotNil(t, x)
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://push.example.com", claims["aud"])
+	assert.Equal(t, "mailto:admin@example.com", claims["sub"])
+
+	_, err = NewRequest(sub, keys, make([]byte, maxPayloadSize+1), Options{})
+	assert.ErrorIs(t, err, ErrPayloadTooLarge)
+
+	sub.Endpoint = "http://push.example.com/send/abc"
+	_, err = NewRequest(sub, keys, nil, Options{})
+	assert.Error(t, err)
+}
@@ -127,6 +127,20 @@ func CommonRoutes() *web.Router {
 		&chef.Auth{},
 	}, verifyAuthOptions{})
 
+	// The Terraform registry protocols are mounted host-wide (see .well-known/terraform.json),
+	// the namespace of module and provider addresses is the package owner.
+	// "-" can't be used as username which avoids conflicts with the routes below.
+	r.Group("/-/terraform", func() {
+		r.Group("/modules/v1/{username}/{name}/{system}", func() {
+			r.Get("/versions", terraform.ListModuleVersions)
+			r.Get("/{version}/download", terraform.GetModuleDownloadLocation)
+		})
+		r.Group("/providers/v1/{username}/{provider}", func() {
+			r.Get("/versions", terraform.ListProviderVersions)
+			r.Get("/{version}/download/{os}/{arch}", terraform.GetProviderDownloadLocation)
+		})
+	}, context.UserAssignmentWeb(), context.PackageAssignment(), reqPackageAccess(perm.AccessModeRead))
+
 	r.Group("/{username}", func() {
 		r.Group("/alpine", func() {
 			r.Get("/key", alpine.GetRepositoryKey)
@@ -327,6 +341,17 @@ func CommonRoutes() *web.Router {
 					r.Delete("/{name}/{version}/{architecture}", debian.DeletePackageFile)
 				}, reqPackageAccess(perm.AccessModeWrite))
 			})
+			r.Group("/-/snapshots/{snapshot}", func() {
+				r.Group("/dists/{distribution}", func() {
+					r.Get("/{filename}", debian.GetSnapshotRepositoryFile)
+					r.Get("/by-hash/{algorithm}/{hash}", debian.GetSnapshotRepositoryFileByHash)
+					r.Group("/{component}/{architecture}", func() {
+						r.Get("/{filename}", debian.GetSnapshotRepositoryFile)
+						r.Get("/by-hash/{algorithm}/{hash}", debian.GetSnapshotRepositoryFileByHash)
+					})
+				})
+				r.Get("/pool/{distribution}/{component}/{name}_{version}_{architecture}.deb", debian.DownloadSnapshotPackageFile)
+			})
 		}, reqPackageAccess(perm.AccessModeRead))
 		r.Group("/go", func() {
 			r.Put("/upload", reqPackageAccess(perm.AccessModeWrite), goproxy.UploadPackage)
@@ -469,6 +494,10 @@ func CommonRoutes() *web.Router {
 		r.Methods("HEAD,GET", "/rpm.repo", reqPackageAccess(perm.AccessModeRead), rpm.GetRepositoryConfig)
 		r.PathGroup("/rpm/*", func(g *web.RouterPathGroup) {
 			g.MatchPath("HEAD,GET", "/repository.key", rpm.GetRepositoryKey)
+			g.MatchPath("HEAD,GET", "/-/snapshots/<snapshot>.repo", rpm.GetSnapshotRepositoryConfig)
+			g.MatchPath("HEAD,GET", "/-/snapshots/<snapshot>/repodata/<filename>", rpm.GetSnapshotRepositoryFile)
+			g.MatchPath("HEAD,GET", "/-/snapshots/<snapshot>/package/<name>/<version>/<architecture>/<filename>", rpm.DownloadSnapshotPackageFile)
+			g.MatchPath("HEAD,GET", "/-/snapshots/<snapshot>/package/<name>/<version>/<architecture>", rpm.DownloadSnapshotPackageFile)
 			g.MatchPath("HEAD,GET", "/<group:*>.repo", rpm.GetRepositoryConfig)
 			g.MatchPath("HEAD", "/<group:*>/repodata/<filename>", rpm.CheckRepositoryFileExistence)
 			g.MatchPath("GET", "/<group:*>/repodata/<filename>", rpm.GetRepositoryFile)
@@ -531,6 +560,23 @@ func CommonRoutes() *web.Router {
 				r.Delete("", terraform.UnlockState)
 			}, reqPackageAccess(perm.AccessModeWrite))
 		}, reqPackageAccess(perm.AccessModeRead))
+		r.Group("/terraform", func() {
+			r.Group("/modules/{name}/{system}/{version}", func() {
+				r.Get("", terraform.DownloadModule)
+				r.Put("", reqPackageAccess(perm.AccessModeWrite), terraform.UploadModule)
+			})
+			r.Group("/providers", func() {
+				r.Get("/repository.key", terraform.GetProviderPublicKey)
+				r.Group("/{provider}/{version}", func() {
+					r.Get("/SHA256SUMS", terraform.GetProviderShasums)
+					r.Get("/SHA256SUMS.sig", terraform.GetProviderShasumsSignature)
+					r.Group("/{os}/{arch}", func() {
+						r.Get("", terraform.DownloadProvider)
+						r.Put("", reqPackageAccess(perm.AccessModeWrite), terraform.UploadProvider)
+					})
+				})
+			})
+		}, reqPackageAccess(perm.AccessModeRead))
 		r.Group("/vagrant", func() {
 			r.Group("/authenticate", func() {
 				r.Get("", vagrant.CheckAuthenticate)
@@ -369,6 +369,8 @@ func DeletePackageVersion(ctx *context.Context) {
 	if err != nil {
 		if errors.Is(err, packages_model.ErrPackageNotExist) {
 			apiError(ctx, http.StatusNotFound, err)
+		} else if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+			apiError(ctx, http.StatusForbidden, err)
 		} else {
 			apiError(ctx, http.StatusInternalServerError, err)
 		}
@@ -391,9 +393,22 @@ func DeletePackage(ctx *context.Context) {
 		return
 	}
 
+	if err := packages_service.CheckPackageVersionsNotProtected(ctx, pvs); err != nil {
+		if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+			apiError(ctx, http.StatusForbidden, err)
+		} else {
+			apiError(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
 	for _, pv := range pvs {
 		if err := packages_service.RemovePackageVersion(ctx, ctx.Doer, pv); err != nil {
-			apiError(ctx, http.StatusInternalServerError, err)
+			if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+				apiError(ctx, http.StatusForbidden, err)
+			} else {
+				apiError(ctx, http.StatusInternalServerError, err)
+			}
 			return
 		}
 	}
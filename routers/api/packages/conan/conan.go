@@ -439,7 +439,7 @@ func uploadFile(ctx *context.Context, fileFilter container.Set[string], fileKey
 	)
 	if err != nil {
 		switch err {
-		case packages_model.ErrDuplicatePackageFile:
+		case packages_model.ErrDuplicatePackageFile, packages_service.ErrPackageVersionProtected:
 			apiError(ctx, http.StatusConflict, err)
 		case packages_service.ErrQuotaTotalCount, packages_service.ErrQuotaTypeSize, packages_service.ErrQuotaTotalSize:
 			apiError(ctx, http.StatusForbidden, err)
@@ -508,6 +508,8 @@ func DeleteRecipeV1(ctx *context.Context) {
 	if err := deleteRecipeOrPackage(ctx, rref, true, nil, false); err != nil {
 		if errors.Is(err, packages_model.ErrPackageNotExist) || errors.Is(err, conan_model.ErrPackageReferenceNotExist) {
 			apiError(ctx, http.StatusNotFound, err)
+		} else if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+			apiError(ctx, http.StatusForbidden, err)
 		} else {
 			apiError(ctx, http.StatusInternalServerError, err)
 		}
@@ -523,6 +525,8 @@ func DeleteRecipeV2(ctx *context.Context) {
 	if err := deleteRecipeOrPackage(ctx, rref, rref.Revision == "", nil, false); err != nil {
 		if errors.Is(err, packages_model.ErrPackageNotExist) || errors.Is(err, conan_model.ErrPackageReferenceNotExist) {
 			apiError(ctx, http.StatusNotFound, err)
+		} else if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+			apiError(ctx, http.StatusForbidden, err)
 		} else {
 			apiError(ctx, http.StatusInternalServerError, err)
 		}
@@ -570,6 +574,8 @@ func DeletePackageV1(ctx *context.Context) {
 			if err := deleteRecipeOrPackage(ctx, currentRref, true, pref, true); err != nil {
 				if errors.Is(err, packages_model.ErrPackageNotExist) || errors.Is(err, conan_model.ErrPackageReferenceNotExist) {
 					apiError(ctx, http.StatusNotFound, err)
+				} else if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+					apiError(ctx, http.StatusForbidden, err)
 				} else {
 					apiError(ctx, http.StatusInternalServerError, err)
 				}
@@ -589,6 +595,8 @@ func DeletePackageV2(ctx *context.Context) {
 		if err := deleteRecipeOrPackage(ctx, rref, false, pref, pref.Revision == ""); err != nil {
 			if errors.Is(err, packages_model.ErrPackageNotExist) || errors.Is(err, conan_model.ErrPackageReferenceNotExist) {
 				apiError(ctx, http.StatusNotFound, err)
+			} else if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+				apiError(ctx, http.StatusForbidden, err)
 			} else {
 				apiError(ctx, http.StatusInternalServerError, err)
 			}
@@ -614,6 +622,8 @@ func DeletePackageV2(ctx *context.Context) {
 		if err := deleteRecipeOrPackage(ctx, rref, false, pref, true); err != nil {
 			if errors.Is(err, packages_model.ErrPackageNotExist) || errors.Is(err, conan_model.ErrPackageReferenceNotExist) {
 				apiError(ctx, http.StatusNotFound, err)
+			} else if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+				apiError(ctx, http.StatusForbidden, err)
 			} else {
 				apiError(ctx, http.StatusInternalServerError, err)
 			}
@@ -634,6 +644,10 @@ func deleteRecipeOrPackage(apictx *context.Context, rref *conan_module.RecipeRef
 			return err
 		}
 
+		if err := packages_service.CheckPackageVersionNotProtected(ctx, pv); err != nil {
+			return err
+		}
+
 		pd, err = packages_model.GetPackageDescriptor(ctx, pv)
 		if err != nil {
 			return err
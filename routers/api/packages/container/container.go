@@ -702,9 +702,22 @@ func DeleteManifest(ctx *context.Context) {
 		return
 	}
 
+	if err := packages_service.CheckPackageVersionsNotProtected(ctx, pvs); err != nil {
+		if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+			apiErrorDefined(ctx, errDenied.WithMessage(err.Error()))
+		} else {
+			apiError(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
 	for _, pv := range pvs {
 		if err := packages_service.RemovePackageVersion(ctx, ctx.Doer, pv); err != nil {
-			apiError(ctx, http.StatusInternalServerError, err)
+			if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+				apiErrorDefined(ctx, errDenied.WithMessage(err.Error()))
+			} else {
+				apiError(ctx, http.StatusInternalServerError, err)
+			}
 			return
 		}
 	}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"strings"
 
@@ -137,7 +138,7 @@ func processOciImageManifest(ctx context.Context, mci *manifestCreationInfo, buf
 			})
 		}
 
-		pv, err := createPackageAndVersion(ctx, mci, metadata)
+		pv, err := createPackageAndVersion(ctx, mci, metadata, digestFromHashSummer(buf))
 		if err != nil {
 			return err
 		}
@@ -217,7 +218,7 @@ func processOciImageIndex(ctx context.Context, mci *manifestCreationInfo, buf *p
 			})
 		}
 
-		pv, err := createPackageAndVersion(ctx, mci, metadata)
+		pv, err := createPackageAndVersion(ctx, mci, metadata, digestFromHashSummer(buf))
 		if err != nil {
 			return fmt.Errorf("createPackageAndVersion: %w", err)
 		}
@@ -230,7 +231,7 @@ func processOciImageIndex(ctx context.Context, mci *manifestCreationInfo, buf *p
 	return handleCreateManifestResult(ctx, err, mci, contentStore, &txRet)
 }
 
-func createPackageAndVersion(ctx context.Context, mci *manifestCreationInfo, metadata *container_module.Metadata) (*packages_model.PackageVersion, error) {
+func createPackageAndVersion(ctx context.Context, mci *manifestCreationInfo, metadata *container_module.Metadata, manifestDigest string) (*packages_model.PackageVersion, error) {
 	created := true
 	p := &packages_model.Package{
 		OwnerID:   mci.Owner.ID,
@@ -281,6 +282,18 @@ func createPackageAndVersion(ctx context.Context, mci *manifestCreationInfo, met
 			log.Error("Error GetOrInsertVersion (first try) package: %v", err)
 			return nil, fmt.Errorf("GetOrInsertVersion: first try: %w", err)
 		}
+		if err = packages_service.CheckPackageVersionNotProtected(ctx, pv); err != nil {
+			if !errors.Is(err, packages_service.ErrPackageVersionProtected) {
+				return nil, fmt.Errorf("CheckPackageVersionNotProtected: %w", err)
+			}
+			// a protected version can only be pushed again with the same manifest
+			if same, err := isSameManifest(ctx, pv, manifestDigest); err != nil {
+				return nil, fmt.Errorf("isSameManifest: %w", err)
+			} else if !same {
+				return nil, errDenied.WithMessage("Manifest is protected and can not be overwritten").WithStatusCode(http.StatusConflict)
+			}
+			return pv, nil
+		}
 		if err = packages_service.DeletePackageVersionAndReferences(ctx, pv); err != nil {
 			return nil, fmt.Errorf("DeletePackageVersionAndReferences: %w", err)
 		}
@@ -321,6 +334,19 @@ func createPackageAndVersion(ctx context.Context, mci *manifestCreationInfo, met
 	return pv, nil
 }
 
+func isSameManifest(ctx context.Context, pv *packages_model.PackageVersion, manifestDigest string) (bool, error) {
+	pfs, err := packages_model.GetFilesByVersionID(ctx, pv.ID)
+	if err != nil {
+		return false, err
+	}
+	for _, pf := range pfs {
+		if pf.IsLead {
+			return pf.CompositeKey == manifestDigest, nil
+		}
+	}
+	return false, nil
+}
+
 type blobReference struct {
 	Digest       digest.Digest
 	MediaType    string
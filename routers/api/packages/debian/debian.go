@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 
 	"gitea.dev/models/db"
@@ -50,6 +51,20 @@ func GetRepositoryFile(ctx *context.Context) {
 		return
 	}
 
+	serveRepositoryFile(ctx, pv)
+}
+
+// GetSnapshotRepositoryFile serves a repository file of a snapshot
+func GetSnapshotRepositoryFile(ctx *context.Context) {
+	pv := getSnapshotRepositoryVersion(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	serveRepositoryFile(ctx, pv)
+}
+
+func serveRepositoryFile(ctx *context.Context, pv *packages_model.PackageVersion) {
 	key := ctx.PathParam("distribution")
 
 	component := ctx.PathParam("component")
@@ -87,6 +102,20 @@ func GetRepositoryFileByHash(ctx *context.Context) {
 		return
 	}
 
+	serveRepositoryFileByHash(ctx, pv)
+}
+
+// GetSnapshotRepositoryFileByHash serves a repository file of a snapshot by its hash
+func GetSnapshotRepositoryFileByHash(ctx *context.Context) {
+	pv := getSnapshotRepositoryVersion(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	serveRepositoryFileByHash(ctx, pv)
+}
+
+func serveRepositoryFileByHash(ctx *context.Context, pv *packages_model.PackageVersion) {
 	algorithm := strings.ToLower(ctx.PathParam("algorithm"))
 	if algorithm == "md5sum" {
 		algorithm = "md5"
@@ -119,6 +148,45 @@ func GetRepositoryFileByHash(ctx *context.Context) {
 	helper.ServePackageFile(ctx, s, u, pf)
 }
 
+// getSnapshot gets the snapshot which matches the requested distribution and component
+func getSnapshot(ctx *context.Context) *packages_model.PackageSnapshot {
+	ps, err := packages_model.GetSnapshotByName(ctx, ctx.Package.Owner.ID, packages_model.TypeDebian, ctx.PathParam("snapshot"))
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			apiError(ctx, http.StatusNotFound, err)
+		} else {
+			apiError(ctx, http.StatusInternalServerError, err)
+		}
+		return nil
+	}
+	// a snapshot of a single component doesn't contain the other components of the distribution
+	component := ctx.PathParam("component")
+	if ps.Distribution != ctx.PathParam("distribution") || (ps.Component != "" && component != "" && ps.Component != component) {
+		apiError(ctx, http.StatusNotFound, packages_model.ErrPackageSnapshotNotExist)
+		return nil
+	}
+	return ps
+}
+
+// getSnapshotRepositoryVersion gets the internal package version of the snapshot which matches the requested distribution
+func getSnapshotRepositoryVersion(ctx *context.Context) *packages_model.PackageVersion {
+	ps := getSnapshot(ctx)
+	if ctx.Written() {
+		return nil
+	}
+
+	pv, err := debian_service.GetSnapshotRepositoryVersion(ctx, ps)
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			apiError(ctx, http.StatusNotFound, err)
+		} else {
+			apiError(ctx, http.StatusInternalServerError, err)
+		}
+		return nil
+	}
+	return pv
+}
+
 func UploadPackageFile(ctx *context.Context) {
 	distribution := ctx.PathParam("distribution")
 	component := ctx.PathParam("component")
@@ -207,23 +275,27 @@ func UploadPackageFile(ctx *context.Context) {
 }
 
 func DownloadPackageFile(ctx *context.Context) {
-	name := ctx.PathParam("name")
-	version := ctx.PathParam("version")
+	pf, err := getPackageFile(ctx)
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			apiError(ctx, http.StatusNotFound, err)
+		} else {
+			apiError(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
 
-	s, u, pf, err := packages_service.OpenFileForDownloadByPackageNameAndVersion(
-		ctx,
-		&packages_service.PackageInfo{
-			Owner:       ctx.Package.Owner,
-			PackageType: packages_model.TypeDebian,
-			Name:        name,
-			Version:     version,
-		},
-		&packages_service.PackageFileInfo{
-			Filename:     fmt.Sprintf("%s_%s_%s.deb", name, version, ctx.PathParam("architecture")),
-			CompositeKey: fmt.Sprintf("%s|%s", ctx.PathParam("distribution"), ctx.PathParam("component")),
-		},
-		ctx.Req.Method,
-	)
+	servePackageFile(ctx, pf)
+}
+
+// DownloadSnapshotPackageFile serves a package file referenced by the index of a snapshot
+func DownloadSnapshotPackageFile(ctx *context.Context) {
+	ps := getSnapshot(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	pf, err := getPackageFile(ctx)
 	if err != nil {
 		if errors.Is(err, util.ErrNotExist) {
 			apiError(ctx, http.StatusNotFound, err)
@@ -233,6 +305,45 @@ func DownloadPackageFile(ctx *context.Context) {
 		return
 	}
 
+	// the pool may contain files which were added after the snapshot was created
+	fileIDs, err := packages_model.GetSnapshotFileIDs(ctx, ps.ID)
+	if err != nil {
+		apiError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if !slices.Contains(fileIDs, pf.ID) {
+		apiError(ctx, http.StatusNotFound, packages_model.ErrPackageFileNotExist)
+		return
+	}
+
+	servePackageFile(ctx, pf)
+}
+
+// getPackageFile gets the package file of the requested distribution and component
+func getPackageFile(ctx *context.Context) (*packages_model.PackageFile, error) {
+	name := ctx.PathParam("name")
+	version := ctx.PathParam("version")
+
+	pv, err := packages_model.GetVersionByNameAndVersion(ctx, ctx.Package.Owner.ID, packages_model.TypeDebian, name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return packages_model.GetFileForVersionByName(
+		ctx,
+		pv.ID,
+		fmt.Sprintf("%s_%s_%s.deb", name, version, ctx.PathParam("architecture")),
+		fmt.Sprintf("%s|%s", ctx.PathParam("distribution"), ctx.PathParam("component")),
+	)
+}
+
+func servePackageFile(ctx *context.Context, pf *packages_model.PackageFile) {
+	s, u, pf, err := packages_service.OpenFileForDownload(ctx, pf, ctx.Req.Method)
+	if err != nil {
+		apiError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
 	helper.ServePackageFile(ctx, s, u, pf, context.ServeHeaderOptions{
 		ContentType:  "application/vnd.debian.binary-package",
 		Filename:     pf.Name,
@@ -267,6 +378,10 @@ func DeletePackageFile(ctx *context.Context) {
 			return err
 		}
 
+		if err := packages_service.CheckPackageVersionNotProtected(ctx, pv); err != nil {
+			return err
+		}
+
 		if err := packages_service.DeletePackageFile(ctx, pf); err != nil {
 			return err
 		}
@@ -291,6 +406,8 @@ func DeletePackageFile(ctx *context.Context) {
 	if err != nil {
 		if errors.Is(err, util.ErrNotExist) {
 			apiError(ctx, http.StatusNotFound, err)
+		} else if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+			apiError(ctx, http.StatusForbidden, err)
 		} else {
 			apiError(ctx, http.StatusInternalServerError, err)
 		}
@@ -365,6 +365,14 @@ func UploadPackageFile(ctx *context.Context) {
 				return
 			}
 			if pv != nil {
+				if err := packages_service.CheckPackageVersionNotProtected(ctx, pv); err != nil {
+					if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+						apiError(ctx, http.StatusConflict, err)
+					} else {
+						apiError(ctx, http.StatusInternalServerError, err)
+					}
+					return
+				}
 				raw, err := json.Marshal(pvci.Metadata)
 				if err != nil {
 					apiError(ctx, http.StatusInternalServerError, err)
@@ -391,7 +399,7 @@ func UploadPackageFile(ctx *context.Context) {
 	)
 	if err != nil {
 		switch err {
-		case packages_model.ErrDuplicatePackageFile:
+		case packages_model.ErrDuplicatePackageFile, packages_service.ErrPackageVersionProtected:
 			apiError(ctx, http.StatusConflict, err)
 		case packages_service.ErrQuotaTotalCount, packages_service.ErrQuotaTypeSize, packages_service.ErrQuotaTotalSize:
 			apiError(ctx, http.StatusForbidden, err)
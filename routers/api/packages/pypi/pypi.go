@@ -186,6 +186,7 @@ func UploadPackageFile(ctx *context.Context) {
 				ProjectURL:      homepageURL,
 				License:         ctx.Req.FormValue("license"),
 				RequiresPython:  ctx.Req.FormValue("requires_python"),
+				RequiresDist:    ctx.Req.Form["requires_dist"],
 			},
 		},
 		&packages_service.PackageFileCreationInfo{
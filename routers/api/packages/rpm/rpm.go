@@ -9,7 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -41,16 +41,43 @@ func GetRepositoryConfig(ctx *context.Context) {
 		groupParts = strings.Split(group, "/")
 	}
 
+	serveRepositoryConfig(ctx, groupParts, groupParts)
+}
+
+// GetSnapshotRepositoryConfig serves the repository config of a snapshot
+func GetSnapshotRepositoryConfig(ctx *context.Context) {
+	ps := getSnapshot(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	serveRepositoryConfig(ctx, []string{"snapshot", ps.LowerName}, []string{"-", "snapshots", ps.LowerName})
+}
+
+func serveRepositoryConfig(ctx *context.Context, nameParts, pathParts []string) {
 	url := fmt.Sprintf("%sapi/packages/%s/rpm", setting.AppURL, ctx.Package.Owner.Name)
 
-	ctx.PlainText(http.StatusOK, `[gitea-`+strings.Join(append([]string{ctx.Package.Owner.LowerName}, groupParts...), "-")+`]
-name=`+strings.Join(append([]string{ctx.Package.Owner.Name, setting.AppName}, groupParts...), " - ")+`
-baseurl=`+strings.Join(append([]string{url}, groupParts...), "/")+`
+	ctx.PlainText(http.StatusOK, `[gitea-`+strings.Join(append([]string{ctx.Package.Owner.LowerName}, nameParts...), "-")+`]
+name=`+strings.Join(append([]string{ctx.Package.Owner.Name, setting.AppName}, nameParts...), " - ")+`
+baseurl=`+strings.Join(append([]string{url}, pathParts...), "/")+`
 enabled=1
 gpgcheck=1
 gpgkey=`+url+`/repository.key`)
 }
 
+func getSnapshot(ctx *context.Context) *packages_model.PackageSnapshot {
+	ps, err := packages_model.GetSnapshotByName(ctx, ctx.Package.Owner.ID, packages_model.TypeRpm, ctx.PathParam("snapshot"))
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			apiError(ctx, http.StatusNotFound, err)
+		} else {
+			apiError(ctx, http.StatusInternalServerError, err)
+		}
+		return nil
+	}
+	return ps
+}
+
 // Gets or creates the PGP public key used to sign repository metadata files
 func GetRepositoryKey(ctx *context.Context) {
 	_, pub, err := rpm_service.GetOrCreateKeyPair(ctx, ctx.Package.Owner.ID)
@@ -97,12 +124,36 @@ func GetRepositoryFile(ctx *context.Context) {
 		return
 	}
 
+	serveRepositoryFile(ctx, pv, ctx.PathParam("group"))
+}
+
+// GetSnapshotRepositoryFile gets a repository metadata file of a snapshot
+func GetSnapshotRepositoryFile(ctx *context.Context) {
+	ps := getSnapshot(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	pv, err := rpm_service.GetSnapshotRepositoryVersion(ctx, ps)
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			apiError(ctx, http.StatusNotFound, err)
+		} else {
+			apiError(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	serveRepositoryFile(ctx, pv, ps.GroupName)
+}
+
+func serveRepositoryFile(ctx *context.Context, pv *packages_model.PackageVersion, group string) {
 	s, u, pf, err := packages_service.OpenFileForDownloadByPackageVersion(
 		ctx,
 		pv,
 		&packages_service.PackageFileInfo{
 			Filename:     ctx.PathParam("filename"),
-			CompositeKey: ctx.PathParam("group"),
+			CompositeKey: group,
 		},
 		ctx.Req.Method,
 	)
@@ -219,37 +270,71 @@ func UploadPackageFile(ctx *context.Context) {
 }
 
 func DownloadPackageFile(ctx *context.Context) {
+	pf, err := getPackageFile(ctx, ctx.PathParam("group"))
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			apiError(ctx, http.StatusNotFound, err)
+		} else {
+			apiError(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	servePackageFile(ctx, pf)
+}
+
+// DownloadSnapshotPackageFile serves a package file referenced by the metadata of a snapshot
+func DownloadSnapshotPackageFile(ctx *context.Context) {
+	ps := getSnapshot(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	pf, err := getPackageFile(ctx, ps.GroupName)
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			apiError(ctx, http.StatusNotFound, err)
+		} else {
+			apiError(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	// the group may contain files which were added after the snapshot was created
+	fileIDs, err := packages_model.GetSnapshotFileIDs(ctx, ps.ID)
+	if err != nil {
+		apiError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if !slices.Contains(fileIDs, pf.ID) {
+		apiError(ctx, http.StatusNotFound, packages_model.ErrPackageFileNotExist)
+		return
+	}
+
+	servePackageFile(ctx, pf)
+}
+
+// getPackageFile gets the package file of the group, the noarch file is used if there is none for the architecture
+func getPackageFile(ctx *context.Context, group string) (*packages_model.PackageFile, error) {
 	name := ctx.PathParam("name")
 	version := ctx.PathParam("version")
 	architecture := ctx.PathParam("architecture")
-	group := ctx.PathParam("group")
 
-	openForDownload := func(filename string) (io.ReadSeekCloser, *url.URL, *packages_model.PackageFile, error) {
-		return packages_service.OpenFileForDownloadByPackageNameAndVersion(
-			ctx,
-			&packages_service.PackageInfo{
-				Owner:       ctx.Package.Owner,
-				PackageType: packages_model.TypeRpm,
-				Name:        name,
-				Version:     version,
-			},
-			&packages_service.PackageFileInfo{
-				Filename:     filename,
-				CompositeKey: group,
-			},
-			ctx.Req.Method,
-		)
+	pv, err := packages_model.GetVersionByNameAndVersion(ctx, ctx.Package.Owner.ID, packages_model.TypeRpm, name, version)
+	if err != nil {
+		return nil, err
 	}
 
-	s, u, pf, err := openForDownload(fmt.Sprintf("%s-%s.%s.rpm", name, version, architecture))
+	pf, err := packages_model.GetFileForVersionByName(ctx, pv.ID, fmt.Sprintf("%s-%s.%s.rpm", name, version, architecture), group)
 	if errors.Is(err, util.ErrNotExist) && architecture != "noarch" {
-		s, u, pf, err = openForDownload(fmt.Sprintf("%s-%s.%s.rpm", name, version, "noarch"))
+		pf, err = packages_model.GetFileForVersionByName(ctx, pv.ID, fmt.Sprintf("%s-%s.%s.rpm", name, version, "noarch"), group)
 	}
+	return pf, err
+}
 
-	if errors.Is(err, util.ErrNotExist) {
-		apiError(ctx, http.StatusNotFound, err)
-		return
-	} else if err != nil {
+func servePackageFile(ctx *context.Context, pf *packages_model.PackageFile) {
+	s, u, pf, err := packages_service.OpenFileForDownload(ctx, pf, ctx.Req.Method)
+	if err != nil {
 		apiError(ctx, http.StatusInternalServerError, err)
 		return
 	}
@@ -285,6 +370,10 @@ func DeletePackageFile(webctx *context.Context) {
 			return err
 		}
 
+		if err := packages_service.CheckPackageVersionNotProtected(ctx, pv); err != nil {
+			return err
+		}
+
 		if err := packages_service.DeletePackageFile(ctx, pf); err != nil {
 			return err
 		}
@@ -309,6 +398,8 @@ func DeletePackageFile(webctx *context.Context) {
 	if err != nil {
 		if errors.Is(err, util.ErrNotExist) {
 			apiError(webctx, http.StatusNotFound, err)
+		} else if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+			apiError(webctx, http.StatusForbidden, err)
 		} else {
 			apiError(webctx, http.StatusInternalServerError, err)
 		}
@@ -0,0 +1,611 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package terraform
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+
+	packages_model "gitea.dev/models/packages"
+	"gitea.dev/modules/json"
+	packages_module "gitea.dev/modules/packages"
+	terraform_registry "gitea.dev/modules/packages/terraform/registry"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/util"
+	"gitea.dev/routers/api/packages/helper"
+	"gitea.dev/services/context"
+	packages_service "gitea.dev/services/packages"
+	terraform_service "gitea.dev/services/packages/terraform"
+
+	"github.com/hashicorp/go-version"
+)
+
+// The registry protocols are described here:
+// https://developer.hashicorp.com/terraform/internals/module-registry-protocol
+// https://developer.hashicorp.com/terraform/internals/provider-registry-protocol
+
+const (
+	shasumsFilename          = "SHA256SUMS"
+	shasumsSignatureFilename = "SHA256SUMS.sig"
+)
+
+// registryError writes an error in the format expected by the Terraform CLI
+func registryError(ctx *context.Context, status int, obj any) {
+	message := helper.ProcessErrorForUser(ctx, status, obj)
+	ctx.JSON(status, struct {
+		Errors []string `json:"errors"`
+	}{
+		Errors: []string{
+			message,
+		},
+	})
+}
+
+func baseURL(ctx *context.Context) string {
+	return fmt.Sprintf("%sapi/packages/%s/terraform", setting.AppURL, url.PathEscape(ctx.Package.Owner.Name))
+}
+
+func getModulePackageName(ctx *context.Context) (string, error) {
+	return terraform_registry.ModulePackageName(ctx.PathParam("name"), ctx.PathParam("system"))
+}
+
+func getProviderType(ctx *context.Context) (string, error) {
+	providerType := ctx.PathParam("provider")
+	if !terraform_registry.IsValidProviderType(providerType) {
+		return "", terraform_registry.ErrInvalidProviderType
+	}
+	return providerType, nil
+}
+
+func getSortedPackageDescriptors(ctx *context.Context, packageType packages_model.Type, packageName string) ([]*packages_model.PackageDescriptor, error) {
+	pvs, err := packages_model.GetVersionsByPackageName(ctx, ctx.Package.Owner.ID, packageType, packageName)
+	if err != nil {
+		return nil, err
+	}
+	if len(pvs) == 0 {
+		return nil, packages_model.ErrPackageNotExist
+	}
+
+	pds, err := packages_model.GetPackageDescriptors(ctx, pvs)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(pds, func(i, j int) bool {
+		return pds[i].SemVer.LessThan(pds[j].SemVer)
+	})
+	return pds, nil
+}
+
+type moduleVersion struct {
+	Version string `json:"version"`
+}
+
+type moduleVersions struct {
+	Versions []*moduleVersion `json:"versions"`
+}
+
+// ListModuleVersions lists the available versions of a module
+func ListModuleVersions(ctx *context.Context) {
+	packageName, err := getModulePackageName(ctx)
+	if err != nil {
+		registryError(ctx, http.StatusNotFound, err)
+		return
+	}
+
+	pds, err := getSortedPackageDescriptors(ctx, packages_model.TypeTerraformModule, packageName)
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			registryError(ctx, http.StatusNotFound, err)
+		} else {
+			registryError(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	versions := make([]*moduleVersion, 0, len(pds))
+	for _, pd := range pds {
+		versions = append(versions, &moduleVersion{Version: pd.Version.Version})
+	}
+
+	ctx.JSON(http.StatusOK, struct {
+		Modules []*moduleVersions `json:"modules"`
+	}{
+		Modules: []*moduleVersions{{Versions: versions}},
+	})
+}
+
+// GetModuleDownloadLocation points the Terraform CLI to the archive of the module version
+func GetModuleDownloadLocation(ctx *context.Context) {
+	packageName, err := getModulePackageName(ctx)
+	if err != nil {
+		registryError(ctx, http.StatusNotFound, err)
+		return
+	}
+
+	pv, err := packages_model.GetVersionByNameAndVersion(ctx, ctx.Package.Owner.ID, packages_model.TypeTerraformModule, packageName, ctx.PathParam("version"))
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			registryError(ctx, http.StatusNotFound, err)
+		} else {
+			registryError(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	// the archive query parameter tells go-getter how to unpack the module
+	ctx.Resp.Header().Set("X-Terraform-Get", fmt.Sprintf(
+		"%s/modules/%s/%s/%s?archive=tar.gz",
+		baseURL(ctx),
+		url.PathEscape(ctx.PathParam("name")),
+		url.PathEscape(ctx.PathParam("system")),
+		url.PathEscape(pv.Version),
+	))
+	ctx.Status(http.StatusNoContent)
+}
+
+// DownloadModule serves the archive of a module version
+func DownloadModule(ctx *context.Context) {
+	packageName, err := getModulePackageName(ctx)
+	if err != nil {
+		registryError(ctx, http.StatusNotFound, err)
+		return
+	}
+
+	packageVersion := ctx.PathParam("version")
+
+	s, u, pf, err := packages_service.OpenFileForDownloadByPackageNameAndVersion(
+		ctx,
+		&packages_service.PackageInfo{
+			Owner:       ctx.Package.Owner,
+			PackageType: packages_model.TypeTerraformModule,
+			Name:        packageName,
+			Version:     packageVersion,
+		},
+		&packages_service.PackageFileInfo{
+			Filename: terraform_registry.ModuleFilename(ctx.PathParam("name"), ctx.PathParam("system"), packageVersion),
+		},
+		ctx.Req.Method,
+	)
+	if err != nil {
+		if errors.Is(err, packages_model.ErrPackageNotExist) || errors.Is(err, packages_model.ErrPackageFileNotExist) {
+			registryError(ctx, http.StatusNotFound, err)
+			return
+		}
+		registryError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	helper.ServePackageFile(ctx, s, u, pf)
+}
+
+// UploadModule uploads the tar.gz archive of a module version
+func UploadModule(ctx *context.Context) {
+	packageName, err := getModulePackageName(ctx)
+	if err != nil {
+		registryError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	packageVersion := ctx.PathParam("version")
+	if _, err := version.NewSemver(packageVersion); err != nil {
+		registryError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	upload, needToClose, err := ctx.UploadStream()
+	if err != nil {
+		registryError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if needToClose {
+		defer upload.Close()
+	}
+
+	buf, err := packages_module.CreateHashedBufferFromReader(upload)
+	if err != nil {
+		registryError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	defer buf.Close()
+
+	metadata, err := terraform_registry.ParseModuleMetadata(buf)
+	if err != nil {
+		registryError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if _, err := buf.Seek(0, io.SeekStart); err != nil {
+		registryError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	_, _, err = packages_service.CreatePackageAndAddFile(
+		ctx,
+		&packages_service.PackageCreationInfo{
+			PackageInfo: packages_service.PackageInfo{
+				Owner:       ctx.Package.Owner,
+				PackageType: packages_model.TypeTerraformModule,
+				Name:        packageName,
+				Version:     packageVersion,
+			},
+			SemverCompatible: true,
+			Creator:          ctx.Doer,
+			Metadata:         metadata,
+		},
+		&packages_service.PackageFileCreationInfo{
+			PackageFileInfo: packages_service.PackageFileInfo{
+				Filename: terraform_registry.ModuleFilename(ctx.PathParam("name"), ctx.PathParam("system"), packageVersion),
+			},
+			Creator: ctx.Doer,
+			Data:    buf,
+			IsLead:  true,
+		},
+	)
+	if err != nil {
+		switch err {
+		case packages_model.ErrDuplicatePackageVersion:
+			registryError(ctx, http.StatusConflict, err)
+		case packages_service.ErrQuotaTotalCount, packages_service.ErrQuotaTypeSize, packages_service.ErrQuotaTotalSize:
+			registryError(ctx, http.StatusForbidden, err)
+		default:
+			registryError(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusCreated)
+}
+
+type providerPlatform struct {
+	OS   string `json:"os"`
+	Arch string `json:"arch"`
+}
+
+type providerVersion struct {
+	Version   string              `json:"version"`
+	Protocols []string            `json:"protocols"`
+	Platforms []*providerPlatform `json:"platforms"`
+}
+
+// ListProviderVersions lists the available versions and platforms of a provider
+func ListProviderVersions(ctx *context.Context) {
+	providerType, err := getProviderType(ctx)
+	if err != nil {
+		registryError(ctx, http.StatusNotFound, err)
+		return
+	}
+
+	pds, err := getSortedPackageDescriptors(ctx, packages_model.TypeTerraformProvider, providerType)
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			registryError(ctx, http.StatusNotFound, err)
+		} else {
+			registryError(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	versions := make([]*providerVersion, 0, len(pds))
+	for _, pd := range pds {
+		platforms := make([]*providerPlatform, 0, len(pd.Files))
+		for _, pfd := range pd.Files {
+			platforms = append(platforms, &providerPlatform{
+				OS:   pfd.Properties.GetByName(terraform_registry.PropertyProviderOS),
+				Arch: pfd.Properties.GetByName(terraform_registry.PropertyProviderArch),
+			})
+		}
+		versions = append(versions, &providerVersion{
+			Version:   pd.Version.Version,
+			Protocols: pd.Metadata.(*terraform_registry.ProviderMetadata).Protocols,
+			Platforms: platforms,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, struct {
+		Versions []*providerVersion `json:"versions"`
+	}{
+		Versions: versions,
+	})
+}
+
+type gpgPublicKey struct {
+	KeyID      string `json:"key_id"`
+	ASCIIArmor string `json:"ascii_armor"`
+}
+
+type providerDownload struct {
+	Protocols           []string `json:"protocols"`
+	OS                  string   `json:"os"`
+	Arch                string   `json:"arch"`
+	Filename            string   `json:"filename"`
+	DownloadURL         string   `json:"download_url"`
+	ShasumsURL          string   `json:"shasums_url"`
+	ShasumsSignatureURL string   `json:"shasums_signature_url"`
+	Shasum              string   `json:"shasum"`
+	SigningKeys         struct {
+		GPGPublicKeys []*gpgPublicKey `json:"gpg_public_keys"`
+	} `json:"signing_keys"`
+}
+
+// GetProviderDownloadLocation returns the download information of a provider package for a specific platform
+func GetProviderDownloadLocation(ctx *context.Context) {
+	providerType, err := getProviderType(ctx)
+	if err != nil {
+		registryError(ctx, http.StatusNotFound, err)
+		return
+	}
+
+	pd := getProviderVersionDescriptor(ctx, providerType)
+	if ctx.Written() {
+		return
+	}
+
+	os, arch := ctx.PathParam("os"), ctx.PathParam("arch")
+	filename := terraform_registry.ProviderFilename(providerType, pd.Version.Version, os, arch)
+
+	var pfd *packages_model.PackageFileDescriptor
+	for _, f := range pd.Files {
+		if f.File.LowerName == strings.ToLower(filename) {
+			pfd = f
+			break
+		}
+	}
+	if pfd == nil {
+		registryError(ctx, http.StatusNotFound, packages_model.ErrPackageFileNotExist)
+		return
+	}
+
+	keyID, publicKey, err := terraform_service.GetPublicKey(ctx, ctx.Package.Owner.ID)
+	if err != nil {
+		registryError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	versionURL := fmt.Sprintf("%s/providers/%s/%s", baseURL(ctx), url.PathEscape(providerType), url.PathEscape(pd.Version.Version))
+
+	resp := &providerDownload{
+		Protocols:           pd.Metadata.(*terraform_registry.ProviderMetadata).Protocols,
+		OS:                  os,
+		Arch:                arch,
+		Filename:            pfd.File.Name,
+		DownloadURL:         fmt.Sprintf("%s/%s/%s", versionURL, url.PathEscape(os), url.PathEscape(arch)),
+		ShasumsURL:          versionURL + "/" + shasumsFilename,
+		ShasumsSignatureURL: versionURL + "/" + shasumsSignatureFilename,
+		Shasum:              pfd.Blob.HashSHA256,
+	}
+	resp.SigningKeys.GPGPublicKeys = []*gpgPublicKey{{KeyID: keyID, ASCIIArmor: publicKey}}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func getProviderVersionDescriptor(ctx *context.Context, providerType string) *packages_model.PackageDescriptor {
+	pv, err := packages_model.GetVersionByNameAndVersion(ctx, ctx.Package.Owner.ID, packages_model.TypeTerraformProvider, providerType, ctx.PathParam("version"))
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			registryError(ctx, http.StatusNotFound, err)
+		} else {
+			registryError(ctx, http.StatusInternalServerError, err)
+		}
+		return nil
+	}
+
+	pd, err := packages_model.GetPackageDescriptor(ctx, pv)
+	if err != nil {
+		registryError(ctx, http.StatusInternalServerError, err)
+		return nil
+	}
+	return pd
+}
+
+// DownloadProvider serves the zip archive of a provider for a specific platform
+func DownloadProvider(ctx *context.Context) {
+	providerType, err := getProviderType(ctx)
+	if err != nil {
+		registryError(ctx, http.StatusNotFound, err)
+		return
+	}
+
+	packageVersion := ctx.PathParam("version")
+
+	s, u, pf, err := packages_service.OpenFileForDownloadByPackageNameAndVersion(
+		ctx,
+		&packages_service.PackageInfo{
+			Owner:       ctx.Package.Owner,
+			PackageType: packages_model.TypeTerraformProvider,
+			Name:        providerType,
+			Version:     packageVersion,
+		},
+		&packages_service.PackageFileInfo{
+			Filename: terraform_registry.ProviderFilename(providerType, packageVersion, ctx.PathParam("os"), ctx.PathParam("arch")),
+		},
+		ctx.Req.Method,
+	)
+	if err != nil {
+		if errors.Is(err, packages_model.ErrPackageNotExist) || errors.Is(err, packages_model.ErrPackageFileNotExist) {
+			registryError(ctx, http.StatusNotFound, err)
+			return
+		}
+		registryError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	helper.ServePackageFile(ctx, s, u, pf)
+}
+
+// GetProviderShasums serves the SHA256SUMS file of a provider version
+func GetProviderShasums(ctx *context.Context) {
+	serveProviderShasums(ctx, false)
+}
+
+// GetProviderShasumsSignature serves the detached signature of the SHA256SUMS file of a provider version
+func GetProviderShasumsSignature(ctx *context.Context) {
+	serveProviderShasums(ctx, true)
+}
+
+func serveProviderShasums(ctx *context.Context, signature bool) {
+	providerType, err := getProviderType(ctx)
+	if err != nil {
+		registryError(ctx, http.StatusNotFound, err)
+		return
+	}
+
+	pd := getProviderVersionDescriptor(ctx, providerType)
+	if ctx.Written() {
+		return
+	}
+
+	shasums, sig, err := terraform_service.BuildShasums(ctx, ctx.Package.Owner.ID, pd)
+	if err != nil {
+		registryError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	filename, content := shasumsFilename, shasums
+	if signature {
+		filename, content = shasumsSignatureFilename, sig
+	}
+
+	ctx.ServeContent(bytes.NewReader(content), context.ServeHeaderOptions{
+		Filename:     filename,
+		LastModified: pd.Version.CreatedUnix.AsLocalTime(),
+	})
+}
+
+// GetProviderPublicKey serves the public key used to sign the providers of the owner
+func GetProviderPublicKey(ctx *context.Context) {
+	_, publicKey, err := terraform_service.GetPublicKey(ctx, ctx.Package.Owner.ID)
+	if err != nil {
+		registryError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.ServeContent(bytes.NewReader([]byte(publicKey)), context.ServeHeaderOptions{
+		ContentType: "application/pgp-keys",
+		Filename:    "repository.key",
+	})
+}
+
+// UploadProvider uploads the zip archive of a provider for a specific platform.
+// The plugin protocol versions supported by the provider can be set with the protocols query parameter.
+func UploadProvider(ctx *context.Context) {
+	providerType, err := getProviderType(ctx)
+	if err != nil {
+		registryError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	packageVersion := ctx.PathParam("version")
+	if _, err := version.NewSemver(packageVersion); err != nil {
+		registryError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	os, arch := ctx.PathParam("os"), ctx.PathParam("arch")
+	if !terraform_registry.IsValidPlatform(os, arch) {
+		registryError(ctx, http.StatusBadRequest, terraform_registry.ErrInvalidPlatform)
+		return
+	}
+
+	protocols, err := terraform_registry.ParseProtocols(ctx.FormString("protocols"))
+	if err != nil {
+		registryError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	// the protocols are stored in the metadata of the version which is shared by all platforms,
+	// the upload of another platform can't change them
+	pv, err := packages_model.GetVersionByNameAndVersion(ctx, ctx.Package.Owner.ID, packages_model.TypeTerraformProvider, providerType, packageVersion)
+	if err == nil {
+		metadata := &terraform_registry.ProviderMetadata{}
+		if err := json.Unmarshal([]byte(pv.MetadataJSON), metadata); err != nil {
+			registryError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		if ctx.FormString("protocols") == "" {
+			protocols = metadata.Protocols
+		} else if !slices.Equal(slices.Sorted(slices.Values(protocols)), slices.Sorted(slices.Values(metadata.Protocols))) {
+			registryError(ctx, http.StatusBadRequest, fmt.Errorf("the protocols of the version are %s", strings.Join(metadata.Protocols, ",")))
+			return
+		}
+	} else if !errors.Is(err, util.ErrNotExist) {
+		registryError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	upload, needToClose, err := ctx.UploadStream()
+	if err != nil {
+		registryError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if needToClose {
+		defer upload.Close()
+	}
+
+	buf, err := packages_module.CreateHashedBufferFromReader(upload)
+	if err != nil {
+		registryError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	defer buf.Close()
+
+	if err := terraform_registry.ValidateProviderArchive(buf, buf.Size(), providerType, os, arch); err != nil {
+		registryError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if _, err := buf.Seek(0, io.SeekStart); err != nil {
+		registryError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	_, _, err = packages_service.CreatePackageOrAddFileToExisting(
+		ctx,
+		&packages_service.PackageCreationInfo{
+			PackageInfo: packages_service.PackageInfo{
+				Owner:       ctx.Package.Owner,
+				PackageType: packages_model.TypeTerraformProvider,
+				Name:        providerType,
+				Version:     packageVersion,
+			},
+			SemverCompatible: true,
+			Creator:          ctx.Doer,
+			Metadata: &terraform_registry.ProviderMetadata{
+				Protocols: protocols,
+			},
+		},
+		&packages_service.PackageFileCreationInfo{
+			PackageFileInfo: packages_service.PackageFileInfo{
+				Filename: terraform_registry.ProviderFilename(providerType, packageVersion, os, arch),
+			},
+			Creator: ctx.Doer,
+			Data:    buf,
+			IsLead:  true,
+			Properties: map[string]string{
+				terraform_registry.PropertyProviderOS:   os,
+				terraform_registry.PropertyProviderArch: arch,
+			},
+		},
+	)
+	if err != nil {
+		switch err {
+		case packages_model.ErrDuplicatePackageFile:
+			registryError(ctx, http.StatusConflict, err)
+		case packages_service.ErrQuotaTotalCount, packages_service.ErrQuotaTypeSize, packages_service.ErrQuotaTotalSize:
+			registryError(ctx, http.StatusForbidden, err)
+		default:
+			registryError(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusCreated)
+}
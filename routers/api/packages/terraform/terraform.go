@@ -220,6 +220,15 @@ func DeleteStateBySerial(ctx *context.Context) {
 		return
 	}
 
+	if err := packages_service.CheckPackageVersionNotProtected(ctx, pv); err != nil {
+		if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+			apiError(ctx, http.StatusForbidden, err)
+		} else {
+			apiError(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
 	err = packages_service.DeletePackageVersionAndReferences(ctx, pv)
 	if err != nil {
 		apiError(ctx, http.StatusInternalServerError, err)
@@ -270,6 +279,15 @@ func DeleteState(ctx *context.Context) {
 		return
 	}
 
+	if err := packages_service.CheckPackageVersionsNotProtected(ctx, pvs); err != nil {
+		if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+			apiError(ctx, http.StatusForbidden, err)
+		} else {
+			apiError(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
 	err = packages_model.DeleteAllProperties(ctx, packages_model.PropertyTypePackage, p.ID)
 	if err != nil {
 		apiError(ctx, http.StatusInternalServerError, err)
@@ -278,7 +296,11 @@ func DeleteState(ctx *context.Context) {
 
 	for _, pv := range pvs {
 		if err := packages_service.RemovePackageVersion(ctx, ctx.Doer, pv); err != nil {
-			apiError(ctx, http.StatusInternalServerError, err)
+			if errors.Is(err, packages_service.ErrPackageVersionProtected) {
+				apiError(ctx, http.StatusForbidden, err)
+			} else {
+				apiError(ctx, http.StatusInternalServerError, err)
+			}
 			return
 		}
 	}
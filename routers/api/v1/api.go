@@ -521,6 +521,28 @@ func reqOrgVisible() func(ctx *context.APIContext) {
 	}
 }
 
+// reqOwnerProjectsAccess requires the doer to have the access mode to the projects of the context user or organization
+func reqOwnerProjectsAccess(accessMode perm.AccessMode) func(ctx *context.APIContext) {
+	return func(ctx *context.APIContext) {
+		if unit.TypeProjects.UnitGlobalDisabled() {
+			ctx.APIErrorNotFound()
+			return
+		}
+		if ctx.ContextUser.IsOrganization() {
+			if organization.OrgFromUser(ctx.ContextUser).UnitPermission(ctx, ctx.Doer, unit.TypeProjects) >= accessMode {
+				return
+			}
+		} else if accessMode <= perm.AccessModeRead || (ctx.Doer != nil && (ctx.Doer.ID == ctx.ContextUser.ID || ctx.Doer.IsAdmin)) {
+			return
+		}
+		if accessMode > perm.AccessModeRead {
+			ctx.APIError(http.StatusForbidden, "user should have a permission to write to the projects")
+		} else {
+			ctx.APIErrorNotFound()
+		}
+	}
+}
+
 func teamAccessPrivileged(ctx *context.APIContext) (orgID int64, privileged, ok bool) {
 	if ctx.IsUserSiteAdmin() {
 		return 0, true, true
@@ -1082,6 +1104,34 @@ func Routes() *web.Router {
 				}, reqSelfOrAdmin(), reqBasicOrRevProxyAuth())
 
 				m.Get("/activities/feeds", user.ListUserActivityFeeds)
+
+				m.Group("/projects", func() {
+					m.Combo("").Get(user.ListProjects).
+						Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.CreateProjectOption{}), user.CreateProject)
+					m.Group("/{id}", func() {
+						m.Combo("").Get(user.GetProject).
+							Patch(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.EditProjectOption{}), user.EditProject).
+							Delete(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), user.DeleteProject)
+						m.Group("/columns", func() {
+							m.Combo("").Get(user.ListProjectColumns).
+								Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.CreateProjectColumnOption{}), user.CreateProjectColumn)
+							m.Combo("/{column_id}").Get(user.GetProjectColumn).
+								Patch(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.EditProjectColumnOption{}), user.EditProjectColumn).
+								Delete(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), user.DeleteProjectColumn)
+							m.Get("/{column_id}/items", user.ListProjectColumnItems)
+						})
+						m.Combo("/items").Get(user.ListProjectItems).
+							Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.AddProjectItemOption{}), user.AddProjectItem)
+						m.Delete("/items/{issue_id}", reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), user.RemoveProjectItem)
+						m.Group("/views", func() {
+							m.Combo("").Get(user.ListProjectViews).
+								Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.CreateProjectViewOption{}), user.CreateProjectView)
+							m.Combo("/{view_id}").Get(user.GetProjectView).
+								Patch(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.EditProjectViewOption{}), user.EditProjectView).
+								Delete(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), user.DeleteProjectView)
+						})
+					})
+				}, reqOwnerProjectsAccess(perm.AccessModeRead))
 			}, context.UserAssignmentAPI(), checkTokenPublicOnly(), individualPermsChecker)
 		}, tokenRequiresScopes(auth_model.AccessTokenScopeCategoryUser))
 
@@ -1214,6 +1264,17 @@ func Routes() *web.Router {
 				m.Delete("", user.DeleteAvatar)
 			}, rejectPublicOnly())
 
+			m.Group("/saved_searches", func() {
+				m.Combo("").Get(user.ListSavedSearches).
+					Post(bind(api.CreateSavedSearchOption{}), user.CreateSavedSearch)
+				m.Combo("/{id}").Get(user.GetSavedSearch).
+					Patch(bind(api.EditSavedSearchOption{}), user.EditSavedSearch).
+					Delete(user.DeleteSavedSearch)
+				m.Combo("/{id}/subscription").
+					Put(user.SubscribeSavedSearch).
+					Delete(user.UnsubscribeSavedSearch)
+			}, rejectPublicOnly())
+
 			m.Group("/blocks", func() {
 				m.Get("", user.ListBlocks)
 				m.Group("/{username}", func() {
@@ -1476,6 +1537,8 @@ func Routes() *web.Router {
 					m.Combo("/{sha}").Get(repo.GetCommitStatuses).
 						Post(reqToken(), reqRepoWriter(unit.TypeCode), bind(api.CreateStatusOption{}), repo.NewCommitStatus)
 				}, reqRepoReader(unit.TypeCode))
+				m.Put("/code-navigation/{sha}/lsif", reqToken(), reqRepoWriter(unit.TypeCode), context.ReferencesGitRepo(true), repo.UploadCodeNavigationLSIF)
+				m.Put("/code-navigation/{sha}/scip", reqToken(), reqRepoWriter(unit.TypeCode), context.ReferencesGitRepo(true), repo.UploadCodeNavigationSCIP)
 				m.Group("/commits", func() {
 					m.Get("", context.ReferencesGitRepo(), repo.GetAllCommits)
 					m.PathGroup("/*", func(g *web.RouterPathGroup) {
@@ -1647,6 +1710,19 @@ func Routes() *web.Router {
 							Get(repo.GetIssueDependencies).
 							Post(reqToken(), mustNotBeArchived, bind(api.IssueMeta{}), repo.CreateIssueDependency).
 							Delete(reqToken(), mustNotBeArchived, bind(api.IssueMeta{}), repo.RemoveIssueDependency)
+						m.Group("/sub_issues", func() {
+							m.Combo("").
+								Get(repo.ListSubIssues).
+								Post(reqToken(), mustNotBeArchived, bind(api.AddSubIssueOption{}), repo.AddSubIssue).
+								Delete(reqToken(), mustNotBeArchived, bind(api.IssueMeta{}), repo.RemoveSubIssue)
+							m.Put("/order", reqToken(), mustNotBeArchived, bind(api.ReorderSubIssuesOption{}), repo.ReorderSubIssues)
+							m.Get("/progress", repo.GetSubIssueProgress)
+						})
+						m.Get("/parent", repo.GetParentIssue)
+						m.Combo("/custom_fields").
+							Get(repo.ListIssueCustomFields).
+							Put(reqToken(), mustNotBeArchived, bind(api.SetIssueCustomFieldsOption{}), repo.SetIssueCustomFields)
+						m.Get("/sla", repo.GetIssueSLA)
 						m.Combo("/blocks").
 							Get(repo.GetIssueBlocks).
 							Post(reqToken(), bind(api.IssueMeta{}), repo.CreateIssueBlocking).
@@ -1671,6 +1747,31 @@ func Routes() *web.Router {
 						Patch(reqToken(), reqRepoWriter(unit.TypeIssues, unit.TypePullRequests), bind(api.EditLabelOption{}), repo.EditLabel).
 						Delete(reqToken(), reqRepoWriter(unit.TypeIssues, unit.TypePullRequests), repo.DeleteLabel)
 				})
+				m.Group("/custom_fields", func() {
+					m.Combo("").Get(repo.ListCustomFields).
+						Post(reqToken(), reqRepoWriter(unit.TypeIssues, unit.TypePullRequests), bind(api.CreateCustomFieldOption{}), repo.CreateCustomField)
+					m.Combo("/{id}").Get(repo.GetCustomField).
+						Patch(reqToken(), reqRepoWriter(unit.TypeIssues, unit.TypePullRequests), bind(api.EditCustomFieldOption{}), repo.EditCustomField).
+						Delete(reqToken(), reqRepoWriter(unit.TypeIssues, unit.TypePullRequests), repo.DeleteCustomField)
+				})
+				m.Group("/sla_policies", func() {
+					m.Combo("").Get(repo.ListSLAPolicies).
+						Post(reqToken(), reqRepoWriter(unit.TypeIssues, unit.TypePullRequests), bind(api.CreateSLAPolicyOption{}), repo.CreateSLAPolicy)
+					m.Combo("/{id}").Get(repo.GetSLAPolicy).
+						Patch(reqToken(), reqRepoWriter(unit.TypeIssues, unit.TypePullRequests), bind(api.EditSLAPolicyOption{}), repo.EditSLAPolicy).
+						Delete(reqToken(), reqRepoWriter(unit.TypeIssues, unit.TypePullRequests), repo.DeleteSLAPolicy)
+				})
+				m.Get("/sla_breaches", mustEnableIssuesOrPulls, repo.ListSLABreaches)
+				m.Group("/automation/rules", func() {
+					m.Combo("").Get(repo.ListAutomationRules).
+						Post(mustNotBeArchived, bind(api.CreateAutomationRuleOption{}), repo.CreateAutomationRule)
+					m.Group("/{id}", func() {
+						m.Combo("").Get(repo.GetAutomationRule).
+							Patch(mustNotBeArchived, bind(api.EditAutomationRuleOption{}), repo.EditAutomationRule).
+							Delete(repo.DeleteAutomationRule)
+						m.Get("/logs", repo.ListAutomationRuleLogs)
+					})
+				}, reqToken(), reqAdmin())
 				m.Group("/milestones", func() {
 					m.Combo("").Get(repo.ListMilestones).
 						Post(reqToken(), reqRepoWriter(unit.TypeIssues, unit.TypePullRequests), bind(api.CreateMilestoneOption{}), repo.CreateMilestone)
@@ -1678,6 +1779,33 @@ func Routes() *web.Router {
 						Patch(reqToken(), reqRepoWriter(unit.TypeIssues, unit.TypePullRequests), bind(api.EditMilestoneOption{}), repo.EditMilestone).
 						Delete(reqToken(), reqRepoWriter(unit.TypeIssues, unit.TypePullRequests), repo.DeleteMilestone)
 				})
+				m.Group("/projects", func() {
+					m.Combo("").Get(repo.ListProjects).
+						Post(reqToken(), reqRepoWriter(unit.TypeProjects), mustNotBeArchived, bind(api.CreateProjectOption{}), repo.CreateProject)
+					m.Group("/{id}", func() {
+						m.Combo("").Get(repo.GetProject).
+							Patch(reqToken(), reqRepoWriter(unit.TypeProjects), mustNotBeArchived, bind(api.EditProjectOption{}), repo.EditProject).
+							Delete(reqToken(), reqRepoWriter(unit.TypeProjects), mustNotBeArchived, repo.DeleteProject)
+						m.Group("/columns", func() {
+							m.Combo("").Get(repo.ListProjectColumns).
+								Post(reqToken(), reqRepoWriter(unit.TypeProjects), mustNotBeArchived, bind(api.CreateProjectColumnOption{}), repo.CreateProjectColumn)
+							m.Combo("/{column_id}").Get(repo.GetProjectColumn).
+								Patch(reqToken(), reqRepoWriter(unit.TypeProjects), mustNotBeArchived, bind(api.EditProjectColumnOption{}), repo.EditProjectColumn).
+								Delete(reqToken(), reqRepoWriter(unit.TypeProjects), mustNotBeArchived, repo.DeleteProjectColumn)
+							m.Get("/{column_id}/items", repo.ListProjectColumnItems)
+						})
+						m.Combo("/items").Get(repo.ListProjectItems).
+							Post(reqToken(), reqRepoWriter(unit.TypeProjects), mustNotBeArchived, bind(api.AddProjectItemOption{}), repo.AddProjectItem)
+						m.Delete("/items/{issue_id}", reqToken(), reqRepoWriter(unit.TypeProjects), mustNotBeArchived, repo.RemoveProjectItem)
+						m.Group("/views", func() {
+							m.Combo("").Get(repo.ListProjectViews).
+								Post(reqToken(), reqRepoWriter(unit.TypeProjects), mustNotBeArchived, bind(api.CreateProjectViewOption{}), repo.CreateProjectView)
+							m.Combo("/{view_id}").Get(repo.GetProjectView).
+								Patch(reqToken(), reqRepoWriter(unit.TypeProjects), mustNotBeArchived, bind(api.EditProjectViewOption{}), repo.EditProjectView).
+								Delete(reqToken(), reqRepoWriter(unit.TypeProjects), mustNotBeArchived, repo.DeleteProjectView)
+						})
+					})
+				}, reqRepoReader(unit.TypeProjects))
 			}, repoAssignment(), checkTokenPublicOnly())
 		}, tokenRequiresScopes(auth_model.AccessTokenScopeCategoryIssue))
 
@@ -1691,6 +1819,9 @@ func Routes() *web.Router {
 					m.Get("", packages.GetPackage)
 					m.Delete("", reqPackageAccess(perm.AccessModeWrite), packages.DeletePackageVersion)
 					m.Get("/files", packages.ListPackageFiles)
+					m.Get("/downloads", packages.ListPackageVersionDownloads)
+					m.Get("/dependencies", packages.ListPackageDependencies)
+					m.Get("/vulnerabilities", packages.ListPackageVulnerabilities)
 				})
 
 				m.Group("/-", func() {
@@ -1700,6 +1831,21 @@ func Routes() *web.Router {
 				})
 			})
 
+			m.Group("/-", func() {
+				m.Combo("/protection_rules").Get(packages.ListPackageProtectionRules).
+					Post(reqPackageAccess(perm.AccessModeAdmin), bind(api.CreatePackageProtectionRuleOption{}), packages.CreatePackageProtectionRule)
+				m.Combo("/protection_rules/{id}").Get(packages.GetPackageProtectionRule).
+					Patch(reqPackageAccess(perm.AccessModeAdmin), bind(api.EditPackageProtectionRuleOption{}), packages.EditPackageProtectionRule).
+					Delete(reqPackageAccess(perm.AccessModeAdmin), packages.DeletePackageProtectionRule)
+				m.Get("/cleanup_preview", reqPackageAccess(perm.AccessModeWrite), packages.PreviewPackageCleanup)
+				m.Get("/audit_events", reqPackageAccess(perm.AccessModeWrite), packages.ListPackageAuditEvents)
+				m.Combo("/snapshots").Get(packages.ListPackageSnapshots).
+					Post(reqPackageAccess(perm.AccessModeWrite), bind(api.CreatePackageSnapshotOption{}), packages.CreatePackageSnapshot)
+				m.Combo("/snapshots/{id}").Get(packages.GetPackageSnapshot).
+					Delete(reqPackageAccess(perm.AccessModeWrite), packages.DeletePackageSnapshot)
+				m.Post("/promote", reqPackageAccess(perm.AccessModeWrite), bind(api.PromotePackagesOption{}), packages.PromotePackages)
+			})
+
 			m.Get("/", packages.ListPackages)
 		}, reqToken(), tokenRequiresScopes(auth_model.AccessTokenScopeCategoryPackage), context.UserAssignmentAPI(), context.PackageAssignmentAPI(), reqPackageAccess(perm.AccessModeRead), checkTokenPublicOnly())
 
@@ -1748,6 +1894,54 @@ func Routes() *web.Router {
 					Patch(reqToken(), reqOrgOwnership(), bind(api.EditLabelOption{}), org.EditLabel).
 					Delete(reqToken(), reqOrgOwnership(), org.DeleteLabel)
 			}, reqOrgVisible())
+			m.Group("/custom_fields", func() {
+				m.Get("", org.ListCustomFields)
+				m.Post("", reqToken(), reqOrgOwnership(), bind(api.CreateCustomFieldOption{}), org.CreateCustomField)
+				m.Combo("/{id}").Get(org.GetCustomField).
+					Patch(reqToken(), reqOrgOwnership(), bind(api.EditCustomFieldOption{}), org.EditCustomField).
+					Delete(reqToken(), reqOrgOwnership(), org.DeleteCustomField)
+			}, reqOrgVisible())
+			m.Group("/sla_policies", func() {
+				m.Get("", org.ListSLAPolicies)
+				m.Post("", reqToken(), reqOrgOwnership(), bind(api.CreateSLAPolicyOption{}), org.CreateSLAPolicy)
+				m.Combo("/{id}").Get(org.GetSLAPolicy).
+					Patch(reqToken(), reqOrgOwnership(), bind(api.EditSLAPolicyOption{}), org.EditSLAPolicy).
+					Delete(reqToken(), reqOrgOwnership(), org.DeleteSLAPolicy)
+			}, reqOrgVisible())
+			m.Group("/issue_types", func() {
+				m.Get("", org.ListIssueTypes)
+				m.Post("", reqToken(), reqOrgOwnership(), bind(api.CreateIssueTypeOption{}), org.CreateIssueType)
+				m.Combo("/{id}").Get(org.GetIssueType).
+					Patch(reqToken(), reqOrgOwnership(), bind(api.EditIssueTypeOption{}), org.EditIssueType).
+					Delete(reqToken(), reqOrgOwnership(), org.DeleteIssueType)
+			}, reqOrgVisible())
+			m.Group("/projects", func() {
+				m.Combo("").Get(org.ListProjects).
+					Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.CreateProjectOption{}), org.CreateProject)
+				m.Group("/{id}", func() {
+					m.Combo("").Get(org.GetProject).
+						Patch(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.EditProjectOption{}), org.EditProject).
+						Delete(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), org.DeleteProject)
+					m.Group("/columns", func() {
+						m.Combo("").Get(org.ListProjectColumns).
+							Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.CreateProjectColumnOption{}), org.CreateProjectColumn)
+						m.Combo("/{column_id}").Get(org.GetProjectColumn).
+							Patch(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.EditProjectColumnOption{}), org.EditProjectColumn).
+							Delete(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), org.DeleteProjectColumn)
+						m.Get("/{column_id}/items", org.ListProjectColumnItems)
+					})
+					m.Combo("/items").Get(org.ListProjectItems).
+						Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.AddProjectItemOption{}), org.AddProjectItem)
+					m.Delete("/items/{issue_id}", reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), org.RemoveProjectItem)
+					m.Group("/views", func() {
+						m.Combo("").Get(org.ListProjectViews).
+							Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.CreateProjectViewOption{}), org.CreateProjectView)
+						m.Combo("/{view_id}").Get(org.GetProjectView).
+							Patch(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.EditProjectViewOption{}), org.EditProjectView).
+							Delete(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), org.DeleteProjectView)
+					})
+				})
+			}, reqOrgVisible(), reqOwnerProjectsAccess(perm.AccessModeRead))
 			m.Group("/hooks", func() {
 				m.Combo("").Get(org.ListHooks).
 					Post(bind(api.CreateHookOption{}), org.CreateHook)
@@ -1760,6 +1954,16 @@ func Routes() *web.Router {
 				m.Delete("", org.DeleteAvatar)
 			}, reqToken(), reqOrgOwnership())
 			m.Get("/activities/feeds", org.ListOrgActivityFeeds)
+			m.Group("/saved_searches", func() {
+				m.Combo("").Get(org.ListSavedSearches).
+					Post(reqOrgOwnership(), bind(api.CreateSavedSearchOption{}), org.CreateSavedSearch)
+				m.Combo("/{id}").Get(org.GetSavedSearch).
+					Patch(reqOrgOwnership(), bind(api.EditSavedSearchOption{}), org.EditSavedSearch).
+					Delete(reqOrgOwnership(), org.DeleteSavedSearch)
+				m.Combo("/{id}/subscription").
+					Put(org.SubscribeSavedSearch).
+					Delete(org.UnsubscribeSavedSearch)
+			}, reqToken(), reqOrgMembership())
 
 			m.Group("/blocks", func() {
 				m.Get("", org.ListBlocks)
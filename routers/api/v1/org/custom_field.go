@@ -0,0 +1,159 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package org
+
+import (
+	"gitea.dev/routers/api/v1/shared"
+	"gitea.dev/services/context"
+)
+
+// ListCustomFields lists the custom fields of an organization
+func ListCustomFields(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/custom_fields organization orgListCustomFields
+	// ---
+	// summary: List the custom fields of an organization
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/CustomFieldList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListCustomFields(ctx, ctx.Org.Organization.ID, 0)
+}
+
+// CreateCustomField creates a custom field for an organization
+func CreateCustomField(ctx *context.APIContext) {
+	// swagger:operation POST /orgs/{org}/custom_fields organization orgCreateCustomField
+	// ---
+	// summary: Create a custom field for an organization
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateCustomFieldOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/CustomField"
+	//   "400":
+	//     "$ref": "#/responses/error"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "409":
+	//     "$ref": "#/responses/conflict"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateCustomField(ctx, ctx.Org.Organization.ID, 0)
+}
+
+// GetCustomField gets a custom field of an organization
+func GetCustomField(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/custom_fields/{id} organization orgGetCustomField
+	// ---
+	// summary: Get a custom field
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the custom field
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/CustomField"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.GetCustomField(ctx, ctx.Org.Organization.ID, 0)
+}
+
+// EditCustomField updates a custom field of an organization
+func EditCustomField(ctx *context.APIContext) {
+	// swagger:operation PATCH /orgs/{org}/custom_fields/{id} organization orgEditCustomField
+	// ---
+	// summary: Update a custom field
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the custom field
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditCustomFieldOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/CustomField"
+	//   "400":
+	//     "$ref": "#/responses/error"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "409":
+	//     "$ref": "#/responses/conflict"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditCustomField(ctx, ctx.Org.Organization.ID, 0)
+}
+
+// DeleteCustomField deletes a custom field of an organization
+func DeleteCustomField(ctx *context.APIContext) {
+	// swagger:operation DELETE /orgs/{org}/custom_fields/{id} organization orgDeleteCustomField
+	// ---
+	// summary: Delete a custom field and unset it on all issues
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the custom field
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.DeleteCustomField(ctx, ctx.Org.Organization.ID, 0)
+}
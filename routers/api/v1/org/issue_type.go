@@ -0,0 +1,233 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package org
+
+import (
+	"net/http"
+
+	issues_model "gitea.dev/models/issues"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/web"
+	"gitea.dev/services/context"
+	"gitea.dev/services/convert"
+	issue_service "gitea.dev/services/issue"
+)
+
+// ListIssueTypes lists the issue types of an organization
+func ListIssueTypes(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/issue_types organization orgListIssueTypes
+	// ---
+	// summary: List the issue types of an organization
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueTypeList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	types, err := issues_model.GetIssueTypesByOrgID(ctx, ctx.Org.Organization.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.SetTotalCountHeader(int64(len(types)))
+	ctx.JSON(http.StatusOK, convert.ToIssueTypeList(types))
+}
+
+// CreateIssueType creates an issue type for an organization
+func CreateIssueType(ctx *context.APIContext) {
+	// swagger:operation POST /orgs/{org}/issue_types organization orgCreateIssueType
+	// ---
+	// summary: Create an issue type for an organization
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateIssueTypeOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/IssueType"
+	//   "400":
+	//     "$ref": "#/responses/error"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "409":
+	//     "$ref": "#/responses/conflict"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.CreateIssueTypeOption)
+	t := &issues_model.IssueType{
+		OrgID:       ctx.Org.Organization.ID,
+		Name:        form.Name,
+		Description: form.Description,
+		Color:       form.Color,
+		Icon:        form.Icon,
+		Sort:        form.Sort,
+	}
+	if err := issues_model.NewIssueType(ctx, t); err != nil {
+		ctx.APIErrorAuto(err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, convert.ToIssueType(t))
+}
+
+func getOrgIssueType(ctx *context.APIContext) *issues_model.IssueType {
+	t, err := issues_model.GetIssueTypeByID(ctx, ctx.PathParamInt64("id"))
+	if err == nil && t.OrgID != ctx.Org.Organization.ID {
+		err = issues_model.ErrIssueTypeNotExist{ID: t.ID}
+	}
+	if err != nil {
+		ctx.APIErrorAuto(err)
+		return nil
+	}
+	return t
+}
+
+// GetIssueType gets an issue type of an organization
+func GetIssueType(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/issue_types/{id} organization orgGetIssueType
+	// ---
+	// summary: Get an issue type
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the issue type
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueType"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	t := getOrgIssueType(ctx)
+	if ctx.Written() {
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToIssueType(t))
+}
+
+// EditIssueType updates an issue type of an organization
+func EditIssueType(ctx *context.APIContext) {
+	// swagger:operation PATCH /orgs/{org}/issue_types/{id} organization orgEditIssueType
+	// ---
+	// summary: Update an issue type
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the issue type
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditIssueTypeOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueType"
+	//   "400":
+	//     "$ref": "#/responses/error"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "409":
+	//     "$ref": "#/responses/conflict"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.EditIssueTypeOption)
+	t := getOrgIssueType(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	if form.Name != nil {
+		t.Name = *form.Name
+	}
+	if form.Description != nil {
+		t.Description = *form.Description
+	}
+	if form.Color != nil {
+		t.Color = *form.Color
+	}
+	if form.Icon != nil {
+		t.Icon = *form.Icon
+	}
+	if form.Sort != nil {
+		t.Sort = *form.Sort
+	}
+	if err := issues_model.UpdateIssueType(ctx, t); err != nil {
+		ctx.APIErrorAuto(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToIssueType(t))
+}
+
+// DeleteIssueType deletes an issue type of an organization
+func DeleteIssueType(ctx *context.APIContext) {
+	// swagger:operation DELETE /orgs/{org}/issue_types/{id} organization orgDeleteIssueType
+	// ---
+	// summary: Delete an issue type and unset it on all issues
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the issue type
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	t := getOrgIssueType(ctx)
+	if ctx.Written() {
+		return
+	}
+	if err := issue_service.DeleteIssueType(ctx, t); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,681 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package org
+
+import (
+	"gitea.dev/routers/api/v1/shared"
+	"gitea.dev/services/context"
+)
+
+// ListProjects lists the projects of the organization
+func ListProjects(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/projects project orgListProjects
+	// ---
+	// summary: List the projects of the organization
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: state
+	//   in: query
+	//   description: state of the projects, open by default
+	//   type: string
+	//   enum: [open, closed, all]
+	// - name: q
+	//   in: query
+	//   description: search the projects by title
+	//   type: string
+	// - name: sort
+	//   in: query
+	//   description: sort order of the projects, newest by default
+	//   type: string
+	//   enum: [newest, oldest, recentupdate, leastupdate, alphabetically, reversealphabetically]
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListProjects(ctx, ctx.ContextUser.ID, 0)
+}
+
+// CreateProject creates a project of the organization
+func CreateProject(ctx *context.APIContext) {
+	// swagger:operation POST /orgs/{org}/projects project orgCreateProject
+	// ---
+	// summary: Create a project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateProjectOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/Project"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateProject(ctx, ctx.ContextUser.ID, 0)
+}
+
+// GetProject gets an organization project
+func GetProject(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/projects/{id} project orgGetProject
+	// ---
+	// summary: Get a project
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/Project"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.GetProject(ctx, ctx.ContextUser.ID, 0)
+}
+
+// EditProject updates an organization project
+func EditProject(ctx *context.APIContext) {
+	// swagger:operation PATCH /orgs/{org}/projects/{id} project orgEditProject
+	// ---
+	// summary: Update a project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditProjectOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/Project"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditProject(ctx, ctx.ContextUser.ID, 0)
+}
+
+// DeleteProject deletes an organization project
+func DeleteProject(ctx *context.APIContext) {
+	// swagger:operation DELETE /orgs/{org}/projects/{id} project orgDeleteProject
+	// ---
+	// summary: Delete a project
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.DeleteProject(ctx, ctx.ContextUser.ID, 0)
+}
+
+// ListProjectColumns lists the columns of an organization project
+func ListProjectColumns(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/projects/{id}/columns project orgListProjectColumns
+	// ---
+	// summary: List the columns of the board of a project
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectColumnList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListProjectColumns(ctx, ctx.ContextUser.ID, 0)
+}
+
+// CreateProjectColumn adds a column to an organization project
+func CreateProjectColumn(ctx *context.APIContext) {
+	// swagger:operation POST /orgs/{org}/projects/{id}/columns project orgCreateProjectColumn
+	// ---
+	// summary: Add a column to the board of a project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateProjectColumnOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/ProjectColumn"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateProjectColumn(ctx, ctx.ContextUser.ID, 0)
+}
+
+// GetProjectColumn gets a column of an organization project
+func GetProjectColumn(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/projects/{id}/columns/{column_id} project orgGetProjectColumn
+	// ---
+	// summary: Get a column of the board of a project
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: column_id
+	//   in: path
+	//   description: id of the column
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectColumn"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.GetProjectColumn(ctx, ctx.ContextUser.ID, 0)
+}
+
+// EditProjectColumn updates a column of an organization project
+func EditProjectColumn(ctx *context.APIContext) {
+	// swagger:operation PATCH /orgs/{org}/projects/{id}/columns/{column_id} project orgEditProjectColumn
+	// ---
+	// summary: Update a column of the board of a project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: column_id
+	//   in: path
+	//   description: id of the column
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditProjectColumnOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectColumn"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditProjectColumn(ctx, ctx.ContextUser.ID, 0)
+}
+
+// DeleteProjectColumn deletes a column of an organization project
+func DeleteProjectColumn(ctx *context.APIContext) {
+	// swagger:operation DELETE /orgs/{org}/projects/{id}/columns/{column_id} project orgDeleteProjectColumn
+	// ---
+	// summary: Delete a column of the board of a project, its items are moved to the default column
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: column_id
+	//   in: path
+	//   description: id of the column
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.DeleteProjectColumn(ctx, ctx.ContextUser.ID, 0)
+}
+
+// ListProjectColumnItems lists the issues and pull requests of a column of an organization project
+func ListProjectColumnItems(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/projects/{id}/columns/{column_id}/items project orgListProjectColumnItems
+	// ---
+	// summary: List the issues and pull requests of a column of a project in their order
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: column_id
+	//   in: path
+	//   description: id of the column
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectItemList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListProjectColumnItems(ctx, ctx.ContextUser.ID, 0)
+}
+
+// ListProjectItems lists the issues and pull requests of an organization project
+func ListProjectItems(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/projects/{id}/items project orgListProjectItems
+	// ---
+	// summary: List the issues and pull requests of a project in the order of its board
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectItemList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListProjectItems(ctx, ctx.ContextUser.ID, 0)
+}
+
+// AddProjectItem adds an issue or a pull request to an organization project
+func AddProjectItem(ctx *context.APIContext) {
+	// swagger:operation POST /orgs/{org}/projects/{id}/items project orgAddProjectItem
+	// ---
+	// summary: Add an issue or a pull request to a project, or move it to another column of the project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/AddProjectItemOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/ProjectItem"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.AddProjectItem(ctx, ctx.ContextUser.ID, 0)
+}
+
+// RemoveProjectItem removes an issue or a pull request from an organization project
+func RemoveProjectItem(ctx *context.APIContext) {
+	// swagger:operation DELETE /orgs/{org}/projects/{id}/items/{issue_id} project orgRemoveProjectItem
+	// ---
+	// summary: Remove an issue or a pull request from a project
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: issue_id
+	//   in: path
+	//   description: id (not the index) of the issue or pull request
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.RemoveProjectItem(ctx, ctx.ContextUser.ID, 0)
+}
+
+// ListProjectViews lists the views of an organization project
+func ListProjectViews(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/projects/{id}/views project orgListProjectViews
+	// ---
+	// summary: List the views of a project
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectViewList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListProjectViews(ctx, ctx.ContextUser.ID, 0)
+}
+
+// CreateProjectView creates a view of an organization project
+func CreateProjectView(ctx *context.APIContext) {
+	// swagger:operation POST /orgs/{org}/projects/{id}/views project orgCreateProjectView
+	// ---
+	// summary: Create a view of a project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateProjectViewOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/ProjectView"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateProjectView(ctx, ctx.ContextUser.ID, 0)
+}
+
+// GetProjectView gets a view of an organization project
+func GetProjectView(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/projects/{id}/views/{view_id} project orgGetProjectView
+	// ---
+	// summary: Get a view of a project
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: view_id
+	//   in: path
+	//   description: id of the view
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectView"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.GetProjectView(ctx, ctx.ContextUser.ID, 0)
+}
+
+// EditProjectView updates a view of an organization project
+func EditProjectView(ctx *context.APIContext) {
+	// swagger:operation PATCH /orgs/{org}/projects/{id}/views/{view_id} project orgEditProjectView
+	// ---
+	// summary: Update a view of a project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: view_id
+	//   in: path
+	//   description: id of the view
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditProjectViewOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectView"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditProjectView(ctx, ctx.ContextUser.ID, 0)
+}
+
+// DeleteProjectView deletes a view of an organization project
+func DeleteProjectView(ctx *context.APIContext) {
+	// swagger:operation DELETE /orgs/{org}/projects/{id}/views/{view_id} project orgDeleteProjectView
+	// ---
+	// summary: Delete a view of a project
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: view_id
+	//   in: path
+	//   description: id of the view
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.DeleteProjectView(ctx, ctx.ContextUser.ID, 0)
+}
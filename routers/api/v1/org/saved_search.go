@@ -0,0 +1,213 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package org
+
+import (
+	"gitea.dev/routers/api/v1/shared"
+	"gitea.dev/services/context"
+)
+
+// ListSavedSearches lists the saved issue searches of an organization
+func ListSavedSearches(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/saved_searches organization orgListSavedSearches
+	// ---
+	// summary: List the saved issue searches of an organization
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/SavedSearchList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListSavedSearches(ctx, ctx.Org.Organization.AsUser())
+}
+
+// CreateSavedSearch saves an issue search for an organization
+func CreateSavedSearch(ctx *context.APIContext) {
+	// swagger:operation POST /orgs/{org}/saved_searches organization orgCreateSavedSearch
+	// ---
+	// summary: Save an issue search for an organization
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateSavedSearchOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/SavedSearch"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateSavedSearch(ctx, ctx.Org.Organization.AsUser())
+}
+
+// GetSavedSearch gets a saved issue search of an organization
+func GetSavedSearch(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/saved_searches/{id} organization orgGetSavedSearch
+	// ---
+	// summary: Get a saved issue search of an organization
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the saved search
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/SavedSearch"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.GetSavedSearch(ctx, ctx.Org.Organization.AsUser())
+}
+
+// EditSavedSearch updates a saved issue search of an organization
+func EditSavedSearch(ctx *context.APIContext) {
+	// swagger:operation PATCH /orgs/{org}/saved_searches/{id} organization orgEditSavedSearch
+	// ---
+	// summary: Update a saved issue search of an organization
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the saved search
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditSavedSearchOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/SavedSearch"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditSavedSearch(ctx, ctx.Org.Organization.AsUser())
+}
+
+// DeleteSavedSearch deletes a saved issue search of an organization
+func DeleteSavedSearch(ctx *context.APIContext) {
+	// swagger:operation DELETE /orgs/{org}/saved_searches/{id} organization orgDeleteSavedSearch
+	// ---
+	// summary: Delete a saved issue search of an organization
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the saved search
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.DeleteSavedSearch(ctx, ctx.Org.Organization.AsUser())
+}
+
+// SubscribeSavedSearch subscribes the authenticated user to new issues and pull requests matching a saved search
+func SubscribeSavedSearch(ctx *context.APIContext) {
+	// swagger:operation PUT /orgs/{org}/saved_searches/{id}/subscription organization orgSubscribeSavedSearch
+	// ---
+	// summary: Get notified about new issues and pull requests matching a saved issue search of an organization
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the saved search
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.SubscribeSavedSearch(ctx, ctx.Org.Organization.AsUser(), true)
+}
+
+// UnsubscribeSavedSearch removes the subscription of the authenticated user to a saved search
+func UnsubscribeSavedSearch(ctx *context.APIContext) {
+	// swagger:operation DELETE /orgs/{org}/saved_searches/{id}/subscription organization orgUnsubscribeSavedSearch
+	// ---
+	// summary: Stop notifications about issues and pull requests matching a saved issue search of an organization
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the saved search
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.SubscribeSavedSearch(ctx, ctx.Org.Organization.AsUser(), false)
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package org
+
+import (
+	"gitea.dev/routers/api/v1/shared"
+	"gitea.dev/services/context"
+)
+
+// ListSLAPolicies lists the SLA policies of an organization
+func ListSLAPolicies(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/sla_policies organization orgListSLAPolicies
+	// ---
+	// summary: List the SLA policies of an organization
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/SLAPolicyList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListSLAPolicies(ctx, ctx.Org.Organization.ID, 0)
+}
+
+// CreateSLAPolicy creates an SLA policy for an organization
+func CreateSLAPolicy(ctx *context.APIContext) {
+	// swagger:operation POST /orgs/{org}/sla_policies organization orgCreateSLAPolicy
+	// ---
+	// summary: Create an SLA policy for an organization
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateSLAPolicyOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/SLAPolicy"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateSLAPolicy(ctx, ctx.Org.Organization.ID, 0)
+}
+
+// GetSLAPolicy gets an SLA policy of an organization
+func GetSLAPolicy(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/sla_policies/{id} organization orgGetSLAPolicy
+	// ---
+	// summary: Get an SLA policy
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the SLA policy
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/SLAPolicy"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.GetSLAPolicy(ctx, ctx.Org.Organization.ID, 0)
+}
+
+// EditSLAPolicy updates an SLA policy of an organization
+func EditSLAPolicy(ctx *context.APIContext) {
+	// swagger:operation PATCH /orgs/{org}/sla_policies/{id} organization orgEditSLAPolicy
+	// ---
+	// summary: Update an SLA policy
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the SLA policy
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditSLAPolicyOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/SLAPolicy"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditSLAPolicy(ctx, ctx.Org.Organization.ID, 0)
+}
+
+// DeleteSLAPolicy deletes an SLA policy of an organization
+func DeleteSLAPolicy(ctx *context.APIContext) {
+	// swagger:operation DELETE /orgs/{org}/sla_policies/{id} organization orgDeleteSLAPolicy
+	// ---
+	// summary: Delete an SLA policy and its reminders
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the SLA policy
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.DeleteSLAPolicy(ctx, ctx.Org.Organization.ID, 0)
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package packages
+
+import (
+	"net/http"
+
+	"gitea.dev/models/packages"
+	user_model "gitea.dev/models/user"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/routers/api/v1/utils"
+	"gitea.dev/services/context"
+	"gitea.dev/services/convert"
+)
+
+// ListPackageVersionDownloads gets the daily download counts of a package version
+func ListPackageVersionDownloads(ctx *context.APIContext) {
+	// swagger:operation GET /packages/{owner}/{type}/{name}/{version}/downloads package listPackageVersionDownloads
+	// ---
+	// summary: Gets the daily download counts of a package version
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the package
+	//   type: string
+	//   required: true
+	// - name: type
+	//   in: path
+	//   description: type of the package
+	//   type: string
+	//   required: true
+	// - name: name
+	//   in: path
+	//   description: name of the package
+	//   type: string
+	//   required: true
+	// - name: version
+	//   in: path
+	//   description: version of the package
+	//   type: string
+	//   required: true
+	// - name: since
+	//   in: query
+	//   description: if provided, only days since the specified time are returned.
+	//   type: string
+	//   format: date-time
+	// - name: before
+	//   in: query
+	//   description: if provided, only days before the specified time are returned.
+	//   type: string
+	//   format: date-time
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/PackageDownloadStatList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	before, since, err := context.GetQueryBeforeSince(ctx.Base)
+	if err != nil {
+		ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	opts := &packages.PackageDownloadStatSearchOptions{
+		VersionIDs: []int64{ctx.Package.Descriptor.Version.ID},
+	}
+	if since != 0 {
+		opts.Since = packages.DownloadStatDay(timeutil.TimeStamp(since).AsTime())
+	}
+	if before != 0 {
+		opts.Before = timeutil.TimeStamp(before)
+	}
+
+	stats, err := packages.GetDownloadStats(ctx, opts)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	apiStats := make([]*api.PackageDownloadStat, 0, len(stats))
+	for _, stat := range stats {
+		apiStats = append(apiStats, convert.ToPackageDownloadStat(stat))
+	}
+
+	ctx.JSON(http.StatusOK, apiStats)
+}
+
+// ListPackageAuditEvents gets the audit trail of the packages of an owner
+func ListPackageAuditEvents(ctx *context.APIContext) {
+	// swagger:operation GET /packages/{owner}/-/audit_events package listPackageAuditEvents
+	// ---
+	// summary: Gets the publish and delete events of the packages of an owner
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the packages
+	//   type: string
+	//   required: true
+	// - name: type
+	//   in: query
+	//   description: package type filter
+	//   type: string
+	// - name: name
+	//   in: query
+	//   description: package name filter
+	//   type: string
+	// - name: version
+	//   in: query
+	//   description: package version filter
+	//   type: string
+	// - name: action
+	//   in: query
+	//   description: action filter
+	//   type: string
+	//   enum: [publish, delete]
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/PackageAuditEventList"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	var action packages.PackageAuditAction
+	if s := ctx.FormTrim("action"); s != "" {
+		if action = packages.PackageAuditActionFromString(s); action == 0 {
+			ctx.APIError(http.StatusUnprocessableEntity, "invalid action "+s)
+			return
+		}
+	}
+
+	listOptions := utils.GetListOptions(ctx)
+
+	paes, count, err := packages.SearchAuditEvents(ctx, &packages.PackageAuditEventSearchOptions{
+		OwnerID:   ctx.Package.Owner.ID,
+		Type:      packages.Type(ctx.FormTrim("type")),
+		Name:      ctx.FormTrim("name"),
+		Version:   ctx.FormTrim("version"),
+		Action:    action,
+		Paginator: &listOptions,
+	})
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	actorIDs := make([]int64, 0, len(paes))
+	for _, pae := range paes {
+		actorIDs = append(actorIDs, pae.DoerID)
+	}
+	actors, err := user_model.GetPossibleUserByIDs(ctx, actorIDs)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	actorsMap := make(map[int64]*user_model.User, len(actors))
+	for _, actor := range actors {
+		actorsMap[actor.ID] = actor
+	}
+
+	apiEvents := make([]*api.PackageAuditEvent, 0, len(paes))
+	for _, pae := range paes {
+		actor, ok := actorsMap[pae.DoerID]
+		if !ok {
+			actor = user_model.NewGhostUser()
+		}
+		apiEvents = append(apiEvents, convert.ToPackageAuditEvent(ctx, pae, actor, ctx.Doer))
+	}
+
+	ctx.SetLinkHeader(count, listOptions.PageSize)
+	ctx.SetTotalCountHeader(count)
+	ctx.JSON(http.StatusOK, apiEvents)
+}
@@ -0,0 +1,302 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package packages
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"gitea.dev/models/packages"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/util"
+	"gitea.dev/modules/web"
+	"gitea.dev/services/context"
+	"gitea.dev/services/convert"
+	cleanup_service "gitea.dev/services/packages/cleanup"
+)
+
+// ListPackageProtectionRules lists the package protection rules of an owner
+func ListPackageProtectionRules(ctx *context.APIContext) {
+	// swagger:operation GET /packages/{owner}/-/protection_rules package listPackageProtectionRules
+	// ---
+	// summary: List the package protection rules of an owner
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the packages
+	//   type: string
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/PackageProtectionRuleList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	pprs, err := packages.GetProtectionRulesByOwner(ctx, ctx.Package.Owner.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	apiRules := make([]*api.PackageProtectionRule, 0, len(pprs))
+	for _, ppr := range pprs {
+		apiRules = append(apiRules, convert.ToPackageProtectionRule(ppr))
+	}
+
+	ctx.JSON(http.StatusOK, apiRules)
+}
+
+// GetPackageProtectionRule gets a package protection rule
+func GetPackageProtectionRule(ctx *context.APIContext) {
+	// swagger:operation GET /packages/{owner}/-/protection_rules/{id} package getPackageProtectionRule
+	// ---
+	// summary: Get a package protection rule
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the packages
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the protection rule
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/PackageProtectionRule"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	ppr := getProtectionRuleFromPath(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, convert.ToPackageProtectionRule(ppr))
+}
+
+// CreatePackageProtectionRule creates a package protection rule
+func CreatePackageProtectionRule(ctx *context.APIContext) {
+	// swagger:operation POST /packages/{owner}/-/protection_rules package createPackageProtectionRule
+	// ---
+	// summary: Create a package protection rule
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the packages
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreatePackageProtectionRuleOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/PackageProtectionRule"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "409":
+	//     "$ref": "#/responses/conflict"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.CreatePackageProtectionRuleOption)
+
+	ppr := &packages.PackageProtectionRule{
+		OwnerID:          ctx.Package.Owner.ID,
+		Type:             packages.Type(form.Type),
+		PackageName:      form.PackageName,
+		Immutable:        form.Immutable,
+		ProtectedPattern: form.ProtectedPattern,
+	}
+	if ppr.Type != "" && !slices.Contains(packages.TypeList, ppr.Type) {
+		ctx.APIError(http.StatusUnprocessableEntity, "invalid package type")
+		return
+	}
+	if err := ppr.CompiledPattern(); err != nil {
+		ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	ppr, err := packages.InsertProtectionRule(ctx, ppr)
+	if err != nil {
+		if errors.Is(err, util.ErrAlreadyExist) {
+			ctx.APIError(http.StatusConflict, err.Error())
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, convert.ToPackageProtectionRule(ppr))
+}
+
+// EditPackageProtectionRule edits a package protection rule
+func EditPackageProtectionRule(ctx *context.APIContext) {
+	// swagger:operation PATCH /packages/{owner}/-/protection_rules/{id} package editPackageProtectionRule
+	// ---
+	// summary: Edit a package protection rule
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the packages
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the protection rule
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditPackageProtectionRuleOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/PackageProtectionRule"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.EditPackageProtectionRuleOption)
+
+	ppr := getProtectionRuleFromPath(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	if form.Immutable != nil {
+		ppr.Immutable = *form.Immutable
+	}
+	if form.ProtectedPattern != nil {
+		ppr.ProtectedPattern = *form.ProtectedPattern
+	}
+	if err := ppr.CompiledPattern(); err != nil {
+		ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	if err := packages.UpdateProtectionRule(ctx, ppr); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, convert.ToPackageProtectionRule(ppr))
+}
+
+// DeletePackageProtectionRule deletes a package protection rule
+func DeletePackageProtectionRule(ctx *context.APIContext) {
+	// swagger:operation DELETE /packages/{owner}/-/protection_rules/{id} package deletePackageProtectionRule
+	// ---
+	// summary: Delete a package protection rule
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the packages
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the protection rule
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	ppr := getProtectionRuleFromPath(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	if err := packages.DeleteProtectionRuleByID(ctx, ppr.ID); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// PreviewPackageCleanup lists the package versions the cleanup rules of an owner would delete
+func PreviewPackageCleanup(ctx *context.APIContext) {
+	// swagger:operation GET /packages/{owner}/-/cleanup_preview package previewPackageCleanup
+	// ---
+	// summary: List the package versions which would be removed by the cleanup rules of an owner
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the packages
+	//   type: string
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/PackageList"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	pds, err := cleanup_service.PreviewCleanupRules(ctx, ctx.Package.Owner.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	apiPackages := make([]*api.Package, 0, len(pds))
+	for _, pd := range pds {
+		apiPackage, err := convert.ToPackage(ctx, pd, ctx.Doer)
+		if err != nil {
+			ctx.APIErrorInternal(err)
+			return
+		}
+		apiPackages = append(apiPackages, apiPackage)
+	}
+
+	ctx.JSON(http.StatusOK, apiPackages)
+}
+
+func getProtectionRuleFromPath(ctx *context.APIContext) *packages.PackageProtectionRule {
+	ppr, err := packages.GetProtectionRuleByID(ctx, ctx.PathParamInt64("id"))
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.APIErrorNotFound()
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return nil
+	}
+	if ppr.OwnerID != ctx.Package.Owner.ID {
+		ctx.APIErrorNotFound()
+		return nil
+	}
+	return ppr
+}
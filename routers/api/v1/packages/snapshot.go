@@ -0,0 +1,283 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package packages
+
+import (
+	"errors"
+	"net/http"
+
+	"gitea.dev/models/packages"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/util"
+	"gitea.dev/modules/web"
+	"gitea.dev/services/context"
+	"gitea.dev/services/convert"
+	debian_service "gitea.dev/services/packages/debian"
+	rpm_service "gitea.dev/services/packages/rpm"
+)
+
+// ListPackageSnapshots lists the repository snapshots of an owner
+func ListPackageSnapshots(ctx *context.APIContext) {
+	// swagger:operation GET /packages/{owner}/-/snapshots package listPackageSnapshots
+	// ---
+	// summary: List the Debian and RPM repository snapshots of an owner
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the packages
+	//   type: string
+	//   required: true
+	// - name: type
+	//   in: query
+	//   description: package type filter
+	//   type: string
+	//   enum: [debian, rpm]
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/PackageSnapshotList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	pss, err := packages.GetSnapshotsByOwner(ctx, ctx.Package.Owner.ID, packages.Type(ctx.FormTrim("type")))
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	apiSnapshots := make([]*api.PackageSnapshot, 0, len(pss))
+	for _, ps := range pss {
+		apiSnapshots = append(apiSnapshots, convert.ToPackageSnapshot(ps))
+	}
+
+	ctx.JSON(http.StatusOK, apiSnapshots)
+}
+
+// GetPackageSnapshot gets a repository snapshot
+func GetPackageSnapshot(ctx *context.APIContext) {
+	// swagger:operation GET /packages/{owner}/-/snapshots/{id} package getPackageSnapshot
+	// ---
+	// summary: Get a repository snapshot
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the packages
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the snapshot
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/PackageSnapshot"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	ps := getSnapshotFromPath(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, convert.ToPackageSnapshot(ps))
+}
+
+// CreatePackageSnapshot creates a repository snapshot
+func CreatePackageSnapshot(ctx *context.APIContext) {
+	// swagger:operation POST /packages/{owner}/-/snapshots package createPackageSnapshot
+	// ---
+	// summary: Create an immutable snapshot of a Debian distribution or a RPM group
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the packages
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreatePackageSnapshotOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/PackageSnapshot"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "409":
+	//     "$ref": "#/responses/conflict"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.CreatePackageSnapshotOption)
+
+	var ps *packages.PackageSnapshot
+	var err error
+	switch packages.Type(form.Type) {
+	case packages.TypeDebian:
+		ps, err = debian_service.CreateSnapshot(ctx, ctx.Package.Owner.ID, ctx.Doer.ID, form.Name, form.Distribution, form.Component)
+	case packages.TypeRpm:
+		ps, err = rpm_service.CreateSnapshot(ctx, ctx.Package.Owner.ID, ctx.Doer.ID, form.Name, form.Group)
+	}
+	if err != nil {
+		if errors.Is(err, util.ErrAlreadyExist) {
+			ctx.APIError(http.StatusConflict, err.Error())
+		} else if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, convert.ToPackageSnapshot(ps))
+}
+
+// DeletePackageSnapshot deletes a repository snapshot
+func DeletePackageSnapshot(ctx *context.APIContext) {
+	// swagger:operation DELETE /packages/{owner}/-/snapshots/{id} package deletePackageSnapshot
+	// ---
+	// summary: Delete a repository snapshot
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the packages
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the snapshot
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	ps := getSnapshotFromPath(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	var err error
+	switch ps.Type {
+	case packages.TypeDebian:
+		err = debian_service.DeleteSnapshot(ctx, ps)
+	case packages.TypeRpm:
+		err = rpm_service.DeleteSnapshot(ctx, ps)
+	}
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// PromotePackages copies package versions to another Debian distribution or RPM group
+func PromotePackages(ctx *context.APIContext) {
+	// swagger:operation POST /packages/{owner}/-/promote package promotePackages
+	// ---
+	// summary: Copy package versions from one Debian distribution or RPM group to another one
+	// consumes:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the packages
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/PromotePackagesOption"
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "409":
+	//     "$ref": "#/responses/conflict"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.PromotePackagesOption)
+	if form.From == form.To {
+		ctx.APIError(http.StatusUnprocessableEntity, "source and target must differ")
+		return
+	}
+
+	packageType := packages.Type(form.Type)
+
+	pvs := make([]*packages.PackageVersion, 0, len(form.Packages))
+	for _, p := range form.Packages {
+		if p == nil {
+			continue
+		}
+		pv, err := packages.GetVersionByNameAndVersion(ctx, ctx.Package.Owner.ID, packageType, p.Name, p.Version)
+		if err != nil {
+			if errors.Is(err, util.ErrNotExist) {
+				ctx.APIErrorNotFound(err.Error())
+			} else {
+				ctx.APIErrorInternal(err)
+			}
+			return
+		}
+		pvs = append(pvs, pv)
+	}
+
+	var err error
+	switch packageType {
+	case packages.TypeDebian:
+		err = debian_service.PromotePackageVersions(ctx, ctx.Package.Owner.ID, pvs, form.From, form.To, form.Component)
+	case packages.TypeRpm:
+		err = rpm_service.PromotePackageVersions(ctx, ctx.Package.Owner.ID, pvs, form.From, form.To)
+	}
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.APIErrorNotFound(err.Error())
+		} else if errors.Is(err, util.ErrAlreadyExist) {
+			ctx.APIError(http.StatusConflict, err.Error())
+		} else if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func getSnapshotFromPath(ctx *context.APIContext) *packages.PackageSnapshot {
+	ps, err := packages.GetSnapshotByID(ctx, ctx.PathParamInt64("id"))
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.APIErrorNotFound()
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return nil
+	}
+	if ps.OwnerID != ctx.Package.Owner.ID {
+		ctx.APIErrorNotFound()
+		return nil
+	}
+	return ps
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package packages
+
+import (
+	"net/http"
+
+	"gitea.dev/models/packages"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/services/context"
+	"gitea.dev/services/convert"
+	packages_vulnerability_service "gitea.dev/services/packages/vulnerability"
+)
+
+// ListPackageDependencies gets the dependencies declared by a package version
+func ListPackageDependencies(ctx *context.APIContext) {
+	// swagger:operation GET /packages/{owner}/{type}/{name}/{version}/dependencies package listPackageDependencies
+	// ---
+	// summary: Gets the dependencies declared by a package version
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the package
+	//   type: string
+	//   required: true
+	// - name: type
+	//   in: path
+	//   description: type of the package
+	//   type: string
+	//   required: true
+	// - name: name
+	//   in: path
+	//   description: name of the package
+	//   type: string
+	//   required: true
+	// - name: version
+	//   in: path
+	//   description: version of the package
+	//   type: string
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/PackageDependencyList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	deps := packages_vulnerability_service.ExtractDependencies(ctx.Package.Descriptor)
+
+	apiDeps := make([]*api.PackageDependency, 0, len(deps))
+	for _, dep := range deps {
+		apiDeps = append(apiDeps, &api.PackageDependency{
+			Ecosystem:   dep.Ecosystem,
+			Name:        dep.Name,
+			Requirement: dep.Requirement,
+			Version:     dep.Version,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, apiDeps)
+}
+
+// ListPackageVulnerabilities gets the known vulnerabilities of a package version
+func ListPackageVulnerabilities(ctx *context.APIContext) {
+	// swagger:operation GET /packages/{owner}/{type}/{name}/{version}/vulnerabilities package listPackageVulnerabilities
+	// ---
+	// summary: Gets the known vulnerabilities of a package version and its dependencies
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the package
+	//   type: string
+	//   required: true
+	// - name: type
+	//   in: path
+	//   description: type of the package
+	//   type: string
+	//   required: true
+	// - name: name
+	//   in: path
+	//   description: name of the package
+	//   type: string
+	//   required: true
+	// - name: version
+	//   in: path
+	//   description: version of the package
+	//   type: string
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/PackageVulnerabilityList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	pvs, err := packages.GetVulnerabilitiesByVersionID(ctx, ctx.Package.Descriptor.Version.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	apiVulnerabilities := make([]*api.PackageVulnerability, 0, len(pvs))
+	for _, pv := range pvs {
+		apiVulnerabilities = append(apiVulnerabilities, convert.ToPackageVulnerability(pv))
+	}
+
+	ctx.JSON(http.StatusOK, apiVulnerabilities)
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	gocontext "context"
+	"errors"
+	"io"
+	"net/http"
+
+	repo_model "gitea.dev/models/repo"
+	"gitea.dev/modules/codenav"
+	"gitea.dev/modules/git"
+	"gitea.dev/modules/setting"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/util"
+	codenav_service "gitea.dev/services/codenav"
+	"gitea.dev/services/context"
+)
+
+// UploadCodeNavigationLSIF stores a precise code navigation index created from a LSIF dump
+func UploadCodeNavigationLSIF(ctx *context.APIContext) {
+	// swagger:operation PUT /repos/{owner}/{repo}/code-navigation/{sha}/lsif repository repoUploadCodeNavigationLSIF
+	// ---
+	// summary: Upload a LSIF dump as the code navigation index of a commit
+	// consumes:
+	// - application/octet-stream
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: sha
+	//   in: path
+	//   description: sha of the commit
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   description: LSIF dump in the JSON lines format
+	//   required: true
+	//   schema:
+	//     type: string
+	//     format: binary
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/CodeNavigationIndex"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "413":
+	//     "$ref": "#/responses/error"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	uploadCodeNavigationIndex(ctx, codenav_service.UploadLSIF)
+}
+
+// UploadCodeNavigationSCIP stores a precise code navigation index created from a SCIP index
+func UploadCodeNavigationSCIP(ctx *context.APIContext) {
+	// swagger:operation PUT /repos/{owner}/{repo}/code-navigation/{sha}/scip repository repoUploadCodeNavigationSCIP
+	// ---
+	// summary: Upload a SCIP index as the code navigation index of a commit
+	// consumes:
+	// - application/octet-stream
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: sha
+	//   in: path
+	//   description: sha of the commit
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   description: SCIP index in the protobuf format
+	//   required: true
+	//   schema:
+	//     type: string
+	//     format: binary
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/CodeNavigationIndex"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "413":
+	//     "$ref": "#/responses/error"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	uploadCodeNavigationIndex(ctx, codenav_service.UploadSCIP)
+}
+
+func uploadCodeNavigationIndex(ctx *context.APIContext, upload func(ctx gocontext.Context, repo *repo_model.Repository, commitID string, r io.Reader) (*codenav.Index, error)) {
+	commit, err := ctx.Repo.GitRepo.GetCommit(ctx.PathParam("sha"))
+	if err != nil {
+		if git.IsErrNotExist(err) {
+			ctx.APIErrorNotFound("commit not found")
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+
+	body := http.MaxBytesReader(ctx.Resp, ctx.Req.Body, setting.CodeNavigation.MaxUploadSize)
+	idx, err := upload(ctx, ctx.Repo.Repository, commit.ID.String(), body)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		switch {
+		case errors.As(err, &maxBytesErr):
+			ctx.APIError(http.StatusRequestEntityTooLarge, err.Error())
+		case errors.Is(err, util.ErrInvalidArgument):
+			ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		case errors.Is(err, util.ErrNotExist):
+			ctx.APIErrorNotFound(err.Error())
+		default:
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, &api.CodeNavigationIndex{
+		CommitID: idx.CommitID,
+		Precise:  idx.Precise,
+		Files:    len(idx.Ranges),
+		Symbols:  len(idx.Results),
+	})
+}
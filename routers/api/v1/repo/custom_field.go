@@ -0,0 +1,184 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"gitea.dev/routers/api/v1/shared"
+	"gitea.dev/services/context"
+)
+
+// ListCustomFields lists the custom fields of a repository
+func ListCustomFields(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/custom_fields repository repoListCustomFields
+	// ---
+	// summary: List the custom fields of a repository, without those of its owner
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/CustomFieldList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListCustomFields(ctx, 0, ctx.Repo.Repository.ID)
+}
+
+// CreateCustomField creates a custom field for a repository
+func CreateCustomField(ctx *context.APIContext) {
+	// swagger:operation POST /repos/{owner}/{repo}/custom_fields repository repoCreateCustomField
+	// ---
+	// summary: Create a custom field for a repository
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateCustomFieldOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/CustomField"
+	//   "400":
+	//     "$ref": "#/responses/error"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "409":
+	//     "$ref": "#/responses/conflict"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateCustomField(ctx, 0, ctx.Repo.Repository.ID)
+}
+
+// GetCustomField gets a custom field of a repository
+func GetCustomField(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/custom_fields/{id} repository repoGetCustomField
+	// ---
+	// summary: Get a custom field
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the custom field
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/CustomField"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.GetCustomField(ctx, 0, ctx.Repo.Repository.ID)
+}
+
+// EditCustomField updates a custom field of a repository
+func EditCustomField(ctx *context.APIContext) {
+	// swagger:operation PATCH /repos/{owner}/{repo}/custom_fields/{id} repository repoEditCustomField
+	// ---
+	// summary: Update a custom field
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the custom field
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditCustomFieldOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/CustomField"
+	//   "400":
+	//     "$ref": "#/responses/error"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "409":
+	//     "$ref": "#/responses/conflict"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditCustomField(ctx, 0, ctx.Repo.Repository.ID)
+}
+
+// DeleteCustomField deletes a custom field of a repository
+func DeleteCustomField(ctx *context.APIContext) {
+	// swagger:operation DELETE /repos/{owner}/{repo}/custom_fields/{id} repository repoDeleteCustomField
+	// ---
+	// summary: Delete a custom field and unset it on all issues
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the custom field
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.DeleteCustomField(ctx, 0, ctx.Repo.Repository.ID)
+}
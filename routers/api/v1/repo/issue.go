@@ -112,7 +112,7 @@ func SearchIssues(ctx *context.APIContext) {
 	//   type: string
 	// - name: q
 	//   in: query
-	//   description: Search string
+	//   description: Search string, qualifiers like `is:open label:bug -label:wontfix assignee:@me sort:updated-desc` are applied as filters
 	//   type: string
 	// - name: type
 	//   in: query
@@ -289,6 +289,10 @@ func SearchIssues(ctx *context.APIContext) {
 		}
 	}
 
+	if keyword != "" && !applySearchQuery(ctx, searchOpt) {
+		return
+	}
+
 	ids, total, err := issue_indexer.SearchIssues(ctx, searchOpt)
 	if err != nil {
 		ctx.APIErrorInternal(err)
@@ -334,7 +338,7 @@ func ListIssues(ctx *context.APIContext) {
 	//   type: string
 	// - name: q
 	//   in: query
-	//   description: search string
+	//   description: search string, qualifiers like `is:open label:bug -label:wontfix assignee:@me sort:updated-desc` are applied as filters
 	//   type: string
 	// - name: type
 	//   in: query
@@ -382,6 +386,8 @@ func ListIssues(ctx *context.APIContext) {
 	//     "$ref": "#/responses/IssueList"
 	//   "404":
 	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
 	before, since, err := context.GetQueryBeforeSince(ctx.Base)
 	if err != nil {
 		ctx.APIError(http.StatusUnprocessableEntity, err.Error())
@@ -517,6 +523,16 @@ func ListIssues(ctx *context.APIContext) {
 		searchOpt.MentionID = optional.Some(mentionedByID)
 	}
 
+	if keyword != "" {
+		if !applySearchQuery(ctx, searchOpt) {
+			return
+		}
+		if searchOpt.IsPull.Has() && !ctx.Repo.Permission.CanReadIssuesOrPulls(searchOpt.IsPull.Value()) {
+			ctx.APIErrorNotFound()
+			return
+		}
+	}
+
 	ids, total, err := issue_indexer.SearchIssues(ctx, searchOpt)
 	if err != nil {
 		ctx.APIErrorInternal(err)
@@ -533,6 +549,23 @@ func ListIssues(ctx *context.APIContext) {
 	ctx.JSON(http.StatusOK, convert.ToAPIIssueList(ctx, ctx.Doer, issues))
 }
 
+// applySearchQuery applies the qualifiers of the search string to the search options, it returns false if the response has been written
+func applySearchQuery(ctx *context.APIContext, searchOpt *issue_indexer.SearchOptions) bool {
+	query, err := issue_indexer.ParseQuery(searchOpt.Keyword)
+	if err == nil {
+		err = issue_service.ApplySearchQuery(ctx, ctx.Doer, query, searchOpt)
+	}
+	if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return false
+	}
+	return true
+}
+
 func getUserIDForFilter(ctx *context.APIContext, queryName string) int64 {
 	userName := ctx.FormString(queryName)
 	if len(userName) == 0 {
@@ -652,6 +685,18 @@ func CreateIssue(ctx *context.APIContext) {
 	var err error
 	if ctx.Repo.Permission.CanWrite(unit.TypeIssues) {
 		issue.MilestoneID = form.Milestone
+		if form.Type != "" {
+			issueType, err := issue_service.GetIssueTypeForRepo(ctx, ctx.Repo.Repository, form.Type)
+			if err != nil {
+				if errors.Is(err, util.ErrNotExist) || errors.Is(err, util.ErrInvalidArgument) {
+					ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+				} else {
+					ctx.APIErrorInternal(err)
+				}
+				return
+			}
+			issue.TypeID = issueType.ID
+		}
 		assigneeIDs, err = issues_model.MakeIDsFromAPIAssigneesToAdd(ctx, form.Assignee, form.Assignees)
 		if err != nil {
 			if user_model.IsErrUserNotExist(err) {
@@ -758,6 +803,8 @@ func EditIssue(ctx *context.APIContext) {
 	//     "$ref": "#/responses/notFound"
 	//   "412":
 	//     "$ref": "#/responses/error"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
 
 	form := web.GetForm(ctx).(*api.EditIssueOption)
 	issue, err := issues_model.GetIssueByIndex(ctx, ctx.Repo.Repository.ID, ctx.PathParamInt64("index"))
@@ -889,6 +936,23 @@ func EditIssue(ctx *context.APIContext) {
 			return
 		}
 	}
+	if canWrite && form.Type != nil {
+		var issueType *issues_model.IssueType
+		if *form.Type != "" {
+			issueType, err = issue_service.GetIssueTypeForRepo(ctx, ctx.Repo.Repository, *form.Type)
+		}
+		if err == nil {
+			err = issue_service.ChangeIssueType(ctx, ctx.Doer, issue, issueType)
+		}
+		if err != nil {
+			if errors.Is(err, util.ErrNotExist) || errors.Is(err, util.ErrInvalidArgument) {
+				ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+			} else {
+				ctx.APIErrorInternal(err)
+			}
+			return
+		}
+	}
 	if form.State != nil {
 		if issue.IsPull {
 			if err := issue.LoadPullRequest(ctx); err != nil {
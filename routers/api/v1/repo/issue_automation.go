@@ -0,0 +1,355 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"errors"
+	"net/http"
+
+	"gitea.dev/models/db"
+	issues_model "gitea.dev/models/issues"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/util"
+	"gitea.dev/modules/web"
+	"gitea.dev/routers/api/v1/utils"
+	automation_service "gitea.dev/services/automation"
+	"gitea.dev/services/context"
+	"gitea.dev/services/convert"
+)
+
+// ListAutomationRules lists the automation rules of a repository
+func ListAutomationRules(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/automation/rules repository repoListAutomationRules
+	// ---
+	// summary: List the automation rules of a repository
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/AutomationRuleList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	rules, count, err := db.FindAndCount[issues_model.AutomationRule](ctx, issues_model.FindAutomationRulesOptions{
+		ListOptions: utils.GetListOptions(ctx),
+		RepoID:      ctx.Repo.Repository.ID,
+	})
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	apiRules := make([]*api.AutomationRule, len(rules))
+	for i := range rules {
+		apiRules[i] = convert.ToAutomationRule(rules[i])
+	}
+	ctx.SetTotalCountHeader(count)
+	ctx.JSON(http.StatusOK, apiRules)
+}
+
+func getAutomationRule(ctx *context.APIContext) *issues_model.AutomationRule {
+	rule, err := issues_model.GetAutomationRuleByRepoID(ctx, ctx.Repo.Repository.ID, ctx.PathParamInt64("id"))
+	if err != nil {
+		ctx.APIErrorAuto(err)
+		return nil
+	}
+	return rule
+}
+
+// handleAutomationRuleError reports the invalid fields of a rule, the labels, projects, columns and users which don't exist are invalid too
+func handleAutomationRuleError(ctx *context.APIContext, err error) {
+	if errors.Is(err, util.ErrInvalidArgument) || errors.Is(err, util.ErrNotExist) {
+		ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	ctx.APIErrorInternal(err)
+}
+
+// GetAutomationRule gets an automation rule of a repository
+func GetAutomationRule(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/automation/rules/{id} repository repoGetAutomationRule
+	// ---
+	// summary: Get an automation rule
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the automation rule
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/AutomationRule"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	rule := getAutomationRule(ctx)
+	if ctx.Written() {
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToAutomationRule(rule))
+}
+
+// CreateAutomationRule creates an automation rule for a repository
+func CreateAutomationRule(ctx *context.APIContext) {
+	// swagger:operation POST /repos/{owner}/{repo}/automation/rules repository repoCreateAutomationRule
+	// ---
+	// summary: Create an automation rule, the rule runs as the user who creates it
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateAutomationRuleOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/AutomationRule"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.CreateAutomationRuleOption)
+	rule := &issues_model.AutomationRule{
+		ProjectID:        form.ProjectID,
+		Name:             form.Name,
+		TriggerEvent:     issues_model.AutomationTrigger(form.Trigger),
+		ConditionLabelID: form.ConditionLabelID,
+		InactiveDays:     form.InactiveDays,
+		Action:           issues_model.AutomationAction(form.Action),
+		ColumnID:         form.ColumnID,
+		LabelID:          form.LabelID,
+		AssigneeID:       form.AssigneeID,
+		IsActive:         form.Active == nil || *form.Active,
+		CreatorID:        ctx.Doer.ID,
+	}
+	if err := automation_service.CreateRule(ctx, ctx.Repo.Repository, rule); err != nil {
+		handleAutomationRuleError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, convert.ToAutomationRule(rule))
+}
+
+// EditAutomationRule updates an automation rule of a repository
+func EditAutomationRule(ctx *context.APIContext) {
+	// swagger:operation PATCH /repos/{owner}/{repo}/automation/rules/{id} repository repoEditAutomationRule
+	// ---
+	// summary: Update an automation rule
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the automation rule
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditAutomationRuleOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/AutomationRule"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.EditAutomationRuleOption)
+	rule := getAutomationRule(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	if form.Name != nil {
+		rule.Name = *form.Name
+	}
+	if form.ProjectID != nil {
+		rule.ProjectID = *form.ProjectID
+	}
+	if form.Trigger != nil {
+		rule.TriggerEvent = issues_model.AutomationTrigger(*form.Trigger)
+	}
+	if form.ConditionLabelID != nil {
+		rule.ConditionLabelID = *form.ConditionLabelID
+	}
+	if form.InactiveDays != nil {
+		rule.InactiveDays = *form.InactiveDays
+	}
+	if form.Action != nil {
+		rule.Action = issues_model.AutomationAction(*form.Action)
+	}
+	if form.ColumnID != nil {
+		rule.ColumnID = *form.ColumnID
+	}
+	if form.LabelID != nil {
+		rule.LabelID = *form.LabelID
+	}
+	if form.AssigneeID != nil {
+		rule.AssigneeID = *form.AssigneeID
+	}
+	if form.Active != nil {
+		rule.IsActive = *form.Active
+	}
+	if err := automation_service.UpdateRule(ctx, ctx.Repo.Repository, rule); err != nil {
+		handleAutomationRuleError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToAutomationRule(rule))
+}
+
+// DeleteAutomationRule deletes an automation rule of a repository
+func DeleteAutomationRule(ctx *context.APIContext) {
+	// swagger:operation DELETE /repos/{owner}/{repo}/automation/rules/{id} repository repoDeleteAutomationRule
+	// ---
+	// summary: Delete an automation rule and its logs
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the automation rule
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	rule := getAutomationRule(ctx)
+	if ctx.Written() {
+		return
+	}
+	if err := issues_model.DeleteAutomationRule(ctx, rule); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// ListAutomationRuleLogs lists the runs of an automation rule which changed an issue or failed
+func ListAutomationRuleLogs(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/automation/rules/{id}/logs repository repoListAutomationRuleLogs
+	// ---
+	// summary: List the runs of an automation rule which changed an issue or failed, the newest first
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the automation rule
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/AutomationRuleLogList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	rule := getAutomationRule(ctx)
+	if ctx.Written() {
+		return
+	}
+	logs, count, err := db.FindAndCount[issues_model.AutomationRuleLog](ctx, issues_model.FindAutomationRuleLogsOptions{
+		ListOptions: utils.GetListOptions(ctx),
+		RuleID:      rule.ID,
+	})
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	apiLogs := make([]*api.AutomationRuleLog, len(logs))
+	for i := range logs {
+		apiLogs[i] = convert.ToAutomationRuleLog(logs[i])
+	}
+	ctx.SetTotalCountHeader(count)
+	ctx.JSON(http.StatusOK, apiLogs)
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"net/http"
+
+	issues_model "gitea.dev/models/issues"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/web"
+	"gitea.dev/services/context"
+	issue_service "gitea.dev/services/issue"
+)
+
+// ListIssueCustomFields list the custom field values of an issue
+func ListIssueCustomFields(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/issues/{index}/custom_fields issue issueListCustomFields
+	// ---
+	// summary: List all the custom fields available to an issue with their values
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueCustomFieldValueList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	issue := getReadableParamsIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+	writeIssueCustomFields(ctx, issue)
+}
+
+// SetIssueCustomFields set the custom field values of an issue
+func SetIssueCustomFields(ctx *context.APIContext) {
+	// swagger:operation PUT /repos/{owner}/{repo}/issues/{index}/custom_fields issue issueSetCustomFields
+	// ---
+	// summary: Set the values of custom fields of an issue, the fields which are not listed are kept
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/SetIssueCustomFieldsOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueCustomFieldValueList"
+	//   "400":
+	//     "$ref": "#/responses/error"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+	//   "423":
+	//     "$ref": "#/responses/repoArchivedError"
+
+	form := web.GetForm(ctx).(*api.SetIssueCustomFieldsOption)
+	issue := getReadableParamsIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+	if !ctx.Repo.Permission.CanWriteIssuesOrPulls(issue.IsPull) {
+		ctx.APIError(http.StatusForbidden, "no permission to change the custom fields")
+		return
+	}
+
+	fields := make([]*issues_model.CustomField, 0, len(form.Fields))
+	for _, option := range form.Fields {
+		field, err := issues_model.GetCustomFieldByID(ctx, option.FieldID)
+		if err != nil {
+			ctx.APIErrorAuto(err)
+			return
+		}
+		// don't reveal the fields of other organizations and repositories
+		if available, err := issue_service.IsCustomFieldAvailable(ctx, issue, field); err != nil {
+			ctx.APIErrorInternal(err)
+			return
+		} else if !available {
+			ctx.APIErrorNotFound()
+			return
+		}
+		fields = append(fields, field)
+	}
+	for i, field := range fields {
+		if err := issue_service.SetIssueCustomFieldValues(ctx, ctx.Doer, issue, field, form.Fields[i].Values); err != nil {
+			ctx.APIErrorAuto(err)
+			return
+		}
+	}
+
+	writeIssueCustomFields(ctx, issue)
+}
+
+func writeIssueCustomFields(ctx *context.APIContext, issue *issues_model.Issue) {
+	fields, err := issues_model.GetAvailableCustomFields(ctx, ctx.Repo.Repository.OwnerID, ctx.Repo.Repository.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	values, err := issues_model.GetIssueCustomFieldValues(ctx, issue.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	apiValues := make([]*api.IssueCustomFieldValue, 0, len(fields))
+	for _, field := range fields {
+		formatted, err := issue_service.FormatCustomFieldValues(ctx, field, values[field.ID])
+		if err != nil {
+			ctx.APIErrorInternal(err)
+			return
+		}
+		apiValues = append(apiValues, &api.IssueCustomFieldValue{
+			FieldID: field.ID,
+			Name:    field.Name,
+			Type:    api.CustomFieldType(field.Type),
+			Values:  append([]string{}, formatted...),
+		})
+	}
+	ctx.JSON(http.StatusOK, apiValues)
+}
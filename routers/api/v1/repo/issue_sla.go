@@ -0,0 +1,115 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"net/http"
+
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/modules/util"
+	"gitea.dev/routers/api/v1/utils"
+	"gitea.dev/routers/common"
+	"gitea.dev/services/context"
+	"gitea.dev/services/convert"
+	sla_service "gitea.dev/services/sla"
+)
+
+// GetIssueSLA gets the state of the SLA targets of an issue
+func GetIssueSLA(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/issues/{index}/sla issue issueGetSLA
+	// ---
+	// summary: Get the due times and the breaches of the SLA policies which apply to an issue
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueSLAList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	issue := getReadableParamsIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+	issuesSLA, err := issues_model.GetIssuesSLA(ctx, issues_model.IssueList{issue})
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToIssueSLAList(ctx, issuesSLA[issue.ID]))
+}
+
+// ListSLABreaches lists the breached SLA targets of the issues of a repository
+func ListSLABreaches(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/sla_breaches repository repoListSLABreaches
+	// ---
+	// summary: List the issues and pull requests of a repository which breached an SLA target, the newest first
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: state
+	//   in: query
+	//   description: whether the issues are open or closed
+	//   type: string
+	//   enum: [closed, open, all]
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueSLAList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	isClosed := common.ParseIssueFilterStateIsClosed(ctx.FormString("state"))
+	breached, err := sla_service.GetBreachedIssuesSLA(ctx, ctx.Repo.Repository, isClosed)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	readable := make([]*issues_model.IssueSLA, 0, len(breached))
+	for _, s := range breached {
+		if ctx.Repo.Permission.CanReadIssuesOrPulls(s.Issue.IsPull) {
+			readable = append(readable, s)
+		}
+	}
+
+	listOptions := utils.GetListOptions(ctx)
+	ctx.SetTotalCountHeader(int64(len(readable)))
+	page := util.PaginateSlice(readable, listOptions.Page, listOptions.PageSize).([]*issues_model.IssueSLA)
+	ctx.JSON(http.StatusOK, convert.ToIssueSLAList(ctx, page))
+}
@@ -0,0 +1,435 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"net/http"
+	"strings"
+
+	issues_model "gitea.dev/models/issues"
+	access_model "gitea.dev/models/perm/access"
+	repo_model "gitea.dev/models/repo"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/web"
+	"gitea.dev/services/context"
+	"gitea.dev/services/convert"
+	issue_service "gitea.dev/services/issue"
+)
+
+// ListSubIssues list the sub-issues of an issue
+func ListSubIssues(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/issues/{index}/sub_issues issue issueListSubIssues
+	// ---
+	// summary: List the sub-issues of an issue in their order
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	issue := getReadableParamsIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	subIssues, err := issues_model.GetSubIssues(ctx, issue.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	if _, err := subIssues.LoadRepositories(ctx); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	// the sub-issues may belong to other repositories of the owner, hide those the doer can't read
+	repoPerms := map[int64]access_model.Permission{ctx.Repo.Repository.ID: ctx.Repo.Permission}
+	readable := make(issues_model.IssueList, 0, len(subIssues))
+	for _, subIssue := range subIssues {
+		perm, ok := repoPerms[subIssue.RepoID]
+		if !ok {
+			perm, err = access_model.GetDoerRepoPermission(ctx, subIssue.Repo, ctx.Doer)
+			if err != nil {
+				ctx.APIErrorInternal(err)
+				return
+			}
+			repoPerms[subIssue.RepoID] = perm
+		}
+		if perm.CanReadIssuesOrPulls(subIssue.IsPull) {
+			readable = append(readable, subIssue)
+		}
+	}
+
+	ctx.JSON(http.StatusOK, convert.ToAPIIssueList(ctx, ctx.Doer, readable))
+}
+
+// GetSubIssueProgress get the progress of an issue computed from its sub-issues
+func GetSubIssueProgress(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/issues/{index}/sub_issues/progress issue issueGetSubIssueProgress
+	// ---
+	// summary: Get the progress of an issue computed from the state of all its sub-issues, including the nested ones
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/SubIssueProgress"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	issue := getReadableParamsIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	progress, err := issues_model.GetSubIssueProgress(ctx, issue.ID, ctx.Doer)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, &api.SubIssueProgress{
+		Total:   progress.Total,
+		Closed:  progress.Closed,
+		Percent: progress.Percent(),
+	})
+}
+
+// GetParentIssue get the parent issue of an issue
+func GetParentIssue(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/issues/{index}/parent issue issueGetParentIssue
+	// ---
+	// summary: Get the parent issue of an issue
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/Issue"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	issue := getReadableParamsIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	parentID, err := issues_model.GetParentIssueID(ctx, issue.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	} else if parentID == 0 {
+		ctx.APIErrorNotFound()
+		return
+	}
+	parent, err := issues_model.GetIssueByID(ctx, parentID)
+	if err != nil {
+		ctx.APIErrorAuto(err)
+		return
+	}
+	if err := parent.LoadRepo(ctx); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	perm := getPermissionForRepo(ctx, parent.Repo)
+	if ctx.Written() {
+		return
+	}
+	if !perm.CanReadIssuesOrPulls(parent.IsPull) {
+		ctx.APIErrorNotFound()
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToAPIIssue(ctx, ctx.Doer, parent))
+}
+
+// AddSubIssue add a sub-issue to an issue
+func AddSubIssue(ctx *context.APIContext) {
+	// swagger:operation POST /repos/{owner}/{repo}/issues/{index}/sub_issues issue issueAddSubIssue
+	// ---
+	// summary: Add a sub-issue to an issue, the sub-issue may belong to another repository of the same owner
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the parent issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/AddSubIssueOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/Issue"
+	//   "400":
+	//     "$ref": "#/responses/error"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "409":
+	//     "$ref": "#/responses/conflict"
+	//   "423":
+	//     "$ref": "#/responses/repoArchivedError"
+
+	form := web.GetForm(ctx).(*api.AddSubIssueOption)
+	parent := getWritableParamsIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+	child := getWritableSubIssue(ctx, form.Owner, form.Name, form.Index)
+	if ctx.Written() {
+		return
+	}
+
+	if err := issue_service.AddSubIssue(ctx, ctx.Doer, parent, child, form.Inherit); err != nil {
+		ctx.APIErrorAuto(err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, convert.ToAPIIssue(ctx, ctx.Doer, child))
+}
+
+// RemoveSubIssue remove a sub-issue from an issue
+func RemoveSubIssue(ctx *context.APIContext) {
+	// swagger:operation DELETE /repos/{owner}/{repo}/issues/{index}/sub_issues issue issueRemoveSubIssue
+	// ---
+	// summary: Remove a sub-issue from an issue
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the parent issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/IssueMeta"
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "423":
+	//     "$ref": "#/responses/repoArchivedError"
+
+	form := web.GetForm(ctx).(*api.IssueMeta)
+	parent := getWritableParamsIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+	child := getWritableSubIssue(ctx, form.Owner, form.Name, form.Index)
+	if ctx.Written() {
+		return
+	}
+
+	if err := issue_service.RemoveSubIssue(ctx, parent, child); err != nil {
+		ctx.APIErrorAuto(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// ReorderSubIssues reorder the sub-issues of an issue
+func ReorderSubIssues(ctx *context.APIContext) {
+	// swagger:operation PUT /repos/{owner}/{repo}/issues/{index}/sub_issues/order issue issueReorderSubIssues
+	// ---
+	// summary: Reorder the sub-issues of an issue
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the parent issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/ReorderSubIssuesOption"
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "400":
+	//     "$ref": "#/responses/error"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "423":
+	//     "$ref": "#/responses/repoArchivedError"
+
+	form := web.GetForm(ctx).(*api.ReorderSubIssuesOption)
+	parent := getWritableParamsIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	if err := issues_model.ReorderSubIssues(ctx, parent.ID, form.IssueIDs); err != nil {
+		ctx.APIErrorAuto(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func getReadableParamsIssue(ctx *context.APIContext) *issues_model.Issue {
+	issue := getParamsIssue(ctx)
+	if ctx.Written() {
+		return nil
+	}
+	if !ctx.Repo.Permission.CanReadIssuesOrPulls(issue.IsPull) {
+		ctx.APIErrorNotFound()
+		return nil
+	}
+	return issue
+}
+
+func getWritableParamsIssue(ctx *context.APIContext) *issues_model.Issue {
+	issue := getReadableParamsIssue(ctx)
+	if ctx.Written() {
+		return nil
+	}
+	if !ctx.Repo.Permission.CanWriteIssuesOrPulls(issue.IsPull) {
+		ctx.APIError(http.StatusForbidden, "no permission to change the sub-issues")
+		return nil
+	}
+	return issue
+}
+
+// getWritableSubIssue returns the issue of the form, an empty owner or repository name refers to the current owner or repository
+func getWritableSubIssue(ctx *context.APIContext, ownerName, repoName string, index int64) *issues_model.Issue {
+	repo := ctx.Repo.Repository
+	if ownerName == "" {
+		ownerName = repo.OwnerName
+	}
+	if repoName == "" {
+		repoName = repo.Name
+	}
+	if !strings.EqualFold(ownerName, repo.OwnerName) || !strings.EqualFold(repoName, repo.Name) {
+		var err error
+		repo, err = repo_model.GetRepositoryByOwnerAndName(ctx, ownerName, repoName)
+		if err != nil {
+			ctx.APIErrorAuto(err)
+			return nil
+		}
+	}
+
+	issue, err := issues_model.GetIssueByIndex(ctx, repo.ID, index)
+	if err != nil {
+		ctx.APIErrorAuto(err)
+		return nil
+	}
+	issue.Repo = repo
+
+	perm := getPermissionForRepo(ctx, repo)
+	if ctx.Written() {
+		return nil
+	}
+	if !perm.CanReadIssuesOrPulls(issue.IsPull) {
+		ctx.APIErrorNotFound()
+		return nil
+	}
+	if !perm.CanWriteIssuesOrPulls(issue.IsPull) {
+		ctx.APIError(http.StatusForbidden, "no permission to change the sub-issue")
+		return nil
+	}
+	if repo.IsArchived {
+		ctx.APIError(http.StatusLocked, "the repository of the sub-issue is archived")
+		return nil
+	}
+	return issue
+}
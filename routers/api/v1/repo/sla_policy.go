@@ -0,0 +1,176 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"gitea.dev/routers/api/v1/shared"
+	"gitea.dev/services/context"
+)
+
+// ListSLAPolicies lists the SLA policies of a repository
+func ListSLAPolicies(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/sla_policies repository repoListSLAPolicies
+	// ---
+	// summary: List the SLA policies of a repository, without those of its owner organization
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/SLAPolicyList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListSLAPolicies(ctx, 0, ctx.Repo.Repository.ID)
+}
+
+// CreateSLAPolicy creates an SLA policy for a repository
+func CreateSLAPolicy(ctx *context.APIContext) {
+	// swagger:operation POST /repos/{owner}/{repo}/sla_policies repository repoCreateSLAPolicy
+	// ---
+	// summary: Create an SLA policy for a repository
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateSLAPolicyOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/SLAPolicy"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateSLAPolicy(ctx, 0, ctx.Repo.Repository.ID)
+}
+
+// GetSLAPolicy gets an SLA policy of a repository
+func GetSLAPolicy(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/sla_policies/{id} repository repoGetSLAPolicy
+	// ---
+	// summary: Get an SLA policy
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the SLA policy
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/SLAPolicy"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.GetSLAPolicy(ctx, 0, ctx.Repo.Repository.ID)
+}
+
+// EditSLAPolicy updates an SLA policy of a repository
+func EditSLAPolicy(ctx *context.APIContext) {
+	// swagger:operation PATCH /repos/{owner}/{repo}/sla_policies/{id} repository repoEditSLAPolicy
+	// ---
+	// summary: Update an SLA policy
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the SLA policy
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditSLAPolicyOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/SLAPolicy"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditSLAPolicy(ctx, 0, ctx.Repo.Repository.ID)
+}
+
+// DeleteSLAPolicy deletes an SLA policy of a repository
+func DeleteSLAPolicy(ctx *context.APIContext) {
+	// swagger:operation DELETE /repos/{owner}/{repo}/sla_policies/{id} repository repoDeleteSLAPolicy
+	// ---
+	// summary: Delete an SLA policy and its reminders
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the SLA policy
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.DeleteSLAPolicy(ctx, 0, ctx.Repo.Repository.ID)
+}
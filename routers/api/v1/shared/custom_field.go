@@ -0,0 +1,108 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package shared
+
+import (
+	"net/http"
+
+	issues_model "gitea.dev/models/issues"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/web"
+	"gitea.dev/services/context"
+	"gitea.dev/services/convert"
+	issue_service "gitea.dev/services/issue"
+)
+
+// ListCustomFields lists the custom fields defined by an organization (orgID) or a repository (repoID)
+func ListCustomFields(ctx *context.APIContext, orgID, repoID int64) {
+	var fields []*issues_model.CustomField
+	var err error
+	if orgID > 0 {
+		fields, err = issues_model.GetCustomFieldsByOrgID(ctx, orgID)
+	} else {
+		fields, err = issues_model.GetCustomFieldsByRepoID(ctx, repoID)
+	}
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.SetTotalCountHeader(int64(len(fields)))
+	ctx.JSON(http.StatusOK, convert.ToCustomFieldList(fields))
+}
+
+func getCustomField(ctx *context.APIContext, orgID, repoID int64) *issues_model.CustomField {
+	field, err := issues_model.GetCustomFieldByID(ctx, ctx.PathParamInt64("id"))
+	if err == nil && (field.OrgID != orgID || field.RepoID != repoID) {
+		err = issues_model.ErrCustomFieldNotExist{ID: field.ID}
+	}
+	if err != nil {
+		ctx.APIErrorAuto(err)
+		return nil
+	}
+	return field
+}
+
+func GetCustomField(ctx *context.APIContext, orgID, repoID int64) {
+	field := getCustomField(ctx, orgID, repoID)
+	if ctx.Written() {
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToCustomField(field))
+}
+
+func CreateCustomField(ctx *context.APIContext, orgID, repoID int64) {
+	form := web.GetForm(ctx).(*api.CreateCustomFieldOption)
+	field := &issues_model.CustomField{
+		OrgID:       orgID,
+		RepoID:      repoID,
+		Name:        form.Name,
+		Description: form.Description,
+		Type:        issues_model.CustomFieldType(form.Type),
+		Options:     form.Options,
+		Sort:        form.Sort,
+	}
+	if err := issues_model.NewCustomField(ctx, field); err != nil {
+		ctx.APIErrorAuto(err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, convert.ToCustomField(field))
+}
+
+func EditCustomField(ctx *context.APIContext, orgID, repoID int64) {
+	form := web.GetForm(ctx).(*api.EditCustomFieldOption)
+	field := getCustomField(ctx, orgID, repoID)
+	if ctx.Written() {
+		return
+	}
+
+	if form.Name != nil {
+		field.Name = *form.Name
+	}
+	if form.Description != nil {
+		field.Description = *form.Description
+	}
+	if form.Options != nil {
+		field.Options = form.Options
+	}
+	if form.Sort != nil {
+		field.Sort = *form.Sort
+	}
+	if err := issue_service.UpdateCustomField(ctx, field); err != nil {
+		ctx.APIErrorAuto(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToCustomField(field))
+}
+
+func DeleteCustomField(ctx *context.APIContext, orgID, repoID int64) {
+	field := getCustomField(ctx, orgID, repoID)
+	if ctx.Written() {
+		return
+	}
+	if err := issue_service.DeleteCustomField(ctx, field); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
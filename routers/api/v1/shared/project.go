@@ -0,0 +1,645 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package shared
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"gitea.dev/models/db"
+	issues_model "gitea.dev/models/issues"
+	access_model "gitea.dev/models/perm/access"
+	project_model "gitea.dev/models/project"
+	"gitea.dev/modules/optional"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/util"
+	"gitea.dev/modules/web"
+	"gitea.dev/routers/api/v1/utils"
+	"gitea.dev/services/context"
+	"gitea.dev/services/convert"
+	project_service "gitea.dev/services/projects"
+)
+
+// getProject returns the project of the "id" path parameter, it has to belong to the repository (repoID),
+// or to the user or organization (ownerID) for the projects which don't belong to a repository
+func getProject(ctx *context.APIContext, ownerID, repoID int64) *project_model.Project {
+	project, err := project_model.GetProjectByID(ctx, ctx.PathParamInt64("id"))
+	if err == nil && (repoID > 0 && project.RepoID != repoID || repoID == 0 && (project.RepoID != 0 || project.OwnerID != ownerID)) {
+		err = project_model.ErrProjectNotExist{ID: project.ID}
+	}
+	if err != nil {
+		ctx.APIErrorAuto(err)
+		return nil
+	}
+	return project
+}
+
+// ListProjects lists the projects of a repository (repoID), or of a user or an organization (ownerID)
+func ListProjects(ctx *context.APIContext, ownerID, repoID int64) {
+	opts := project_model.SearchOptions{
+		ListOptions: utils.GetListOptions(ctx),
+		OwnerID:     ownerID,
+		RepoID:      repoID,
+		OrderBy:     project_model.GetSearchOrderByBySortType(ctx.FormTrim("sort")),
+		Title:       ctx.FormTrim("q"),
+	}
+	switch ctx.FormTrim("state") {
+	case "closed":
+		opts.IsClosed = optional.Some(true)
+	case api.StateAll:
+	default:
+		opts.IsClosed = optional.Some(false)
+	}
+	if repoID > 0 {
+		opts.Type = project_model.TypeRepository
+	} else if ctx.ContextUser.IsOrganization() {
+		opts.Type = project_model.TypeOrganization
+	} else {
+		opts.Type = project_model.TypeIndividual
+	}
+
+	projects, total, err := db.FindAndCount[project_model.Project](ctx, opts)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.SetLinkHeader(total, opts.PageSize)
+	ctx.SetTotalCountHeader(total)
+	ctx.JSON(http.StatusOK, convert.ToAPIProjectList(ctx, projects))
+}
+
+func parseProjectCardType(cardType api.ProjectCardType) (project_model.CardType, error) {
+	switch cardType {
+	case "", api.ProjectCardTypeTextOnly:
+		return project_model.CardTypeTextOnly, nil
+	case api.ProjectCardTypeImagesAndText:
+		return project_model.CardTypeImagesAndText, nil
+	}
+	return 0, util.NewInvalidArgumentErrorf("invalid card type %q", cardType)
+}
+
+func parseProjectTemplateType(templateType api.ProjectTemplate) (project_model.TemplateType, error) {
+	switch templateType {
+	case "", api.ProjectTemplateNone:
+		return project_model.TemplateTypeNone, nil
+	case api.ProjectTemplateBasicKanban:
+		return project_model.TemplateTypeBasicKanban, nil
+	case api.ProjectTemplateBugTriage:
+		return project_model.TemplateTypeBugTriage, nil
+	}
+	return 0, util.NewInvalidArgumentErrorf("invalid template %q", templateType)
+}
+
+// CreateProject creates a project of a repository (repoID), or of a user or an organization (ownerID)
+func CreateProject(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.CreateProjectOption)
+
+	templateType, err := parseProjectTemplateType(form.Template)
+	if err != nil {
+		ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	cardType, err := parseProjectCardType(form.CardType)
+	if err != nil {
+		ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	project := &project_model.Project{
+		OwnerID:      ownerID,
+		RepoID:       repoID,
+		Title:        form.Title,
+		Description:  form.Description,
+		CreatorID:    ctx.Doer.ID,
+		TemplateType: templateType,
+		CardType:     cardType,
+	}
+	if repoID > 0 {
+		project.Type = project_model.TypeRepository
+	} else if ctx.ContextUser.IsOrganization() {
+		project.Type = project_model.TypeOrganization
+	} else {
+		project.Type = project_model.TypeIndividual
+	}
+	if err := project_model.NewProject(ctx, project); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, convert.ToAPIProject(ctx, project))
+}
+
+// GetProject returns a project
+func GetProject(ctx *context.APIContext, ownerID, repoID int64) {
+	project := getProject(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToAPIProject(ctx, project))
+}
+
+// EditProject updates a project and opens or closes it
+func EditProject(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.EditProjectOption)
+	project := getProject(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+
+	if form.Title != nil {
+		if strings.TrimSpace(*form.Title) == "" {
+			ctx.APIError(http.StatusUnprocessableEntity, "title can't be empty")
+			return
+		}
+		project.Title = *form.Title
+	}
+	if form.Description != nil {
+		project.Description = *form.Description
+	}
+	if form.CardType != nil {
+		cardType, err := parseProjectCardType(*form.CardType)
+		if err != nil {
+			ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		project.CardType = cardType
+	}
+	if err := project_model.UpdateProject(ctx, project); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	if form.State != nil {
+		var isClosed bool
+		switch api.StateType(*form.State) {
+		case api.StateOpen:
+		case api.StateClosed:
+			isClosed = true
+		default:
+			ctx.APIError(http.StatusUnprocessableEntity, "invalid state "+*form.State)
+			return
+		}
+		if isClosed != project.IsClosed {
+			if err := project_model.ChangeProjectStatus(ctx, project, isClosed); err != nil {
+				ctx.APIErrorInternal(err)
+				return
+			}
+		}
+	}
+
+	project, err := project_model.GetProjectByID(ctx, project.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToAPIProject(ctx, project))
+}
+
+// DeleteProject deletes a project
+func DeleteProject(ctx *context.APIContext, ownerID, repoID int64) {
+	project := getProject(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+	if err := project_model.DeleteProjectByID(ctx, project.ID); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// ListProjectColumns lists the columns of the board of a project
+func ListProjectColumns(ctx *context.APIContext, ownerID, repoID int64) {
+	project := getProject(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+	columns, err := project.GetColumns(ctx)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.SetTotalCountHeader(int64(len(columns)))
+	ctx.JSON(http.StatusOK, convert.ToAPIProjectColumnList(columns))
+}
+
+func getProjectColumn(ctx *context.APIContext, ownerID, repoID int64) (*project_model.Project, *project_model.Column) {
+	project := getProject(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return nil, nil
+	}
+	column, err := project_model.GetColumnByIDAndProjectID(ctx, ctx.PathParamInt64("column_id"), project.ID)
+	if err != nil {
+		ctx.APIErrorAuto(err)
+		return nil, nil
+	}
+	return project, column
+}
+
+// GetProjectColumn returns a column of the board of a project
+func GetProjectColumn(ctx *context.APIContext, ownerID, repoID int64) {
+	_, column := getProjectColumn(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToAPIProjectColumn(column))
+}
+
+// handleProjectColumnError responds to the errors of the changes of the columns, the invalid arguments are validation errors
+func handleProjectColumnError(ctx *context.APIContext, err error) {
+	if errors.Is(err, util.ErrInvalidArgument) {
+		ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+	} else {
+		ctx.APIErrorInternal(err)
+	}
+}
+
+// CreateProjectColumn adds a column to the board of a project
+func CreateProjectColumn(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.CreateProjectColumnOption)
+	project := getProject(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+
+	column := &project_model.Column{
+		ProjectID: project.ID,
+		Title:     form.Title,
+		Color:     form.Color,
+		CreatorID: ctx.Doer.ID,
+	}
+	if err := project_service.NewColumn(ctx, ctx.Doer, column); err != nil {
+		handleProjectColumnError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, convert.ToAPIProjectColumn(column))
+}
+
+// EditProjectColumn updates a column of the board of a project
+func EditProjectColumn(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.EditProjectColumnOption)
+	_, column := getProjectColumn(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+
+	if form.Title != nil || form.Color != nil || form.Sorting != nil {
+		if form.Title != nil {
+			if strings.TrimSpace(*form.Title) == "" {
+				ctx.APIError(http.StatusUnprocessableEntity, "title can't be empty")
+				return
+			}
+			column.Title = *form.Title
+		}
+		if form.Color != nil {
+			column.Color = *form.Color
+		}
+		if form.Sorting != nil {
+			if *form.Sorting < 0 || *form.Sorting > 127 {
+				ctx.APIError(http.StatusUnprocessableEntity, "sorting has to be between 0 and 127")
+				return
+			}
+			column.Sorting = int8(*form.Sorting)
+		}
+		if err := project_service.UpdateColumn(ctx, ctx.Doer, column); err != nil {
+			handleProjectColumnError(ctx, err)
+			return
+		}
+	}
+
+	if form.Default != nil && *form.Default != column.Default {
+		if !*form.Default {
+			ctx.APIError(http.StatusUnprocessableEntity, "the default column can't be unset, another column has to be made the default column")
+			return
+		}
+		if err := project_service.SetDefaultColumn(ctx, ctx.Doer, column); err != nil {
+			handleProjectColumnError(ctx, err)
+			return
+		}
+	}
+
+	column, err := project_model.GetColumn(ctx, column.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToAPIProjectColumn(column))
+}
+
+// DeleteProjectColumn deletes a column of the board of a project, its items are moved to the default column
+func DeleteProjectColumn(ctx *context.APIContext, ownerID, repoID int64) {
+	_, column := getProjectColumn(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+	if err := project_service.DeleteColumn(ctx, ctx.Doer, column); err != nil {
+		handleProjectColumnError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// ListProjectColumnItems lists the issues and pull requests of a column of a project in their order
+func ListProjectColumnItems(ctx *context.APIContext, ownerID, repoID int64) {
+	project, column := getProjectColumn(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+	listProjectItems(ctx, project, column, repoID)
+}
+
+// ListProjectItems lists the issues and pull requests of a project in the order of its board
+func ListProjectItems(ctx *context.APIContext, ownerID, repoID int64) {
+	project := getProject(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+	listProjectItems(ctx, project, nil, repoID)
+}
+
+// listProjectItems lists the issues and pull requests of a project which the doer can read, or only the ones of a column if it isn't nil
+func listProjectItems(ctx *context.APIContext, project *project_model.Project, onlyColumn *project_model.Column, repoID int64) {
+
+	opts := &issues_model.IssuesOptions{}
+	if repoID > 0 {
+		opts.RepoIDs = []int64{repoID}
+	} else {
+		if err := project.LoadOwner(ctx); err != nil {
+			ctx.APIErrorInternal(err)
+			return
+		}
+		opts.Owner = project.Owner
+		if ctx.Doer != nil {
+			opts.Doer = ctx.Doer
+		} else {
+			opts.AllPublic = true
+		}
+	}
+	columns, err := project.GetColumns(ctx)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	issuesMap, err := project_service.LoadIssuesFromProject(ctx, project, opts)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	projectIssues, err := project_model.GetProjectIssueMap(ctx, project.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	var issues issues_model.IssueList
+	issueColumnIDs := make(map[int64]int64)
+	for _, column := range columns {
+		if onlyColumn != nil && column.ID != onlyColumn.ID {
+			continue
+		}
+		for _, issue := range issuesMap[column.ID] {
+			if repoID > 0 && !ctx.Repo.Permission.CanReadIssuesOrPulls(issue.IsPull) {
+				continue
+			}
+			issues = append(issues, issue)
+			issueColumnIDs[issue.ID] = column.ID
+		}
+	}
+	total := len(issues)
+	listOptions := utils.GetListOptions(ctx)
+	skip, take := listOptions.GetSkipTake()
+	issues = issues[min(skip, total):min(skip+take, total)]
+
+	items := make([]*api.ProjectItem, 0, len(issues))
+	for i, apiIssue := range convert.ToAPIIssueList(ctx, ctx.Doer, issues) {
+		item := &api.ProjectItem{Issue: apiIssue, ColumnID: issueColumnIDs[issues[i].ID]}
+		if projectIssue := projectIssues[issues[i].ID]; projectIssue != nil {
+			item.Sorting = projectIssue.Sorting
+		}
+		items = append(items, item)
+	}
+	ctx.SetLinkHeader(int64(total), listOptions.PageSize)
+	ctx.SetTotalCountHeader(int64(total))
+	ctx.JSON(http.StatusOK, items)
+}
+
+// getProjectItemIssue returns the issue or pull request of an item which is added to or removed from the project,
+// the doer has to be able to change the issue
+func getProjectItemIssue(ctx *context.APIContext, project *project_model.Project, issueID int64, notExistStatus int) *issues_model.Issue {
+	issue, err := issues_model.GetIssueByID(ctx, issueID)
+	if err != nil {
+		if issues_model.IsErrIssueNotExist(err) {
+			ctx.APIError(notExistStatus, err.Error())
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return nil
+	}
+	if err := issue.LoadRepo(ctx); err != nil {
+		ctx.APIErrorInternal(err)
+		return nil
+	}
+	perm, err := access_model.GetDoerRepoPermission(ctx, issue.Repo, ctx.Doer)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return nil
+	}
+	if !perm.CanReadIssuesOrPulls(issue.IsPull) {
+		ctx.APIError(notExistStatus, issues_model.ErrIssueNotExist{ID: issue.ID}.Error())
+		return nil
+	}
+	if !perm.CanWriteIssuesOrPulls(issue.IsPull) {
+		ctx.APIError(http.StatusForbidden, "user should have a permission to write to the issue")
+		return nil
+	}
+	if !project.CanBeAccessedByOwnerRepo(issue.Repo.OwnerID, issue.Repo) {
+		ctx.APIError(http.StatusUnprocessableEntity, "the issue can't be added to the project")
+		return nil
+	}
+	return issue
+}
+
+// AddProjectItem adds an issue or a pull request to a project or moves it to another column of the project
+func AddProjectItem(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.AddProjectItemOption)
+	project := getProject(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+	issue := getProjectItemIssue(ctx, project, form.IssueID, http.StatusUnprocessableEntity)
+	if ctx.Written() {
+		return
+	}
+
+	if err := project_service.AddIssueToProject(ctx, ctx.Doer, project, issue, form.ColumnID, optional.FromPtr(form.Sorting)); err != nil {
+		if project_model.IsErrProjectColumnNotExist(err) {
+			ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+
+	projectIssues, err := project_model.GetProjectIssueMap(ctx, project.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	item := &api.ProjectItem{Issue: convert.ToAPIIssue(ctx, ctx.Doer, issue)}
+	if projectIssue := projectIssues[issue.ID]; projectIssue != nil {
+		item.ColumnID = projectIssue.ProjectColumnID
+		item.Sorting = projectIssue.Sorting
+	}
+	ctx.JSON(http.StatusCreated, item)
+}
+
+// RemoveProjectItem removes an issue or a pull request from a project
+func RemoveProjectItem(ctx *context.APIContext, ownerID, repoID int64) {
+	project := getProject(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+	issue := getProjectItemIssue(ctx, project, ctx.PathParamInt64("issue_id"), http.StatusNotFound)
+	if ctx.Written() {
+		return
+	}
+
+	if err := project_service.RemoveIssueFromProject(ctx, ctx.Doer, project, issue); err != nil {
+		ctx.APIErrorAuto(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// ListProjectViews lists the views of a project
+func ListProjectViews(ctx *context.APIContext, ownerID, repoID int64) {
+	project := getProject(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+	views, err := project_model.GetViewsByProjectID(ctx, project.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.SetTotalCountHeader(int64(len(views)))
+	ctx.JSON(http.StatusOK, convert.ToAPIProjectViewList(views))
+}
+
+func getProjectView(ctx *context.APIContext, ownerID, repoID int64) (*project_model.Project, *project_model.View) {
+	project := getProject(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return nil, nil
+	}
+	view, err := project_model.GetViewByIDAndProjectID(ctx, ctx.PathParamInt64("view_id"), project.ID)
+	if err != nil {
+		ctx.APIErrorAuto(err)
+		return nil, nil
+	}
+	return project, view
+}
+
+// GetProjectView returns a view of a project
+func GetProjectView(ctx *context.APIContext, ownerID, repoID int64) {
+	_, view := getProjectView(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToAPIProjectView(view))
+}
+
+func saveProjectView(ctx *context.APIContext, project *project_model.Project, view *project_model.View, save func() error) bool {
+	customFields, err := project_service.GetProjectCustomFields(ctx, project)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return false
+	}
+	if err = project_service.ValidateViewCustomFields(view, customFields); err == nil {
+		err = save()
+	}
+	if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return false
+	}
+	return true
+}
+
+// CreateProjectView creates a view of a project
+func CreateProjectView(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.CreateProjectViewOption)
+	project := getProject(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+	view := &project_model.View{
+		ProjectID: project.ID,
+		Name:      form.Name,
+		Layout:    project_model.ViewLayout(form.Layout),
+		Fields:    form.Fields,
+		GroupBy:   form.GroupBy,
+		SortBy:    form.SortBy,
+		SortDesc:  form.SortDesc,
+		Filter:    form.Filter,
+		Sort:      form.Sort,
+	}
+	if !saveProjectView(ctx, project, view, func() error { return project_model.NewView(ctx, view) }) {
+		return
+	}
+	ctx.JSON(http.StatusCreated, convert.ToAPIProjectView(view))
+}
+
+// EditProjectView updates a view of a project
+func EditProjectView(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.EditProjectViewOption)
+	project, view := getProjectView(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+
+	if form.Name != nil {
+		view.Name = *form.Name
+	}
+	if form.Layout != nil {
+		view.Layout = project_model.ViewLayout(*form.Layout)
+	}
+	if form.Fields != nil {
+		view.Fields = form.Fields
+	}
+	if form.GroupBy != nil {
+		view.GroupBy = *form.GroupBy
+	}
+	if form.SortBy != nil {
+		view.SortBy = *form.SortBy
+	}
+	if form.SortDesc != nil {
+		view.SortDesc = *form.SortDesc
+	}
+	if form.Filter != nil {
+		view.Filter = *form.Filter
+	}
+	if form.Sort != nil {
+		view.Sort = *form.Sort
+	}
+	if !saveProjectView(ctx, project, view, func() error { return project_model.UpdateView(ctx, view) }) {
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToAPIProjectView(view))
+}
+
+// DeleteProjectView deletes a view of a project
+func DeleteProjectView(ctx *context.APIContext, ownerID, repoID int64) {
+	_, view := getProjectView(ctx, ownerID, repoID)
+	if ctx.Written() {
+		return
+	}
+	if err := project_model.DeleteViewByID(ctx, view.ID); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
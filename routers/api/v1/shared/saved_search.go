@@ -0,0 +1,143 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package shared
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"gitea.dev/models/db"
+	issues_model "gitea.dev/models/issues"
+	user_model "gitea.dev/models/user"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/util"
+	"gitea.dev/modules/web"
+	"gitea.dev/routers/api/v1/utils"
+	"gitea.dev/services/context"
+	"gitea.dev/services/convert"
+	issue_service "gitea.dev/services/issue"
+)
+
+func ListSavedSearches(ctx *context.APIContext, owner *user_model.User) {
+	listOptions := utils.GetListOptions(ctx)
+	searches, total, err := db.FindAndCount[issues_model.SavedSearch](ctx, issues_model.FindSavedSearchesOptions{
+		ListOptions: listOptions,
+		OwnerID:     owner.ID,
+	})
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	ids := make([]int64, 0, len(searches))
+	for _, s := range searches {
+		ids = append(ids, s.ID)
+	}
+	subscribedIDs, err := issues_model.GetSubscribedSavedSearchIDs(ctx, ctx.Doer.ID, ids)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	apiSearches := make([]*api.SavedSearch, 0, len(searches))
+	for _, s := range searches {
+		apiSearches = append(apiSearches, convert.ToSavedSearch(s, slices.Contains(subscribedIDs, s.ID)))
+	}
+
+	ctx.SetLinkHeader(total, listOptions.PageSize)
+	ctx.SetTotalCountHeader(total)
+	ctx.JSON(http.StatusOK, &apiSearches)
+}
+
+func getSavedSearch(ctx *context.APIContext, owner *user_model.User) *issues_model.SavedSearch {
+	s, err := issues_model.GetSavedSearchByID(ctx, owner.ID, ctx.PathParamInt64("id"))
+	if err != nil {
+		ctx.APIErrorAuto(err)
+		return nil
+	}
+	return s
+}
+
+func writeSavedSearch(ctx *context.APIContext, status int, s *issues_model.SavedSearch) {
+	subscribedIDs, err := issues_model.GetSubscribedSavedSearchIDs(ctx, ctx.Doer.ID, []int64{s.ID})
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.JSON(status, convert.ToSavedSearch(s, len(subscribedIDs) > 0))
+}
+
+func GetSavedSearch(ctx *context.APIContext, owner *user_model.User) {
+	s := getSavedSearch(ctx, owner)
+	if ctx.Written() {
+		return
+	}
+	writeSavedSearch(ctx, http.StatusOK, s)
+}
+
+func CreateSavedSearch(ctx *context.APIContext, owner *user_model.User) {
+	form := web.GetForm(ctx).(*api.CreateSavedSearchOption)
+	s, err := issue_service.CreateSavedSearch(ctx, ctx.Doer, owner, form.Name, form.Query, form.Pinned)
+	if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+	writeSavedSearch(ctx, http.StatusCreated, s)
+}
+
+func EditSavedSearch(ctx *context.APIContext, owner *user_model.User) {
+	s := getSavedSearch(ctx, owner)
+	if ctx.Written() {
+		return
+	}
+
+	form := web.GetForm(ctx).(*api.EditSavedSearchOption)
+	if form.Name != nil {
+		s.Name = *form.Name
+	}
+	if form.Query != nil {
+		s.Query = *form.Query
+	}
+	if form.Pinned != nil {
+		s.IsPinned = *form.Pinned
+	}
+	if err := issue_service.UpdateSavedSearch(ctx, s); err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+	writeSavedSearch(ctx, http.StatusOK, s)
+}
+
+func DeleteSavedSearch(ctx *context.APIContext, owner *user_model.User) {
+	s := getSavedSearch(ctx, owner)
+	if ctx.Written() {
+		return
+	}
+	if err := issues_model.DeleteSavedSearch(ctx, s.ID); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func SubscribeSavedSearch(ctx *context.APIContext, owner *user_model.User, subscribe bool) {
+	s := getSavedSearch(ctx, owner)
+	if ctx.Written() {
+		return
+	}
+	if err := issue_service.SubscribeSavedSearch(ctx, s, ctx.Doer, subscribe); err != nil {
+		ctx.APIErrorAuto(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
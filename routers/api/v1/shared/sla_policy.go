@@ -0,0 +1,112 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package shared
+
+import (
+	"errors"
+	"net/http"
+
+	issues_model "gitea.dev/models/issues"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/util"
+	"gitea.dev/modules/web"
+	"gitea.dev/services/context"
+	"gitea.dev/services/convert"
+	sla_service "gitea.dev/services/sla"
+)
+
+// ListSLAPolicies lists the SLA policies defined by an organization (orgID) or a repository (repoID)
+func ListSLAPolicies(ctx *context.APIContext, orgID, repoID int64) {
+	policies, err := issues_model.GetSLAPoliciesByScope(ctx, orgID, repoID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.SetTotalCountHeader(int64(len(policies)))
+	ctx.JSON(http.StatusOK, convert.ToSLAPolicyList(policies))
+}
+
+func getSLAPolicy(ctx *context.APIContext, orgID, repoID int64) *issues_model.SLAPolicy {
+	policy, err := issues_model.GetSLAPolicyByID(ctx, ctx.PathParamInt64("id"))
+	if err == nil && (policy.OrgID != orgID || policy.RepoID != repoID) {
+		err = issues_model.ErrSLAPolicyNotExist{ID: policy.ID}
+	}
+	if err != nil {
+		ctx.APIErrorAuto(err)
+		return nil
+	}
+	return policy
+}
+
+// handleSLAPolicyError reports the invalid fields of a policy, a label which doesn't exist is invalid too
+func handleSLAPolicyError(ctx *context.APIContext, err error) {
+	if errors.Is(err, util.ErrInvalidArgument) || errors.Is(err, util.ErrNotExist) {
+		ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	ctx.APIErrorInternal(err)
+}
+
+func GetSLAPolicy(ctx *context.APIContext, orgID, repoID int64) {
+	policy := getSLAPolicy(ctx, orgID, repoID)
+	if ctx.Written() {
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToSLAPolicy(policy))
+}
+
+func CreateSLAPolicy(ctx *context.APIContext, orgID, repoID int64) {
+	form := web.GetForm(ctx).(*api.CreateSLAPolicyOption)
+	policy := &issues_model.SLAPolicy{
+		OrgID:              orgID,
+		RepoID:             repoID,
+		Name:               form.Name,
+		LabelID:            form.LabelID,
+		FirstResponseHours: form.FirstResponseHours,
+		ResolutionDays:     form.ResolutionDays,
+	}
+	if err := sla_service.CreatePolicy(ctx, policy); err != nil {
+		handleSLAPolicyError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, convert.ToSLAPolicy(policy))
+}
+
+func EditSLAPolicy(ctx *context.APIContext, orgID, repoID int64) {
+	form := web.GetForm(ctx).(*api.EditSLAPolicyOption)
+	policy := getSLAPolicy(ctx, orgID, repoID)
+	if ctx.Written() {
+		return
+	}
+
+	if form.Name != nil {
+		policy.Name = *form.Name
+	}
+	if form.LabelID != nil {
+		policy.LabelID = *form.LabelID
+	}
+	if form.FirstResponseHours != nil {
+		policy.FirstResponseHours = *form.FirstResponseHours
+	}
+	if form.ResolutionDays != nil {
+		policy.ResolutionDays = *form.ResolutionDays
+	}
+	if err := sla_service.UpdatePolicy(ctx, policy); err != nil {
+		handleSLAPolicyError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToSLAPolicy(policy))
+}
+
+func DeleteSLAPolicy(ctx *context.APIContext, orgID, repoID int64) {
+	policy := getSLAPolicy(ctx, orgID, repoID)
+	if ctx.Written() {
+		return
+	}
+	if err := issues_model.DeleteSLAPolicy(ctx, policy); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
@@ -70,6 +70,62 @@ type swaggerResponseMilestoneList struct {
 	Body []api.Milestone `json:"body"`
 }
 
+// SavedSearch
+// swagger:response SavedSearch
+type swaggerResponseSavedSearch struct {
+	// in:body
+	Body api.SavedSearch `json:"body"`
+}
+
+// SavedSearchList
+// swagger:response SavedSearchList
+type swaggerResponseSavedSearchList struct {
+	// in:body
+	Body []api.SavedSearch `json:"body"`
+}
+
+// SubIssueProgress
+// swagger:response SubIssueProgress
+type swaggerResponseSubIssueProgress struct {
+	// in:body
+	Body api.SubIssueProgress `json:"body"`
+}
+
+// CustomField
+// swagger:response CustomField
+type swaggerResponseCustomField struct {
+	// in:body
+	Body api.CustomField `json:"body"`
+}
+
+// CustomFieldList
+// swagger:response CustomFieldList
+type swaggerResponseCustomFieldList struct {
+	// in:body
+	Body []api.CustomField `json:"body"`
+}
+
+// IssueCustomFieldValueList
+// swagger:response IssueCustomFieldValueList
+type swaggerResponseIssueCustomFieldValueList struct {
+	// in:body
+	Body []api.IssueCustomFieldValue `json:"body"`
+}
+
+// IssueType
+// swagger:response IssueType
+type swaggerResponseIssueType struct {
+	// in:body
+	Body api.IssueType `json:"body"`
+}
+
+// IssueTypeList
+// swagger:response IssueTypeList
+type swaggerResponseIssueTypeList struct {
+	// in:body
+	Body []api.IssueType `json:"body"`
+}
+
 // TrackedTime
 // swagger:response TrackedTime
 type swaggerResponseTrackedTime struct {
@@ -125,3 +181,45 @@ type swaggerReactionList struct {
 	// in:body
 	Body []api.Reaction `json:"body"`
 }
+
+// AutomationRule
+// swagger:response AutomationRule
+type swaggerResponseAutomationRule struct {
+	// in:body
+	Body api.AutomationRule `json:"body"`
+}
+
+// AutomationRuleList
+// swagger:response AutomationRuleList
+type swaggerResponseAutomationRuleList struct {
+	// in:body
+	Body []api.AutomationRule `json:"body"`
+}
+
+// AutomationRuleLogList
+// swagger:response AutomationRuleLogList
+type swaggerResponseAutomationRuleLogList struct {
+	// in:body
+	Body []api.AutomationRuleLog `json:"body"`
+}
+
+// SLAPolicy
+// swagger:response SLAPolicy
+type swaggerResponseSLAPolicy struct {
+	// in:body
+	Body api.SLAPolicy `json:"body"`
+}
+
+// SLAPolicyList
+// swagger:response SLAPolicyList
+type swaggerResponseSLAPolicyList struct {
+	// in:body
+	Body []api.SLAPolicy `json:"body"`
+}
+
+// IssueSLAList
+// swagger:response IssueSLAList
+type swaggerResponseIssueSLAList struct {
+	// in:body
+	Body []api.IssueSLA `json:"body"`
+}
@@ -45,6 +45,22 @@ type swaggerParameterBodies struct {
 	EditIssueCommentOption api.EditIssueCommentOption
 	// in:body
 	IssueMeta api.IssueMeta
+	// in:body
+	AddSubIssueOption api.AddSubIssueOption
+	// in:body
+	ReorderSubIssuesOption api.ReorderSubIssuesOption
+
+	// in:body
+	CreateCustomFieldOption api.CreateCustomFieldOption
+	// in:body
+	EditCustomFieldOption api.EditCustomFieldOption
+	// in:body
+	SetIssueCustomFieldsOption api.SetIssueCustomFieldsOption
+
+	// in:body
+	CreateIssueTypeOption api.CreateIssueTypeOption
+	// in:body
+	EditIssueTypeOption api.EditIssueTypeOption
 
 	// in:body
 	IssueLabelsOption api.IssueLabelsOption
@@ -70,6 +86,11 @@ type swaggerParameterBodies struct {
 	// in:body
 	EditMilestoneOption api.EditMilestoneOption
 
+	// in:body
+	CreateSavedSearchOption api.CreateSavedSearchOption
+	// in:body
+	EditSavedSearchOption api.EditSavedSearchOption
+
 	// in:body
 	CreateOrgOption api.CreateOrgOption
 	// in:body
@@ -235,4 +256,41 @@ type swaggerParameterBodies struct {
 
 	// in:body
 	LockIssueOption api.LockIssueOption
+
+	// in:body
+	CreatePackageProtectionRuleOption api.CreatePackageProtectionRuleOption
+
+	// in:body
+	EditPackageProtectionRuleOption api.EditPackageProtectionRuleOption
+
+	// in:body
+	CreatePackageSnapshotOption api.CreatePackageSnapshotOption
+
+	// in:body
+	PromotePackagesOption api.PromotePackagesOption
+
+	// in:body
+	CreateProjectOption api.CreateProjectOption
+	// in:body
+	EditProjectOption api.EditProjectOption
+	// in:body
+	CreateProjectColumnOption api.CreateProjectColumnOption
+	// in:body
+	EditProjectColumnOption api.EditProjectColumnOption
+	// in:body
+	AddProjectItemOption api.AddProjectItemOption
+	// in:body
+	CreateProjectViewOption api.CreateProjectViewOption
+	// in:body
+	EditProjectViewOption api.EditProjectViewOption
+
+	// in:body
+	CreateAutomationRuleOption api.CreateAutomationRuleOption
+	// in:body
+	EditAutomationRuleOption api.EditAutomationRuleOption
+
+	// in:body
+	CreateSLAPolicyOption api.CreateSLAPolicyOption
+	// in:body
+	EditSLAPolicyOption api.EditSLAPolicyOption
 }
@@ -27,3 +27,59 @@ type swaggerResponsePackageFileList struct {
 	// in:body
 	Body []api.PackageFile `json:"body"`
 }
+
+// PackageProtectionRule
+// swagger:response PackageProtectionRule
+type swaggerResponsePackageProtectionRule struct {
+	// in:body
+	Body api.PackageProtectionRule `json:"body"`
+}
+
+// PackageProtectionRuleList
+// swagger:response PackageProtectionRuleList
+type swaggerResponsePackageProtectionRuleList struct {
+	// in:body
+	Body []api.PackageProtectionRule `json:"body"`
+}
+
+// PackageDownloadStatList
+// swagger:response PackageDownloadStatList
+type swaggerResponsePackageDownloadStatList struct {
+	// in:body
+	Body []api.PackageDownloadStat `json:"body"`
+}
+
+// PackageAuditEventList
+// swagger:response PackageAuditEventList
+type swaggerResponsePackageAuditEventList struct {
+	// in:body
+	Body []api.PackageAuditEvent `json:"body"`
+}
+
+// PackageDependencyList
+// swagger:response PackageDependencyList
+type swaggerResponsePackageDependencyList struct {
+	// in:body
+	Body []api.PackageDependency `json:"body"`
+}
+
+// PackageVulnerabilityList
+// swagger:response PackageVulnerabilityList
+type swaggerResponsePackageVulnerabilityList struct {
+	// in:body
+	Body []api.PackageVulnerability `json:"body"`
+}
+
+// PackageSnapshot
+// swagger:response PackageSnapshot
+type swaggerResponsePackageSnapshot struct {
+	// in:body
+	Body api.PackageSnapshot `json:"body"`
+}
+
+// PackageSnapshotList
+// swagger:response PackageSnapshotList
+type swaggerResponsePackageSnapshotList struct {
+	// in:body
+	Body []api.PackageSnapshot `json:"body"`
+}
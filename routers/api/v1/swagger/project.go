@@ -0,0 +1,64 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package swagger
+
+import (
+	api "gitea.dev/modules/structs"
+)
+
+// Project
+// swagger:response Project
+type swaggerResponseProject struct {
+	// in:body
+	Body api.Project `json:"body"`
+}
+
+// ProjectList
+// swagger:response ProjectList
+type swaggerResponseProjectList struct {
+	// in:body
+	Body []api.Project `json:"body"`
+}
+
+// ProjectColumn
+// swagger:response ProjectColumn
+type swaggerResponseProjectColumn struct {
+	// in:body
+	Body api.ProjectColumn `json:"body"`
+}
+
+// ProjectColumnList
+// swagger:response ProjectColumnList
+type swaggerResponseProjectColumnList struct {
+	// in:body
+	Body []api.ProjectColumn `json:"body"`
+}
+
+// ProjectItem
+// swagger:response ProjectItem
+type swaggerResponseProjectItem struct {
+	// in:body
+	Body api.ProjectItem `json:"body"`
+}
+
+// ProjectItemList
+// swagger:response ProjectItemList
+type swaggerResponseProjectItemList struct {
+	// in:body
+	Body []api.ProjectItem `json:"body"`
+}
+
+// ProjectView
+// swagger:response ProjectView
+type swaggerResponseProjectView struct {
+	// in:body
+	Body api.ProjectView `json:"body"`
+}
+
+// ProjectViewList
+// swagger:response ProjectViewList
+type swaggerResponseProjectViewList struct {
+	// in:body
+	Body []api.ProjectView `json:"body"`
+}
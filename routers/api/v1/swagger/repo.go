@@ -196,6 +196,13 @@ type swaggerResponseCommitStatusList struct {
 	Body []api.CommitStatus `json:"body"`
 }
 
+// CodeNavigationIndex
+// swagger:response CodeNavigationIndex
+type swaggerResponseCodeNavigationIndex struct {
+	// in:body
+	Body api.CodeNavigationIndex `json:"body"`
+}
+
 // WatchInfo
 // swagger:response WatchInfo
 type swaggerResponseWatchInfo struct {
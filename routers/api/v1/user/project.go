@@ -0,0 +1,681 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package user
+
+import (
+	"gitea.dev/routers/api/v1/shared"
+	"gitea.dev/services/context"
+)
+
+// ListProjects lists the projects of the user
+func ListProjects(ctx *context.APIContext) {
+	// swagger:operation GET /users/{username}/projects project userListProjects
+	// ---
+	// summary: List the projects of the user
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: state
+	//   in: query
+	//   description: state of the projects, open by default
+	//   type: string
+	//   enum: [open, closed, all]
+	// - name: q
+	//   in: query
+	//   description: search the projects by title
+	//   type: string
+	// - name: sort
+	//   in: query
+	//   description: sort order of the projects, newest by default
+	//   type: string
+	//   enum: [newest, oldest, recentupdate, leastupdate, alphabetically, reversealphabetically]
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListProjects(ctx, ctx.ContextUser.ID, 0)
+}
+
+// CreateProject creates a project of the user
+func CreateProject(ctx *context.APIContext) {
+	// swagger:operation POST /users/{username}/projects project userCreateProject
+	// ---
+	// summary: Create a project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateProjectOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/Project"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateProject(ctx, ctx.ContextUser.ID, 0)
+}
+
+// GetProject gets a user project
+func GetProject(ctx *context.APIContext) {
+	// swagger:operation GET /users/{username}/projects/{id} project userGetProject
+	// ---
+	// summary: Get a project
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/Project"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.GetProject(ctx, ctx.ContextUser.ID, 0)
+}
+
+// EditProject updates a user project
+func EditProject(ctx *context.APIContext) {
+	// swagger:operation PATCH /users/{username}/projects/{id} project userEditProject
+	// ---
+	// summary: Update a project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditProjectOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/Project"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditProject(ctx, ctx.ContextUser.ID, 0)
+}
+
+// DeleteProject deletes a user project
+func DeleteProject(ctx *context.APIContext) {
+	// swagger:operation DELETE /users/{username}/projects/{id} project userDeleteProject
+	// ---
+	// summary: Delete a project
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.DeleteProject(ctx, ctx.ContextUser.ID, 0)
+}
+
+// ListProjectColumns lists the columns of a user project
+func ListProjectColumns(ctx *context.APIContext) {
+	// swagger:operation GET /users/{username}/projects/{id}/columns project userListProjectColumns
+	// ---
+	// summary: List the columns of the board of a project
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectColumnList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListProjectColumns(ctx, ctx.ContextUser.ID, 0)
+}
+
+// CreateProjectColumn adds a column to a user project
+func CreateProjectColumn(ctx *context.APIContext) {
+	// swagger:operation POST /users/{username}/projects/{id}/columns project userCreateProjectColumn
+	// ---
+	// summary: Add a column to the board of a project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateProjectColumnOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/ProjectColumn"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateProjectColumn(ctx, ctx.ContextUser.ID, 0)
+}
+
+// GetProjectColumn gets a column of a user project
+func GetProjectColumn(ctx *context.APIContext) {
+	// swagger:operation GET /users/{username}/projects/{id}/columns/{column_id} project userGetProjectColumn
+	// ---
+	// summary: Get a column of the board of a project
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: column_id
+	//   in: path
+	//   description: id of the column
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectColumn"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.GetProjectColumn(ctx, ctx.ContextUser.ID, 0)
+}
+
+// EditProjectColumn updates a column of a user project
+func EditProjectColumn(ctx *context.APIContext) {
+	// swagger:operation PATCH /users/{username}/projects/{id}/columns/{column_id} project userEditProjectColumn
+	// ---
+	// summary: Update a column of the board of a project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: column_id
+	//   in: path
+	//   description: id of the column
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditProjectColumnOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectColumn"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditProjectColumn(ctx, ctx.ContextUser.ID, 0)
+}
+
+// DeleteProjectColumn deletes a column of a user project
+func DeleteProjectColumn(ctx *context.APIContext) {
+	// swagger:operation DELETE /users/{username}/projects/{id}/columns/{column_id} project userDeleteProjectColumn
+	// ---
+	// summary: Delete a column of the board of a project, its items are moved to the default column
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: column_id
+	//   in: path
+	//   description: id of the column
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.DeleteProjectColumn(ctx, ctx.ContextUser.ID, 0)
+}
+
+// ListProjectColumnItems lists the issues and pull requests of a column of a user project
+func ListProjectColumnItems(ctx *context.APIContext) {
+	// swagger:operation GET /users/{username}/projects/{id}/columns/{column_id}/items project userListProjectColumnItems
+	// ---
+	// summary: List the issues and pull requests of a column of a project in their order
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: column_id
+	//   in: path
+	//   description: id of the column
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectItemList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListProjectColumnItems(ctx, ctx.ContextUser.ID, 0)
+}
+
+// ListProjectItems lists the issues and pull requests of a user project
+func ListProjectItems(ctx *context.APIContext) {
+	// swagger:operation GET /users/{username}/projects/{id}/items project userListProjectItems
+	// ---
+	// summary: List the issues and pull requests of a project in the order of its board
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectItemList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListProjectItems(ctx, ctx.ContextUser.ID, 0)
+}
+
+// AddProjectItem adds an issue or a pull request to a user project
+func AddProjectItem(ctx *context.APIContext) {
+	// swagger:operation POST /users/{username}/projects/{id}/items project userAddProjectItem
+	// ---
+	// summary: Add an issue or a pull request to a project, or move it to another column of the project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/AddProjectItemOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/ProjectItem"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.AddProjectItem(ctx, ctx.ContextUser.ID, 0)
+}
+
+// RemoveProjectItem removes an issue or a pull request from a user project
+func RemoveProjectItem(ctx *context.APIContext) {
+	// swagger:operation DELETE /users/{username}/projects/{id}/items/{issue_id} project userRemoveProjectItem
+	// ---
+	// summary: Remove an issue or a pull request from a project
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: issue_id
+	//   in: path
+	//   description: id (not the index) of the issue or pull request
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.RemoveProjectItem(ctx, ctx.ContextUser.ID, 0)
+}
+
+// ListProjectViews lists the views of a user project
+func ListProjectViews(ctx *context.APIContext) {
+	// swagger:operation GET /users/{username}/projects/{id}/views project userListProjectViews
+	// ---
+	// summary: List the views of a project
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectViewList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListProjectViews(ctx, ctx.ContextUser.ID, 0)
+}
+
+// CreateProjectView creates a view of a user project
+func CreateProjectView(ctx *context.APIContext) {
+	// swagger:operation POST /users/{username}/projects/{id}/views project userCreateProjectView
+	// ---
+	// summary: Create a view of a project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateProjectViewOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/ProjectView"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateProjectView(ctx, ctx.ContextUser.ID, 0)
+}
+
+// GetProjectView gets a view of a user project
+func GetProjectView(ctx *context.APIContext) {
+	// swagger:operation GET /users/{username}/projects/{id}/views/{view_id} project userGetProjectView
+	// ---
+	// summary: Get a view of a project
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: view_id
+	//   in: path
+	//   description: id of the view
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectView"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.GetProjectView(ctx, ctx.ContextUser.ID, 0)
+}
+
+// EditProjectView updates a view of a user project
+func EditProjectView(ctx *context.APIContext) {
+	// swagger:operation PATCH /users/{username}/projects/{id}/views/{view_id} project userEditProjectView
+	// ---
+	// summary: Update a view of a project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: view_id
+	//   in: path
+	//   description: id of the view
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditProjectViewOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectView"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditProjectView(ctx, ctx.ContextUser.ID, 0)
+}
+
+// DeleteProjectView deletes a view of a user project
+func DeleteProjectView(ctx *context.APIContext) {
+	// swagger:operation DELETE /users/{username}/projects/{id}/views/{view_id} project userDeleteProjectView
+	// ---
+	// summary: Delete a view of a project
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: view_id
+	//   in: path
+	//   description: id of the view
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.DeleteProjectView(ctx, ctx.ContextUser.ID, 0)
+}
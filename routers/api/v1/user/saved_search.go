@@ -0,0 +1,178 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package user
+
+import (
+	"gitea.dev/routers/api/v1/shared"
+	"gitea.dev/services/context"
+)
+
+// ListSavedSearches lists the saved issue searches of the authenticated user
+func ListSavedSearches(ctx *context.APIContext) {
+	// swagger:operation GET /user/saved_searches user userListSavedSearches
+	// ---
+	// summary: List the saved issue searches of the authenticated user
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/SavedSearchList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListSavedSearches(ctx, ctx.Doer)
+}
+
+// CreateSavedSearch saves an issue search for the authenticated user
+func CreateSavedSearch(ctx *context.APIContext) {
+	// swagger:operation POST /user/saved_searches user userCreateSavedSearch
+	// ---
+	// summary: Save an issue search for the authenticated user
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateSavedSearchOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/SavedSearch"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateSavedSearch(ctx, ctx.Doer)
+}
+
+// GetSavedSearch gets a saved issue search of the authenticated user
+func GetSavedSearch(ctx *context.APIContext) {
+	// swagger:operation GET /user/saved_searches/{id} user userGetSavedSearch
+	// ---
+	// summary: Get a saved issue search of the authenticated user
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: id
+	//   in: path
+	//   description: id of the saved search
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/SavedSearch"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.GetSavedSearch(ctx, ctx.Doer)
+}
+
+// EditSavedSearch updates a saved issue search of the authenticated user
+func EditSavedSearch(ctx *context.APIContext) {
+	// swagger:operation PATCH /user/saved_searches/{id} user userEditSavedSearch
+	// ---
+	// summary: Update a saved issue search of the authenticated user
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: id
+	//   in: path
+	//   description: id of the saved search
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditSavedSearchOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/SavedSearch"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditSavedSearch(ctx, ctx.Doer)
+}
+
+// DeleteSavedSearch deletes a saved issue search of the authenticated user
+func DeleteSavedSearch(ctx *context.APIContext) {
+	// swagger:operation DELETE /user/saved_searches/{id} user userDeleteSavedSearch
+	// ---
+	// summary: Delete a saved issue search of the authenticated user
+	// parameters:
+	// - name: id
+	//   in: path
+	//   description: id of the saved search
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.DeleteSavedSearch(ctx, ctx.Doer)
+}
+
+// SubscribeSavedSearch subscribes the authenticated user to new issues and pull requests matching a saved search
+func SubscribeSavedSearch(ctx *context.APIContext) {
+	// swagger:operation PUT /user/saved_searches/{id}/subscription user userSubscribeSavedSearch
+	// ---
+	// summary: Get notified about new issues and pull requests matching a saved issue search of the authenticated user
+	// parameters:
+	// - name: id
+	//   in: path
+	//   description: id of the saved search
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.SubscribeSavedSearch(ctx, ctx.Doer, true)
+}
+
+// UnsubscribeSavedSearch removes the subscription of the authenticated user to a saved search
+func UnsubscribeSavedSearch(ctx *context.APIContext) {
+	// swagger:operation DELETE /user/saved_searches/{id}/subscription user userUnsubscribeSavedSearch
+	// ---
+	// summary: Stop notifications about issues and pull requests matching a saved issue search of the authenticated user
+	// parameters:
+	// - name: id
+	//   in: path
+	//   description: id of the saved search
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.SubscribeSavedSearch(ctx, ctx.Doer, false)
+}
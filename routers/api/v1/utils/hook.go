@@ -94,13 +94,55 @@ func checkCreateHookOption(ctx *context.APIContext, form *api.CreateHookOption)
 		ctx.APIError(http.StatusUnprocessableEntity, "Invalid content type")
 		return false
 	}
-	if !validation.IsValidURL(form.Config["url"]) {
+	if !isValidHookURL(form.Type, form.Config["url"]) {
 		ctx.APIError(http.StatusUnprocessableEntity, "Invalid url")
 		return false
 	}
 	return true
 }
 
+// isValidHookURL checks the HTTP URL of a webhook, or the broker URL of the webhooks which publish to a message broker
+func isValidHookURL(hookType, url string) bool {
+	if webhook_service.IsBrokerHookType(hookType) {
+		return webhook_service.IsValidBrokerURL(hookType, url)
+	}
+	return validation.IsValidURL(url)
+}
+
+// brokerHookMeta returns the broker metadata of a webhook updated with the config options
+func brokerHookMeta(config api.CreateHookOptionConfig, meta *webhook_service.BrokerMeta) (*webhook_service.BrokerMeta, error) {
+	if topic, ok := config["topic"]; ok {
+		meta.Topic = strings.TrimSpace(topic)
+	}
+	if exchange, ok := config["exchange"]; ok {
+		meta.Exchange = strings.TrimSpace(exchange)
+	}
+	if v, ok := config["cloudevents"]; ok {
+		cloudEvents, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, err
+		}
+		meta.CloudEvents = cloudEvents
+	}
+	return meta, nil
+}
+
+// checkPayloadTemplate sets the payload template of a webhook from the config options, the webhooks with the
+// template content type must have a valid one
+func checkPayloadTemplate(ctx *context.APIContext, config api.CreateHookOptionConfig, w *webhook.Webhook) bool {
+	if tmpl, ok := config["payload_template"]; ok {
+		w.PayloadTemplate = tmpl
+	}
+	if w.ContentType != webhook.ContentTypeTemplate {
+		return true
+	}
+	if err := webhook_service.ValidatePayloadTemplate(w.PayloadTemplate); err != nil {
+		ctx.APIError(http.StatusUnprocessableEntity, "Invalid payload template: "+err.Error())
+		return false
+	}
+	return true
+}
+
 // AddSystemHook add a system hook
 func AddSystemHook(ctx *context.APIContext, form *api.CreateHookOption) {
 	hook, ok := addHook(ctx, form, 0, 0)
@@ -174,6 +216,7 @@ func updateHookEvents(events []string) webhook_module.HookEvents {
 	hookEvents[webhook_module.HookEventRelease] = util.SliceContainsString(events, string(webhook_module.HookEventRelease), true)
 	hookEvents[webhook_module.HookEventPackage] = util.SliceContainsString(events, string(webhook_module.HookEventPackage), true)
 	hookEvents[webhook_module.HookEventStatus] = util.SliceContainsString(events, string(webhook_module.HookEventStatus), true)
+	hookEvents[webhook_module.HookEventProjectColumn] = util.SliceContainsString(events, string(webhook_module.HookEventProjectColumn), true)
 	hookEvents[webhook_module.HookEventWorkflowRun] = util.SliceContainsString(events, string(webhook_module.HookEventWorkflowRun), true)
 	hookEvents[webhook_module.HookEventWorkflowJob] = util.SliceContainsString(events, string(webhook_module.HookEventWorkflowJob), true)
 
@@ -222,13 +265,25 @@ func addHook(ctx *context.APIContext, form *api.CreateHookOption, ownerID, repoI
 		HTTPMethod:      "POST",
 		IsSystemWebhook: isSystemWebhook,
 		HookEvent: &webhook_module.HookEvent{
-			ChooseEvents: true,
-			HookEvents:   updateHookEvents(form.Events),
-			BranchFilter: form.BranchFilter,
+			ChooseEvents:   true,
+			HookEvents:     updateHookEvents(form.Events),
+			BranchFilter:   form.BranchFilter,
+			PathFilter:     form.PathFilter,
+			LabelFilter:    form.LabelFilter,
+			ActionFilter:   form.ActionFilter,
+			IncludeSenders: form.IncludeSenders,
+			ExcludeSenders: form.ExcludeSenders,
 		},
 		IsActive: form.Active,
 		Type:     form.Type,
 	}
+	if err := webhook_service.NormalizeHookFilters(w.HookEvent); err != nil {
+		ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		return nil, false
+	}
+	if !checkPayloadTemplate(ctx, form.Config, w) {
+		return nil, false
+	}
 	err := w.SetHeaderAuthorization(form.AuthorizationHeader)
 	if err != nil {
 		ctx.APIErrorInternal(err)
@@ -258,6 +313,29 @@ func addHook(ctx *context.APIContext, form *api.CreateHookOption, ownerID, repoI
 			return nil, false
 		}
 		w.Meta = string(meta)
+	} else if w.Type == webhook_module.MATTERMOST {
+		meta, err := json.Marshal(&webhook_service.MattermostMeta{
+			Channel:  strings.TrimSpace(form.Config["channel"]),
+			Username: form.Config["username"],
+			IconURL:  form.Config["icon_url"],
+		})
+		if err != nil {
+			ctx.APIErrorInternal(err)
+			return nil, false
+		}
+		w.Meta = string(meta)
+	} else if webhook_service.IsBrokerHookType(w.Type) {
+		brokerMeta, err := brokerHookMeta(form.Config, &webhook_service.BrokerMeta{})
+		if err != nil {
+			ctx.APIError(http.StatusUnprocessableEntity, "Invalid cloudevents value")
+			return nil, false
+		}
+		meta, err := json.Marshal(brokerMeta)
+		if err != nil {
+			ctx.APIErrorInternal(err)
+			return nil, false
+		}
+		w.Meta = string(meta)
 	}
 
 	if err := w.UpdateEvent(); err != nil {
@@ -340,7 +418,7 @@ func EditRepoHook(ctx *context.APIContext, form *api.EditHookOption, hookID int6
 func editHook(ctx *context.APIContext, form *api.EditHookOption, w *webhook.Webhook) bool {
 	if form.Config != nil {
 		if url, ok := form.Config["url"]; ok {
-			if !validation.IsValidURL(url) {
+			if !isValidHookURL(w.Type, url) {
 				ctx.APIError(http.StatusUnprocessableEntity, "Invalid url")
 				return false
 			}
@@ -353,6 +431,9 @@ func editHook(ctx *context.APIContext, form *api.EditHookOption, w *webhook.Webh
 			}
 			w.ContentType = webhook.ToHookContentType(ct)
 		}
+		if !checkPayloadTemplate(ctx, form.Config, w) {
+			return false
+		}
 
 		if w.Type == webhook_module.SLACK {
 			if channel, ok := form.Config["channel"]; ok {
@@ -368,6 +449,35 @@ func editHook(ctx *context.APIContext, form *api.EditHookOption, w *webhook.Webh
 				}
 				w.Meta = string(meta)
 			}
+		} else if w.Type == webhook_module.MATTERMOST {
+			mattermostMeta := webhook_service.GetMattermostHook(w)
+			if channel, ok := form.Config["channel"]; ok {
+				mattermostMeta.Channel = strings.TrimSpace(channel)
+			}
+			if username, ok := form.Config["username"]; ok {
+				mattermostMeta.Username = username
+			}
+			if iconURL, ok := form.Config["icon_url"]; ok {
+				mattermostMeta.IconURL = iconURL
+			}
+			meta, err := json.Marshal(mattermostMeta)
+			if err != nil {
+				ctx.APIErrorInternal(err)
+				return false
+			}
+			w.Meta = string(meta)
+		} else if webhook_service.IsBrokerHookType(w.Type) {
+			brokerMeta, err := brokerHookMeta(form.Config, webhook_service.GetBrokerHook(w))
+			if err != nil {
+				ctx.APIError(http.StatusUnprocessableEntity, "Invalid cloudevents value")
+				return false
+			}
+			meta, err := json.Marshal(brokerMeta)
+			if err != nil {
+				ctx.APIErrorInternal(err)
+				return false
+			}
+			w.Meta = string(meta)
 		}
 	}
 
@@ -377,6 +487,25 @@ func editHook(ctx *context.APIContext, form *api.EditHookOption, w *webhook.Webh
 	w.SendEverything = false
 	w.ChooseEvents = true
 	w.BranchFilter = form.BranchFilter
+	if form.PathFilter != nil {
+		w.PathFilter = *form.PathFilter
+	}
+	if form.LabelFilter != nil {
+		w.LabelFilter = form.LabelFilter
+	}
+	if form.ActionFilter != nil {
+		w.ActionFilter = form.ActionFilter
+	}
+	if form.IncludeSenders != nil {
+		w.IncludeSenders = form.IncludeSenders
+	}
+	if form.ExcludeSenders != nil {
+		w.ExcludeSenders = form.ExcludeSenders
+	}
+	if err := webhook_service.NormalizeHookFilters(w.HookEvent); err != nil {
+		ctx.APIError(http.StatusUnprocessableEntity, err.Error())
+		return false
+	}
 
 	err := w.SetHeaderAuthorization(form.AuthorizationHeader)
 	if err != nil {
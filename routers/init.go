@@ -37,7 +37,9 @@ import (
 	asymkey_service "gitea.dev/services/asymkey"
 	"gitea.dev/services/auth"
 	"gitea.dev/services/auth/source/oauth2"
+	"gitea.dev/services/automation"
 	"gitea.dev/services/automerge"
+	codenav_service "gitea.dev/services/codenav"
 	"gitea.dev/services/cron"
 	feed_service "gitea.dev/services/feed"
 	indexer_service "gitea.dev/services/indexer"
@@ -153,6 +155,8 @@ func InitWebInstalled(ctx context.Context) {
 	mustInit(webhook.Init)
 	mustInit(pull_service.Init)
 	mustInit(automerge.Init)
+	mustInit(automation.Init)
+	mustInit(codenav_service.Init)
 	mustInit(task.Init)
 	mustInit(repo_migrations.Init)
 	eventsource.GetManager().Init()
@@ -4,6 +4,7 @@
 package explore
 
 import (
+	"errors"
 	"net/http"
 	"slices"
 
@@ -12,6 +13,7 @@ import (
 	code_indexer "gitea.dev/modules/indexer/code"
 	"gitea.dev/modules/setting"
 	"gitea.dev/modules/templates"
+	"gitea.dev/modules/util"
 	"gitea.dev/routers/common"
 	"gitea.dev/services/context"
 )
@@ -82,7 +84,9 @@ func Code(ctx *context.Context) {
 				PageSize: setting.UI.RepoSearchPagingNum,
 			},
 		})
-		if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.Flash.Error(ctx.Tr("search.code_search_invalid_query", err.Error()), true)
+		} else if err != nil {
 			if code_indexer.IsAvailable(ctx) {
 				ctx.ServerError("SearchResults", err)
 				return
@@ -20,6 +20,7 @@ import (
 	"gitea.dev/modules/templates"
 	"gitea.dev/modules/web"
 	"gitea.dev/routers/web/shared/issue"
+	shared_project "gitea.dev/routers/web/shared/project"
 	shared_user "gitea.dev/routers/web/shared/user"
 	"gitea.dev/services/context"
 	"gitea.dev/services/forms"
@@ -351,6 +352,16 @@ func ViewProject(ctx *context.Context) {
 		column.NumIssues = int64(len(issuesMap[column.ID]))
 	}
 
+	shared_project.PrepareProjectViews(ctx, project, columns, issuesMap)
+	if ctx.Written() {
+		return
+	}
+
+	columns, issuesMap = shared_project.GroupColumnsByCustomField(ctx, ctx.ContextUser.ID, 0, columns, issuesMap)
+	if ctx.Written() {
+		return
+	}
+
 	if project.CardType != project_model.CardTypeTextOnly {
 		issuesAttachmentMap := make(map[int64][]*repo_model.Attachment)
 		for _, issuesList := range issuesMap {
@@ -490,13 +501,13 @@ func DeleteProjectColumn(ctx *context.Context) {
 		return
 	}
 
-	_, err = project_model.GetColumnByIDAndProjectID(ctx, ctx.PathParamInt64("columnID"), project.ID)
+	column, err := project_model.GetColumnByIDAndProjectID(ctx, ctx.PathParamInt64("columnID"), project.ID)
 	if err != nil {
 		ctx.NotFoundOrServerError("GetColumnByIDAndProjectID", project_model.IsErrProjectColumnNotExist, err)
 		return
 	}
 
-	if err := project_model.DeleteColumnByID(ctx, ctx.PathParamInt64("columnID")); err != nil {
+	if err := project_service.DeleteColumn(ctx, ctx.Doer, column); err != nil {
 		ctx.ServerError("DeleteProjectColumnByID", err)
 		return
 	}
@@ -514,7 +525,7 @@ func AddColumnToProjectPost(ctx *context.Context) {
 		return
 	}
 
-	if err := project_model.NewColumn(ctx, &project_model.Column{
+	if err := project_service.NewColumn(ctx, ctx.Doer, &project_model.Column{
 		ProjectID: project.ID,
 		Title:     form.Title,
 		Color:     form.Color,
@@ -567,7 +578,7 @@ func EditProjectColumn(ctx *context.Context) {
 		column.Sorting = form.Sorting
 	}
 
-	if err := project_model.UpdateColumn(ctx, column); err != nil {
+	if err := project_service.UpdateColumn(ctx, ctx.Doer, column); err != nil {
 		ctx.ServerError("UpdateProjectColumn", err)
 		return
 	}
@@ -577,12 +588,12 @@ func EditProjectColumn(ctx *context.Context) {
 
 // SetDefaultProjectColumn set default column for uncategorized issues/pulls
 func SetDefaultProjectColumn(ctx *context.Context) {
-	project, column := CheckProjectColumnChangePermissions(ctx)
+	_, column := CheckProjectColumnChangePermissions(ctx)
 	if ctx.Written() {
 		return
 	}
 
-	if err := project_model.SetDefaultColumn(ctx, project.ID, column.ID); err != nil {
+	if err := project_service.SetDefaultColumn(ctx, ctx.Doer, column); err != nil {
 		ctx.ServerError("SetDefaultColumn", err)
 		return
 	}
@@ -0,0 +1,42 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package org
+
+import (
+	"net/http"
+
+	"gitea.dev/modules/templates"
+	shared_user "gitea.dev/routers/web/shared/user"
+	"gitea.dev/services/context"
+)
+
+const (
+	tplSettingsReviewReminders templates.TplName = "org/settings/review_reminders"
+)
+
+func ReviewReminders(ctx *context.Context) {
+	ctx.Data["Title"] = ctx.Tr("settings.review_reminders")
+	ctx.Data["PageIsOrgSettings"] = true
+	ctx.Data["PageIsSettingsReviewReminders"] = true
+
+	if _, err := shared_user.RenderUserOrgHeader(ctx); err != nil {
+		ctx.ServerError("RenderUserOrgHeader", err)
+		return
+	}
+
+	shared_user.ReviewReminders(ctx, ctx.ContextUser)
+	if ctx.Written() {
+		return
+	}
+
+	ctx.HTML(http.StatusOK, tplSettingsReviewReminders)
+}
+
+func ReviewReminderPost(ctx *context.Context) {
+	shared_user.ReviewReminderPost(ctx, ctx.ContextUser, ctx.ContextUser.OrganisationLink()+"/settings/review_reminders")
+}
+
+func ReviewReminderDelete(ctx *context.Context) {
+	shared_user.ReviewReminderDelete(ctx, ctx.ContextUser, ctx.ContextUser.OrganisationLink()+"/settings/review_reminders")
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"gitea.dev/modules/codenav"
+	"gitea.dev/modules/git"
+	"gitea.dev/modules/util"
+	codenav_service "gitea.dev/services/codenav"
+	"gitea.dev/services/context"
+)
+
+type codeNavigationLocation struct {
+	Path   string `json:"path"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+	Link   string `json:"link"`
+}
+
+type codeNavigationResult struct {
+	Precise     bool                      `json:"precise"`
+	Hover       string                    `json:"hover"`
+	Definitions []*codeNavigationLocation `json:"definitions"`
+	References  []*codeNavigationLocation `json:"references"`
+	I18n        map[string]string         `json:"i18n"`
+}
+
+// CodeNavigation returns the hover text, the definitions and the references of the symbol at a position in a file of the commit
+func CodeNavigation(ctx *context.Context) {
+	commit, err := ctx.Repo.GitRepo.GetCommit(ctx.PathParam("sha"))
+	if err != nil {
+		if git.IsErrNotExist(err) {
+			ctx.NotFound(err)
+		} else {
+			ctx.ServerError("GetCommit", err)
+		}
+		return
+	}
+
+	idx, err := codenav_service.GetIndex(ctx, ctx.Repo.Repository, commit.ID.String())
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.NotFound(err)
+		} else {
+			ctx.ServerError("GetIndex", err)
+		}
+		return
+	}
+
+	commitLink := ctx.Repo.RepoLink + "/src/commit/" + util.PathEscapeSegments(commit.ID.String()) + "/"
+	toLocations := func(locations []codenav.Location) []*codeNavigationLocation {
+		result := make([]*codeNavigationLocation, 0, len(locations))
+		for _, l := range locations {
+			result = append(result, &codeNavigationLocation{
+				Path:   l.Path,
+				Line:   l.Line,
+				Column: l.Column,
+				Link:   commitLink + util.PathEscapeSegments(l.Path) + "#L" + strconv.Itoa(l.Line),
+			})
+		}
+		return result
+	}
+
+	resp := &codeNavigationResult{
+		Precise:     idx.Precise,
+		Definitions: []*codeNavigationLocation{},
+		References:  []*codeNavigationLocation{},
+	}
+	if result := idx.Lookup(ctx.FormString("path"), ctx.FormInt("line"), ctx.FormInt("column"), ctx.FormString("name")); result != nil {
+		resp.Hover = result.Hover
+		resp.Definitions = toLocations(result.Definitions)
+		resp.References = toLocations(result.References)
+	}
+	resp.I18n = map[string]string{
+		"definitions": ctx.Locale.TrString("repo.code_nav.definitions"),
+		"references":  ctx.Locale.TrString("repo.code_nav.references", len(resp.References)),
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"errors"
+	"strconv"
+
+	issues_model "gitea.dev/models/issues"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/util"
+	"gitea.dev/services/context"
+	issue_service "gitea.dev/services/issue"
+)
+
+type issueSidebarCustomFieldData struct {
+	Field  *issues_model.CustomField
+	Values []string // the values shown to users, usernames for user fields
+}
+
+// FormName returns the name of the form input of the custom field
+func (d *issueSidebarCustomFieldData) FormName() string {
+	return customFieldFormName(d.Field)
+}
+
+// Value returns the first value, fields which are not multi select have at most one value
+func (d *issueSidebarCustomFieldData) Value() string {
+	if len(d.Values) == 0 {
+		return ""
+	}
+	return d.Values[0]
+}
+
+type issueSidebarCustomFieldsData struct {
+	Fields []*issueSidebarCustomFieldData
+}
+
+func customFieldFormName(field *issues_model.CustomField) string {
+	return "custom_field_" + strconv.FormatInt(field.ID, 10)
+}
+
+func (d *IssuePageMetaData) retrieveCustomFieldsData(ctx *context.Context) {
+	fields, err := issues_model.GetAvailableCustomFields(ctx, d.Repository.OwnerID, d.Repository.ID)
+	if err != nil {
+		ctx.ServerError("GetAvailableCustomFields", err)
+		return
+	}
+
+	var values issues_model.CustomFieldValues
+	if d.Issue != nil {
+		if values, err = issues_model.GetIssueCustomFieldValues(ctx, d.Issue.ID); err != nil {
+			ctx.ServerError("GetIssueCustomFieldValues", err)
+			return
+		}
+	}
+
+	d.CustomFieldsData.Fields = make([]*issueSidebarCustomFieldData, 0, len(fields))
+	for _, field := range fields {
+		formatted, err := issue_service.FormatCustomFieldValues(ctx, field, values[field.ID])
+		if err != nil {
+			ctx.ServerError("FormatCustomFieldValues", err)
+			return
+		}
+		d.CustomFieldsData.Fields = append(d.CustomFieldsData.Fields, &issueSidebarCustomFieldData{Field: field, Values: formatted})
+	}
+}
+
+// SetTemplateValues preselects the custom field values of an issue template, the fields are referred to by name
+func (d *issueSidebarCustomFieldsData) SetTemplateValues(values map[string]api.IssueTemplateStringSlice) {
+	fields := make([]*issues_model.CustomField, 0, len(d.Fields))
+	for _, data := range d.Fields {
+		fields = append(fields, data.Field)
+	}
+	for name, fieldValues := range values {
+		field := issue_service.FindCustomFieldByName(fields, name)
+		if field == nil {
+			continue
+		}
+		for _, data := range d.Fields {
+			if data.Field.ID != field.ID {
+				continue
+			}
+			data.Values = fieldValues
+			if field.Type.HasOptions() {
+				// select the options even if the template uses another case
+				if normalized, err := field.NormalizeValues(fieldValues); err == nil {
+					data.Values = normalized
+				}
+			}
+		}
+	}
+}
+
+// parseCustomFieldsForm resolves the submitted values of all the custom fields available to the repository,
+// a field without a submitted value is unset
+func parseCustomFieldsForm(ctx *context.Context) (map[*issues_model.CustomField][]string, error) {
+	fields, err := issues_model.GetAvailableCustomFields(ctx, ctx.Repo.Repository.OwnerID, ctx.Repo.Repository.ID)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[*issues_model.CustomField][]string, len(fields))
+	for _, field := range fields {
+		fieldValues := ctx.Req.Form[customFieldFormName(field)]
+		if _, err := issue_service.ResolveCustomFieldValues(ctx, field, fieldValues); err != nil {
+			if errors.Is(err, util.ErrInvalidArgument) {
+				return nil, util.NewInvalidArgumentErrorf("%s", ctx.Locale.TrString("repo.issues.custom_field.invalid_value", field.Name))
+			}
+			return nil, err
+		}
+		values[field] = fieldValues
+	}
+	return values, nil
+}
+
+// parseNewIssueCustomFields returns the custom field values submitted with a new issue or pull request, only writers can set them
+func parseNewIssueCustomFields(ctx *context.Context, isPull bool) map[*issues_model.CustomField][]string {
+	if !ctx.Repo.Permission.CanWriteIssuesOrPulls(isPull) {
+		return nil
+	}
+	values, err := parseCustomFieldsForm(ctx)
+	if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.JSONError(err.Error())
+		} else {
+			ctx.ServerError("parseCustomFieldsForm", err)
+		}
+		return nil
+	}
+	return values
+}
+
+func setIssueCustomFields(ctx *context.Context, issue *issues_model.Issue, values map[*issues_model.CustomField][]string) error {
+	for field, fieldValues := range values {
+		if err := issue_service.SetIssueCustomFieldValues(ctx, ctx.Doer, issue, field, fieldValues); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateIssueCustomFields sets the values of all the custom fields of an issue
+func UpdateIssueCustomFields(ctx *context.Context) {
+	issue := GetActionIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+	if !ctx.Repo.Permission.CanWriteIssuesOrPulls(issue.IsPull) || ctx.Repo.Repository.IsArchived {
+		ctx.JSONError(ctx.Tr("error.permission_denied"))
+		return
+	}
+
+	values, err := parseCustomFieldsForm(ctx)
+	if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.JSONError(err.Error())
+		} else {
+			ctx.ServerError("parseCustomFieldsForm", err)
+		}
+		return
+	}
+	if err := setIssueCustomFields(ctx, issue, values); err != nil {
+		ctx.ServerError("SetIssueCustomFieldValues", err)
+		return
+	}
+	ctx.JSONRedirect("")
+}
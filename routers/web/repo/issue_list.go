@@ -537,8 +537,18 @@ func prepareIssueFilterAndList(ctx *context.Context, milestoneID int64, projectI
 		IssueIDs:          nil,
 	}
 
+	var query *issue_indexer.Query
 	if keyword != "" {
-		keywordMatchedIssueIDs, _, err = issue_indexer.SearchIssues(ctx, issue_indexer.ToSearchOptions(keyword, statsOpts))
+		searchOpt := issue_indexer.ToSearchOptions(keyword, statsOpts)
+		query = issue.ApplySearchQuery(ctx, keyword, searchOpt)
+		if ctx.Written() {
+			return
+		}
+		if query != nil {
+			// the state is applied to the list below, the stats need both open and closed issues
+			searchOpt.IsClosed = optional.None[bool]()
+			keywordMatchedIssueIDs, _, err = issue_indexer.SearchIssues(ctx, searchOpt)
+		}
 		if err != nil {
 			if issue_indexer.IsAvailable(ctx) {
 				ctx.ServerError("issueIDsFromSearch", err)
@@ -572,6 +582,12 @@ func prepareIssueFilterAndList(ctx *context.Context, milestoneID int64, projectI
 	if ctx.FormString("state") == "" && issueStats.OpenCount == 0 && issueStats.ClosedCount != 0 {
 		isShowClosed = optional.None[bool]()
 	}
+	if query != nil && query.IsClosed.Has() {
+		isShowClosed = query.IsClosed
+	}
+	if query != nil && query.SortType != "" {
+		sortType = query.SortType
+	}
 
 	if repo.IsTimetrackerEnabled(ctx) {
 		totalTrackedTime, err := issues_model.GetIssueTotalTrackedTime(ctx, statsOpts, isShowClosed)
@@ -674,6 +690,17 @@ func prepareIssueFilterAndList(ctx *context.Context, milestoneID int64, projectI
 
 	ctx.Data["IssueRefEndNames"], ctx.Data["IssueRefURLs"] = issue_service.GetRefEndNamesAndURLs(issues, ctx.Repo.RepoLink)
 
+	issuesSLA, err := issues_model.GetIssuesSLA(ctx, issues)
+	if err != nil {
+		ctx.ServerError("GetIssuesSLA", err)
+		return
+	}
+	mostUrgentSLA := make(map[int64]*issues_model.IssueSLA, len(issuesSLA))
+	for issueID, slas := range issuesSLA {
+		mostUrgentSLA[issueID] = issues_model.MostUrgentIssueSLA(slas)
+	}
+	ctx.Data["IssueSLAs"] = mostUrgentSLA
+
 	ctx.Data["ApprovalCounts"] = func(issueID int64, typ string) int64 {
 		counts, ok := approvalCounts[issueID]
 		if !ok || len(counts) == 0 {
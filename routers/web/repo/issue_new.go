@@ -76,6 +76,8 @@ func setTemplateIfExists(ctx *context.Context, ctxDataKey string, possibleFiles
 		}
 
 		metaData.LabelsData.SetSelectedLabelNames(template.Labels)
+		metaData.TypesData.SetSelectedTypeName(template.IssueType)
+		metaData.CustomFieldsData.SetTemplateValues(template.CustomFields)
 
 		selectedAssigneeIDStrings := make([]string, 0, len(template.Assignees))
 		if userIDs, err := user_model.GetUserIDsByNames(ctx, template.Assignees, true); err == nil {
@@ -241,6 +243,7 @@ func toSet[ItemType any, KeyType comparable](slice []ItemType, keyFunc func(Item
 func ValidateRepoMetasForNewIssue(ctx *context.Context, form forms.CreateIssueForm, isPull bool) (ret struct {
 	LabelIDs, AssigneeIDs []int64
 	MilestoneID           int64
+	TypeID                int64
 	ProjectIDs            []int64
 
 	Reviewers     []*user_model.User
@@ -268,6 +271,13 @@ func ValidateRepoMetasForNewIssue(ctx *context.Context, form forms.CreateIssueFo
 	}
 	pageMetaData.MilestonesData.SelectedMilestoneID = form.MilestoneID
 
+	candidateTypes := toSet(pageMetaData.TypesData.Types, func(t *issues_model.IssueType) int64 { return t.ID })
+	if form.TypeID > 0 && !candidateTypes.Contains(form.TypeID) {
+		ctx.NotFound(nil)
+		return ret
+	}
+	pageMetaData.TypesData.SelectedTypeID = form.TypeID
+
 	inputProjectIDs := ctx.FormStringInt64s("project_ids")
 	pageMetaData.SetSelectedProjectIDs(inputProjectIDs)
 
@@ -316,6 +326,7 @@ func ValidateRepoMetasForNewIssue(ctx *context.Context, form forms.CreateIssueFo
 
 	// Return only the validated IDs.
 	ret.LabelIDs, ret.AssigneeIDs, ret.MilestoneID, ret.ProjectIDs = inputLabelIDs, inputAssigneeIDs, form.MilestoneID, inputProjectIDs
+	ret.TypeID = form.TypeID
 	ret.Reviewers, ret.TeamReviewers = reviewers, teamReviewers
 	return ret
 }
@@ -356,6 +367,11 @@ func NewIssuePost(ctx *context.Context) {
 		return
 	}
 
+	customFieldValues := parseNewIssueCustomFields(ctx, false)
+	if ctx.Written() {
+		return
+	}
+
 	content := form.Content
 	if filename := ctx.Req.Form.Get("template-file"); filename != "" {
 		if template, err := issue_template.UnmarshalFromRepo(ctx.Repo.GitRepo, ctx.Repo.Repository.DefaultBranch, filename); err == nil {
@@ -370,6 +386,7 @@ func NewIssuePost(ctx *context.Context) {
 		PosterID:    ctx.Doer.ID,
 		Poster:      ctx.Doer,
 		MilestoneID: milestoneID,
+		TypeID:      validateRet.TypeID,
 		Content:     content,
 		Ref:         form.Ref,
 	}
@@ -385,6 +402,11 @@ func NewIssuePost(ctx *context.Context) {
 		return
 	}
 
+	if err := setIssueCustomFields(ctx, issue, customFieldValues); err != nil {
+		ctx.ServerError("SetIssueCustomFieldValues", err)
+		return
+	}
+
 	log.Trace("Issue created: %d/%d", repo.ID, issue.ID)
 	if ctx.FormString("redirect_after_creation") == "project" && len(projectIDs) > 0 {
 		// When issue is in multiple projects, redirect to first project from form order.
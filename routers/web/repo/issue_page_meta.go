@@ -59,6 +59,9 @@ type IssuePageMetaData struct {
 	MilestonesData *issueSidebarMilestoneData
 	ProjectsData   *issueSidebarProjectsData
 	AssigneesData  *issueSidebarAssigneesData
+	TypesData      *issueSidebarTypesData
+
+	CustomFieldsData *issueSidebarCustomFieldsData
 }
 
 func retrieveRepoIssueMetaData(ctx *context.Context, repo *repo_model.Repository, issue *issues_model.Issue, isPull bool) *IssuePageMetaData {
@@ -73,6 +76,9 @@ func retrieveRepoIssueMetaData(ctx *context.Context, repo *repo_model.Repository
 		MilestonesData: &issueSidebarMilestoneData{},
 		ProjectsData:   &issueSidebarProjectsData{},
 		AssigneesData:  &issueSidebarAssigneesData{},
+		TypesData:      &issueSidebarTypesData{},
+
+		CustomFieldsData: &issueSidebarCustomFieldsData{},
 	}
 	ctx.Data["IssuePageMetaData"] = data
 
@@ -87,6 +93,11 @@ func retrieveRepoIssueMetaData(ctx *context.Context, repo *repo_model.Repository
 		return data
 	}
 
+	data.retrieveCustomFieldsData(ctx)
+	if ctx.Written() {
+		return data
+	}
+
 	// it sets "Branches" template data,
 	// it is used to render the "edit PR target branches" dropdown, and the "branch selector" in the issue's sidebar.
 	PrepareBranchList(ctx)
@@ -125,6 +136,11 @@ func retrieveRepoIssueMetaData(ctx *context.Context, repo *repo_model.Repository
 		return data
 	}
 
+	data.retrieveTypesDataForIssueWriter(ctx)
+	if ctx.Written() {
+		return data
+	}
+
 	ctx.Data["CanCreateIssueDependencies"] = ctx.Repo.CanCreateIssueDependencies(ctx, ctx.Doer, isPull)
 	return data
 }
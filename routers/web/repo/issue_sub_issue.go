@@ -0,0 +1,224 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	issues_model "gitea.dev/models/issues"
+	access_model "gitea.dev/models/perm/access"
+	repo_model "gitea.dev/models/repo"
+	"gitea.dev/modules/util"
+	"gitea.dev/services/context"
+	issue_service "gitea.dev/services/issue"
+)
+
+func prepareIssueViewSidebarSubIssues(ctx *context.Context, issue *issues_model.Issue) {
+	if issue.IsPull {
+		return
+	}
+
+	repoPerms := map[int64]access_model.Permission{ctx.Repo.Repository.ID: ctx.Repo.Permission}
+	canRead := func(issue *issues_model.Issue) bool {
+		perm, ok := repoPerms[issue.RepoID]
+		if !ok {
+			var err error
+			if perm, err = access_model.GetDoerRepoPermission(ctx, issue.Repo, ctx.Doer); err != nil {
+				ctx.ServerError("GetDoerRepoPermission", err)
+				return false
+			}
+			repoPerms[issue.RepoID] = perm
+		}
+		return perm.CanReadIssuesOrPulls(issue.IsPull)
+	}
+
+	parentID, err := issues_model.GetParentIssueID(ctx, issue.ID)
+	if err != nil {
+		ctx.ServerError("GetParentIssueID", err)
+		return
+	}
+	if parentID != 0 {
+		parent, err := issues_model.GetIssueByID(ctx, parentID)
+		if err != nil {
+			ctx.ServerError("GetIssueByID", err)
+			return
+		}
+		if err := parent.LoadRepo(ctx); err != nil {
+			ctx.ServerError("LoadRepo", err)
+			return
+		}
+		if canRead(parent) {
+			ctx.Data["ParentIssue"] = parent
+		} else if ctx.Written() {
+			return
+		}
+	}
+
+	subIssues, err := issues_model.GetSubIssues(ctx, issue.ID)
+	if err != nil {
+		ctx.ServerError("GetSubIssues", err)
+		return
+	}
+	if _, err := subIssues.LoadRepositories(ctx); err != nil {
+		ctx.ServerError("LoadRepositories", err)
+		return
+	}
+	readable := make(issues_model.IssueList, 0, len(subIssues))
+	for _, subIssue := range subIssues {
+		if canRead(subIssue) {
+			readable = append(readable, subIssue)
+		} else if ctx.Written() {
+			return
+		}
+	}
+	ctx.Data["SubIssues"] = readable
+
+	progress, err := issues_model.GetSubIssueProgress(ctx, issue.ID, ctx.Doer)
+	if err != nil {
+		ctx.ServerError("GetSubIssueProgress", err)
+		return
+	}
+	ctx.Data["SubIssueProgress"] = progress
+	ctx.Data["CanManageSubIssues"] = ctx.IsSigned && !ctx.Repo.Repository.IsArchived && ctx.Repo.Permission.CanWriteIssuesOrPulls(false)
+}
+
+// getSubIssueByRef returns the issue referenced by "#index", "repo#index" or "owner/repo#index",
+// a reference without owner or repository refers to the current owner or repository
+func getSubIssueByRef(ctx *context.Context, ref string) (*issues_model.Issue, error) {
+	repoName, indexStr, ok := strings.Cut(strings.TrimSpace(ref), "#")
+	if !ok {
+		repoName, indexStr = "", repoName
+	}
+	index, err := strconv.ParseInt(indexStr, 10, 64)
+	if err != nil {
+		return nil, util.NewInvalidArgumentErrorf("invalid issue reference %q", ref)
+	}
+
+	repo := ctx.Repo.Repository
+	if repoName != "" {
+		ownerName := repo.OwnerName
+		if owner, name, ok := strings.Cut(repoName, "/"); ok {
+			ownerName, repoName = owner, name
+		}
+		if repo, err = repo_model.GetRepositoryByOwnerAndName(ctx, ownerName, repoName); err != nil {
+			return nil, err
+		}
+	}
+
+	issue, err := issues_model.GetIssueByIndex(ctx, repo.ID, index)
+	if err != nil {
+		return nil, err
+	}
+	issue.Repo = repo
+	return issue, nil
+}
+
+func getSubIssueParent(ctx *context.Context) *issues_model.Issue {
+	issue := GetActionIssue(ctx)
+	if ctx.Written() {
+		return nil
+	}
+	if issue.IsPull || !ctx.Repo.Permission.CanWriteIssuesOrPulls(false) {
+		ctx.JSONError(ctx.Tr("error.permission_denied"))
+		return nil
+	}
+	return issue
+}
+
+// canWriteSubIssue checks whether the doer can change the sub-issues of the parent issue from the repository of the child issue,
+// the permission in the repository of the parent issue is checked by getSubIssueParent
+func canWriteSubIssue(ctx *context.Context, parent, child *issues_model.Issue) (bool, error) {
+	if child.RepoID == parent.RepoID {
+		return true, nil
+	}
+	if err := child.LoadRepo(ctx); err != nil {
+		return false, err
+	}
+	perm, err := access_model.GetDoerRepoPermission(ctx, child.Repo, ctx.Doer)
+	if err != nil {
+		return false, err
+	}
+	return perm.CanWriteIssuesOrPulls(child.IsPull) && !child.Repo.IsArchived, nil
+}
+
+// AddSubIssuePost adds a sub-issue to an issue
+func AddSubIssuePost(ctx *context.Context) {
+	parent := getSubIssueParent(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	child, err := getSubIssueByRef(ctx, ctx.FormString("issue"))
+	if err == nil {
+		var canWrite bool
+		if canWrite, err = canWriteSubIssue(ctx, parent, child); err != nil {
+			ctx.ServerError("canWriteSubIssue", err)
+			return
+		}
+		if !canWrite {
+			err = util.ErrNotExist
+		}
+	}
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) || errors.Is(err, util.ErrInvalidArgument) {
+			ctx.JSONError(ctx.Tr("repo.issues.sub_issue.add_error_not_exist"))
+		} else {
+			ctx.ServerError("getSubIssueByRef", err)
+		}
+		return
+	}
+
+	if err := issue_service.AddSubIssue(ctx, ctx.Doer, parent, child, ctx.FormBool("inherit")); err != nil {
+		switch {
+		case issues_model.IsErrCircularSubIssue(err):
+			ctx.JSONError(ctx.Tr("repo.issues.sub_issue.add_error_circular"))
+		case issues_model.IsErrSubIssueHasParent(err):
+			ctx.JSONError(ctx.Tr("repo.issues.sub_issue.add_error_has_parent"))
+		case errors.Is(err, util.ErrInvalidArgument):
+			ctx.JSONError(ctx.Tr("repo.issues.sub_issue.add_error_invalid"))
+		default:
+			ctx.ServerError("AddSubIssue", err)
+		}
+		return
+	}
+	ctx.JSONRedirect("")
+}
+
+// RemoveSubIssuePost removes a sub-issue from an issue
+func RemoveSubIssuePost(ctx *context.Context) {
+	parent := getSubIssueParent(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	child, err := issues_model.GetIssueByID(ctx, ctx.FormInt64("issue_id"))
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.JSONErrorNotFound()
+		} else {
+			ctx.ServerError("GetIssueByID", err)
+		}
+		return
+	}
+	canWrite, err := canWriteSubIssue(ctx, parent, child)
+	if err != nil {
+		ctx.ServerError("canWriteSubIssue", err)
+		return
+	}
+	if !canWrite {
+		ctx.JSONErrorNotFound()
+		return
+	}
+	if err := issue_service.RemoveSubIssue(ctx, parent, child); err != nil {
+		if issues_model.IsErrSubIssueNotExist(err) {
+			ctx.JSONErrorNotFound()
+		} else {
+			ctx.ServerError("RemoveSubIssue", err)
+		}
+		return
+	}
+	ctx.JSONRedirect("")
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"errors"
+	"strings"
+
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/modules/util"
+	"gitea.dev/services/context"
+	issue_service "gitea.dev/services/issue"
+)
+
+type issueSidebarTypesData struct {
+	SelectedTypeID int64
+	Types          []*issues_model.IssueType
+}
+
+// SetSelectedTypeName preselects the issue type of an issue template, the type is referred to by name
+func (d *issueSidebarTypesData) SetSelectedTypeName(name string) {
+	for _, t := range d.Types {
+		if name != "" && strings.EqualFold(t.Name, name) {
+			d.SelectedTypeID = t.ID
+			return
+		}
+	}
+}
+
+func (d *IssuePageMetaData) retrieveTypesDataForIssueWriter(ctx *context.Context) {
+	if d.IsPullRequest {
+		return
+	}
+	var err error
+	d.TypesData.Types, err = issue_service.GetIssueTypesForRepo(ctx, d.Repository)
+	if err != nil {
+		ctx.ServerError("GetIssueTypesForRepo", err)
+		return
+	}
+	if d.Issue != nil {
+		d.TypesData.SelectedTypeID = d.Issue.TypeID
+	}
+}
+
+// UpdateIssueType changes the type of the issues
+func UpdateIssueType(ctx *context.Context) {
+	issues := getActionIssues(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	var issueType *issues_model.IssueType
+	if typeID := ctx.FormInt64("id"); typeID > 0 {
+		var err error
+		issueType, err = issues_model.GetIssueTypeByID(ctx, typeID)
+		if err != nil {
+			ctx.NotFoundOrServerError("GetIssueTypeByID", issues_model.IsErrIssueTypeNotExist, err)
+			return
+		}
+	}
+
+	for _, issue := range issues {
+		if err := issue_service.ChangeIssueType(ctx, ctx.Doer, issue, issueType); err != nil {
+			if errors.Is(err, util.ErrInvalidArgument) {
+				ctx.JSONError(err.Error())
+			} else {
+				ctx.ServerError("ChangeIssueType", err)
+			}
+			return
+		}
+	}
+
+	ctx.JSONOK()
+}
@@ -374,6 +374,7 @@ func ViewIssue(ctx *context.Context) {
 		prepareIssueViewSidebarWatch,
 		prepareIssueViewSidebarTimeTracker,
 		prepareIssueViewSidebarDependency,
+		prepareIssueViewSidebarSubIssues,
 		prepareIssueViewSidebarPin,
 	}
 	if issue.IsPull {
@@ -25,6 +25,7 @@ import (
 	"gitea.dev/modules/util"
 	"gitea.dev/modules/web"
 	"gitea.dev/routers/web/shared/issue"
+	shared_project "gitea.dev/routers/web/shared/project"
 	shared_user "gitea.dev/routers/web/shared/user"
 	"gitea.dev/services/context"
 	"gitea.dev/services/forms"
@@ -328,6 +329,16 @@ func ViewProject(ctx *context.Context) {
 		column.NumIssues = int64(len(issuesMap[column.ID]))
 	}
 
+	shared_project.PrepareProjectViews(ctx, project, columns, issuesMap)
+	if ctx.Written() {
+		return
+	}
+
+	columns, issuesMap = shared_project.GroupColumnsByCustomField(ctx, ctx.Repo.Owner.ID, ctx.Repo.Repository.ID, columns, issuesMap)
+	if ctx.Written() {
+		return
+	}
+
 	if project.CardType != project_model.CardTypeTextOnly {
 		issuesAttachmentMap := make(map[int64][]*repo_model.Attachment)
 		for _, issuesList := range issuesMap {
@@ -561,7 +572,7 @@ func DeleteProjectColumn(ctx *context.Context) {
 		return
 	}
 
-	if err := project_model.DeleteColumnByID(ctx, ctx.PathParamInt64("columnID")); err != nil {
+	if err := project_service.DeleteColumn(ctx, ctx.Doer, pb); err != nil {
 		ctx.ServerError("DeleteProjectColumnByID", err)
 		return
 	}
@@ -589,7 +600,7 @@ func AddColumnToProjectPost(ctx *context.Context) {
 		return
 	}
 
-	if err := project_model.NewColumn(ctx, &project_model.Column{
+	if err := project_service.NewColumn(ctx, ctx.Doer, &project_model.Column{
 		ProjectID: project.ID,
 		Title:     form.Title,
 		Color:     form.Color,
@@ -664,7 +675,7 @@ func EditProjectColumn(ctx *context.Context) {
 		column.Sorting = form.Sorting
 	}
 
-	if err := project_model.UpdateColumn(ctx, column); err != nil {
+	if err := project_service.UpdateColumn(ctx, ctx.Doer, column); err != nil {
 		ctx.ServerError("UpdateProjectColumn", err)
 		return
 	}
@@ -674,12 +685,12 @@ func EditProjectColumn(ctx *context.Context) {
 
 // SetDefaultProjectColumn set default column for uncategorized issues/pulls
 func SetDefaultProjectColumn(ctx *context.Context) {
-	project, column := checkProjectColumnChangePermissions(ctx)
+	_, column := checkProjectColumnChangePermissions(ctx)
 	if ctx.Written() {
 		return
 	}
 
-	if err := project_model.SetDefaultColumn(ctx, project.ID, column.ID); err != nil {
+	if err := project_service.SetDefaultColumn(ctx, ctx.Doer, column); err != nil {
 		ctx.ServerError("SetDefaultColumn", err)
 		return
 	}
@@ -770,6 +770,9 @@ func viewPullFiles(ctx *context.Context, beforeCommitID, afterCommitID string) {
 	ctx.Data["CompareInfo"] = prCompareInfo
 	ctx.Data["AfterCommitID"] = afterCommitID
 	ctx.Data["BeforeCommitID"] = beforeCommitID
+	if setting.CodeNavigation.Enabled {
+		ctx.Data["CodeNavigationLink"] = ctx.Repo.RepoLink + "/code-nav/" + afterCommitID
+	}
 
 	maxLines, maxFiles := setting.Git.MaxGitDiffLines, setting.Git.MaxGitDiffFiles
 	files := ctx.FormStrings("files")
@@ -1375,6 +1378,11 @@ func CompareAndPullRequestPost(ctx *context.Context) {
 		return
 	}
 
+	customFieldValues := parseNewIssueCustomFields(ctx, true)
+	if ctx.Written() {
+		return
+	}
+
 	content := form.Content
 	if filename := ctx.Req.Form.Get("template-file"); filename != "" {
 		if template, err := issue_template.UnmarshalFromRepo(ctx.Repo.GitRepo, ctx.Repo.Repository.DefaultBranch, filename); err == nil {
@@ -1466,6 +1474,11 @@ func CompareAndPullRequestPost(ctx *context.Context) {
 		return
 	}
 
+	if err := setIssueCustomFields(ctx, pullIssue, customFieldValues); err != nil {
+		ctx.ServerError("SetIssueCustomFieldValues", err)
+		return
+	}
+
 	log.Trace("Pull request created: %d/%d", repo.ID, pullIssue.ID)
 	ctx.JSONRedirect(pullIssue.Link())
 }
@@ -4,6 +4,7 @@
 package repo
 
 import (
+	"errors"
 	"net/http"
 
 	"gitea.dev/models/db"
@@ -12,6 +13,7 @@ import (
 	"gitea.dev/modules/indexer/code/gitgrep"
 	"gitea.dev/modules/setting"
 	"gitea.dev/modules/templates"
+	"gitea.dev/modules/util"
 	"gitea.dev/routers/common"
 	"gitea.dev/services/context"
 )
@@ -47,7 +49,9 @@ func Search(ctx *context.Context) {
 				PageSize: setting.UI.RepoSearchPagingNum,
 			},
 		})
-		if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.Flash.Error(ctx.Tr("search.code_search_invalid_query", err.Error()), true)
+		} else if err != nil {
 			if code_indexer.IsAvailable(ctx) {
 				ctx.ServerError("SearchResults", err)
 				return
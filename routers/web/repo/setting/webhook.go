@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"slices"
 	"strings"
 
 	"gitea.dev/models/db"
@@ -149,8 +150,11 @@ func WebhooksNew(ctx *context.Context) {
 		ctx.Data["DiscordHook"] = map[string]any{
 			"Username": "Gitea",
 		}
+	} else if webhook_service.IsBrokerHookType(hookType) {
+		ctx.Data["BrokerHook"] = &webhook_service.BrokerMeta{Topic: webhook_service.DefaultBrokerTopic}
 	}
 	ctx.Data["BaseLink"] = orCtx.LinkNew
+	ctx.Data["PayloadTemplatePreviewEvents"] = webhook_service.PayloadTemplatePreviewEvents
 	ctx.Data["BaseLinkNew"] = orCtx.LinkNew
 
 	ctx.HTML(http.StatusOK, orCtx.NewTemplate)
@@ -158,7 +162,7 @@ func WebhooksNew(ctx *context.Context) {
 
 // ParseHookEvent convert web form content to webhook.HookEvent
 func ParseHookEvent(form forms.WebhookForm) *webhook_module.HookEvent {
-	return &webhook_module.HookEvent{
+	hookEvent := &webhook_module.HookEvent{
 		PushOnly:       form.PushOnly(),
 		SendEverything: form.SendEverything(),
 		ChooseEvents:   form.ChooseEvents(),
@@ -185,11 +189,20 @@ func ParseHookEvent(form forms.WebhookForm) *webhook_module.HookEvent {
 			webhook_module.HookEventRepository:               form.Repository,
 			webhook_module.HookEventPackage:                  form.Package,
 			webhook_module.HookEventStatus:                   form.Status,
+			webhook_module.HookEventProjectColumn:            form.ProjectColumn,
 			webhook_module.HookEventWorkflowRun:              form.WorkflowRun,
 			webhook_module.HookEventWorkflowJob:              form.WorkflowJob,
 		},
-		BranchFilter: form.BranchFilter,
+		BranchFilter:   form.BranchFilter,
+		PathFilter:     form.PathFilter,
+		LabelFilter:    strings.Split(form.LabelFilter, ","),
+		ActionFilter:   strings.Split(form.ActionFilter, ","),
+		IncludeSenders: strings.Split(form.IncludeSenders, ","),
+		ExcludeSenders: strings.Split(form.ExcludeSenders, ","),
 	}
+	// the path filter has been validated by the form binding, so only the lists are normalized
+	_ = webhook_service.NormalizeHookFilters(hookEvent)
+	return hookEvent
 }
 
 type webhookParams struct {
@@ -201,6 +214,8 @@ type webhookParams struct {
 	HTTPMethod  string
 	WebhookForm forms.WebhookForm
 	Meta        any
+	// PayloadTemplate renders the payloads of the webhooks with the template content type
+	PayloadTemplate string
 }
 
 func createWebhook(ctx *context.Context, params webhookParams) {
@@ -216,6 +231,7 @@ func createWebhook(ctx *context.Context, params webhookParams) {
 		return
 	}
 	ctx.Data["BaseLink"] = orCtx.LinkNew
+	ctx.Data["PayloadTemplatePreviewEvents"] = webhook_service.PayloadTemplatePreviewEvents
 
 	if ctx.HasError() {
 		ctx.HTML(http.StatusOK, orCtx.NewTemplate)
@@ -237,6 +253,7 @@ func createWebhook(ctx *context.Context, params webhookParams) {
 		Name:            strings.TrimSpace(params.WebhookForm.Name),
 		HTTPMethod:      params.HTTPMethod,
 		ContentType:     params.ContentType,
+		PayloadTemplate: params.PayloadTemplate,
 		Secret:          params.WebhookForm.Secret,
 		HookEvent:       ParseHookEvent(params.WebhookForm),
 		IsActive:        params.WebhookForm.Active,
@@ -291,6 +308,7 @@ func editWebhook(ctx *context.Context, params webhookParams) {
 	w.URL = params.URL
 	w.Name = strings.TrimSpace(params.WebhookForm.Name)
 	w.ContentType = params.ContentType
+	w.PayloadTemplate = params.PayloadTemplate
 	w.Secret = params.WebhookForm.Secret
 	w.HookEvent = ParseHookEvent(params.WebhookForm)
 	w.IsActive = params.WebhookForm.Active
@@ -329,17 +347,42 @@ func giteaHookParams(ctx *context.Context) webhookParams {
 	form := web.GetForm(ctx).(*forms.NewWebhookForm)
 
 	contentType := webhook.ContentTypeJSON
-	if webhook.HookContentType(form.ContentType) == webhook.ContentTypeForm {
-		contentType = webhook.ContentTypeForm
+	switch ct := webhook.HookContentType(form.ContentType); ct {
+	case webhook.ContentTypeForm, webhook.ContentTypeTemplate, webhook.ContentTypeCloudEvents, webhook.ContentTypeCloudEventsBinary:
+		contentType = ct
+	}
+	if contentType == webhook.ContentTypeTemplate && !ctx.HasError() {
+		if err := webhook_service.ValidatePayloadTemplate(form.PayloadTemplate); err != nil {
+			ctx.Data["HasError"] = true
+			ctx.Data["Err_PayloadTemplate"] = true
+			ctx.Data["ErrorMsg"] = ctx.Tr("repo.settings.payload_template.invalid", err.Error())
+		}
 	}
 
 	return webhookParams{
-		Type:        webhook_module.GITEA,
-		URL:         form.PayloadURL,
-		ContentType: contentType,
-		HTTPMethod:  form.HTTPMethod,
-		WebhookForm: form.WebhookForm,
+		Type:            webhook_module.GITEA,
+		URL:             form.PayloadURL,
+		ContentType:     contentType,
+		HTTPMethod:      form.HTTPMethod,
+		WebhookForm:     form.WebhookForm,
+		PayloadTemplate: form.PayloadTemplate,
+	}
+}
+
+// WebhookTemplatePreview renders a payload template with the sample payload of an event
+func WebhookTemplatePreview(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.WebhookTemplatePreviewForm)
+	event := webhook_module.HookEventType(form.PreviewEvent)
+	if !slices.Contains(webhook_service.PayloadTemplatePreviewEvents, event) {
+		ctx.JSONError(ctx.Tr("repo.settings.payload_template.unknown_event"))
+		return
 	}
+	payload, err := webhook_service.PreviewPayloadTemplate(form.PayloadTemplate, event)
+	if err != nil {
+		ctx.JSONError(ctx.Tr("repo.settings.payload_template.invalid", err.Error()))
+		return
+	}
+	ctx.JSON(http.StatusOK, map[string]any{"payload": string(payload)})
 }
 
 // GogsHooksNewPost response for creating Gogs webhook
@@ -497,6 +540,53 @@ func mSTeamsHookParams(ctx *context.Context) webhookParams {
 	}
 }
 
+// GoogleChatHooksNewPost response for creating Google Chat webhook
+func GoogleChatHooksNewPost(ctx *context.Context) {
+	createWebhook(ctx, googleChatHookParams(ctx))
+}
+
+// GoogleChatHooksEditPost response for editing Google Chat webhook
+func GoogleChatHooksEditPost(ctx *context.Context) {
+	editWebhook(ctx, googleChatHookParams(ctx))
+}
+
+func googleChatHookParams(ctx *context.Context) webhookParams {
+	form := web.GetForm(ctx).(*forms.NewGoogleChatHookForm)
+
+	return webhookParams{
+		Type:        webhook_module.GOOGLECHAT,
+		URL:         form.PayloadURL,
+		ContentType: webhook.ContentTypeJSON,
+		WebhookForm: form.WebhookForm,
+	}
+}
+
+// MattermostHooksNewPost response for creating Mattermost webhook
+func MattermostHooksNewPost(ctx *context.Context) {
+	createWebhook(ctx, mattermostHookParams(ctx))
+}
+
+// MattermostHooksEditPost response for editing Mattermost webhook
+func MattermostHooksEditPost(ctx *context.Context) {
+	editWebhook(ctx, mattermostHookParams(ctx))
+}
+
+func mattermostHookParams(ctx *context.Context) webhookParams {
+	form := web.GetForm(ctx).(*forms.NewMattermostHookForm)
+
+	return webhookParams{
+		Type:        webhook_module.MATTERMOST,
+		URL:         form.PayloadURL,
+		ContentType: webhook.ContentTypeJSON,
+		WebhookForm: form.WebhookForm,
+		Meta: &webhook_service.MattermostMeta{
+			Channel:  strings.TrimSpace(form.Channel),
+			Username: form.Username,
+			IconURL:  form.IconURL,
+		},
+	}
+}
+
 // SlackHooksNewPost response for creating Slack webhook
 func SlackHooksNewPost(ctx *context.Context) {
 	createWebhook(ctx, slackHookParams(ctx))
@@ -593,6 +683,74 @@ func packagistHookParams(ctx *context.Context) webhookParams {
 	}
 }
 
+// AMQPHooksNewPost response for creating AMQP webhook
+func AMQPHooksNewPost(ctx *context.Context) {
+	createWebhook(ctx, brokerHookParams(ctx, webhook_module.AMQP))
+}
+
+// AMQPHooksEditPost response for editing AMQP webhook
+func AMQPHooksEditPost(ctx *context.Context) {
+	editWebhook(ctx, brokerHookParams(ctx, webhook_module.AMQP))
+}
+
+// KafkaHooksNewPost response for creating Kafka webhook
+func KafkaHooksNewPost(ctx *context.Context) {
+	createWebhook(ctx, brokerHookParams(ctx, webhook_module.KAFKA))
+}
+
+// KafkaHooksEditPost response for editing Kafka webhook
+func KafkaHooksEditPost(ctx *context.Context) {
+	editWebhook(ctx, brokerHookParams(ctx, webhook_module.KAFKA))
+}
+
+// NATSHooksNewPost response for creating NATS webhook
+func NATSHooksNewPost(ctx *context.Context) {
+	createWebhook(ctx, brokerHookParams(ctx, webhook_module.NATS))
+}
+
+// NATSHooksEditPost response for editing NATS webhook
+func NATSHooksEditPost(ctx *context.Context) {
+	editWebhook(ctx, brokerHookParams(ctx, webhook_module.NATS))
+}
+
+// RedisHooksNewPost response for creating Redis Streams webhook
+func RedisHooksNewPost(ctx *context.Context) {
+	createWebhook(ctx, brokerHookParams(ctx, webhook_module.REDIS))
+}
+
+// RedisHooksEditPost response for editing Redis Streams webhook
+func RedisHooksEditPost(ctx *context.Context) {
+	editWebhook(ctx, brokerHookParams(ctx, webhook_module.REDIS))
+}
+
+func brokerHookParams(ctx *context.Context, hookType webhook_module.HookType) webhookParams {
+	form := web.GetForm(ctx).(*forms.NewBrokerHookForm)
+
+	meta := &webhook_service.BrokerMeta{
+		Topic:       strings.TrimSpace(form.Topic),
+		CloudEvents: form.CloudEvents,
+	}
+	if hookType == webhook_module.AMQP {
+		meta.Exchange = strings.TrimSpace(form.Exchange)
+	}
+	// keep the submitted values when the form is rendered again with an error
+	ctx.Data["BrokerHook"] = meta
+	if !ctx.HasError() && !webhook_service.IsValidBrokerURL(hookType, form.BrokerURL) {
+		ctx.Data["HasError"] = true
+		ctx.Data["Err_BrokerURL"] = true
+		ctx.Data["ErrorMsg"] = ctx.Tr("repo.settings.broker.invalid_url")
+	}
+
+	return webhookParams{
+		Type:        hookType,
+		URL:         form.BrokerURL,
+		ContentType: webhook.ContentTypeJSON,
+		HTTPMethod:  http.MethodPost,
+		WebhookForm: form.WebhookForm,
+		Meta:        meta,
+	}
+}
+
 func checkWebhook(ctx *context.Context) (*ownerRepoCtx, *webhook.Webhook) {
 	orCtx, err := getOwnerRepoCtx(ctx)
 	if err != nil {
@@ -600,6 +758,7 @@ func checkWebhook(ctx *context.Context) (*ownerRepoCtx, *webhook.Webhook) {
 		return nil, nil
 	}
 	ctx.Data["BaseLink"] = orCtx.Link
+	ctx.Data["PayloadTemplatePreviewEvents"] = webhook_service.PayloadTemplatePreviewEvents
 	ctx.Data["BaseLinkNew"] = orCtx.LinkNew
 
 	var w *webhook.Webhook
@@ -631,6 +790,10 @@ func checkWebhook(ctx *context.Context) (*ownerRepoCtx, *webhook.Webhook) {
 		ctx.Data["MatrixHook"] = webhook_service.GetMatrixHook(w)
 	case webhook_module.PACKAGIST:
 		ctx.Data["PackagistHook"] = webhook_service.GetPackagistHook(w)
+	case webhook_module.MATTERMOST:
+		ctx.Data["MattermostHook"] = webhook_service.GetMattermostHook(w)
+	case webhook_module.AMQP, webhook_module.KAFKA, webhook_module.NATS, webhook_module.REDIS:
+		ctx.Data["BrokerHook"] = webhook_service.GetBrokerHook(w)
 	}
 
 	ctx.Data["History"], err = w.History(ctx, 1)
@@ -709,8 +872,8 @@ func TestWebhook(ctx *context.Context) {
 		Pusher:       apiUser,
 		Sender:       apiUser,
 	}
-	if err := webhook_service.PrepareWebhook(ctx, w, webhook_module.HookEventPush, p); err != nil {
-		ctx.Flash.Error("PrepareWebhook: " + err.Error())
+	if err := webhook_service.PrepareTestWebhook(ctx, w, webhook_module.HookEventPush, p); err != nil {
+		ctx.Flash.Error("PrepareTestWebhook: " + err.Error())
 		ctx.Status(http.StatusInternalServerError)
 	} else {
 		ctx.Flash.Info(ctx.Tr("repo.settings.webhook.delivery.success"))
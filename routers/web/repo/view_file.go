@@ -242,6 +242,9 @@ func prepareFileView(ctx *context.Context, entry *git.TreeEntry) {
 	case handleFileViewRenderSource(ctx, attrs, fInfo, contentReader):
 		// it also sets ctx.Data["FileContent"] and more
 		ctx.Data["IsDisplayingSource"] = true
+		if setting.CodeNavigation.Enabled {
+			ctx.Data["CodeNavigationLink"] = ctx.Repo.RepoLink + "/code-nav/" + ctx.Repo.CommitID
+		}
 	case handleFileViewRenderImage(ctx, fInfo, buf):
 		ctx.Data["IsImageFile"] = true
 	case fInfo.st.IsVideo():
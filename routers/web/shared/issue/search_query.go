@@ -0,0 +1,31 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issue
+
+import (
+	"errors"
+
+	issue_indexer "gitea.dev/modules/indexer/issues"
+	"gitea.dev/modules/util"
+	"gitea.dev/services/context"
+	issue_service "gitea.dev/services/issue"
+)
+
+// ApplySearchQuery parses the keyword as issue search query and applies its qualifiers to the search options.
+// If the query is invalid, a flash message is shown and nil is returned. The response is written on other errors.
+func ApplySearchQuery(ctx *context.Context, keyword string, opts *issue_indexer.SearchOptions) *issue_indexer.Query {
+	query, err := issue_indexer.ParseQuery(keyword)
+	if err == nil {
+		err = issue_service.ApplySearchQuery(ctx, ctx.Doer, query, opts)
+	}
+	if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.Flash.Error(ctx.Tr("repo.issues.search_query_invalid", err.Error()), true)
+		} else {
+			ctx.ServerError("ApplySearchQuery", err)
+		}
+		return nil
+	}
+	return query
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	issues_model "gitea.dev/models/issues"
+	project_model "gitea.dev/models/project"
+	"gitea.dev/services/context"
+	project_service "gitea.dev/services/projects"
+)
+
+// GroupColumnsByCustomField prepares the single select custom fields which can be used to group the issues of a project board,
+// and regroups the columns by the field selected with the "group_by" query parameter if there is one.
+// The board is read-only when it is grouped because its columns don't exist.
+func GroupColumnsByCustomField(ctx *context.Context, ownerID, repoID int64, columns project_model.ColumnList, issuesMap map[int64]issues_model.IssueList) (project_model.ColumnList, map[int64]issues_model.IssueList) {
+	fields, err := issues_model.GetAvailableCustomFields(ctx, ownerID, repoID)
+	if err != nil {
+		ctx.ServerError("GetAvailableCustomFields", err)
+		return nil, nil
+	}
+
+	groupByFields := make([]*issues_model.CustomField, 0, len(fields))
+	var groupByField *issues_model.CustomField
+	groupByFieldID := ctx.FormInt64("group_by")
+	for _, field := range fields {
+		if field.Type != issues_model.CustomFieldTypeSingleSelect {
+			continue
+		}
+		groupByFields = append(groupByFields, field)
+		if field.ID == groupByFieldID {
+			groupByField = field
+		}
+	}
+	ctx.Data["GroupByFields"] = groupByFields
+	if groupByField == nil {
+		return columns, issuesMap
+	}
+	ctx.Data["GroupByField"] = groupByField
+	ctx.Data["GroupByFieldID"] = groupByField.ID
+
+	columns, issuesMap, err = project_service.GroupIssuesByCustomField(ctx, groupByField, columns, issuesMap, ctx.Locale.TrString("projects.group_by.no_value"))
+	if err != nil {
+		ctx.ServerError("GroupIssuesByCustomField", err)
+		return nil, nil
+	}
+	return columns, issuesMap
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	"errors"
+	"strconv"
+
+	issues_model "gitea.dev/models/issues"
+	project_model "gitea.dev/models/project"
+	"gitea.dev/modules/util"
+	"gitea.dev/modules/web"
+	"gitea.dev/services/context"
+	"gitea.dev/services/forms"
+	project_service "gitea.dev/services/projects"
+)
+
+// PrepareProjectViews prepares the views of a project and arranges the issues of the board by the view selected with the "view" query parameter.
+// It returns whether a view is selected, then the board is not shown.
+func PrepareProjectViews(ctx *context.Context, project *project_model.Project, columns project_model.ColumnList, issuesMap map[int64]issues_model.IssueList) bool {
+	views, err := project_model.GetViewsByProjectID(ctx, project.ID)
+	if err != nil {
+		ctx.ServerError("GetViewsByProjectID", err)
+		return false
+	}
+	customFields, err := project_service.GetProjectCustomFields(ctx, project)
+	if err != nil {
+		ctx.ServerError("GetProjectCustomFields", err)
+		return false
+	}
+	ctx.Data["ProjectViews"] = views
+	ctx.Data["ProjectViewFields"] = project_model.ViewFields
+	ctx.Data["ProjectViewGroupFields"] = project_model.ViewGroupFields
+	ctx.Data["ProjectViewSortFields"] = project_model.ViewSortFields
+	ctx.Data["ProjectCustomFields"] = customFields
+
+	viewID := ctx.FormInt64("view")
+	if viewID == 0 {
+		return false
+	}
+	var view *project_model.View
+	for _, v := range views {
+		if v.ID == viewID {
+			view = v
+			break
+		}
+	}
+	if view == nil {
+		ctx.NotFound(nil)
+		return false
+	}
+
+	data, err := project_service.BuildViewData(ctx, ctx.Doer, view, customFields, columns, issuesMap)
+	if err != nil {
+		ctx.ServerError("BuildViewData", err)
+		return false
+	}
+	ctx.Data["ProjectView"] = view
+	ctx.Data["ProjectViewData"] = data
+	return true
+}
+
+func getProjectForViewChange(ctx *context.Context) *project_model.Project {
+	project, err := project_model.GetProjectByID(ctx, ctx.PathParamInt64("id"))
+	if err != nil {
+		ctx.NotFoundOrServerError("GetProjectByID", project_model.IsErrProjectNotExist, err)
+		return nil
+	}
+	if !project.CanBeAccessedByOwnerRepo(ctx.ContextUser.ID, ctx.Repo.Repository) {
+		ctx.NotFound(nil)
+		return nil
+	}
+	return project
+}
+
+func saveProjectView(ctx *context.Context, project *project_model.Project, view *project_model.View, save func() error) {
+	if ctx.HasError() {
+		ctx.JSONError(ctx.GetErrMsg())
+		return
+	}
+	form := web.GetForm(ctx).(*forms.ProjectViewForm)
+	view.Name = form.Name
+	view.Layout = project_model.ViewLayout(form.Layout)
+	view.Fields = form.Fields
+	view.GroupBy = form.GroupBy
+	view.SortBy = form.SortBy
+	view.SortDesc = form.SortDesc
+	view.Filter = form.Filter
+
+	customFields, err := project_service.GetProjectCustomFields(ctx, project)
+	if err != nil {
+		ctx.ServerError("GetProjectCustomFields", err)
+		return
+	}
+	if err = project_service.ValidateViewCustomFields(view, customFields); err == nil {
+		err = save()
+	}
+	if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.JSONError(err.Error())
+		} else {
+			ctx.ServerError("SaveProjectView", err)
+		}
+		return
+	}
+	ctx.JSONRedirect(project.Link(ctx) + "?view=" + strconv.FormatInt(view.ID, 10))
+}
+
+// NewProjectViewPost creates a view of a project
+func NewProjectViewPost(ctx *context.Context) {
+	project := getProjectForViewChange(ctx)
+	if ctx.Written() {
+		return
+	}
+	view := &project_model.View{ProjectID: project.ID}
+	saveProjectView(ctx, project, view, func() error {
+		return project_model.NewView(ctx, view)
+	})
+}
+
+// EditProjectViewPost updates a view of a project
+func EditProjectViewPost(ctx *context.Context) {
+	project := getProjectForViewChange(ctx)
+	if ctx.Written() {
+		return
+	}
+	view, err := project_model.GetViewByIDAndProjectID(ctx, ctx.PathParamInt64("viewID"), project.ID)
+	if err != nil {
+		ctx.NotFoundOrServerError("GetViewByIDAndProjectID", project_model.IsErrProjectViewNotExist, err)
+		return
+	}
+	saveProjectView(ctx, project, view, func() error {
+		return project_model.UpdateView(ctx, view)
+	})
+}
+
+// DeleteProjectView deletes a view of a project
+func DeleteProjectView(ctx *context.Context) {
+	project := getProjectForViewChange(ctx)
+	if ctx.Written() {
+		return
+	}
+	view, err := project_model.GetViewByIDAndProjectID(ctx, ctx.PathParamInt64("viewID"), project.ID)
+	if err != nil {
+		ctx.NotFoundOrServerError("GetViewByIDAndProjectID", project_model.IsErrProjectViewNotExist, err)
+		return
+	}
+	if err := project_model.DeleteViewByID(ctx, view.ID); err != nil {
+		ctx.ServerError("DeleteViewByID", err)
+		return
+	}
+	ctx.JSONRedirect(project.Link(ctx))
+}
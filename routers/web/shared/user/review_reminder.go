@@ -0,0 +1,131 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package user
+
+import (
+	"errors"
+	"time"
+
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/organization"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/util"
+	"gitea.dev/modules/web"
+	"gitea.dev/services/context"
+	"gitea.dev/services/forms"
+)
+
+// reviewReminderWeekdays are the days of the week in the order they are shown, starting on Monday
+var reviewReminderWeekdays = []time.Weekday{
+	time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday,
+}
+
+// reviewReminderItem is a review reminder shown in the settings
+type reviewReminderItem struct {
+	*issues_model.ReviewReminder
+	Team *organization.Team
+}
+
+// ReviewReminders lists the review reminders of a user or of the teams of an organization
+func ReviewReminders(ctx *context.Context, owner *user_model.User) {
+	reminders, err := issues_model.GetReviewRemindersByOwner(ctx, owner.ID)
+	if err != nil {
+		ctx.ServerError("GetReviewRemindersByOwner", err)
+		return
+	}
+
+	teams := make(map[int64]*organization.Team)
+	if owner.IsOrganization() {
+		orgTeams, err := organization.FindOrgTeams(ctx, owner.ID)
+		if err != nil {
+			ctx.ServerError("FindOrgTeams", err)
+			return
+		}
+		for _, team := range orgTeams {
+			teams[team.ID] = team
+		}
+		ctx.Data["Teams"] = orgTeams
+	}
+
+	items := make([]*reviewReminderItem, 0, len(reminders))
+	for _, r := range reminders {
+		items = append(items, &reviewReminderItem{ReviewReminder: r, Team: teams[r.TeamID]})
+	}
+	ctx.Data["ReviewReminders"] = items
+	ctx.Data["IsOrgReviewReminders"] = owner.IsOrganization()
+	ctx.Data["Weekdays"] = reviewReminderWeekdays
+	ctx.Data["DefaultTimeZone"] = setting.DefaultUILocation.String()
+}
+
+func reviewReminderPost(ctx *context.Context, form *forms.ReviewReminderForm, owner *user_model.User) error {
+	r := &issues_model.ReviewReminder{
+		OwnerID:    owner.ID,
+		SkipDrafts: form.SkipDrafts,
+		Delivery:   issues_model.ReviewReminderDelivery(form.Delivery),
+		WebhookURL: form.WebhookURL,
+	}
+	// the personal reminders follow the time zone of the user, the team reminders have their own
+	if owner.IsOrganization() {
+		team, err := organization.GetTeamByID(ctx, form.TeamID)
+		if err != nil {
+			return err
+		}
+		if team.OrgID != owner.ID {
+			return util.ErrNotExist
+		}
+		r.TeamID = team.ID
+		r.TimeZone = form.TimeZone
+	}
+
+	clock, err := time.Parse("15:04", form.Time)
+	if err != nil {
+		return util.NewInvalidArgumentErrorf("invalid time of day %q", form.Time)
+	}
+	r.TimeOfDay = clock.Hour()*60 + clock.Minute()
+	for _, day := range form.Weekdays {
+		if day < int(time.Sunday) || day > int(time.Saturday) {
+			return util.NewInvalidArgumentErrorf("invalid day of the week %d", day)
+		}
+		r.Weekdays |= 1 << day
+	}
+	return issues_model.CreateReviewReminder(ctx, r)
+}
+
+// ReviewReminderPost adds a review reminder for the user or for a team of the organization
+func ReviewReminderPost(ctx *context.Context, owner *user_model.User, redirect string) {
+	if ctx.HasError() {
+		ctx.Flash.Error(ctx.GetErrMsg())
+		ctx.Redirect(redirect)
+		return
+	}
+
+	form := web.GetForm(ctx).(*forms.ReviewReminderForm)
+	err := reviewReminderPost(ctx, form, owner)
+	if errors.Is(err, util.ErrInvalidArgument) {
+		ctx.Flash.Error(ctx.Tr("settings.review_reminders.add_failed", err.Error()))
+	} else if errors.Is(err, util.ErrNotExist) {
+		ctx.Flash.Error(ctx.Tr("error.not_found"))
+	} else if err != nil {
+		ctx.ServerError("ReviewReminderPost", err)
+		return
+	} else {
+		ctx.Flash.Success(ctx.Tr("settings.review_reminders.add_success"))
+	}
+	ctx.Redirect(redirect)
+}
+
+// ReviewReminderDelete removes a review reminder of the user or of a team of the organization
+func ReviewReminderDelete(ctx *context.Context, owner *user_model.User, redirect string) {
+	if err := issues_model.DeleteReviewReminder(ctx, owner.ID, ctx.PathParamInt64("id")); err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.JSONErrorNotFound()
+			return
+		}
+		ctx.ServerError("DeleteReviewReminder", err)
+		return
+	}
+	ctx.Flash.Success(ctx.Tr("settings.review_reminders.deletion_success"))
+	ctx.JSONRedirect(redirect)
+}
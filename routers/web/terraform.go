@@ -0,0 +1,32 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package web
+
+import (
+	"net/http"
+
+	"gitea.dev/modules/setting"
+	"gitea.dev/services/context"
+)
+
+// https://developer.hashicorp.com/terraform/internals/remote-service-discovery
+type terraformServicesType struct {
+	ModulesV1   string `json:"modules.v1"`
+	ProvidersV1 string `json:"providers.v1"`
+}
+
+// terraformServiceDiscovery announces the Terraform module and provider registry.
+// The namespace of a module or provider address is the name of the package owner.
+func terraformServiceDiscovery(ctx *context.Context) {
+	if !setting.Packages.Enabled {
+		ctx.NotFound(nil)
+		return
+	}
+
+	base := setting.AppSubURL + "/api/packages/-/terraform/"
+	ctx.JSON(http.StatusOK, terraformServicesType{
+		ModulesV1:   base + "modules/v1/",
+		ProvidersV1: base + "providers/v1/",
+	})
+}
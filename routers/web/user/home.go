@@ -110,6 +110,10 @@ func Dashboard(ctx *context.Context) {
 	}
 
 	prepareHeatmapURL(ctx)
+	prepareSavedSearches(ctx, true)
+	if ctx.Written() {
+		return
+	}
 
 	pageSize := setting.UI.User.RepoPagingNum
 	feeds, count, err := feed_service.GetFeedsForDashboard(ctx, activities_model.GetFeedsOptions{
@@ -368,6 +372,10 @@ func buildIssueOverview(ctx *context.Context, unitType unit.Type) {
 	if ctx.Written() {
 		return
 	}
+	prepareSavedSearches(ctx, false)
+	if ctx.Written() {
+		return
+	}
 
 	// Default to recently updated, unlike repository issues list
 	sortType := util.IfZero(ctx.FormString("sort"), "recentupdate")
@@ -536,13 +544,39 @@ func buildIssueOverview(ctx *context.Context, unitType unit.Type) {
 
 	// Slice of Issues that will be displayed on the overview page
 	// USING FINAL STATE OF opts FOR A QUERY.
+	searchOpts := issue_indexer.ToSearchOptions(keyword, opts).Copy(
+		func(o *issue_indexer.SearchOptions) {
+			o.SearchMode = indexer.SearchModeType(searchMode)
+		},
+	)
+	searchAllPublic := ctx.Doer.ID == ctxUser.ID
+	if keyword != "" {
+		query := issue.ApplySearchQuery(ctx, keyword, searchOpts)
+		if ctx.Written() {
+			return
+		}
+		if query == nil {
+			// the query is invalid, there is nothing to show
+			searchOpts.RepoIDs = []int64{0}
+			searchOpts.AllPublic = false
+			searchAllPublic = false
+		} else {
+			if query.IsClosed.Has() {
+				isShowClosed = query.IsClosed.Value()
+			}
+			if query.SortType != "" {
+				sortType = query.SortType
+			}
+			if query.Repo != "" {
+				searchAllPublic = false
+			}
+		}
+		searchOpts.IsClosed = optional.Some(isShowClosed)
+	}
+
 	var issues issues_model.IssueList
 	{
-		issueIDs, _, err := issue_indexer.SearchIssues(ctx, issue_indexer.ToSearchOptions(keyword, opts).Copy(
-			func(o *issue_indexer.SearchOptions) {
-				o.SearchMode = indexer.SearchModeType(searchMode)
-			},
-		))
+		issueIDs, _, err := issue_indexer.SearchIssues(ctx, searchOpts)
 		if err != nil {
 			ctx.ServerError("issueIDsFromSearch", err)
 			return
@@ -568,11 +602,7 @@ func buildIssueOverview(ctx *context.Context, unitType unit.Type) {
 	// -------------------------------
 	// Fill stats to post to ctx.Data.
 	// -------------------------------
-	issueStats, err := getUserIssueStats(ctx, ctxUser, filterMode, issue_indexer.ToSearchOptions(keyword, opts).Copy(
-		func(o *issue_indexer.SearchOptions) {
-			o.SearchMode = indexer.SearchModeType(searchMode)
-		},
-	))
+	issueStats, err := getUserIssueStats(ctx, filterMode, searchAllPublic, searchOpts)
 	if err != nil {
 		ctx.ServerError("getUserIssueStats", err)
 		return
@@ -768,16 +798,17 @@ func UsernameSubRoute(ctx *context.Context) {
 	}
 }
 
-func getUserIssueStats(ctx *context.Context, ctxUser *user_model.User, filterMode int, opts *issue_indexer.SearchOptions) (ret *issues_model.IssueStats, err error) {
+// getUserIssueStats counts the issues for the tabs and the sidebar of the issue overview.
+// If the doer is the same as the context user, which means the doer is viewing his own dashboard,
+// it's not enough to show the repos that the doer owns or has been explicitly granted access to,
+// because the doer may create issues or be mentioned in any public repo.
+// So searchAllPublic is true and issues in all public repos are counted, unless the search query limits the repos.
+func getUserIssueStats(ctx *context.Context, filterMode int, searchAllPublic bool, opts *issue_indexer.SearchOptions) (ret *issues_model.IssueStats, err error) {
 	ret = &issues_model.IssueStats{}
 	doerID := ctx.Doer.ID
 
 	opts = opts.Copy(func(o *issue_indexer.SearchOptions) {
-		// If the doer is the same as the context user, which means the doer is viewing his own dashboard,
-		// it's not enough to show the repos that the doer owns or has been explicitly granted access to,
-		// because the doer may create issues or be mentioned in any public repo.
-		// So we need search issues in all public repos.
-		o.AllPublic = doerID == ctxUser.ID
+		o.AllPublic = searchAllPublic
 	})
 
 	// Open/Closed are for the tabs of the issue list
@@ -318,6 +318,13 @@ func ViewPackageVersion(ctx *context.Context) {
 	}
 	ctx.Data["LatestVersions"] = pvs
 	ctx.Data["TotalVersionCount"] = pvsTotal
+
+	vulnerabilities, err := packages_model.GetVulnerabilitiesByVersionID(ctx, pd.Version.ID)
+	if err != nil {
+		ctx.ServerError("GetVulnerabilitiesByVersionID", err)
+		return
+	}
+	ctx.Data["PackageVulnerabilities"] = vulnerabilities
 	ctx.Data["PackageVersionViewData"], err = packages_service.GetSpecManager().Get(pd.Package.Type).GetViewPackageVersionData(ctx, pd)
 	if err != nil {
 		ctx.ServerError("GetViewPackageVersionData", err)
@@ -0,0 +1,145 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package user
+
+import (
+	"errors"
+	"net/url"
+
+	"gitea.dev/models/db"
+	issues_model "gitea.dev/models/issues"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/optional"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/util"
+	"gitea.dev/services/context"
+	issue_service "gitea.dev/services/issue"
+)
+
+// savedSearchOwner returns the owner of the saved searches shown on the current dashboard page,
+// members of an organization can use its saved searches but only the owners can change them
+func savedSearchOwner(ctx *context.Context) (owner *user_model.User, canManage bool) {
+	if ctx.Org != nil && ctx.Org.Organization != nil {
+		return ctx.Org.Organization.AsUser(), ctx.Org.IsOwner
+	}
+	return ctx.Doer, true
+}
+
+// savedSearchIssuesLink returns the link of the issue overview the saved searches of the owner are used in
+func savedSearchIssuesLink(owner *user_model.User) string {
+	if owner.IsOrganization() {
+		return setting.AppSubURL + "/org/" + url.PathEscape(owner.Name) + "/issues"
+	}
+	return setting.AppSubURL + "/issues"
+}
+
+// prepareSavedSearches loads the saved searches of the owner, only the pinned ones if onlyPinned is set
+func prepareSavedSearches(ctx *context.Context, onlyPinned bool) {
+	owner, canManage := savedSearchOwner(ctx)
+	opts := issues_model.FindSavedSearchesOptions{OwnerID: owner.ID}
+	if onlyPinned {
+		opts.IsPinned = optional.Some(true)
+	}
+	searches, err := db.Find[issues_model.SavedSearch](ctx, opts)
+	if err != nil {
+		ctx.ServerError("FindSavedSearches", err)
+		return
+	}
+	ids := make([]int64, 0, len(searches))
+	for _, s := range searches {
+		ids = append(ids, s.ID)
+	}
+	subscribedIDs, err := issues_model.GetSubscribedSavedSearchIDs(ctx, ctx.Doer.ID, ids)
+	if err != nil {
+		ctx.ServerError("GetSubscribedSavedSearchIDs", err)
+		return
+	}
+
+	ctx.Data["SavedSearches"] = searches
+	ctx.Data["SubscribedSavedSearchIDs"] = subscribedIDs
+	ctx.Data["CanManageSavedSearches"] = canManage
+	ctx.Data["SavedSearchIssuesLink"] = savedSearchIssuesLink(owner)
+}
+
+func getSavedSearchFromPath(ctx *context.Context, needManage bool) *issues_model.SavedSearch {
+	owner, canManage := savedSearchOwner(ctx)
+	if needManage && !canManage {
+		ctx.JSONError(ctx.Tr("error.permission_denied"))
+		return nil
+	}
+	s, err := issues_model.GetSavedSearchByID(ctx, owner.ID, ctx.PathParamInt64("id"))
+	if err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.JSONErrorNotFound()
+		} else {
+			ctx.ServerError("GetSavedSearchByID", err)
+		}
+		return nil
+	}
+	return s
+}
+
+// NewSavedSearchPost saves the current issue search query
+func NewSavedSearchPost(ctx *context.Context) {
+	owner, canManage := savedSearchOwner(ctx)
+	if !canManage {
+		ctx.JSONError(ctx.Tr("error.permission_denied"))
+		return
+	}
+	_, err := issue_service.CreateSavedSearch(ctx, ctx.Doer, owner, ctx.FormString("name"), ctx.FormString("q"), ctx.FormBool("pinned"))
+	if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.JSONError(ctx.Tr("repo.issues.saved_search_invalid", err.Error()))
+		} else {
+			ctx.ServerError("CreateSavedSearch", err)
+		}
+		return
+	}
+	ctx.Flash.Success(ctx.Tr("repo.issues.saved_search_created"))
+	ctx.JSONRedirect("")
+}
+
+// DeleteSavedSearchPost deletes a saved search
+func DeleteSavedSearchPost(ctx *context.Context) {
+	s := getSavedSearchFromPath(ctx, true)
+	if ctx.Written() {
+		return
+	}
+	if err := issues_model.DeleteSavedSearch(ctx, s.ID); err != nil {
+		ctx.ServerError("DeleteSavedSearch", err)
+		return
+	}
+	ctx.JSONRedirect("")
+}
+
+// PinSavedSearchPost pins a saved search to the dashboard or unpins it
+func PinSavedSearchPost(ctx *context.Context) {
+	s := getSavedSearchFromPath(ctx, true)
+	if ctx.Written() {
+		return
+	}
+	s.IsPinned = ctx.FormBool("pinned")
+	if err := issue_service.UpdateSavedSearch(ctx, s); err != nil {
+		ctx.ServerError("UpdateSavedSearch", err)
+		return
+	}
+	ctx.JSONRedirect("")
+}
+
+// SubscribeSavedSearchPost subscribes the doer to new issues matching a saved search or removes the subscription
+func SubscribeSavedSearchPost(ctx *context.Context) {
+	s := getSavedSearchFromPath(ctx, false)
+	if ctx.Written() {
+		return
+	}
+	if err := issue_service.SubscribeSavedSearch(ctx, s, ctx.Doer, ctx.FormBool("subscribe")); err != nil {
+		if errors.Is(err, util.ErrPermissionDenied) {
+			ctx.JSONError(ctx.Tr("error.permission_denied"))
+		} else {
+			ctx.ServerError("SubscribeSavedSearch", err)
+		}
+		return
+	}
+	ctx.JSONRedirect("")
+}
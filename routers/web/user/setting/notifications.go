@@ -4,14 +4,25 @@
 package setting
 
 import (
+	"errors"
 	"net/http"
+	"strings"
 
+	activities_model "gitea.dev/models/activities"
+	"gitea.dev/models/db"
+	"gitea.dev/models/organization"
+	access_model "gitea.dev/models/perm/access"
+	repo_model "gitea.dev/models/repo"
 	"gitea.dev/models/unit"
 	user_model "gitea.dev/models/user"
 	"gitea.dev/modules/optional"
 	"gitea.dev/modules/setting"
 	"gitea.dev/modules/templates"
+	"gitea.dev/modules/util"
+	"gitea.dev/modules/web"
 	"gitea.dev/services/context"
+	"gitea.dev/services/forms"
+	"gitea.dev/services/uinotification"
 	"gitea.dev/services/user"
 )
 
@@ -19,15 +30,19 @@ const tplSettingsNotifications templates.TplName = "user/settings/notifications"
 
 // Notifications render user's notifications settings
 func Notifications(ctx *context.Context) {
-	if !setting.Service.EnableNotifyMail {
-		ctx.NotFound(nil)
-		return
-	}
-
 	ctx.Data["Title"] = ctx.Tr("notifications")
 	ctx.Data["PageIsSettingsNotifications"] = true
 	ctx.Data["EmailNotificationsPreference"] = ctx.Doer.EmailNotificationsPreference
 
+	channels, err := db.Find[activities_model.NotificationChannel](ctx, activities_model.FindNotificationChannelsOptions{UserID: ctx.Doer.ID})
+	if err != nil {
+		ctx.ServerError("FindNotificationChannels", err)
+		return
+	}
+	ctx.Data["NotificationChannels"] = channels
+	ctx.Data["NotificationChannelTypes"] = activities_model.NotificationChannelTypes()
+	ctx.Data["NotificationReasons"] = activities_model.NotificationReasons()
+
 	actionsEmailPref, err := user_model.GetUserSetting(ctx, ctx.Doer.ID, user_model.SettingsKeyEmailNotificationGiteaActions, user_model.SettingEmailNotificationGiteaActionsFailureOnly)
 	if err != nil {
 		ctx.ServerError("GetUserSetting", err)
@@ -35,6 +50,29 @@ func Notifications(ctx *context.Context) {
 	}
 	ctx.Data["ActionsEmailNotificationsPreference"] = actionsEmailPref
 
+	if setting.WebPush.Enabled {
+		subs, err := activities_model.GetPushSubscriptionsByUserID(ctx, ctx.Doer.ID)
+		if err != nil {
+			ctx.ServerError("GetPushSubscriptionsByUserID", err)
+			return
+		}
+		publicKey, err := uinotification.WebPushPublicKey(ctx)
+		if err != nil {
+			ctx.ServerError("WebPushPublicKey", err)
+			return
+		}
+		ctx.Data["EnableWebPush"] = true
+		ctx.Data["PushSubscriptions"] = subs
+		ctx.Data["WebPushPublicKey"] = publicKey
+	}
+
+	if setting.Service.EnableNotifyMail {
+		if err := loadMailPreferences(ctx); err != nil {
+			ctx.ServerError("loadMailPreferences", err)
+			return
+		}
+	}
+
 	ctx.HTML(http.StatusOK, tplSettingsNotifications)
 }
 
@@ -87,3 +125,342 @@ func NotificationsActionsEmailPost(ctx *context.Context) {
 	ctx.Flash.Success(ctx.Tr("settings.email_preference_set_success"))
 	ctx.Redirect(setting.AppSubURL + "/user/settings/notifications")
 }
+
+// NotificationChannelPost adds a personal channel the user's notifications are pushed to
+func NotificationChannelPost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.NotificationChannelForm)
+	if ctx.HasError() {
+		ctx.Flash.Error(ctx.GetErrMsg())
+		ctx.Redirect(setting.AppSubURL + "/user/settings/notifications")
+		return
+	}
+
+	channelType := activities_model.NotificationChannelType(form.Type)
+	if channelType == activities_model.NotificationChannelMatrix && form.RoomID == "" {
+		ctx.Flash.Error(ctx.Tr("settings.notification_channels.room_id_required"))
+		ctx.Redirect(setting.AppSubURL + "/user/settings/notifications")
+		return
+	}
+	reasons := make([]activities_model.NotificationReason, 0, len(form.Reasons))
+	for _, r := range form.Reasons {
+		reason := activities_model.NotificationReason(r)
+		if !reason.IsValid() {
+			ctx.Flash.Error(ctx.Tr("invalid_data", r))
+			ctx.Redirect(setting.AppSubURL + "/user/settings/notifications")
+			return
+		}
+		reasons = append(reasons, reason)
+	}
+
+	channel := &activities_model.NotificationChannel{
+		UserID:   ctx.Doer.ID,
+		Name:     form.Name,
+		Type:     channelType,
+		URL:      form.URL,
+		RoomID:   form.RoomID,
+		Reasons:  reasons,
+		IsActive: true,
+	}
+	if err := channel.SetToken(form.Token); err != nil {
+		ctx.ServerError("SetToken", err)
+		return
+	}
+	if err := activities_model.CreateNotificationChannel(ctx, channel); err != nil {
+		ctx.ServerError("CreateNotificationChannel", err)
+		return
+	}
+	ctx.Flash.Success(ctx.Tr("settings.notification_channels.add_success"))
+	ctx.Redirect(setting.AppSubURL + "/user/settings/notifications")
+}
+
+// NotificationChannelActivePost enables or disables a personal notification channel
+func NotificationChannelActivePost(ctx *context.Context) {
+	channel, err := activities_model.GetNotificationChannelByID(ctx, ctx.Doer.ID, ctx.PathParamInt64("id"))
+	if errors.Is(err, util.ErrNotExist) {
+		ctx.NotFound(nil)
+		return
+	} else if err != nil {
+		ctx.ServerError("GetNotificationChannelByID", err)
+		return
+	}
+	channel.IsActive = ctx.FormBool("active")
+	if err := activities_model.UpdateNotificationChannel(ctx, channel); err != nil {
+		ctx.ServerError("UpdateNotificationChannel", err)
+		return
+	}
+	ctx.Redirect(setting.AppSubURL + "/user/settings/notifications")
+}
+
+// NotificationChannelDelete removes a personal notification channel
+func NotificationChannelDelete(ctx *context.Context) {
+	if err := activities_model.DeleteNotificationChannel(ctx, ctx.Doer.ID, ctx.PathParamInt64("id")); err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.JSONErrorNotFound()
+			return
+		}
+		ctx.ServerError("DeleteNotificationChannel", err)
+		return
+	}
+	ctx.Flash.Success(ctx.Tr("settings.notification_channels.deletion_success"))
+	ctx.JSONRedirect(setting.AppSubURL + "/user/settings/notifications")
+}
+
+// mailPreferenceOverride is a per-organization or per-repository mail preference shown in the settings
+type mailPreferenceOverride struct {
+	*user_model.MailPreference
+	Name string
+	Link string
+}
+
+func loadMailPreferences(ctx *context.Context) error {
+	global, err := user_model.GetMailPreference(ctx, ctx.Doer.ID, 0, 0)
+	if err != nil {
+		return err
+	}
+	deliveries := make(map[user_model.MailEventType]user_model.MailDelivery, len(user_model.MailEventTypes()))
+	for _, event := range user_model.MailEventTypes() {
+		deliveries[event] = user_model.MailDeliveryInstant
+		if d, ok := global.Events[event]; ok {
+			deliveries[event] = d
+		}
+	}
+	ctx.Data["MailEventTypes"] = user_model.MailEventTypes()
+	ctx.Data["MailDeliveries"] = deliveries
+
+	frequency, err := user_model.GetUserSetting(ctx, ctx.Doer.ID, user_model.SettingsKeyEmailDigestFrequency, user_model.SettingEmailDigestDaily)
+	if err != nil {
+		return err
+	}
+	ctx.Data["EmailDigestFrequency"] = frequency
+
+	prefs, err := user_model.GetMailPreferenceOverrides(ctx, ctx.Doer.ID)
+	if err != nil {
+		return err
+	}
+	ownerIDs := make([]int64, 0, len(prefs))
+	repoIDs := make([]int64, 0, len(prefs))
+	for _, p := range prefs {
+		if p.RepoID != 0 {
+			repoIDs = append(repoIDs, p.RepoID)
+		} else {
+			ownerIDs = append(ownerIDs, p.OwnerID)
+		}
+	}
+	owners, err := user_model.GetUsersMapByIDs(ctx, ownerIDs)
+	if err != nil {
+		return err
+	}
+	repos, err := repo_model.GetRepositoriesMapByIDs(ctx, repoIDs)
+	if err != nil {
+		return err
+	}
+	overrides := make([]*mailPreferenceOverride, 0, len(prefs))
+	for _, p := range prefs {
+		o := &mailPreferenceOverride{MailPreference: p}
+		if repo := repos[p.RepoID]; repo != nil {
+			o.Name, o.Link = repo.FullName(), repo.Link()
+		} else if owner := owners[p.OwnerID]; owner != nil {
+			o.Name, o.Link = owner.Name, owner.HomeLink()
+		} else {
+			continue
+		}
+		overrides = append(overrides, o)
+	}
+	ctx.Data["MailPreferenceOverrides"] = overrides
+	return nil
+}
+
+// parseMailDeliveries reads the delivery of every event type from the form, empty values are skipped
+func parseMailDeliveries(ctx *context.Context) (map[user_model.MailEventType]user_model.MailDelivery, bool) {
+	events := make(map[user_model.MailEventType]user_model.MailDelivery, len(user_model.MailEventTypes()))
+	for _, event := range user_model.MailEventTypes() {
+		value := ctx.FormString("event_" + string(event))
+		if value == "" {
+			continue
+		}
+		delivery := user_model.MailDelivery(value)
+		if !delivery.IsValid() {
+			ctx.Flash.Error(ctx.Tr("invalid_data", value))
+			return nil, false
+		}
+		events[event] = delivery
+	}
+	return events, true
+}
+
+// NotificationsEmailDeliveryPost sets how the mails of each event type are delivered and how often the digest is sent
+func NotificationsEmailDeliveryPost(ctx *context.Context) {
+	if !setting.Service.EnableNotifyMail {
+		ctx.NotFound(nil)
+		return
+	}
+
+	frequency := ctx.FormString("digest_frequency")
+	if frequency != user_model.SettingEmailDigestDaily && frequency != user_model.SettingEmailDigestWeekly {
+		ctx.Flash.Error(ctx.Tr("invalid_data", frequency))
+		ctx.Redirect(setting.AppSubURL + "/user/settings/notifications")
+		return
+	}
+	events, ok := parseMailDeliveries(ctx)
+	if !ok {
+		ctx.Redirect(setting.AppSubURL + "/user/settings/notifications")
+		return
+	}
+	// instant delivery is the default, there is no need to store it
+	for event, delivery := range events {
+		if delivery == user_model.MailDeliveryInstant {
+			delete(events, event)
+		}
+	}
+
+	if err := user_model.SetMailPreference(ctx, &user_model.MailPreference{UserID: ctx.Doer.ID, Events: events}); err != nil {
+		ctx.ServerError("SetMailPreference", err)
+		return
+	}
+	if err := user_model.SetUserSetting(ctx, ctx.Doer.ID, user_model.SettingsKeyEmailDigestFrequency, frequency); err != nil {
+		ctx.ServerError("SetUserSetting", err)
+		return
+	}
+	ctx.Flash.Success(ctx.Tr("settings.email_preference_set_success"))
+	ctx.Redirect(setting.AppSubURL + "/user/settings/notifications")
+}
+
+// NotificationsEmailOverridePost adds or replaces the mail preference of an organization or a repository
+func NotificationsEmailOverridePost(ctx *context.Context) {
+	if !setting.Service.EnableNotifyMail {
+		ctx.NotFound(nil)
+		return
+	}
+
+	level := ctx.FormString("level")
+	if !(level == "" ||
+		level == user_model.EmailNotificationsEnabled ||
+		level == user_model.EmailNotificationsOnMention ||
+		level == user_model.EmailNotificationsDisabled ||
+		level == user_model.EmailNotificationsAndYourOwn) {
+		ctx.Flash.Error(ctx.Tr("invalid_data", level))
+		ctx.Redirect(setting.AppSubURL + "/user/settings/notifications")
+		return
+	}
+	events, ok := parseMailDeliveries(ctx)
+	if !ok {
+		ctx.Redirect(setting.AppSubURL + "/user/settings/notifications")
+		return
+	}
+
+	pref := &user_model.MailPreference{UserID: ctx.Doer.ID, Level: level, Events: events}
+	target := strings.Trim(strings.TrimSpace(ctx.FormString("target")), "/")
+	if ownerName, repoName, isRepo := strings.Cut(target, "/"); isRepo {
+		repo, err := repo_model.GetRepositoryByOwnerAndName(ctx, ownerName, repoName)
+		if err != nil && !repo_model.IsErrRepoNotExist(err) {
+			ctx.ServerError("GetRepositoryByOwnerAndName", err)
+			return
+		}
+		if repo != nil {
+			if hasAccess, err := access_model.HasAnyUnitAccess(ctx, ctx.Doer.ID, repo); err != nil {
+				ctx.ServerError("HasAnyUnitAccess", err)
+				return
+			} else if !hasAccess {
+				repo = nil
+			}
+		}
+		if repo == nil {
+			ctx.Flash.Error(ctx.Tr("settings.email_overrides.target_not_exist", target))
+			ctx.Redirect(setting.AppSubURL + "/user/settings/notifications")
+			return
+		}
+		pref.RepoID = repo.ID
+	} else {
+		owner, err := user_model.GetUserByName(ctx, target)
+		if err != nil && !user_model.IsErrUserNotExist(err) {
+			ctx.ServerError("GetUserByName", err)
+			return
+		}
+		if owner == nil || !owner.IsOrganization() || !organization.HasOrgOrUserVisible(ctx, owner, ctx.Doer) {
+			ctx.Flash.Error(ctx.Tr("settings.email_overrides.target_not_exist", target))
+			ctx.Redirect(setting.AppSubURL + "/user/settings/notifications")
+			return
+		}
+		pref.OwnerID = owner.ID
+	}
+
+	if pref.IsEmpty() {
+		ctx.Flash.Error(ctx.Tr("settings.email_overrides.empty"))
+		ctx.Redirect(setting.AppSubURL + "/user/settings/notifications")
+		return
+	}
+	if err := user_model.SetMailPreference(ctx, pref); err != nil {
+		ctx.ServerError("SetMailPreference", err)
+		return
+	}
+	ctx.Flash.Success(ctx.Tr("settings.email_overrides.add_success"))
+	ctx.Redirect(setting.AppSubURL + "/user/settings/notifications")
+}
+
+// NotificationsEmailOverrideDelete removes the mail preference of an organization or a repository
+func NotificationsEmailOverrideDelete(ctx *context.Context) {
+	if err := user_model.DeleteMailPreference(ctx, ctx.Doer.ID, ctx.PathParamInt64("id")); err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.JSONErrorNotFound()
+			return
+		}
+		ctx.ServerError("DeleteMailPreference", err)
+		return
+	}
+	ctx.Flash.Success(ctx.Tr("settings.email_overrides.deletion_success"))
+	ctx.JSONRedirect(setting.AppSubURL + "/user/settings/notifications")
+}
+
+// PushSubscriptionPost subscribes the browser of the user to push notifications
+func PushSubscriptionPost(ctx *context.Context) {
+	if !setting.WebPush.Enabled {
+		ctx.NotFound(nil)
+		return
+	}
+	form := web.GetForm(ctx).(*forms.PushSubscriptionForm)
+	if ctx.HasError() {
+		ctx.JSONError(ctx.GetErrMsg())
+		return
+	}
+	if !strings.HasPrefix(form.Endpoint, "https://") {
+		ctx.JSONError(ctx.Tr("invalid_data", form.Endpoint))
+		return
+	}
+
+	sub := &activities_model.PushSubscription{
+		UserID:    ctx.Doer.ID,
+		Endpoint:  form.Endpoint,
+		P256DH:    form.P256DH,
+		Auth:      form.Auth,
+		UserAgent: util.TruncateRunes(ctx.Req.UserAgent(), 255),
+	}
+	if err := activities_model.CreateOrUpdatePushSubscription(ctx, sub); err != nil {
+		ctx.ServerError("CreateOrUpdatePushSubscription", err)
+		return
+	}
+	ctx.Flash.Success(ctx.Tr("settings.web_push.subscribe_success"))
+	ctx.JSONOK()
+}
+
+// PushUnsubscribePost removes the push subscription of the browser the user unsubscribes
+func PushUnsubscribePost(ctx *context.Context) {
+	if err := activities_model.DeletePushSubscriptionByEndpoint(ctx, ctx.Doer.ID, ctx.FormString("endpoint")); err != nil {
+		ctx.ServerError("DeletePushSubscriptionByEndpoint", err)
+		return
+	}
+	ctx.JSONOK()
+}
+
+// PushSubscriptionDelete removes a push subscription of the user, e.g. of a browser which isn't used anymore
+func PushSubscriptionDelete(ctx *context.Context) {
+	if err := activities_model.DeletePushSubscription(ctx, ctx.Doer.ID, ctx.PathParamInt64("id")); err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.JSONErrorNotFound()
+			return
+		}
+		ctx.ServerError("DeletePushSubscription", err)
+		return
+	}
+	ctx.Flash.Success(ctx.Tr("settings.web_push.deletion_success"))
+	ctx.JSONRedirect(setting.AppSubURL + "/user/settings/notifications")
+}
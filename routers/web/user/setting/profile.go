@@ -13,6 +13,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"gitea.dev/models/avatars"
 	"gitea.dev/models/db"
@@ -348,6 +349,14 @@ func Appearance(ctx *context.Context) {
 		return forms.IsUserHiddenCommentTypeGroupChecked(commentTypeGroup, hiddenCommentTypes)
 	}
 
+	timeZone, err := user_model.GetUserSetting(ctx, ctx.Doer.ID, user_model.SettingsKeyTimeZone)
+	if err != nil {
+		ctx.ServerError("GetUserSetting", err)
+		return
+	}
+	ctx.Data["TimeZone"] = timeZone
+	ctx.Data["DefaultTimeZone"] = setting.DefaultUILocation.String()
+
 	ctx.HTML(http.StatusOK, tplSettingsAppearance)
 }
 
@@ -411,6 +420,31 @@ func UpdateUserLang(ctx *context.Context) {
 	ctx.Redirect(setting.AppSubURL + "/user/settings/appearance")
 }
 
+// UpdateUserTimeZone updates the time zone of a user, an empty time zone resets it to the default one
+func UpdateUserTimeZone(ctx *context.Context) {
+	zone := strings.TrimSpace(ctx.FormString("time_zone"))
+	if zone == "" {
+		if err := user_model.DeleteUserSetting(ctx, ctx.Doer.ID, user_model.SettingsKeyTimeZone); err != nil {
+			ctx.ServerError("DeleteUserSetting", err)
+			return
+		}
+	} else {
+		if _, err := time.LoadLocation(zone); err != nil {
+			ctx.Flash.Error(ctx.Tr("settings.time_zone_not_found", zone))
+			ctx.Redirect(setting.AppSubURL + "/user/settings/appearance")
+			return
+		}
+		if err := user_model.SetUserSetting(ctx, ctx.Doer.ID, user_model.SettingsKeyTimeZone, zone); err != nil {
+			ctx.ServerError("SetUserSetting", err)
+			return
+		}
+	}
+
+	log.Trace("User settings updated: %s", ctx.Doer.Name)
+	ctx.Flash.Success(ctx.Tr("settings.time_zone_update_success"))
+	ctx.Redirect(setting.AppSubURL + "/user/settings/appearance")
+}
+
 // UpdateUserHiddenComments update a user's shown comment types
 func UpdateUserHiddenComments(ctx *context.Context) {
 	err := user_model.SetUserSetting(ctx, ctx.Doer.ID, user_model.SettingsKeyHiddenCommentTypes, forms.UserHiddenCommentTypesFromRequest(ctx).String())
@@ -0,0 +1,45 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package setting
+
+import (
+	"net/http"
+
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/templates"
+	shared_user "gitea.dev/routers/web/shared/user"
+	"gitea.dev/services/context"
+)
+
+const (
+	tplSettingsReviewReminders templates.TplName = "user/settings/review_reminders"
+)
+
+func ReviewReminders(ctx *context.Context) {
+	ctx.Data["Title"] = ctx.Tr("settings.review_reminders")
+	ctx.Data["PageIsSettingsReviewReminders"] = true
+
+	shared_user.ReviewReminders(ctx, ctx.Doer)
+	if ctx.Written() {
+		return
+	}
+
+	timeZone, err := user_model.GetUserLocation(ctx, ctx.Doer.ID)
+	if err != nil {
+		ctx.ServerError("GetUserLocation", err)
+		return
+	}
+	ctx.Data["UserTimeZone"] = timeZone.String()
+
+	ctx.HTML(http.StatusOK, tplSettingsReviewReminders)
+}
+
+func ReviewReminderPost(ctx *context.Context) {
+	shared_user.ReviewReminderPost(ctx, ctx.Doer, setting.AppSubURL+"/user/settings/review_reminders")
+}
+
+func ReviewReminderDelete(ctx *context.Context) {
+	shared_user.ReviewReminderDelete(ctx, ctx.Doer, setting.AppSubURL+"/user/settings/review_reminders")
+}
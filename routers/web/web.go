@@ -453,9 +453,16 @@ func registerWebRoutes(m *web.Router, webAuth *AuthMiddleware) {
 		m.Post("/telegram/new", web.Bind(forms.NewTelegramHookForm{}), repo_setting.TelegramHooksNewPost)
 		m.Post("/matrix/new", web.Bind(forms.NewMatrixHookForm{}), repo_setting.MatrixHooksNewPost)
 		m.Post("/msteams/new", web.Bind(forms.NewMSTeamsHookForm{}), repo_setting.MSTeamsHooksNewPost)
+		m.Post("/googlechat/new", web.Bind(forms.NewGoogleChatHookForm{}), repo_setting.GoogleChatHooksNewPost)
+		m.Post("/mattermost/new", web.Bind(forms.NewMattermostHookForm{}), repo_setting.MattermostHooksNewPost)
 		m.Post("/feishu/new", web.Bind(forms.NewFeishuHookForm{}), repo_setting.FeishuHooksNewPost)
 		m.Post("/wechatwork/new", web.Bind(forms.NewWechatWorkHookForm{}), repo_setting.WechatworkHooksNewPost)
 		m.Post("/packagist/new", web.Bind(forms.NewPackagistHookForm{}), repo_setting.PackagistHooksNewPost)
+		m.Post("/amqp/new", web.Bind(forms.NewBrokerHookForm{}), repo_setting.AMQPHooksNewPost)
+		m.Post("/kafka/new", web.Bind(forms.NewBrokerHookForm{}), repo_setting.KafkaHooksNewPost)
+		m.Post("/nats/new", web.Bind(forms.NewBrokerHookForm{}), repo_setting.NATSHooksNewPost)
+		m.Post("/redis/new", web.Bind(forms.NewBrokerHookForm{}), repo_setting.RedisHooksNewPost)
+		m.Post("/template_preview", web.Bind(forms.WebhookTemplatePreviewForm{}), repo_setting.WebhookTemplatePreview)
 	}
 
 	addWebhookEditRoutes := func() {
@@ -467,9 +474,15 @@ func registerWebRoutes(m *web.Router, webAuth *AuthMiddleware) {
 		m.Post("/telegram/{id}", web.Bind(forms.NewTelegramHookForm{}), repo_setting.TelegramHooksEditPost)
 		m.Post("/matrix/{id}", web.Bind(forms.NewMatrixHookForm{}), repo_setting.MatrixHooksEditPost)
 		m.Post("/msteams/{id}", web.Bind(forms.NewMSTeamsHookForm{}), repo_setting.MSTeamsHooksEditPost)
+		m.Post("/googlechat/{id}", web.Bind(forms.NewGoogleChatHookForm{}), repo_setting.GoogleChatHooksEditPost)
+		m.Post("/mattermost/{id}", web.Bind(forms.NewMattermostHookForm{}), repo_setting.MattermostHooksEditPost)
 		m.Post("/feishu/{id}", web.Bind(forms.NewFeishuHookForm{}), repo_setting.FeishuHooksEditPost)
 		m.Post("/wechatwork/{id}", web.Bind(forms.NewWechatWorkHookForm{}), repo_setting.WechatworkHooksEditPost)
 		m.Post("/packagist/{id}", web.Bind(forms.NewPackagistHookForm{}), repo_setting.PackagistHooksEditPost)
+		m.Post("/amqp/{id}", web.Bind(forms.NewBrokerHookForm{}), repo_setting.AMQPHooksEditPost)
+		m.Post("/kafka/{id}", web.Bind(forms.NewBrokerHookForm{}), repo_setting.KafkaHooksEditPost)
+		m.Post("/nats/{id}", web.Bind(forms.NewBrokerHookForm{}), repo_setting.NATSHooksEditPost)
+		m.Post("/redis/{id}", web.Bind(forms.NewBrokerHookForm{}), repo_setting.RedisHooksEditPost)
 	}
 
 	addSettingsVariablesRoutes := func() {
@@ -524,6 +537,7 @@ func registerWebRoutes(m *web.Router, webAuth *AuthMiddleware) {
 			ctx.Redirect(setting.AppSubURL + "/user/settings/account")
 		})
 		m.Get("/passkey-endpoints", passkeyEndpoints)
+		m.Get("/terraform.json", terraformServiceDiscovery)
 		m.Methods("GET, HEAD", "/*", public.FileHandlerFunc())
 	}, optionsCorsHandler())
 
@@ -550,9 +564,17 @@ func registerWebRoutes(m *web.Router, webAuth *AuthMiddleware) {
 		m.Get("/topics/search", explore.TopicSearch)
 	}, optExploreSignIn)
 
+	savedSearchRoutes := func() {
+		m.Post("/new", user.NewSavedSearchPost)
+		m.Post("/{id}/delete", user.DeleteSavedSearchPost)
+		m.Post("/{id}/pin", user.PinSavedSearchPost)
+		m.Post("/{id}/subscribe", user.SubscribeSavedSearchPost)
+	}
+
 	m.Group("/issues", func() {
 		m.Get("", user.Issues)
 		m.Get("/search", repo.SearchIssues)
+		m.Group("/-/saved-searches", savedSearchRoutes)
 	}, reqSignIn)
 
 	m.Get("/pulls", reqSignIn, user.Pulls)
@@ -633,13 +655,29 @@ func registerWebRoutes(m *web.Router, webAuth *AuthMiddleware) {
 		m.Group("/appearance", func() {
 			m.Get("", user_setting.Appearance)
 			m.Post("/language", web.Bind(forms.UpdateLanguageForm{}), user_setting.UpdateUserLang)
+			m.Post("/time_zone", user_setting.UpdateUserTimeZone)
 			m.Post("/hidden_comments", user_setting.UpdateUserHiddenComments)
 			m.Post("/theme", web.Bind(forms.UpdateThemeForm{}), user_setting.UpdateUIThemePost)
 		})
 		m.Group("/notifications", func() {
 			m.Get("", user_setting.Notifications)
 			m.Post("/email", user_setting.NotificationsEmailPost)
+			m.Post("/email/delivery", user_setting.NotificationsEmailDeliveryPost)
+			m.Group("/email/overrides", func() {
+				m.Post("", user_setting.NotificationsEmailOverridePost)
+				m.Post("/{id}/delete", user_setting.NotificationsEmailOverrideDelete)
+			})
 			m.Post("/actions", user_setting.NotificationsActionsEmailPost)
+			m.Group("/channels", func() {
+				m.Post("", web.Bind(forms.NotificationChannelForm{}), user_setting.NotificationChannelPost)
+				m.Post("/{id}/active", user_setting.NotificationChannelActivePost)
+				m.Post("/{id}/delete", user_setting.NotificationChannelDelete)
+			})
+			m.Group("/push", func() {
+				m.Post("/subscribe", web.Bind(forms.PushSubscriptionForm{}), user_setting.PushSubscriptionPost)
+				m.Post("/unsubscribe", user_setting.PushUnsubscribePost)
+				m.Post("/{id}/delete", user_setting.PushSubscriptionDelete)
+			})
 		})
 		m.Group("/security", func() {
 			m.Get("", security.Security)
@@ -733,6 +771,12 @@ func registerWebRoutes(m *web.Router, webAuth *AuthMiddleware) {
 			m.Get("", user_setting.BlockedUsers)
 			m.Post("", web.Bind(forms.BlockUserForm{}), user_setting.BlockedUsersPost)
 		})
+
+		m.Group("/review_reminders", func() {
+			m.Get("", user_setting.ReviewReminders)
+			m.Post("", web.Bind(forms.ReviewReminderForm{}), user_setting.ReviewReminderPost)
+			m.Post("/{id}/delete", user_setting.ReviewReminderDelete)
+		})
 	}, reqSignIn, user_setting.SettingsCtxData)
 
 	m.Group("/user", func() {
@@ -959,6 +1003,7 @@ func registerWebRoutes(m *web.Router, webAuth *AuthMiddleware) {
 			m.Get("/dashboard/-/heatmap/{team}", user.DashboardHeatmap)
 			m.Get("/issues", user.Issues)
 			m.Get("/issues/{team}", user.Issues)
+			m.Group("/issues/-/saved-searches", savedSearchRoutes)
 			m.Get("/pulls", user.Pulls)
 			m.Get("/pulls/{team}", user.Pulls)
 			m.Get("/milestones", reqMilestonesDashboardPageEnabled, user.Milestones)
@@ -1063,6 +1108,12 @@ func registerWebRoutes(m *web.Router, webAuth *AuthMiddleware) {
 					m.Get("", org.BlockedUsers)
 					m.Post("", web.Bind(forms.BlockUserForm{}), org.BlockedUsersPost)
 				})
+
+				m.Group("/review_reminders", func() {
+					m.Get("", org.ReviewReminders)
+					m.Post("", web.Bind(forms.ReviewReminderForm{}), org.ReviewReminderPost)
+					m.Post("/{id}/delete", org.ReviewReminderDelete)
+				})
 			}, ctxDataSet("EnableOAuth2", setting.OAuth2.Enabled, "EnablePackages", setting.Packages.Enabled, "PageIsOrgSettings", true))
 		}, context.OrgAssignment(context.OrgAssignmentOptions{RequireOwner: true}))
 	}, reqSignIn)
@@ -1126,6 +1177,9 @@ func registerWebRoutes(m *web.Router, webAuth *AuthMiddleware) {
 					// TODO: improper name. Others are "delete project", "edit project", but this one is "move columns"
 					m.Post("/move", project.MoveColumns)
 					m.Post("/columns/new", web.Bind(forms.EditProjectColumnForm{}), org.AddColumnToProjectPost)
+					m.Post("/views/new", web.Bind(forms.ProjectViewForm{}), project.NewProjectViewPost)
+					m.Post("/views/{viewID}/edit", web.Bind(forms.ProjectViewForm{}), project.EditProjectViewPost)
+					m.Post("/views/{viewID}/delete", project.DeleteProjectView)
 					m.Group("/{columnID}", func() {
 						m.Put("", web.Bind(forms.EditProjectColumnForm{}), org.EditProjectColumn)
 						m.Delete("", org.DeleteProjectColumn)
@@ -1349,6 +1403,11 @@ func registerWebRoutes(m *web.Router, webAuth *AuthMiddleware) {
 					m.Post("/add", repo.AddDependency)
 					m.Post("/delete", repo.RemoveDependency)
 				})
+				m.Group("/sub_issues", func() {
+					m.Post("/add", repo.AddSubIssuePost)
+					m.Post("/remove", repo.RemoveSubIssuePost)
+				})
+				m.Post("/custom_fields", repo.UpdateIssueCustomFields)
 				m.Combo("/comments").Post(repo.MustAllowUserComment, web.Bind(forms.CreateCommentForm{}), repo.NewComment)
 				m.Group("/times", func() {
 					m.Post("/add", web.Bind(forms.AddTimeManuallyForm{}), repo.AddTimeManually)
@@ -1372,6 +1431,7 @@ func registerWebRoutes(m *web.Router, webAuth *AuthMiddleware) {
 
 			m.Post("/labels", reqRepoIssuesOrPullsWriter, repo.UpdateIssueLabel)
 			m.Post("/milestone", reqRepoIssuesOrPullsWriter, repo.UpdateIssueMilestone)
+			m.Post("/type", reqRepoIssuesOrPullsWriter, repo.UpdateIssueType)
 			m.Post("/projects", reqRepoIssuesOrPullsWriter, reqRepoProjectsReader, repo.UpdateIssueProject)
 			m.Post("/projects/column", reqRepoIssuesOrPullsWriter, reqRepoProjectsWriter, repo.UpdateIssueProjectColumn)
 			m.Post("/assignee", reqRepoIssuesOrPullsWriter, repo.UpdateIssueAssignee)
@@ -1532,6 +1592,9 @@ func registerWebRoutes(m *web.Router, webAuth *AuthMiddleware) {
 				// TODO: improper name. Others are "delete project", "edit project", but this one is "move columns"
 				m.Post("/move", project.MoveColumns)
 				m.Post("/columns/new", web.Bind(forms.EditProjectColumnForm{}), repo.AddColumnToProjectPost)
+				m.Post("/views/new", web.Bind(forms.ProjectViewForm{}), project.NewProjectViewPost)
+				m.Post("/views/{viewID}/edit", web.Bind(forms.ProjectViewForm{}), project.EditProjectViewPost)
+				m.Post("/views/{viewID}/delete", project.DeleteProjectView)
 				m.Group("/{columnID}", func() {
 					m.Put("", web.Bind(forms.EditProjectColumnForm{}), repo.EditProjectColumn)
 					m.Delete("", repo.DeleteProjectColumn)
@@ -1712,6 +1775,7 @@ func registerWebRoutes(m *web.Router, webAuth *AuthMiddleware) {
 			m.Get("/graph", repo.Graph)
 			m.Get("/commit/{sha:([a-f0-9]{7,64})$}", repo.SetEditorconfigIfExists, repo.SetDiffViewStyle, repo.SetWhitespaceBehavior, repo.Diff)
 			m.Get("/commit/{sha:([a-f0-9]{7,64})$}/load-branches-and-tags", repo.LoadBranchesAndTags)
+			m.Get("/code-nav/{sha:([a-f0-9]{7,64})$}", repo.CodeNavigation)
 
 			// FIXME: this route `/cherry-pick/{sha}` doesn't seem useful or right, the new code always uses `/_cherrypick/` which could handle branch name correctly
 			m.Get("/cherry-pick/{sha:([a-f0-9]{7,64})$}", repo.SetEditorconfigIfExists, context.RepoRefByDefaultBranch(), repo.CherryPick)
@@ -144,6 +144,7 @@ func (o *OAuth2) userFromToken(ctx context.Context, tokenSHA string, store DataS
 	}
 	store.GetData()["IsApiToken"] = true
 	store.GetData()["ApiTokenScope"] = t.Scope
+	store.GetData()["ApiTokenID"] = t.ID
 	return user_model.GetUserByID(ctx, t.UID)
 }
 
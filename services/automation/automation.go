@@ -0,0 +1,301 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package automation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gitea.dev/models/db"
+	issues_model "gitea.dev/models/issues"
+	access_model "gitea.dev/models/perm/access"
+	project_model "gitea.dev/models/project"
+	repo_model "gitea.dev/models/repo"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/optional"
+	"gitea.dev/modules/util"
+	issue_service "gitea.dev/services/issue"
+	notify_service "gitea.dev/services/notify"
+	project_service "gitea.dev/services/projects"
+)
+
+// Init registers the notifier which runs the automation rules
+func Init() error {
+	notify_service.RegisterNotifier(NewNotifier())
+	return nil
+}
+
+type runningRuleKey struct{}
+
+// isRunningRule returns whether the context belongs to the run of a rule, the changes of the rules don't run other rules
+func isRunningRule(ctx context.Context) bool {
+	return ctx.Value(runningRuleKey{}) != nil
+}
+
+// getRepoLabel returns a label of the repository or of its organization
+func getRepoLabel(ctx context.Context, repo *repo_model.Repository, labelID int64) (*issues_model.Label, error) {
+	label, err := issues_model.GetLabelInRepoByID(ctx, repo.ID, labelID)
+	if issues_model.IsErrRepoLabelNotExist(err) {
+		if err := repo.LoadOwner(ctx); err != nil {
+			return nil, err
+		}
+		if repo.Owner.IsOrganization() {
+			label, err = issues_model.GetLabelInOrgByID(ctx, repo.OwnerID, labelID)
+		}
+	}
+	return label, err
+}
+
+// ValidateRule checks the trigger, the condition and the action of a rule of the repository, the fields which don't apply to them are reset
+func ValidateRule(ctx context.Context, repo *repo_model.Repository, rule *issues_model.AutomationRule) error {
+	rule.Name = strings.TrimSpace(rule.Name)
+	if rule.Name == "" {
+		return util.NewInvalidArgumentErrorf("the name of the rule can't be empty")
+	}
+	if !rule.TriggerEvent.IsValid() {
+		return util.NewInvalidArgumentErrorf("invalid trigger %q", rule.TriggerEvent)
+	}
+	if !rule.Action.IsValid() {
+		return util.NewInvalidArgumentErrorf("invalid action %q", rule.Action)
+	}
+
+	if rule.TriggerEvent == issues_model.AutomationTriggerInactive {
+		if rule.InactiveDays <= 0 {
+			return util.NewInvalidArgumentErrorf("the inactive trigger needs a number of days")
+		}
+		if rule.Action == issues_model.AutomationActionReopen {
+			return util.NewInvalidArgumentErrorf("the inactive trigger only applies to open issues")
+		}
+	} else {
+		rule.InactiveDays = 0
+	}
+
+	if rule.ConditionLabelID > 0 {
+		if _, err := getRepoLabel(ctx, repo, rule.ConditionLabelID); err != nil {
+			return fmt.Errorf("the label of the condition: %w", err)
+		}
+	}
+
+	if rule.ProjectID > 0 {
+		project, err := project_model.GetProjectByID(ctx, rule.ProjectID)
+		if err != nil {
+			return fmt.Errorf("the project of the rule: %w", err)
+		}
+		if !project.CanBeAccessedByOwnerRepo(repo.OwnerID, repo) {
+			return util.NewInvalidArgumentErrorf("the project can't be used by the repository")
+		}
+	}
+
+	if rule.Action != issues_model.AutomationActionMoveToColumn {
+		rule.ColumnID = 0
+	} else if rule.ProjectID == 0 {
+		return util.NewInvalidArgumentErrorf("the rule has to belong to a project to move the items to a column")
+	} else if _, err := project_model.GetColumnByIDAndProjectID(ctx, rule.ColumnID, rule.ProjectID); err != nil {
+		return fmt.Errorf("the column of the action: %w", err)
+	}
+
+	if rule.Action != issues_model.AutomationActionAddLabel && rule.Action != issues_model.AutomationActionRemoveLabel {
+		rule.LabelID = 0
+	} else if _, err := getRepoLabel(ctx, repo, rule.LabelID); err != nil {
+		return fmt.Errorf("the label of the action: %w", err)
+	}
+
+	if rule.Action != issues_model.AutomationActionAssign {
+		rule.AssigneeID = 0
+	} else {
+		assignee, err := user_model.GetUserByID(ctx, rule.AssigneeID)
+		if err != nil {
+			return fmt.Errorf("the assignee of the action: %w", err)
+		}
+		canBeAssigned, err := access_model.CanBeAssigned(ctx, assignee, repo)
+		if err != nil {
+			return err
+		}
+		if !canBeAssigned {
+			return util.NewInvalidArgumentErrorf("the user %s can't be assigned to the issues of the repository", assignee.Name)
+		}
+	}
+	return nil
+}
+
+// CreateRule validates and creates an automation rule of the repository
+func CreateRule(ctx context.Context, repo *repo_model.Repository, rule *issues_model.AutomationRule) error {
+	rule.RepoID = repo.ID
+	if err := ValidateRule(ctx, repo, rule); err != nil {
+		return err
+	}
+	return issues_model.CreateAutomationRule(ctx, rule)
+}
+
+// UpdateRule validates and updates an automation rule of the repository
+func UpdateRule(ctx context.Context, repo *repo_model.Repository, rule *issues_model.AutomationRule) error {
+	if err := ValidateRule(ctx, repo, rule); err != nil {
+		return err
+	}
+	return issues_model.UpdateAutomationRule(ctx, rule)
+}
+
+// runRules runs the active rules of the trigger for the issue, the label triggers only run the rules of the labels
+func runRules(ctx context.Context, trigger issues_model.AutomationTrigger, issue *issues_model.Issue, labels []*issues_model.Label) {
+	if isRunningRule(ctx) {
+		return
+	}
+	rules, err := db.Find[issues_model.AutomationRule](ctx, issues_model.FindAutomationRulesOptions{
+		RepoID:       issue.RepoID,
+		TriggerEvent: trigger,
+		IsActive:     true,
+	})
+	if err != nil {
+		log.Error("Find automation rules of repository %d: %v", issue.RepoID, err)
+		return
+	}
+	for _, rule := range rules {
+		if (trigger == issues_model.AutomationTriggerLabelAdded || trigger == issues_model.AutomationTriggerLabelRemoved) && rule.ConditionLabelID > 0 &&
+			!slices.ContainsFunc(labels, func(label *issues_model.Label) bool { return label.ID == rule.ConditionLabelID }) {
+			continue
+		}
+		runRule(ctx, rule, issue)
+	}
+}
+
+// runRule runs a rule for an issue if it meets the condition of the rule, the run is logged if the rule changes the issue or fails
+func runRule(ctx context.Context, rule *issues_model.AutomationRule, issue *issues_model.Issue) {
+	ctx = context.WithValue(ctx, runningRuleKey{}, true)
+
+	if rule.TriggerEvent != issues_model.AutomationTriggerLabelAdded && rule.TriggerEvent != issues_model.AutomationTriggerLabelRemoved &&
+		rule.ConditionLabelID > 0 && !issues_model.HasIssueLabel(ctx, issue.ID, rule.ConditionLabelID) {
+		return
+	}
+	if rule.ProjectID > 0 {
+		projectColumns, err := issue.ProjectColumnMap(ctx)
+		if err != nil {
+			log.Error("ProjectColumnMap of issue %d: %v", issue.ID, err)
+			return
+		}
+		if _, ok := projectColumns[rule.ProjectID]; !ok {
+			return
+		}
+	}
+
+	message, err := runAction(ctx, rule, issue)
+	if err == nil && message == "" {
+		return
+	}
+	ruleLog := &issues_model.AutomationRuleLog{
+		RuleID:  rule.ID,
+		RepoID:  rule.RepoID,
+		IssueID: issue.ID,
+		Success: err == nil,
+		Message: message,
+	}
+	if err != nil {
+		ruleLog.Message = err.Error()
+	}
+	if err := issues_model.CreateAutomationRuleLog(ctx, ruleLog); err != nil {
+		log.Error("CreateAutomationRuleLog of rule %d: %v", rule.ID, err)
+	}
+}
+
+// runAction makes the change of the action of the rule to the issue as the creator of the rule,
+// it returns a description of the change, or an empty string if the issue has already been changed
+func runAction(ctx context.Context, rule *issues_model.AutomationRule, issue *issues_model.Issue) (string, error) {
+	doer, err := user_model.GetUserByID(ctx, rule.CreatorID)
+	if err != nil {
+		return "", fmt.Errorf("the creator of the rule: %w", err)
+	}
+	if err := issue.LoadRepo(ctx); err != nil {
+		return "", err
+	}
+	perm, err := access_model.GetDoerRepoPermission(ctx, issue.Repo, doer)
+	if err != nil {
+		return "", err
+	}
+	if !perm.CanWriteIssuesOrPulls(issue.IsPull) {
+		return "", fmt.Errorf("the creator of the rule %s can't change the issue", doer.Name)
+	}
+
+	switch rule.Action {
+	case issues_model.AutomationActionMoveToColumn:
+		project, err := project_model.GetProjectByID(ctx, rule.ProjectID)
+		if err != nil {
+			return "", err
+		}
+		column, err := project_model.GetColumnByIDAndProjectID(ctx, rule.ColumnID, project.ID)
+		if err != nil {
+			return "", err
+		}
+		projectColumns, err := issue.ProjectColumnMap(ctx)
+		if err != nil {
+			return "", err
+		}
+		if columnID, ok := projectColumns[project.ID]; ok && columnID == column.ID {
+			return "", nil
+		}
+		if err := project_service.AddIssueToProject(ctx, doer, project, issue, column.ID, optional.None[int64]()); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("moved to the column %q", column.Title), nil
+
+	case issues_model.AutomationActionClose:
+		if issue.IsClosed {
+			return "", nil
+		}
+		if err := issue_service.CloseIssue(ctx, issue, doer, ""); err != nil {
+			return "", err
+		}
+		return "closed", nil
+
+	case issues_model.AutomationActionReopen:
+		if !issue.IsClosed {
+			return "", nil
+		}
+		if err := issue_service.ReopenIssue(ctx, issue, doer, ""); err != nil {
+			return "", err
+		}
+		return "reopened", nil
+
+	case issues_model.AutomationActionAddLabel, issues_model.AutomationActionRemoveLabel:
+		label, err := getRepoLabel(ctx, issue.Repo, rule.LabelID)
+		if err != nil {
+			return "", err
+		}
+		hasLabel := issues_model.HasIssueLabel(ctx, issue.ID, label.ID)
+		if rule.Action == issues_model.AutomationActionAddLabel {
+			if hasLabel {
+				return "", nil
+			}
+			if err := issue_service.AddLabel(ctx, issue, doer, label); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("added the label %q", label.Name), nil
+		}
+		if !hasLabel {
+			return "", nil
+		}
+		if err := issue_service.RemoveLabel(ctx, issue, doer, label); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("removed the label %q", label.Name), nil
+
+	case issues_model.AutomationActionAssign:
+		assignee, err := user_model.GetUserByID(ctx, rule.AssigneeID)
+		if err != nil {
+			return "", err
+		}
+		comment, err := issue_service.AddAssigneeIfNotAssigned(ctx, issue, doer, assignee)
+		if err != nil {
+			return "", err
+		}
+		if comment == nil {
+			return "", nil
+		}
+		notify_service.IssueChangeAssignee(ctx, doer, issue, assignee, false, comment)
+		return "assigned " + assignee.Name, nil
+	}
+	return "", errors.New("unknown action " + string(rule.Action))
+}
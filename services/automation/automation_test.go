@@ -0,0 +1,94 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package automation
+
+import (
+	"testing"
+	"time"
+
+	"gitea.dev/models/db"
+	issues_model "gitea.dev/models/issues"
+	repo_model "gitea.dev/models/repo"
+	"gitea.dev/models/unittest"
+	"gitea.dev/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRule(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	repo := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 1})
+
+	rule := &issues_model.AutomationRule{
+		Name:         " Done ",
+		TriggerEvent: issues_model.AutomationTriggerIssueClosed,
+		InactiveDays: 7,
+		Action:       issues_model.AutomationActionMoveToColumn,
+		ProjectID:    1,
+		ColumnID:     3,
+		LabelID:      1,
+	}
+	require.NoError(t, ValidateRule(t.Context(), repo, rule))
+	assert.Equal(t, "Done", rule.Name)
+	assert.Zero(t, rule.InactiveDays)
+	assert.Zero(t, rule.LabelID)
+
+	// the column has to belong to the project of the rule
+	rule.ColumnID = 4
+	assert.ErrorIs(t, ValidateRule(t.Context(), repo, rule), util.ErrNotExist)
+	rule.ProjectID = 0
+	assert.ErrorIs(t, ValidateRule(t.Context(), repo, rule), util.ErrInvalidArgument)
+
+	rule = &issues_model.AutomationRule{
+		Name:         "Stale",
+		TriggerEvent: issues_model.AutomationTriggerInactive,
+		Action:       issues_model.AutomationActionAddLabel,
+		LabelID:      1,
+	}
+	assert.ErrorIs(t, ValidateRule(t.Context(), repo, rule), util.ErrInvalidArgument)
+	rule.InactiveDays = 30
+	require.NoError(t, ValidateRule(t.Context(), repo, rule))
+
+	// the labels of other repositories can't be used
+	rule.LabelID = 3
+	assert.ErrorIs(t, ValidateRule(t.Context(), repo, rule), util.ErrNotExist)
+}
+
+func TestRunInactiveRules(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	rule := &issues_model.AutomationRule{
+		RepoID:           1,
+		ProjectID:        1,
+		Name:             "Close stale",
+		TriggerEvent:     issues_model.AutomationTriggerInactive,
+		ConditionLabelID: 1,
+		InactiveDays:     30,
+		Action:           issues_model.AutomationActionClose,
+		IsActive:         true,
+		CreatorID:        2,
+	}
+	require.NoError(t, issues_model.CreateAutomationRule(t.Context(), rule))
+
+	// the open items of the project with the label are closed
+	require.NoError(t, RunInactiveRules(t.Context(), time.Hour))
+	assert.True(t, unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 1}).IsClosed)
+	assert.True(t, unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 2}).IsClosed)
+	assert.False(t, unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 3}).IsClosed)
+	logs, err := db.Find[issues_model.AutomationRuleLog](t.Context(), issues_model.FindAutomationRuleLogsOptions{RuleID: rule.ID})
+	require.NoError(t, err)
+	if assert.Len(t, logs, 2) {
+		assert.True(t, logs[0].Success)
+		assert.Equal(t, "closed", logs[0].Message)
+	}
+
+	// the closed items are not changed again
+	require.NoError(t, RunInactiveRules(t.Context(), time.Hour))
+	unittest.AssertCount(t, &issues_model.AutomationRuleLog{RuleID: rule.ID}, 2)
+
+	// the old logs are cleaned up
+	require.NoError(t, RunInactiveRules(t.Context(), -time.Hour))
+	unittest.AssertCount(t, &issues_model.AutomationRuleLog{RuleID: rule.ID}, 0)
+}
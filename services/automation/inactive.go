@@ -0,0 +1,62 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package automation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitea.dev/models/db"
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/timeutil"
+
+	"xorm.io/builder"
+)
+
+// RunInactiveRules runs the rules of the inactive trigger for the open issues which haven't been updated for the days of the rules,
+// and deletes the logs of the rules which are older than logsOlderThan
+func RunInactiveRules(ctx context.Context, logsOlderThan time.Duration) error {
+	rules, err := db.Find[issues_model.AutomationRule](ctx, issues_model.FindAutomationRulesOptions{
+		TriggerEvent: issues_model.AutomationTriggerInactive,
+		IsActive:     true,
+	})
+	if err != nil {
+		return fmt.Errorf("find inactive automation rules: %w", err)
+	}
+
+	for _, rule := range rules {
+		select {
+		case <-ctx.Done():
+			return db.ErrCancelledf("before running automation rule %d", rule.ID)
+		default:
+		}
+
+		// collect the issues first, the actions change the issues which match the conditions
+		cond := builder.Eq{"repo_id": rule.RepoID, "is_closed": false}.
+			And(builder.Lt{"updated_unix": timeutil.TimeStampNow().AddDuration(-time.Duration(rule.InactiveDays) * 24 * time.Hour)})
+		if rule.ConditionLabelID > 0 {
+			cond = cond.And(builder.In("id", builder.Select("issue_id").From("issue_label").Where(builder.Eq{"label_id": rule.ConditionLabelID})))
+		}
+		if rule.ProjectID > 0 {
+			cond = cond.And(builder.In("id", builder.Select("issue_id").From("project_issue").Where(builder.Eq{"project_id": rule.ProjectID})))
+		}
+		var issueIDs []int64
+		if err := db.GetEngine(ctx).Table("issue").Where(cond).Cols("id").Find(&issueIDs); err != nil {
+			return fmt.Errorf("find inactive issues of automation rule %d: %w", rule.ID, err)
+		}
+
+		for _, issueID := range issueIDs {
+			issue, err := issues_model.GetIssueByID(ctx, issueID)
+			if err != nil {
+				log.Error("GetIssueByID %d: %v", issueID, err)
+				continue
+			}
+			runRule(ctx, rule, issue)
+		}
+	}
+
+	return issues_model.DeleteAutomationRuleLogsOlderThan(ctx, timeutil.TimeStampNow().AddDuration(-logsOlderThan))
+}
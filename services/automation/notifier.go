@@ -0,0 +1,73 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package automation
+
+import (
+	"context"
+
+	issues_model "gitea.dev/models/issues"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/references"
+	notify_service "gitea.dev/services/notify"
+)
+
+type automationNotifier struct {
+	notify_service.NullNotifier
+}
+
+var _ notify_service.Notifier = &automationNotifier{}
+
+// NewNotifier create a new automationNotifier notifier
+func NewNotifier() notify_service.Notifier {
+	return &automationNotifier{}
+}
+
+func (n *automationNotifier) NewIssue(ctx context.Context, issue *issues_model.Issue, mentions []*user_model.User) {
+	runRules(ctx, issues_model.AutomationTriggerIssueOpened, issue, nil)
+}
+
+func (n *automationNotifier) IssueChangeStatus(ctx context.Context, doer *user_model.User, commitID string, issue *issues_model.Issue, actionComment *issues_model.Comment, isClosed bool) {
+	if isClosed {
+		runRules(ctx, issues_model.AutomationTriggerIssueClosed, issue, nil)
+	} else {
+		runRules(ctx, issues_model.AutomationTriggerIssueReopened, issue, nil)
+	}
+}
+
+func (n *automationNotifier) IssueChangeLabels(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, addedLabels, removedLabels []*issues_model.Label) {
+	if len(addedLabels) > 0 {
+		runRules(ctx, issues_model.AutomationTriggerLabelAdded, issue, addedLabels)
+	}
+	if len(removedLabels) > 0 {
+		runRules(ctx, issues_model.AutomationTriggerLabelRemoved, issue, removedLabels)
+	}
+}
+
+// NewPullRequest runs the rules of the issues which will be closed by the pull request
+func (n *automationNotifier) NewPullRequest(ctx context.Context, pr *issues_model.PullRequest, mentions []*user_model.User) {
+	if isRunningRule(ctx) {
+		return
+	}
+	if err := pr.LoadIssue(ctx); err != nil {
+		log.Error("LoadIssue of pull request %d: %v", pr.ID, err)
+		return
+	}
+	refs, err := pr.ResolveCrossReferences(ctx)
+	if err != nil {
+		log.Error("ResolveCrossReferences of pull request %d: %v", pr.ID, err)
+		return
+	}
+	for _, ref := range refs {
+		if ref.RefAction != references.XRefActionCloses {
+			continue
+		}
+		issue, err := issues_model.GetIssueByID(ctx, ref.IssueID)
+		if err != nil {
+			log.Error("GetIssueByID %d: %v", ref.IssueID, err)
+			continue
+		}
+		runRules(ctx, issues_model.AutomationTriggerPullRequestOpened, issue, nil)
+	}
+}
@@ -0,0 +1,373 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package codenav
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitea.dev/models/db"
+	repo_model "gitea.dev/models/repo"
+	"gitea.dev/modules/analyze"
+	"gitea.dev/modules/charset"
+	"gitea.dev/modules/codenav"
+	"gitea.dev/modules/git"
+	"gitea.dev/modules/git/gitcmd"
+	"gitea.dev/modules/gitrepo"
+	"gitea.dev/modules/globallock"
+	"gitea.dev/modules/graceful"
+	"gitea.dev/modules/json"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/queue"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/storage"
+	"gitea.dev/modules/typesniffer"
+	"gitea.dev/modules/util"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// indexCache keeps the recently used indexes because every lookup needs the whole index of the commit
+var indexCache *lru.Cache[string, *codenav.Index]
+
+const defaultIndexCacheSize = 32
+
+func init() {
+	c, err := lru.New[string, *codenav.Index](defaultIndexCacheSize)
+	if err != nil {
+		log.Fatal("failed to new code navigation index cache, err: %v", err)
+	}
+	indexCache = c
+}
+
+func indexKey(repoID int64, commitID string) string {
+	return strconv.FormatInt(repoID, 10) + "/" + commitID
+}
+
+func indexPath(repoID int64, commitID string) string {
+	return fmt.Sprintf("%d/%s/%s.json.gz", repoID, commitID[:2], commitID)
+}
+
+// ErrNotIndexed is returned for a commit whose index isn't available (yet)
+var ErrNotIndexed = util.NewNotExistErrorf("code navigation index is not available")
+
+type indexRequest struct {
+	RepoID   int64
+	CommitID string
+}
+
+var indexQueue *queue.WorkerPoolQueue[*indexRequest]
+
+// Init starts the queue which generates the indexes
+func Init() error {
+	if !setting.CodeNavigation.Enabled {
+		return nil
+	}
+
+	handler := func(items ...*indexRequest) []*indexRequest {
+		for _, req := range items {
+			if err := generateIndex(graceful.GetManager().ShutdownContext(), req); err != nil {
+				log.Error("Generate code navigation index of repository %d at %s failed: %v", req.RepoID, req.CommitID, err)
+			}
+		}
+		return nil
+	}
+
+	indexQueue = queue.CreateUniqueQueue(graceful.GetManager().ShutdownContext(), "code_navigation", handler)
+	if indexQueue == nil {
+		return errors.New("unable to create code_navigation queue")
+	}
+	go graceful.GetManager().RunWithCancel(indexQueue)
+
+	return nil
+}
+
+func generateIndex(ctx context.Context, req *indexRequest) error {
+	repo, err := repo_model.GetRepositoryByID(ctx, req.RepoID)
+	if err != nil {
+		if repo_model.IsErrRepoNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	key := indexKey(repo.ID, req.CommitID)
+	return globallock.LockAndDo(ctx, "codenav_"+key, func(ctx context.Context) error {
+		_, err := readIndex(repo.ID, req.CommitID)
+		if err == nil {
+			// an uploaded index or one generated by a previous request
+			return nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Warn("Unable to read code navigation index of %s@%s, it will be regenerated: %v", repo.FullName(), req.CommitID, err)
+		}
+
+		idx, err := buildIndex(ctx, repo, req.CommitID)
+		if err != nil {
+			return err
+		}
+		return saveIndex(repo.ID, idx)
+	})
+}
+
+// isBranchOrPullHead checks if the commit is the head of a branch, which includes the default branch, or of a pull request
+func isBranchOrPullHead(ctx context.Context, repo *repo_model.Repository, commitID string) (bool, error) {
+	stdout, _, err := gitrepo.RunCmdString(ctx, repo, gitcmd.NewCommand("for-each-ref", "--count=1", "--format=%(refname)").
+		AddOptionValues("--points-at", commitID).
+		AddDynamicArguments(git.BranchPrefix, git.PullPrefix))
+	if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(stdout) != "", nil
+}
+
+// GetIndex returns the navigation index of the commit.
+// If no index exists yet, it is generated in the background for the heads of the branches and pull requests,
+// ErrNotIndexed is returned until it is available. Other commits are only indexed by uploads.
+func GetIndex(ctx context.Context, repo *repo_model.Repository, commitID string) (*codenav.Index, error) {
+	if !setting.CodeNavigation.Enabled {
+		return nil, util.NewNotExistErrorf("code navigation is disabled")
+	}
+
+	key := indexKey(repo.ID, commitID)
+	if idx, ok := indexCache.Get(key); ok {
+		return idx, nil
+	}
+
+	idx, err := readIndex(repo.ID, commitID)
+	if err == nil {
+		indexCache.Add(key, idx)
+		return idx, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		log.Warn("Unable to read code navigation index of %s@%s, it will be regenerated: %v", repo.FullName(), commitID, err)
+	}
+
+	isHead, err := isBranchOrPullHead(ctx, repo, commitID)
+	if err != nil {
+		return nil, err
+	}
+	if !isHead {
+		return nil, ErrNotIndexed
+	}
+
+	req := &indexRequest{RepoID: repo.ID, CommitID: commitID}
+	has, err := indexQueue.Has(req)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		if err := indexQueue.Push(req); err != nil && !errors.Is(err, queue.ErrAlreadyInQueue) {
+			return nil, err
+		}
+	}
+	return nil, ErrNotIndexed
+}
+
+// UploadLSIF stores a precise index created from the LSIF dump for the commit, it replaces the generated index
+func UploadLSIF(ctx context.Context, repo *repo_model.Repository, commitID string, r io.Reader) (*codenav.Index, error) {
+	return uploadIndex(ctx, repo, commitID, r, codenav.ParseLSIF)
+}
+
+// UploadSCIP stores a precise index created from the SCIP index for the commit, it replaces the generated index
+func UploadSCIP(ctx context.Context, repo *repo_model.Repository, commitID string, r io.Reader) (*codenav.Index, error) {
+	return uploadIndex(ctx, repo, commitID, r, codenav.ParseSCIP)
+}
+
+func uploadIndex(ctx context.Context, repo *repo_model.Repository, commitID string, r io.Reader, parse func(r io.Reader, commitID string, maxReferences int) (*codenav.Index, error)) (*codenav.Index, error) {
+	if !setting.CodeNavigation.Enabled {
+		return nil, util.NewNotExistErrorf("code navigation is disabled")
+	}
+
+	idx, err := parse(r, commitID, setting.CodeNavigation.MaxReferences)
+	if err != nil {
+		return nil, err
+	}
+
+	key := indexKey(repo.ID, commitID)
+	err = globallock.LockAndDo(ctx, "codenav_"+key, func(ctx context.Context) error {
+		return saveIndex(repo.ID, idx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	indexCache.Add(key, idx)
+	return idx, nil
+}
+
+// DeleteOldIndexes removes the indexes which are older than olderThan
+// and whose commit isn't the head of a branch or pull request anymore
+func DeleteOldIndexes(ctx context.Context, olderThan time.Duration) error {
+	if !setting.CodeNavigation.Enabled {
+		return nil
+	}
+
+	deleteBefore := time.Now().Add(-olderThan)
+	repos := make(map[int64]*repo_model.Repository)
+	return storage.CodeNavigation.IterateObjects("", func(path string, obj storage.Object) error {
+		stat, err := obj.Stat()
+		_ = obj.Close()
+		if err != nil {
+			return err
+		}
+		if stat.ModTime().After(deleteBefore) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return db.ErrCancelledf("before deleting code navigation index %s", path)
+		default:
+		}
+
+		// the path is "repoID/xx/commitID.json.gz"
+		parts := strings.Split(path, "/")
+		repoID, _ := strconv.ParseInt(parts[0], 10, 64)
+		commitID := strings.TrimSuffix(parts[len(parts)-1], ".json.gz")
+
+		repo, ok := repos[repoID]
+		if !ok {
+			repo, err = repo_model.GetRepositoryByID(ctx, repoID)
+			if err != nil && !repo_model.IsErrRepoNotExist(err) {
+				return err
+			}
+			repos[repoID] = repo
+		}
+		if repo != nil {
+			isHead, err := isBranchOrPullHead(ctx, repo, commitID)
+			if err != nil {
+				log.Warn("Unable to check if %s is a head of %s: %v", commitID, repo.FullName(), err)
+				return nil
+			}
+			if isHead {
+				return nil
+			}
+		}
+
+		indexCache.Remove(indexKey(repoID, commitID))
+		if err := storage.CodeNavigation.Delete(path); err != nil {
+			return err
+		}
+		log.Trace("Deleted code navigation index %s", path)
+		return nil
+	})
+}
+
+// DeleteRepositoryIndexes removes all indexes of the repository
+func DeleteRepositoryIndexes(repoID int64) error {
+	if !setting.CodeNavigation.Enabled {
+		return nil
+	}
+
+	prefix := strconv.FormatInt(repoID, 10) + "/"
+	for _, key := range indexCache.Keys() {
+		if strings.HasPrefix(key, prefix) {
+			indexCache.Remove(key)
+		}
+	}
+
+	return storage.CodeNavigation.IterateObjects(prefix, func(path string, obj storage.Object) error {
+		_ = obj.Close()
+		return storage.CodeNavigation.Delete(path)
+	})
+}
+
+func readIndex(repoID int64, commitID string) (*codenav.Index, error) {
+	f, err := storage.CodeNavigation.Open(indexPath(repoID, commitID))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+
+	idx := &codenav.Index{}
+	if err := json.NewDecoder(gr).Decode(idx); err != nil {
+		return nil, err
+	}
+	if idx.Version != codenav.IndexVersion {
+		return nil, fmt.Errorf("unsupported index version %d", idx.Version)
+	}
+	return idx, nil
+}
+
+func saveIndex(repoID int64, idx *codenav.Index) error {
+	return storage.SaveFrom(storage.CodeNavigation, indexPath(repoID, idx.CommitID), func(w io.Writer) error {
+		gw := gzip.NewWriter(w)
+		if err := json.NewEncoder(gw).Encode(idx); err != nil {
+			return err
+		}
+		return gw.Close()
+	})
+}
+
+// buildIndex extracts the definitions and references from the files of the commit
+func buildIndex(ctx context.Context, repo *repo_model.Repository, commitID string) (*codenav.Index, error) {
+	stdout, _, runErr := gitrepo.RunCmdBytes(ctx, repo, gitcmd.NewCommand("ls-tree", "--full-tree", "-l", "-r").AddDynamicArguments(commitID))
+	if runErr != nil {
+		return nil, runErr
+	}
+	entries, err := git.ParseTreeEntries(stdout)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := codenav.NewBuilder(commitID, setting.CodeNavigation.MaxReferences)
+
+	batch, err := gitrepo.NewBatch(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	defer batch.Close()
+
+	files := 0
+	for _, entry := range entries {
+		if !entry.IsRegular() && !entry.IsExecutable() {
+			continue
+		}
+		if entry.Size() > setting.CodeNavigation.MaxFileSize || analyze.IsVendor(entry.Name()) {
+			continue
+		}
+		language := analyze.GetCodeLanguage(entry.Name(), nil)
+		if !codenav.IsSupportedLanguage(language) {
+			continue
+		}
+		if files >= setting.CodeNavigation.MaxFiles {
+			log.Debug("Code navigation index of %s@%s is limited to %d files", repo.FullName(), commitID, files)
+			break
+		}
+
+		info, rd, err := batch.QueryContent(entry.ID.String())
+		if err != nil {
+			return nil, err
+		}
+		content, err := io.ReadAll(io.LimitReader(rd, info.Size))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := rd.Discard(1); err != nil {
+			return nil, err
+		}
+		if !typesniffer.DetectContentType(content).IsText() {
+			continue
+		}
+
+		builder.AddFile(entry.Name(), language, string(charset.ToUTF8DropErrors(content)))
+		files++
+	}
+
+	return builder.Build(), nil
+}
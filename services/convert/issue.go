@@ -95,11 +95,18 @@ func toIssue(ctx context.Context, doer *user_model.User, issue *issues_model.Iss
 		apiIssue.Milestone = ToAPIMilestone(issue.Milestone)
 	}
 
+	if err := issue.LoadType(ctx); err != nil {
+		return &api.Issue{}
+	}
+	if issue.Type != nil {
+		apiIssue.Type = ToIssueType(issue.Type)
+	}
+
 	if err := issue.LoadProjects(ctx); err != nil {
 		return &api.Issue{}
 	}
 	if len(issue.Projects) > 0 {
-		apiIssue.Projects = ToAPIProjectList(issue.Projects)
+		apiIssue.Projects = ToAPIProjectList(ctx, issue.Projects)
 	}
 
 	if err := issue.LoadAssignees(ctx); err != nil {
@@ -295,6 +302,49 @@ func ToLabelList(labels []*issues_model.Label, repo *repo_model.Repository, org
 	return result
 }
 
+// ToCustomField converts CustomField to API format
+func ToCustomField(field *issues_model.CustomField) *api.CustomField {
+	return &api.CustomField{
+		ID:          field.ID,
+		Name:        field.Name,
+		Description: field.Description,
+		Type:        api.CustomFieldType(field.Type),
+		Options:     util.Iif(field.Options == nil, []string{}, field.Options),
+		Sort:        field.Sort,
+		Scope:       util.Iif(field.BelongsToOrg(), "organization", "repository"),
+	}
+}
+
+// ToCustomFieldList converts list of CustomField to API format
+func ToCustomFieldList(fields []*issues_model.CustomField) []*api.CustomField {
+	result := make([]*api.CustomField, len(fields))
+	for i := range fields {
+		result[i] = ToCustomField(fields[i])
+	}
+	return result
+}
+
+// ToIssueType converts IssueType to API format
+func ToIssueType(t *issues_model.IssueType) *api.IssueType {
+	return &api.IssueType{
+		ID:          t.ID,
+		Name:        t.Name,
+		Description: t.Description,
+		Color:       t.Color,
+		Icon:        t.Icon,
+		Sort:        t.Sort,
+	}
+}
+
+// ToIssueTypeList converts list of IssueType to API format
+func ToIssueTypeList(types []*issues_model.IssueType) []*api.IssueType {
+	result := make([]*api.IssueType, len(types))
+	for i := range types {
+		result[i] = ToIssueType(types[i])
+	}
+	return result
+}
+
 // ToAPIMilestone converts Milestone into API Format
 func ToAPIMilestone(m *issues_model.Milestone) *api.Milestone {
 	apiMilestone := &api.Milestone{
@@ -336,3 +386,103 @@ func ToLabelTemplateList(labels []*label.Label) []*api.LabelTemplate {
 	}
 	return result
 }
+
+// ToSavedSearch converts SavedSearch to API format
+func ToSavedSearch(s *issues_model.SavedSearch, subscribed bool) *api.SavedSearch {
+	return &api.SavedSearch{
+		ID:         s.ID,
+		Name:       s.Name,
+		Query:      s.Query,
+		Pinned:     s.IsPinned,
+		Subscribed: subscribed,
+		Created:    s.CreatedUnix.AsTime(),
+		Updated:    s.UpdatedUnix.AsTime(),
+	}
+}
+
+// ToSLAPolicy converts SLAPolicy to API format
+func ToSLAPolicy(policy *issues_model.SLAPolicy) *api.SLAPolicy {
+	return &api.SLAPolicy{
+		ID:                 policy.ID,
+		Name:               policy.Name,
+		LabelID:            policy.LabelID,
+		FirstResponseHours: policy.FirstResponseHours,
+		ResolutionDays:     policy.ResolutionDays,
+		Scope:              util.Iif(policy.BelongsToOrg(), "organization", "repository"),
+	}
+}
+
+// ToSLAPolicyList converts list of SLAPolicy to API format
+func ToSLAPolicyList(policies []*issues_model.SLAPolicy) []*api.SLAPolicy {
+	result := make([]*api.SLAPolicy, len(policies))
+	for i := range policies {
+		result[i] = ToSLAPolicy(policies[i])
+	}
+	return result
+}
+
+// ToIssueSLA converts IssueSLA to API format
+func ToIssueSLA(ctx context.Context, s *issues_model.IssueSLA) *api.IssueSLA {
+	apiSLA := &api.IssueSLA{
+		PolicyID:              s.Policy.ID,
+		PolicyName:            s.Policy.Name,
+		IssueIndex:            s.Issue.Index,
+		HTMLURL:               s.Issue.HTMLURL(ctx),
+		FirstResponseBreached: s.IsFirstResponseBreached(),
+		ResolutionBreached:    s.IsResolutionBreached(),
+	}
+	if s.FirstResponseDue > 0 {
+		apiSLA.FirstResponseDue = s.FirstResponseDue.AsTimePtr()
+	}
+	if s.FirstResponseAt > 0 {
+		apiSLA.FirstResponseAt = s.FirstResponseAt.AsTimePtr()
+	}
+	if s.ResolutionDue > 0 {
+		apiSLA.ResolutionDue = s.ResolutionDue.AsTimePtr()
+	}
+	if s.ResolvedAt > 0 {
+		apiSLA.ResolvedAt = s.ResolvedAt.AsTimePtr()
+	}
+	return apiSLA
+}
+
+// ToIssueSLAList converts list of IssueSLA to API format
+func ToIssueSLAList(ctx context.Context, slas []*issues_model.IssueSLA) []*api.IssueSLA {
+	result := make([]*api.IssueSLA, len(slas))
+	for i := range slas {
+		result[i] = ToIssueSLA(ctx, slas[i])
+	}
+	return result
+}
+
+// ToAutomationRule converts AutomationRule to API format
+func ToAutomationRule(rule *issues_model.AutomationRule) *api.AutomationRule {
+	return &api.AutomationRule{
+		ID:               rule.ID,
+		ProjectID:        rule.ProjectID,
+		Name:             rule.Name,
+		Trigger:          api.AutomationTrigger(rule.TriggerEvent),
+		ConditionLabelID: rule.ConditionLabelID,
+		InactiveDays:     rule.InactiveDays,
+		Action:           api.AutomationAction(rule.Action),
+		ColumnID:         rule.ColumnID,
+		LabelID:          rule.LabelID,
+		AssigneeID:       rule.AssigneeID,
+		Active:           rule.IsActive,
+		CreatorID:        rule.CreatorID,
+		Created:          rule.CreatedUnix.AsTime(),
+		Updated:          rule.UpdatedUnix.AsTime(),
+	}
+}
+
+// ToAutomationRuleLog converts AutomationRuleLog to API format
+func ToAutomationRuleLog(ruleLog *issues_model.AutomationRuleLog) *api.AutomationRuleLog {
+	return &api.AutomationRuleLog{
+		ID:      ruleLog.ID,
+		RuleID:  ruleLog.RuleID,
+		IssueID: ruleLog.IssueID,
+		Success: ruleLog.Success,
+		Message: ruleLog.Message,
+		Created: ruleLog.CreatedUnix.AsTime(),
+	}
+}
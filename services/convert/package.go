@@ -51,3 +51,64 @@ func ToPackageFile(pfd *packages.PackageFileDescriptor) *api.PackageFile {
 		HashSHA512: pfd.Blob.HashSHA512,
 	}
 }
+
+// ToPackageProtectionRule converts packages.PackageProtectionRule to api.PackageProtectionRule
+func ToPackageProtectionRule(ppr *packages.PackageProtectionRule) *api.PackageProtectionRule {
+	return &api.PackageProtectionRule{
+		ID:               ppr.ID,
+		Type:             string(ppr.Type),
+		PackageName:      ppr.PackageName,
+		Immutable:        ppr.Immutable,
+		ProtectedPattern: ppr.ProtectedPattern,
+		Created:          ppr.CreatedUnix.AsTime(),
+		Updated:          ppr.UpdatedUnix.AsTime(),
+	}
+}
+
+// ToPackageDownloadStat converts packages.PackageDownloadStat to api.PackageDownloadStat
+func ToPackageDownloadStat(pds *packages.PackageDownloadStat) *api.PackageDownloadStat {
+	return &api.PackageDownloadStat{
+		Date:  pds.Day.AsTime().UTC(),
+		Count: pds.Count,
+	}
+}
+
+// ToPackageAuditEvent converts packages.PackageAuditEvent to api.PackageAuditEvent
+func ToPackageAuditEvent(ctx context.Context, pae *packages.PackageAuditEvent, actor, doer *user_model.User) *api.PackageAuditEvent {
+	return &api.PackageAuditEvent{
+		ID:            pae.ID,
+		Type:          string(pae.Type),
+		Name:          pae.Name,
+		Version:       pae.Version,
+		Action:        pae.Action.String(),
+		Actor:         ToUser(ctx, actor, doer),
+		AccessTokenID: pae.AccessTokenID,
+		ActionsTaskID: pae.ActionsTaskID,
+		Created:       pae.CreatedUnix.AsTime(),
+	}
+}
+
+// ToPackageVulnerability converts packages.PackageVulnerability to api.PackageVulnerability
+func ToPackageVulnerability(pv *packages.PackageVulnerability) *api.PackageVulnerability {
+	return &api.PackageVulnerability{
+		AdvisoryID:        pv.AdvisoryID,
+		Summary:           pv.Summary,
+		Severity:          pv.Severity,
+		DependencyName:    pv.DependencyName,
+		DependencyVersion: pv.DependencyVersion,
+		Created:           pv.CreatedUnix.AsTime(),
+	}
+}
+
+// ToPackageSnapshot converts packages.PackageSnapshot to api.PackageSnapshot
+func ToPackageSnapshot(ps *packages.PackageSnapshot) *api.PackageSnapshot {
+	return &api.PackageSnapshot{
+		ID:           ps.ID,
+		Type:         string(ps.Type),
+		Name:         ps.Name,
+		Distribution: ps.Distribution,
+		Component:    ps.Component,
+		Group:        ps.GroupName,
+		Created:      ps.CreatedUnix.AsTime(),
+	}
+}
@@ -4,12 +4,15 @@
 package convert
 
 import (
+	"context"
+
 	project_model "gitea.dev/models/project"
+	"gitea.dev/modules/httplib"
 	api "gitea.dev/modules/structs"
 )
 
 // ToAPIProject converts a Project to API format
-func ToAPIProject(p *project_model.Project) *api.Project {
+func ToAPIProject(ctx context.Context, p *project_model.Project) *api.Project {
 	apiProject := &api.Project{
 		ID:          p.ID,
 		Title:       p.Title,
@@ -18,6 +21,8 @@ func ToAPIProject(p *project_model.Project) *api.Project {
 		RepoID:      p.RepoID,
 		CreatorID:   p.CreatorID,
 		IsClosed:    p.IsClosed,
+		CardType:    ToAPIProjectCardType(p.CardType),
+		HTMLURL:     httplib.MakeAbsoluteURL(ctx, p.Link(ctx)),
 		Created:     p.CreatedUnix.AsTime(),
 		Updated:     p.UpdatedUnix.AsTime(),
 	}
@@ -28,10 +33,72 @@ func ToAPIProject(p *project_model.Project) *api.Project {
 }
 
 // ToAPIProjectList converts a list of Projects to API format
-func ToAPIProjectList(projects []*project_model.Project) []*api.Project {
+func ToAPIProjectList(ctx context.Context, projects []*project_model.Project) []*api.Project {
 	result := make([]*api.Project, len(projects))
 	for i := range projects {
-		result[i] = ToAPIProject(projects[i])
+		result[i] = ToAPIProject(ctx, projects[i])
+	}
+	return result
+}
+
+// ToAPIProjectCardType converts a project CardType to its API name
+func ToAPIProjectCardType(cardType project_model.CardType) api.ProjectCardType {
+	if cardType == project_model.CardTypeImagesAndText {
+		return api.ProjectCardTypeImagesAndText
+	}
+	return api.ProjectCardTypeTextOnly
+}
+
+// ToAPIProjectColumn converts a project Column to API format
+func ToAPIProjectColumn(c *project_model.Column) *api.ProjectColumn {
+	return &api.ProjectColumn{
+		ID:        c.ID,
+		ProjectID: c.ProjectID,
+		Title:     c.Title,
+		Color:     c.Color,
+		Default:   c.Default,
+		Sorting:   int(c.Sorting),
+		Created:   c.CreatedUnix.AsTime(),
+		Updated:   c.UpdatedUnix.AsTime(),
+	}
+}
+
+// ToAPIProjectColumnList converts a list of project Columns to API format
+func ToAPIProjectColumnList(columns []*project_model.Column) []*api.ProjectColumn {
+	result := make([]*api.ProjectColumn, len(columns))
+	for i := range columns {
+		result[i] = ToAPIProjectColumn(columns[i])
+	}
+	return result
+}
+
+// ToAPIProjectView converts a project View to API format
+func ToAPIProjectView(v *project_model.View) *api.ProjectView {
+	fields := v.Fields
+	if fields == nil {
+		fields = []string{}
+	}
+	return &api.ProjectView{
+		ID:        v.ID,
+		ProjectID: v.ProjectID,
+		Name:      v.Name,
+		Layout:    api.ProjectViewLayout(v.Layout),
+		Fields:    fields,
+		GroupBy:   v.GroupBy,
+		SortBy:    v.SortBy,
+		SortDesc:  v.SortDesc,
+		Filter:    v.Filter,
+		Sort:      v.Sort,
+		Created:   v.CreatedUnix.AsTime(),
+		Updated:   v.UpdatedUnix.AsTime(),
+	}
+}
+
+// ToAPIProjectViewList converts a list of project Views to API format
+func ToAPIProjectViewList(views []*project_model.View) []*api.ProjectView {
+	result := make([]*api.ProjectView, len(views))
+	for i := range views {
+		result[i] = ToAPIProjectView(views[i])
 	}
 	return result
 }
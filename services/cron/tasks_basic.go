@@ -14,11 +14,17 @@ import (
 	"gitea.dev/modules/git/gitcmd"
 	"gitea.dev/modules/setting"
 	"gitea.dev/services/auth"
+	"gitea.dev/services/automation"
+	codenav_service "gitea.dev/services/codenav"
+	"gitea.dev/services/mailer"
 	"gitea.dev/services/migrations"
 	mirror_service "gitea.dev/services/mirror"
 	packages_cleanup_service "gitea.dev/services/packages/cleanup"
+	packages_vulnerability_service "gitea.dev/services/packages/vulnerability"
 	repo_service "gitea.dev/services/repository"
 	archiver_service "gitea.dev/services/repository/archiver"
+	review_reminder_service "gitea.dev/services/reviewreminder"
+	sla_service "gitea.dev/services/sla"
 )
 
 func registerUpdateMirrorTask() {
@@ -142,6 +148,64 @@ func registerCleanupHookTaskTable() {
 	})
 }
 
+func registerAutomationRules() {
+	RegisterTaskFatal("automation_rules", &OlderThanConfig{
+		BaseConfig: BaseConfig{
+			Enabled:    true,
+			RunAtStart: false,
+			Schedule:   "@every 1h",
+		},
+		OlderThan: 30 * 24 * time.Hour,
+	}, func(ctx context.Context, _ *user_model.User, config Config) error {
+		realConfig := config.(*OlderThanConfig)
+		return automation.RunInactiveRules(ctx, realConfig.OlderThan)
+	})
+}
+
+func registerSLAReminders() {
+	RegisterTaskFatal("sla_reminders", &BaseConfig{
+		Enabled:    true,
+		RunAtStart: false,
+		Schedule:   "@every 30m",
+	}, func(ctx context.Context, _ *user_model.User, _ Config) error {
+		return sla_service.SendReminders(ctx)
+	})
+}
+
+func registerMailDigests() {
+	RegisterTaskFatal("mail_digests", &BaseConfig{
+		Enabled:    true,
+		RunAtStart: false,
+		Schedule:   "@midnight",
+	}, func(ctx context.Context, _ *user_model.User, _ Config) error {
+		return mailer.SendMailDigests(ctx)
+	})
+}
+
+func registerReviewReminders() {
+	RegisterTaskFatal("review_reminders", &BaseConfig{
+		Enabled:    true,
+		RunAtStart: false,
+		Schedule:   "@every 10m",
+	}, func(ctx context.Context, _ *user_model.User, _ Config) error {
+		return review_reminder_service.SendReminders(ctx)
+	})
+}
+
+func registerCleanupCodeNavigationIndexes() {
+	RegisterTaskFatal("cleanup_code_navigation_indexes", &OlderThanConfig{
+		BaseConfig: BaseConfig{
+			Enabled:    true,
+			RunAtStart: false,
+			Schedule:   "@midnight",
+		},
+		OlderThan: 7 * 24 * time.Hour,
+	}, func(ctx context.Context, _ *user_model.User, config Config) error {
+		realConfig := config.(*OlderThanConfig)
+		return codenav_service.DeleteOldIndexes(ctx, realConfig.OlderThan)
+	})
+}
+
 func registerCleanupPackages() {
 	RegisterTaskFatal("cleanup_packages", &OlderThanConfig{
 		BaseConfig: BaseConfig{
@@ -156,6 +220,16 @@ func registerCleanupPackages() {
 	})
 }
 
+func registerScanPackageVulnerabilities() {
+	RegisterTaskFatal("scan_package_vulnerabilities", &BaseConfig{
+		Enabled:    true,
+		RunAtStart: false,
+		Schedule:   "@midnight",
+	}, func(ctx context.Context, _ *user_model.User, _ Config) error {
+		return packages_vulnerability_service.ScanTask(ctx, setting.Packages.OSVDatabasePath)
+	})
+}
+
 func registerSyncRepoLicenses() {
 	RegisterTaskFatal("sync_repo_licenses", &BaseConfig{
 		Enabled:    false,
@@ -179,8 +253,18 @@ func initBasicTasks() {
 		registerUpdateMigrationPosterID()
 	}
 	registerCleanupHookTaskTable()
+	registerAutomationRules()
+	registerSLAReminders()
+	registerMailDigests()
+	registerReviewReminders()
+	if setting.CodeNavigation.Enabled {
+		registerCleanupCodeNavigationIndexes()
+	}
 	if setting.Packages.Enabled {
 		registerCleanupPackages()
+		if setting.Packages.OSVDatabasePath != "" {
+			registerScanPackageVulnerabilities()
+		}
 	}
 	registerSyncRepoLicenses()
 }
@@ -233,10 +233,16 @@ type WebhookForm struct {
 	Release                  bool
 	Package                  bool
 	Status                   bool
+	ProjectColumn            bool
 	WorkflowRun              bool
 	WorkflowJob              bool
 	Active                   bool
 	BranchFilter             string `binding:"GlobPattern"`
+	PathFilter               string `binding:"GlobPattern"`
+	LabelFilter              string
+	ActionFilter             string
+	IncludeSenders           string
+	ExcludeSenders           string
 	AuthorizationHeader      string
 	Secret                   string
 }
@@ -258,9 +264,10 @@ func (f WebhookForm) ChooseEvents() bool {
 
 // NewWebhookForm form for creating web hook
 type NewWebhookForm struct {
-	PayloadURL  string `binding:"Required;ValidUrl"`
-	HTTPMethod  string `binding:"Required;In(POST,GET)"`
-	ContentType int    `binding:"Required"`
+	PayloadURL      string `binding:"Required;ValidUrl"`
+	HTTPMethod      string `binding:"Required;In(POST,GET)"`
+	ContentType     int    `binding:"Required"`
+	PayloadTemplate string
 	WebhookForm
 }
 
@@ -270,6 +277,12 @@ func (f *NewWebhookForm) Validate(req *http.Request, errs binding.Errors) bindin
 	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
 }
 
+// WebhookTemplatePreviewForm form for previewing the payload template of a webhook
+type WebhookTemplatePreviewForm struct {
+	PayloadTemplate string
+	PreviewEvent    string
+}
+
 // NewGogshookForm form for creating gogs hook
 type NewGogshookForm struct {
 	PayloadURL  string `binding:"Required;ValidUrl"`
@@ -372,6 +385,33 @@ func (f *NewMSTeamsHookForm) Validate(req *http.Request, errs binding.Errors) bi
 	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
 }
 
+// NewGoogleChatHookForm form for creating Google Chat hook
+type NewGoogleChatHookForm struct {
+	PayloadURL string `binding:"Required;ValidUrl"`
+	WebhookForm
+}
+
+// Validate validates the fields
+func (f *NewGoogleChatHookForm) Validate(req *http.Request, errs binding.Errors) binding.Errors {
+	ctx := context.GetValidateContext(req)
+	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+// NewMattermostHookForm form for creating Mattermost hook
+type NewMattermostHookForm struct {
+	PayloadURL string `binding:"Required;ValidUrl"`
+	Channel    string
+	Username   string
+	IconURL    string
+	WebhookForm
+}
+
+// Validate validates the fields
+func (f *NewMattermostHookForm) Validate(req *http.Request, errs binding.Errors) binding.Errors {
+	ctx := context.GetValidateContext(req)
+	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
+}
+
 // NewFeishuHookForm form for creating feishu hook
 type NewFeishuHookForm struct {
 	PayloadURL string `binding:"Required;ValidUrl"`
@@ -410,6 +450,21 @@ func (f *NewPackagistHookForm) Validate(req *http.Request, errs binding.Errors)
 	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
 }
 
+// NewBrokerHookForm form for creating a message broker hook
+type NewBrokerHookForm struct {
+	BrokerURL   string `binding:"Required"`
+	Topic       string
+	Exchange    string
+	CloudEvents bool
+	WebhookForm
+}
+
+// Validate validates the fields
+func (f *NewBrokerHookForm) Validate(req *http.Request, errs binding.Errors) binding.Errors {
+	ctx := context.GetValidateContext(req)
+	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
+}
+
 // CreateIssueForm form for creating issue
 type CreateIssueForm struct {
 	Title               string `binding:"Required;MaxSize(255)"`
@@ -417,6 +472,7 @@ type CreateIssueForm struct {
 	ReviewerIDs         string `form:"reviewer_ids"`
 	Ref                 string `form:"ref"`
 	MilestoneID         int64
+	TypeID              int64
 	Content             string
 	Files               []string
 	AllowMaintainerEdit bool
@@ -478,6 +534,17 @@ type EditProjectColumnForm struct {
 	Color   string `binding:"MaxSize(7)"`
 }
 
+// ProjectViewForm is a form for creating or editing a project view
+type ProjectViewForm struct {
+	Name     string `binding:"Required;MaxSize(255)"`
+	Layout   string `binding:"Required;In(table,roadmap)"`
+	Fields   []string
+	GroupBy  string
+	SortBy   string
+	SortDesc bool
+	Filter   string
+}
+
 // CreateMilestoneForm form for creating milestone
 type CreateMilestoneForm struct {
 	Title    string `binding:"Required;MaxSize(50)"`
@@ -270,6 +270,52 @@ func (f *AddEmailForm) Validate(req *http.Request, errs binding.Errors) binding.
 	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
 }
 
+// NotificationChannelForm form for adding a personal notification channel
+type NotificationChannelForm struct {
+	Name    string `binding:"Required;MaxSize(255)"`
+	Type    string `binding:"Required;In(webhook,ntfy,matrix)"`
+	URL     string `binding:"Required;ValidUrl"`
+	RoomID  string `binding:"MaxSize(255)"`
+	Token   string `binding:"MaxSize(1024)"`
+	Reasons []string
+}
+
+// Validate validates the fields
+func (f *NotificationChannelForm) Validate(req *http.Request, errs binding.Errors) binding.Errors {
+	ctx := context.GetValidateContext(req)
+	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+// PushSubscriptionForm form for subscribing a browser to push notifications
+type PushSubscriptionForm struct {
+	Endpoint string `form:"endpoint" binding:"Required;ValidUrl;MaxSize(2048)"`
+	P256DH   string `form:"p256dh" binding:"Required;MaxSize(255)"`
+	Auth     string `form:"auth" binding:"Required;MaxSize(255)"`
+}
+
+// Validate validates the fields
+func (f *PushSubscriptionForm) Validate(req *http.Request, errs binding.Errors) binding.Errors {
+	ctx := context.GetValidateContext(req)
+	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+// ReviewReminderForm form for adding a scheduled reminder of the pull requests awaiting a review
+type ReviewReminderForm struct {
+	TeamID     int64
+	Weekdays   []int
+	Time       string `binding:"Required;MaxSize(5)"`
+	TimeZone   string `binding:"MaxSize(64)"`
+	SkipDrafts bool
+	Delivery   string `binding:"Required;In(mail,webhook)"`
+	WebhookURL string `binding:"MaxSize(2048)"`
+}
+
+// Validate validates the fields
+func (f *ReviewReminderForm) Validate(req *http.Request, errs binding.Errors) binding.Errors {
+	ctx := context.GetValidateContext(req)
+	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
+}
+
 // UpdateThemeForm form for updating a users' theme
 type UpdateThemeForm struct {
 	Theme string `binding:"Required;MaxSize(255)"`
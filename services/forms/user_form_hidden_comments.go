@@ -23,6 +23,7 @@ var hiddenCommentTypeGroups = hiddenCommentTypeGroupsType{
 	},
 	"label": {
 		/*7*/ issues_model.CommentTypeLabel,
+		/*39*/ issues_model.CommentTypeChangeIssueType,
 	},
 	"milestone": {
 		/*8*/ issues_model.CommentTypeMilestone,
@@ -74,6 +74,11 @@ func (r *indexerNotifier) DeleteRepository(ctx context.Context, doer *user_model
 	}
 }
 
+func (r *indexerNotifier) TransferRepository(ctx context.Context, doer *user_model.User, repo *repo_model.Repository, oldOwnerName string) {
+	// the issue types and the custom field values of the old owner have been removed from the issues
+	issue_indexer.UpdateRepoIndexer(ctx, repo.ID)
+}
+
 func (r *indexerNotifier) MigrateRepository(ctx context.Context, doer, u *user_model.User, repo *repo_model.Repository) {
 	issue_indexer.UpdateRepoIndexer(ctx, repo.ID)
 	if setting.Indexer.RepoIndexerEnabled && !repo.IsEmpty {
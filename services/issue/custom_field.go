@@ -0,0 +1,150 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issue
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	issues_model "gitea.dev/models/issues"
+	user_model "gitea.dev/models/user"
+	issue_indexer "gitea.dev/modules/indexer/issues"
+	"gitea.dev/modules/util"
+)
+
+// IsCustomFieldAvailable returns whether the custom field can be set on the issue
+func IsCustomFieldAvailable(ctx context.Context, issue *issues_model.Issue, field *issues_model.CustomField) (bool, error) {
+	if field.BelongsToRepo() {
+		return field.RepoID == issue.RepoID, nil
+	}
+	if err := issue.LoadRepo(ctx); err != nil {
+		return false, err
+	}
+	return field.OrgID == issue.Repo.OwnerID, nil
+}
+
+// FindCustomFieldByName returns the custom field with the given name, case-insensitively,
+// fields of the repository take precedence over the fields of the organization
+func FindCustomFieldByName(fields []*issues_model.CustomField, name string) *issues_model.CustomField {
+	var found *issues_model.CustomField
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, name) && (found == nil || field.BelongsToRepo()) {
+			found = field
+		}
+	}
+	return found
+}
+
+// ResolveCustomFieldValues converts the values entered by users into the stored values of the custom field,
+// users are entered by their names and stored by their IDs
+func ResolveCustomFieldValues(ctx context.Context, field *issues_model.CustomField, values []string) ([]string, error) {
+	if field.Type == issues_model.CustomFieldTypeUser {
+		resolved := make([]string, 0, len(values))
+		for _, name := range values {
+			if name = strings.TrimSpace(name); name == "" {
+				continue
+			}
+			u, err := user_model.GetUserByName(ctx, name)
+			if err != nil {
+				if user_model.IsErrUserNotExist(err) {
+					return nil, util.NewInvalidArgumentErrorf("user %q does not exist", name)
+				}
+				return nil, err
+			}
+			if !u.IsIndividual() {
+				return nil, util.NewInvalidArgumentErrorf("%q is not an individual user", name)
+			}
+			resolved = append(resolved, strconv.FormatInt(u.ID, 10))
+		}
+		values = resolved
+	}
+	return field.NormalizeValues(values)
+}
+
+// FormatCustomFieldValues converts the stored values of the custom field into the values shown to users,
+// users which have been deleted are skipped
+func FormatCustomFieldValues(ctx context.Context, field *issues_model.CustomField, values []string) ([]string, error) {
+	if field.Type != issues_model.CustomFieldTypeUser {
+		return values, nil
+	}
+	users, err := GetCustomFieldValueUsers(ctx, values)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(users))
+	for _, u := range users {
+		names = append(names, u.Name)
+	}
+	return names, nil
+}
+
+// GetCustomFieldValueUsers returns the users stored as the values of a user custom field
+func GetCustomFieldValueUsers(ctx context.Context, values []string) (user_model.UserList, error) {
+	ids := make([]int64, 0, len(values))
+	for _, value := range values {
+		if id, err := strconv.ParseInt(value, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return user_model.GetUsersByIDs(ctx, ids)
+}
+
+// SetIssueCustomFieldValues replaces the values of a custom field of the issue with the values entered by the doer,
+// empty values unset the custom field
+func SetIssueCustomFieldValues(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, field *issues_model.CustomField, values []string) error {
+	available, err := IsCustomFieldAvailable(ctx, issue, field)
+	if err != nil {
+		return err
+	}
+	if !available {
+		return util.NewInvalidArgumentErrorf("custom field %q can't be set on this issue", field.Name)
+	}
+
+	normalized, err := ResolveCustomFieldValues(ctx, field, values)
+	if err != nil {
+		return err
+	}
+	if err := issues_model.SetIssueCustomFieldValues(ctx, issue.ID, field.ID, normalized); err != nil {
+		return err
+	}
+
+	issue_indexer.UpdateIssueIndexer(ctx, issue.ID)
+	return nil
+}
+
+// UpdateCustomField updates a custom field definition, values of the options which have been removed are unset
+func UpdateCustomField(ctx context.Context, field *issues_model.CustomField) error {
+	issueIDs, err := issues_model.GetIssueIDsByCustomFieldID(ctx, field.ID)
+	if err != nil {
+		return err
+	}
+	if err := issues_model.UpdateCustomField(ctx, field); err != nil {
+		return err
+	}
+	if field.Type.HasOptions() {
+		for _, issueID := range issueIDs {
+			issue_indexer.UpdateIssueIndexer(ctx, issueID)
+		}
+	}
+	return nil
+}
+
+// DeleteCustomField deletes a custom field definition and unsets it on all the issues
+func DeleteCustomField(ctx context.Context, field *issues_model.CustomField) error {
+	issueIDs, err := issues_model.GetIssueIDsByCustomFieldID(ctx, field.ID)
+	if err != nil {
+		return err
+	}
+	if err := issues_model.DeleteCustomField(ctx, field); err != nil {
+		return err
+	}
+	for _, issueID := range issueIDs {
+		issue_indexer.UpdateIssueIndexer(ctx, issueID)
+	}
+	return nil
+}
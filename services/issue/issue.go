@@ -17,6 +17,7 @@ import (
 	user_model "gitea.dev/models/user"
 	"gitea.dev/modules/git"
 	"gitea.dev/modules/gitrepo"
+	issue_indexer "gitea.dev/modules/indexer/issues"
 	"gitea.dev/modules/log"
 	"gitea.dev/modules/storage"
 	notify_service "gitea.dev/services/notify"
@@ -157,6 +158,12 @@ func DeleteIssue(ctx context.Context, doer *user_model.User, issue *issues_model
 		return err
 	}
 
+	// the sub-issues lose their parent, so they have to be reindexed
+	subIssueIDs, err := issues_model.GetSubIssueIDs(ctx, issue.ID)
+	if err != nil {
+		return err
+	}
+
 	// delete entries in database
 	attachmentPaths, err := deleteIssue(ctx, issue)
 	if err != nil {
@@ -177,6 +184,9 @@ func DeleteIssue(ctx context.Context, doer *user_model.User, issue *issues_model
 	}
 
 	notify_service.DeleteIssue(ctx, doer, issue)
+	for _, id := range subIssueIDs {
+		issue_indexer.UpdateIssueIndexer(ctx, id)
+	}
 
 	return nil
 }
@@ -246,6 +256,10 @@ func deleteIssue(ctx context.Context, issue *issues_model.Issue) ([]string, erro
 			&issues_model.IssueDependency{DependencyID: issue.ID},
 			&issues_model.Comment{DependentIssueID: issue.ID},
 			&issues_model.IssuePin{IssueID: issue.ID},
+			&issues_model.SubIssue{IssueID: issue.ID},
+			&issues_model.SubIssue{ParentID: issue.ID},
+			&issues_model.CustomFieldValue{IssueID: issue.ID},
+			&issues_model.SLAReminder{IssueID: issue.ID},
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,81 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issue
+
+import (
+	"context"
+
+	issues_model "gitea.dev/models/issues"
+	repo_model "gitea.dev/models/repo"
+	user_model "gitea.dev/models/user"
+	issue_indexer "gitea.dev/modules/indexer/issues"
+	"gitea.dev/modules/util"
+)
+
+// GetIssueTypeForRepo returns the issue type with the given name which can be set on the issues of the repository,
+// the types are defined by the organization owning the repository
+func GetIssueTypeForRepo(ctx context.Context, repo *repo_model.Repository, name string) (*issues_model.IssueType, error) {
+	if err := repo.LoadOwner(ctx); err != nil {
+		return nil, err
+	}
+	if !repo.Owner.IsOrganization() {
+		return nil, util.NewInvalidArgumentErrorf("issue types are only available to the repositories of organizations")
+	}
+	return issues_model.GetIssueTypeByOrgIDAndName(ctx, repo.OwnerID, name)
+}
+
+// GetIssueTypesForRepo returns the issue types which can be set on the issues of the repository
+func GetIssueTypesForRepo(ctx context.Context, repo *repo_model.Repository) ([]*issues_model.IssueType, error) {
+	if err := repo.LoadOwner(ctx); err != nil {
+		return nil, err
+	}
+	if !repo.Owner.IsOrganization() {
+		return nil, nil
+	}
+	return issues_model.GetIssueTypesByOrgID(ctx, repo.OwnerID)
+}
+
+// ChangeIssueType changes the type of the issue, a nil type removes it.
+// Pull requests don't have types.
+func ChangeIssueType(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, issueType *issues_model.IssueType) error {
+	if issue.IsPull {
+		return util.NewInvalidArgumentErrorf("pull requests don't have types")
+	}
+	if err := issue.LoadRepo(ctx); err != nil {
+		return err
+	}
+
+	var typeID int64
+	if issueType != nil {
+		if issueType.OrgID != issue.Repo.OwnerID {
+			return util.NewInvalidArgumentErrorf("issue type %q can't be set on this issue", issueType.Name)
+		}
+		typeID = issueType.ID
+	}
+	if issue.TypeID == typeID {
+		return nil
+	}
+
+	if err := issues_model.ChangeIssueType(ctx, issue, doer, issueType); err != nil {
+		return err
+	}
+
+	issue_indexer.UpdateIssueIndexer(ctx, issue.ID)
+	return nil
+}
+
+// DeleteIssueType deletes an issue type, the issues of this type don't have a type anymore
+func DeleteIssueType(ctx context.Context, issueType *issues_model.IssueType) error {
+	issueIDs, err := issues_model.GetIssueIDsByTypeID(ctx, issueType.ID)
+	if err != nil {
+		return err
+	}
+	if err := issues_model.DeleteIssueType(ctx, issueType); err != nil {
+		return err
+	}
+	for _, issueID := range issueIDs {
+		issue_indexer.UpdateIssueIndexer(ctx, issueID)
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issue
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/organization"
+	access_model "gitea.dev/models/perm/access"
+	repo_model "gitea.dev/models/repo"
+	"gitea.dev/models/unit"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/container"
+	issue_indexer "gitea.dev/modules/indexer/issues"
+	db_indexer "gitea.dev/modules/indexer/issues/db"
+	"gitea.dev/modules/util"
+
+	"xorm.io/builder"
+)
+
+func validateSavedSearch(s *issues_model.SavedSearch) error {
+	s.Name = strings.TrimSpace(s.Name)
+	s.Query = strings.TrimSpace(s.Query)
+	if s.Name == "" || len(s.Name) > 255 {
+		return util.NewInvalidArgumentErrorf("the name of a saved search must have 1 to 255 characters")
+	}
+	if s.Query == "" {
+		return util.NewInvalidArgumentErrorf("the query of a saved search must not be empty")
+	}
+	_, err := issue_indexer.ParseQuery(s.Query)
+	return err
+}
+
+// CreateSavedSearch saves the issue search query for the owner, which may be the doer or an organization
+func CreateSavedSearch(ctx context.Context, doer, owner *user_model.User, name, query string, isPinned bool) (*issues_model.SavedSearch, error) {
+	s := &issues_model.SavedSearch{
+		OwnerID:   owner.ID,
+		Name:      name,
+		Query:     query,
+		IsPinned:  isPinned,
+		CreatorID: doer.ID,
+	}
+	if err := validateSavedSearch(s); err != nil {
+		return nil, err
+	}
+	if err := issues_model.CreateSavedSearch(ctx, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UpdateSavedSearch validates and stores the changed saved search
+func UpdateSavedSearch(ctx context.Context, s *issues_model.SavedSearch) error {
+	if err := validateSavedSearch(s); err != nil {
+		return err
+	}
+	return issues_model.UpdateSavedSearch(ctx, s)
+}
+
+// SubscribeSavedSearch subscribes the user to the new issues and pull requests matching the saved search.
+// Users can only subscribe to their own saved searches and to the saved searches of their organizations.
+func SubscribeSavedSearch(ctx context.Context, s *issues_model.SavedSearch, user *user_model.User, subscribe bool) error {
+	if subscribe && s.OwnerID != user.ID {
+		isMember, err := organization.IsOrganizationMember(ctx, s.OwnerID, user.ID)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			return util.NewPermissionDeniedErrorf("only members of the organization can subscribe to its saved searches")
+		}
+	}
+	return issues_model.SetSavedSearchSubscription(ctx, s.ID, user.ID, subscribe)
+}
+
+// GetSavedSearchSubscriberIDs returns the users who are subscribed to a saved search matching the issue
+// and who are allowed to read it.
+func GetSavedSearchSubscriberIDs(ctx context.Context, issue *issues_model.Issue) ([]int64, error) {
+	if err := issue.LoadRepo(ctx); err != nil {
+		return nil, err
+	}
+
+	subs, err := issues_model.GetSavedSearchSubscriptionsForRepo(ctx, issue.Repo)
+	if err != nil || len(subs) == 0 {
+		return nil, err
+	}
+	searchIDs := make(container.Set[int64])
+	userIDs := make(container.Set[int64])
+	for _, sub := range subs {
+		searchIDs.Add(sub.SavedSearchID)
+		userIDs.Add(sub.UserID)
+	}
+	searches, err := issues_model.GetSavedSearchesByIDs(ctx, searchIDs.Values())
+	if err != nil {
+		return nil, err
+	}
+	users, err := user_model.GetUsersMapByIDs(ctx, userIDs.Values())
+	if err != nil {
+		return nil, err
+	}
+
+	subscribers := make(container.Set[int64])
+	canRead := make(map[int64]bool)
+	for _, sub := range subs {
+		s, ok := searches[sub.SavedSearchID]
+		if !ok || subscribers.Contains(sub.UserID) {
+			continue
+		}
+		subscriber, ok := users[sub.UserID]
+		if !ok {
+			continue
+		}
+		// a saved search limited to another repository can't match
+		if q, err := issue_indexer.ParseQuery(s.Query); err != nil || (q.Repo != "" && !strings.EqualFold(q.Repo, issue.Repo.FullName())) {
+			continue
+		}
+
+		readable, ok := canRead[subscriber.ID]
+		if !ok {
+			perm, err := access_model.GetIndividualUserRepoPermission(ctx, issue.Repo, subscriber)
+			if err != nil {
+				return nil, err
+			}
+			readable = perm.CanReadIssuesOrPulls(issue.IsPull)
+			canRead[subscriber.ID] = readable
+		}
+		if !readable {
+			continue
+		}
+
+		matched, err := matchSavedSearch(ctx, subscriber, s.Query, issue)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			subscribers.Add(sub.UserID)
+		}
+	}
+	return subscribers.Values(), nil
+}
+
+// matchSavedSearch checks whether the issue is found by the query, the keyword is matched against the title and the content only
+func matchSavedSearch(ctx context.Context, subscriber *user_model.User, query string, issue *issues_model.Issue) (bool, error) {
+	issues, err := FilterIssuesByQuery(ctx, subscriber, query, issues_model.IssueList{issue})
+	if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			// an invalid query matches nothing, e.g. the labels of the query don't exist in the repository of the issue
+			return false, nil
+		}
+		return false, err
+	}
+	return len(issues) > 0, nil
+}
+
+// FilterIssuesByQuery returns the issues which are found by the search query without using the issue indexer,
+// the keyword is matched against the title and the content only.
+// It returns an invalid argument error if the query can't be parsed or refers to things which don't exist in the repositories of the issues.
+func FilterIssuesByQuery(ctx context.Context, doer *user_model.User, query string, issues issues_model.IssueList) (issues_model.IssueList, error) {
+	if len(issues) == 0 {
+		return issues, nil
+	}
+	q, err := issue_indexer.ParseQuery(query)
+	if err != nil {
+		return nil, util.NewInvalidArgumentErrorf("%v", err)
+	}
+	// ApplySearchQuery requires the options to be limited to the repositories the doer can access
+	repoIDs, err := repo_model.SearchRepositoryIDsByCondition(ctx, builder.And(
+		builder.In("`repository`.id", container.FilterSlice(issues, func(issue *issues_model.Issue) (int64, bool) {
+			return issue.RepoID, true
+		})),
+		repo_model.AccessibleRepositoryCondition(doer, unit.TypeInvalid),
+	))
+	if err != nil {
+		return nil, err
+	}
+	if len(repoIDs) == 0 {
+		return issues_model.IssueList{}, nil
+	}
+	opts := &issue_indexer.SearchOptions{RepoIDs: repoIDs}
+	if err := ApplySearchQuery(ctx, doer, q, opts); err != nil {
+		return nil, err
+	}
+
+	dbOpts, err := db_indexer.ToDBOptions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	dbOpts.IssueIDs = make([]int64, 0, len(issues))
+	for _, issue := range issues {
+		dbOpts.IssueIDs = append(dbOpts.IssueIDs, issue.ID)
+	}
+	dbOpts.Paginator = nil
+	ids, _, err := issues_model.IssueIDs(ctx, dbOpts)
+	if err != nil {
+		return nil, err
+	}
+	found := container.SetOf(ids...)
+
+	keywords := strings.Fields(strings.ToLower(strings.ReplaceAll(q.Keyword, `"`, "")))
+	return slices.DeleteFunc(slices.Clone(issues), func(issue *issues_model.Issue) bool {
+		if !found.Contains(issue.ID) {
+			return true
+		}
+		text := strings.ToLower(issue.Title + "\n" + issue.Content)
+		for _, field := range keywords {
+			if field != strconv.FormatInt(issue.Index, 10) && !strings.Contains(text, field) {
+				return true
+			}
+		}
+		return false
+	}), nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issue
+
+import (
+	"testing"
+
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	user_model "gitea.dev/models/user"
+	issue_indexer "gitea.dev/modules/indexer/issues"
+	"gitea.dev/modules/optional"
+	"gitea.dev/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplySearchQuery(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+
+	apply := func(doer *user_model.User, query string) (*issue_indexer.SearchOptions, error) {
+		q, err := issue_indexer.ParseQuery(query)
+		require.NoError(t, err)
+		opts := &issue_indexer.SearchOptions{RepoIDs: []int64{1}}
+		return opts, ApplySearchQuery(t.Context(), doer, q, opts)
+	}
+
+	opts, err := apply(user2, "is:open is:issue label:label1 -label:label2 author:user1 assignee:@me no:milestone crash")
+	require.NoError(t, err)
+	assert.Equal(t, "crash", opts.Keyword)
+	assert.Equal(t, optional.Some(false), opts.IsClosed)
+	assert.Equal(t, optional.Some(false), opts.IsPull)
+	assert.Equal(t, []int64{1}, opts.IncludedLabelIDs)
+	assert.Equal(t, []int64{2}, opts.ExcludedLabelIDs)
+	assert.Equal(t, "1", opts.PosterID)
+	assert.Equal(t, "2", opts.AssigneeID)
+	assert.Equal(t, []int64{0}, opts.MilestoneIDs)
+
+	opts, err = apply(user2, "repo:user2/repo1 milestone:milestone1 sort:created-asc")
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, opts.RepoIDs)
+	assert.Equal(t, []int64{1}, opts.MilestoneIDs)
+	assert.Equal(t, issue_indexer.SortByCreatedAsc, opts.SortBy)
+
+	for _, query := range []string{"label:unknown", "author:unknown", "repo:user2/repo2", "milestone:unknown"} {
+		_, err = apply(user2, query)
+		assert.ErrorIs(t, err, util.ErrInvalidArgument, query)
+	}
+	_, err = apply(nil, "assignee:@me")
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+}
+
+func TestGetSavedSearchSubscriberIDs(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	user4 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 4})
+	issue1 := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 1})
+	issue2 := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 2})
+
+	_, err := CreateSavedSearch(t.Context(), user2, user2, " ", "is:open", false)
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+	_, err = CreateSavedSearch(t.Context(), user2, user2, "invalid", "is:unknown", false)
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+
+	s, err := CreateSavedSearch(t.Context(), user2, user2, "First issue", "is:issue label:label1 \"FIRST issue\"", true)
+	require.NoError(t, err)
+	require.NoError(t, SubscribeSavedSearch(t.Context(), s, user2, true))
+	// only the owner can subscribe to the saved searches of a user
+	assert.ErrorIs(t, SubscribeSavedSearch(t.Context(), s, user4, true), util.ErrPermissionDenied)
+
+	ids, err := GetSavedSearchSubscriberIDs(t.Context(), issue1)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, ids)
+
+	// issue2 is a pull request
+	ids, err = GetSavedSearchSubscriberIDs(t.Context(), issue2)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+
+	s.Query = "label:label1 second"
+	require.NoError(t, UpdateSavedSearch(t.Context(), s))
+	ids, err = GetSavedSearchSubscriberIDs(t.Context(), issue1)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+	ids, err = GetSavedSearchSubscriberIDs(t.Context(), issue2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, ids)
+
+	// the subscribers who can't read the issue aren't returned, issue7 is in the private repo2 of user2
+	s4, err := CreateSavedSearch(t.Context(), user4, user4, "Open issues", "is:issue is:open", false)
+	require.NoError(t, err)
+	require.NoError(t, SubscribeSavedSearch(t.Context(), s4, user4, true))
+	ids, err = GetSavedSearchSubscriberIDs(t.Context(), issue1)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4}, ids)
+	issue7 := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 7})
+	ids, err = GetSavedSearchSubscriberIDs(t.Context(), issue7)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+
+	// the personal saved searches only match the repositories the subscriber is involved in, user4 watches repo1
+	user5 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 5})
+	s5, err := CreateSavedSearch(t.Context(), user5, user5, "Open issues", "is:issue is:open", false)
+	require.NoError(t, err)
+	require.NoError(t, SubscribeSavedSearch(t.Context(), s5, user5, true))
+	ids, err = GetSavedSearchSubscriberIDs(t.Context(), issue1)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4}, ids)
+
+	require.NoError(t, issues_model.DeleteSavedSearch(t.Context(), s.ID))
+	unittest.AssertNotExistsBean(t, &issues_model.SavedSearchSubscription{SavedSearchID: s.ID})
+}
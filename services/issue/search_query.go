@@ -0,0 +1,271 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issue
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	issues_model "gitea.dev/models/issues"
+	project_model "gitea.dev/models/project"
+	repo_model "gitea.dev/models/repo"
+	user_model "gitea.dev/models/user"
+	issue_indexer "gitea.dev/modules/indexer/issues"
+	"gitea.dev/modules/optional"
+	"gitea.dev/modules/util"
+)
+
+// ApplySearchQuery resolves the names used by the qualifiers of the query and applies them to the search options.
+// Labels, milestones, projects and issue types are looked up in the repositories of the options (and their owners),
+// so the options must already be limited to the repositories the doer is allowed to search.
+func ApplySearchQuery(ctx context.Context, doer *user_model.User, q *issue_indexer.Query, opts *issue_indexer.SearchOptions) error {
+	opts.Keyword = q.Keyword
+
+	if q.IsPull.Has() {
+		opts.IsPull = q.IsPull
+	}
+	if q.IsClosed.Has() {
+		opts.IsClosed = q.IsClosed
+	}
+	if q.IsArchived.Has() {
+		opts.IsArchived = q.IsArchived
+	}
+
+	if q.Repo != "" {
+		if err := applySearchQueryRepo(ctx, q.Repo, opts); err != nil {
+			return err
+		}
+	}
+
+	if err := applySearchQueryLabels(ctx, q, opts); err != nil {
+		return err
+	}
+
+	if q.NoMilestone {
+		opts.MilestoneIDs = []int64{0}
+	} else if len(q.Milestones) > 0 {
+		opts.MilestoneIDs = nil
+		for _, name := range q.Milestones {
+			ids, err := issues_model.GetMilestoneIDsInReposByName(ctx, opts.RepoIDs, name)
+			if err != nil {
+				return err
+			}
+			if len(ids) == 0 {
+				return util.NewInvalidArgumentErrorf("milestone %q does not exist", name)
+			}
+			opts.MilestoneIDs = append(opts.MilestoneIDs, ids...)
+		}
+	}
+
+	if q.NoProject {
+		opts.NoProjectOnly = true
+	} else if len(q.Projects) > 0 {
+		opts.ProjectIDs = nil
+		for _, title := range q.Projects {
+			ids, err := project_model.GetProjectIDsInReposByTitle(ctx, opts.RepoIDs, title)
+			if err != nil {
+				return err
+			}
+			if len(ids) == 0 {
+				return util.NewInvalidArgumentErrorf("project %q does not exist", title)
+			}
+			opts.ProjectIDs = append(opts.ProjectIDs, ids...)
+		}
+	}
+
+	if q.NoType {
+		opts.TypeIDs = []int64{0}
+	} else if len(q.Types) > 0 {
+		opts.TypeIDs = nil
+		for _, name := range q.Types {
+			ids, err := issues_model.GetIssueTypeIDsInReposByName(ctx, opts.RepoIDs, name)
+			if err != nil {
+				return err
+			}
+			if len(ids) == 0 {
+				return util.NewInvalidArgumentErrorf("issue type %q does not exist", name)
+			}
+			opts.TypeIDs = append(opts.TypeIDs, ids...)
+		}
+	}
+
+	userID := func(name string) (int64, error) {
+		if name == issue_indexer.QueryCurrentUser {
+			if doer == nil {
+				return 0, util.NewInvalidArgumentErrorf("%s can only be used when signed in", issue_indexer.QueryCurrentUser)
+			}
+			return doer.ID, nil
+		}
+		u, err := user_model.GetUserByName(ctx, name)
+		if err != nil {
+			if user_model.IsErrUserNotExist(err) {
+				return 0, util.NewInvalidArgumentErrorf("user %q does not exist", name)
+			}
+			return 0, err
+		}
+		return u.ID, nil
+	}
+
+	if q.Author != "" {
+		id, err := userID(q.Author)
+		if err != nil {
+			return err
+		}
+		opts.PosterID = strconv.FormatInt(id, 10)
+	}
+	if q.NoParent {
+		opts.ParentID = optional.Some[int64](0)
+	}
+	for _, field := range q.CustomFields {
+		value := field.Value
+		if value == issue_indexer.QueryCurrentUser && doer != nil {
+			value = doer.Name
+		}
+		filter, err := resolveSearchQueryCustomField(ctx, opts.RepoIDs, field.Name, value)
+		if err != nil {
+			return err
+		}
+		opts.CustomFields = append(opts.CustomFields, filter)
+	}
+	if q.NoAssignee {
+		opts.AssigneeID = "(none)"
+	} else if q.Assignee != "" {
+		id, err := userID(q.Assignee)
+		if err != nil {
+			return err
+		}
+		opts.AssigneeID = strconv.FormatInt(id, 10)
+	}
+	for _, filter := range []struct {
+		name   string
+		target *optional.Option[int64]
+	}{
+		{q.Mentions, &opts.MentionID},
+		{q.ReviewRequested, &opts.ReviewRequestedID},
+		{q.ReviewedBy, &opts.ReviewedID},
+	} {
+		if filter.name == "" {
+			continue
+		}
+		id, err := userID(filter.name)
+		if err != nil {
+			return err
+		}
+		*filter.target = optional.Some(id)
+	}
+
+	if q.UpdatedAfter.Has() {
+		opts.UpdatedAfterUnix = q.UpdatedAfter
+	}
+	if q.UpdatedBefore.Has() {
+		opts.UpdatedBeforeUnix = q.UpdatedBefore
+	}
+	if q.SortType != "" {
+		opts.SortBy = issue_indexer.ToSortBy(q.SortType)
+	}
+	return nil
+}
+
+// applySearchQueryRepo limits the search to one repository, it must be part of the repositories which are already searched
+func applySearchQueryRepo(ctx context.Context, fullName string, opts *issue_indexer.SearchOptions) error {
+	ownerName, repoName, _ := strings.Cut(fullName, "/")
+	repo, err := repo_model.GetRepositoryByOwnerAndName(ctx, ownerName, repoName)
+	if err != nil && !repo_model.IsErrRepoNotExist(err) {
+		return err
+	}
+	if repo == nil || !(slices.Contains(opts.RepoIDs, repo.ID) || opts.AllPublic && !repo.IsPrivate) {
+		return util.NewInvalidArgumentErrorf("repository %q does not exist", fullName)
+	}
+	opts.RepoIDs = []int64{repo.ID}
+	opts.AllPublic = false
+	return nil
+}
+
+// resolveSearchQueryCustomField looks up the custom fields with the name, fields with the same name may exist in several repositories
+// and the issue needs to have the value for one of them. The value is normalized per field, so it must resolve to the same stored value for all of them.
+func resolveSearchQueryCustomField(ctx context.Context, repoIDs []int64, name, value string) (issue_indexer.CustomFieldFilter, error) {
+	fields, err := issues_model.GetCustomFieldsInReposByName(ctx, repoIDs, name)
+	if err != nil {
+		return issue_indexer.CustomFieldFilter{}, err
+	}
+	if len(fields) == 0 {
+		return issue_indexer.CustomFieldFilter{}, util.NewInvalidArgumentErrorf("custom field %q does not exist", name)
+	}
+
+	var filter issue_indexer.CustomFieldFilter
+	for _, field := range fields {
+		values, err := ResolveCustomFieldValues(ctx, field, []string{value})
+		if errors.Is(err, util.ErrInvalidArgument) || err == nil && len(values) == 0 {
+			continue // the value is not valid for this field, e.g. it's not one of its options
+		} else if err != nil {
+			return issue_indexer.CustomFieldFilter{}, err
+		}
+		if filter.Value != "" && filter.Value != values[0] {
+			return issue_indexer.CustomFieldFilter{}, util.NewInvalidArgumentErrorf("custom field %q is ambiguous, its value %q refers to different values", name, value)
+		}
+		filter.Value = values[0]
+		filter.FieldIDs = append(filter.FieldIDs, field.ID)
+	}
+	if len(filter.FieldIDs) == 0 {
+		return issue_indexer.CustomFieldFilter{}, util.NewInvalidArgumentErrorf("%q is not a valid value of custom field %q", value, name)
+	}
+	return filter, nil
+}
+
+func applySearchQueryLabels(ctx context.Context, q *issue_indexer.Query, opts *issue_indexer.SearchOptions) error {
+	if q.NoLabel {
+		opts.NoLabelOnly = true
+		return nil
+	}
+	if len(q.Labels) == 0 && len(q.ExcludedLabels) == 0 {
+		return nil
+	}
+
+	labelIDs := func(name string) ([]int64, error) {
+		ids, err := issues_model.GetLabelIDsInReposByName(ctx, opts.RepoIDs, name)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return nil, util.NewInvalidArgumentErrorf("label %q does not exist", name)
+		}
+		return ids, nil
+	}
+
+	// Labels with the same name may exist in several repositories, an issue needs to have only one of them.
+	// The indexer can only search for issues having all of some labels or any of some labels,
+	// so several labels can only be combined if each name refers to exactly one label.
+	var included [][]int64
+	for _, name := range q.Labels {
+		ids, err := labelIDs(name)
+		if err != nil {
+			return err
+		}
+		included = append(included, ids)
+	}
+	opts.IncludedLabelIDs = nil
+	opts.IncludedAnyLabelIDs = nil
+	if len(included) == 1 && len(included[0]) > 1 {
+		opts.IncludedAnyLabelIDs = included[0]
+	} else {
+		for i, ids := range included {
+			if len(ids) > 1 {
+				return util.NewInvalidArgumentErrorf("label %q exists in several repositories, it cannot be combined with other labels", q.Labels[i])
+			}
+			opts.IncludedLabelIDs = append(opts.IncludedLabelIDs, ids[0])
+		}
+	}
+
+	for _, name := range q.ExcludedLabels {
+		ids, err := labelIDs(name)
+		if err != nil {
+			return err
+		}
+		opts.ExcludedLabelIDs = append(opts.ExcludedLabelIDs, ids...)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issue
+
+import (
+	"context"
+	"slices"
+
+	"gitea.dev/models/db"
+	issues_model "gitea.dev/models/issues"
+	user_model "gitea.dev/models/user"
+	issue_indexer "gitea.dev/modules/indexer/issues"
+	"gitea.dev/modules/util"
+	notify_service "gitea.dev/services/notify"
+)
+
+// AddSubIssue makes the child issue a sub-issue of the parent issue, both issues must belong to repositories of the same owner.
+// If inherit is set, the child issue is also added to the milestone and the projects of the parent issue.
+func AddSubIssue(ctx context.Context, doer *user_model.User, parent, child *issues_model.Issue, inherit bool) error {
+	if parent.IsPull || child.IsPull {
+		return util.NewInvalidArgumentErrorf("pull requests can't be used as parent or sub-issues")
+	}
+	if err := parent.LoadRepo(ctx); err != nil {
+		return err
+	}
+	if err := child.LoadRepo(ctx); err != nil {
+		return err
+	}
+	if parent.Repo.OwnerID != child.Repo.OwnerID {
+		return util.NewInvalidArgumentErrorf("sub-issues must belong to a repository of the same owner")
+	}
+
+	// the link and the inherited milestone and projects are added together, a failing inheritance doesn't leave a sub-issue behind
+	oldMilestoneID := child.MilestoneID
+	milestoneChanged := false
+	err := db.WithTx(ctx, func(ctx context.Context) error {
+		if err := issues_model.AddSubIssue(ctx, doer.ID, parent, child); err != nil {
+			return err
+		}
+		if !inherit {
+			return nil
+		}
+		var err error
+		milestoneChanged, err = inheritParentIssue(ctx, doer, parent, child)
+		return err
+	})
+	if err != nil {
+		child.MilestoneID = oldMilestoneID
+		return err
+	}
+
+	if milestoneChanged {
+		notify_service.IssueChangeMilestone(ctx, doer, child, oldMilestoneID)
+	}
+	issue_indexer.UpdateIssueIndexer(ctx, child.ID)
+	return nil
+}
+
+// inheritParentIssue adds the child issue to the milestone and the projects of the parent issue,
+// milestones belong to a repository so they are only inherited within the same repository.
+// It returns whether the milestone of the child issue has been changed.
+func inheritParentIssue(ctx context.Context, doer *user_model.User, parent, child *issues_model.Issue) (bool, error) {
+	milestoneChanged := false
+	if parent.MilestoneID != 0 && parent.RepoID == child.RepoID && child.MilestoneID != parent.MilestoneID {
+		oldMilestoneID := child.MilestoneID
+		child.MilestoneID = parent.MilestoneID
+		if err := changeMilestoneAssign(ctx, doer, child, oldMilestoneID); err != nil {
+			return false, err
+		}
+		milestoneChanged = true
+	}
+
+	if err := parent.LoadProjects(ctx); err != nil {
+		return false, err
+	}
+	if err := child.LoadProjects(ctx); err != nil {
+		return false, err
+	}
+	projectIDs := make([]int64, 0, len(parent.Projects)+len(child.Projects))
+	for _, project := range child.Projects {
+		projectIDs = append(projectIDs, project.ID)
+	}
+	changed := false
+	for _, project := range parent.Projects {
+		if !slices.Contains(projectIDs, project.ID) && project.CanBeAccessedByOwnerRepo(child.Repo.OwnerID, child.Repo) {
+			projectIDs = append(projectIDs, project.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return milestoneChanged, nil
+	}
+	return milestoneChanged, issues_model.IssueAssignOrRemoveProject(ctx, child, doer, projectIDs)
+}
+
+// RemoveSubIssue removes the child issue from the sub-issues of the parent issue
+func RemoveSubIssue(ctx context.Context, parent, child *issues_model.Issue) error {
+	if err := issues_model.RemoveSubIssue(ctx, parent, child); err != nil {
+		return err
+	}
+	issue_indexer.UpdateIssueIndexer(ctx, child.ID)
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package incoming
+
+import (
+	"strings"
+
+	issues_model "gitea.dev/models/issues"
+)
+
+// mailCommands are the actions requested by keyword lines in the reply, e.g. "/approve" or "/close"
+type mailCommands struct {
+	// Review submits the reply as a review of the given type, ReviewTypeUnknown if no review was requested
+	Review issues_model.ReviewType
+	// Status is "close" or "reopen" if the status of the issue should be changed
+	Status string
+}
+
+// extractMailCommands removes the lines which only consist of a command from the reply content.
+// If the reply contains conflicting commands the last one wins.
+func extractMailCommands(content string) (string, mailCommands) {
+	cmds := mailCommands{Review: issues_model.ReviewTypeUnknown}
+	lines := strings.Split(content, "\n")
+	kept := make([]string, 0, len(lines))
+	for _, line := range lines {
+		switch strings.ToLower(strings.TrimSpace(line)) {
+		case "/approve":
+			cmds.Review = issues_model.ReviewTypeApprove
+		case "/request-changes", "/reject":
+			cmds.Review = issues_model.ReviewTypeReject
+		case "/close":
+			cmds.Status = "close"
+		case "/reopen":
+			cmds.Status = "reopen"
+		default:
+			kept = append(kept, line)
+		}
+	}
+	return strings.TrimSpace(strings.Join(kept, "\n")), cmds
+}
@@ -13,6 +13,7 @@ import (
 	access_model "gitea.dev/models/perm/access"
 	repo_model "gitea.dev/models/repo"
 	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/gitrepo"
 	"gitea.dev/modules/log"
 	"gitea.dev/modules/setting"
 	"gitea.dev/modules/util"
@@ -41,7 +42,7 @@ func (h *ReplyHandler) Handle(ctx context.Context, content *MailContent, doer *u
 		return util.NewInvalidArgumentErrorf("doer can't be nil")
 	}
 
-	ref, err := incoming_payload.GetReferenceFromPayload(ctx, payload)
+	ref, headCommitID, err := incoming_payload.GetPullReferenceFromPayload(ctx, payload)
 	if err != nil {
 		return err
 	}
@@ -109,42 +110,157 @@ func (h *ReplyHandler) Handle(ctx context.Context, content *MailContent, doer *u
 		}
 	}
 
-	if content.Content == "" && len(attachmentIDs) == 0 {
+	text, cmds := extractMailCommands(content.Content)
+
+	switch {
+	case cmds.Review != issues_model.ReviewTypeUnknown:
+		if err := submitReviewFromMail(ctx, doer, issue, ref, headCommitID, cmds.Review, text, attachmentIDs); err != nil {
+			return err
+		}
+	case text != "" || len(attachmentIDs) > 0:
+		if err := createCommentFromMail(ctx, doer, issue, ref, text, attachmentIDs); err != nil {
+			return err
+		}
+	}
+
+	if cmds.Status != "" {
+		return changeIssueStatusFromMail(ctx, doer, perm, issue, cmds.Status)
+	}
+	return nil
+}
+
+func createCommentFromMail(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, ref any, content string, attachmentIDs []string) error {
+	// a reply to an inline code comment is added to the same conversation
+	if comment, ok := ref.(*issues_model.Comment); ok && comment.Type == issues_model.CommentTypeCode {
+		_, err := pull_service.CreateCodeComment(
+			ctx,
+			doer,
+			nil,
+			issue,
+			comment.Line,
+			content,
+			comment.TreePath,
+			false, // not pending review but a single review
+			comment.ReviewID,
+			"",
+			attachmentIDs,
+		)
+		if err != nil {
+			return fmt.Errorf("CreateCodeComment failed: %w", err)
+		}
 		return nil
 	}
 
-	switch r := ref.(type) {
-	case *issues_model.Issue:
-		_, err := issue_service.CreateIssueComment(ctx, doer, issue.Repo, issue, content.Content, attachmentIDs)
+	if _, err := issue_service.CreateIssueComment(ctx, doer, issue.Repo, issue, content, attachmentIDs); err != nil {
+		return fmt.Errorf("CreateIssueComment failed: %w", err)
+	}
+	return nil
+}
+
+// submitReviewFromMail approves or requests changes on the pull request for the head commit the mail has been sent for,
+// the review is marked as stale if the pull request has changed since then.
+// The reply content becomes the review message, or the reply in the conversation of an inline code comment.
+func submitReviewFromMail(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, ref any, headCommitID string, reviewType issues_model.ReviewType, content string, attachmentIDs []string) error {
+	if !issue.IsPull {
+		log.Debug("can't review an issue")
+		return nil
+	}
+	// can not approve/reject your own PR
+	if issue.IsPoster(doer.ID) {
+		log.Debug("can't review own pull request")
+		return nil
+	}
+	if issue.IsClosed {
+		log.Debug("can't review a closed pull request")
+		return nil
+	}
+	// the mails sent before the head commit has been added to the reply token can't be used to review
+	if headCommitID == "" {
+		log.Debug("can't review without the head commit of the mail")
+		return nil
+	}
+
+	gitRepo, err := gitrepo.OpenRepository(ctx, issue.Repo)
+	if err != nil {
+		return err
+	}
+	defer gitRepo.Close()
+
+	// a reply to an inline code comment is added to its conversation as a part of the review
+	if comment, ok := ref.(*issues_model.Comment); ok && comment.Type == issues_model.CommentTypeCode && (content != "" || len(attachmentIDs) > 0) {
+		_, err := pull_service.CreateCodeComment(
+			ctx,
+			doer,
+			gitRepo,
+			issue,
+			comment.Line,
+			content,
+			comment.TreePath,
+			true, // pending review which is submitted below
+			comment.ReviewID,
+			headCommitID,
+			attachmentIDs,
+		)
 		if err != nil {
-			return fmt.Errorf("CreateIssueComment failed: %w", err)
+			return fmt.Errorf("CreateCodeComment failed: %w", err)
 		}
-	case *issues_model.Comment:
-		comment := r
+		content, attachmentIDs = "", nil
+	}
 
-		switch comment.Type {
-		case issues_model.CommentTypeCode:
-			_, err := pull_service.CreateCodeComment(
-				ctx,
-				doer,
-				nil,
-				issue,
-				comment.Line,
-				content.Content,
-				comment.TreePath,
-				false, // not pending review but a single review
-				comment.ReviewID,
-				"",
-				attachmentIDs,
-			)
-			if err != nil {
-				return fmt.Errorf("CreateCodeComment failed: %w", err)
+	if _, _, err := pull_service.SubmitReview(ctx, doer, gitRepo, issue, reviewType, content, headCommitID, attachmentIDs); err != nil {
+		if errors.Is(err, pull_service.ErrSubmitReviewOnClosedPR) {
+			log.Debug("can't review a closed pull request")
+			return nil
+		}
+		return fmt.Errorf("SubmitReview failed: %w", err)
+	}
+	return nil
+}
+
+// changeIssueStatusFromMail closes or reopens the issue with the same checks as a status change from the web UI
+func changeIssueStatusFromMail(ctx context.Context, doer *user_model.User, perm access_model.Permission, issue *issues_model.Issue, status string) error {
+	if !perm.CanWriteIssuesOrPulls(issue.IsPull) && !issue.IsPoster(doer.ID) {
+		log.Debug("can't change the status of issue or pull")
+		return nil
+	}
+	if issue.IsPull {
+		if err := issue.LoadPullRequest(ctx); err != nil {
+			return err
+		}
+		if issue.PullRequest.HasMerged {
+			log.Debug("can't change the status of a merged pull request")
+			return nil
+		}
+	}
+
+	switch {
+	case status == "close" && !issue.IsClosed:
+		if err := issue_service.CloseIssue(ctx, issue, doer, ""); err != nil {
+			if issues_model.IsErrDependenciesLeft(err) {
+				log.Debug("can't close issue with open dependencies")
+				return nil
 			}
-		default:
-			_, err := issue_service.CreateIssueComment(ctx, doer, issue.Repo, issue, content.Content, attachmentIDs)
-			if err != nil {
-				return fmt.Errorf("CreateIssueComment failed: %w", err)
+			return fmt.Errorf("CloseIssue failed: %w", err)
+		}
+	case status == "reopen" && issue.IsClosed:
+		if issue.IsPull {
+			pull := issue.PullRequest
+			other, err := issues_model.GetUnmergedPullRequest(ctx, pull.HeadRepoID, pull.BaseRepoID, pull.HeadBranch, pull.BaseBranch, pull.Flow)
+			if err != nil && !issues_model.IsErrPullRequestNotExist(err) {
+				return err
 			}
+			if other != nil {
+				log.Debug("can't reopen pull request, another unmerged pull request exists for the same branch")
+				return nil
+			}
+		}
+		if err := issue_service.ReopenIssue(ctx, issue, doer, ""); err != nil {
+			return fmt.Errorf("ReopenIssue failed: %w", err)
+		}
+		if issue.IsPull {
+			// Regenerate patch and test conflict.
+			issue.PullRequest.HeadCommitID = ""
+			pull_service.StartPullRequestCheckImmediately(ctx, issue.PullRequest)
 		}
 	}
 	return nil
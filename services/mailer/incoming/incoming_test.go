@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	issues_model "gitea.dev/models/issues"
 	"gitea.dev/modules/setting"
 
 	"github.com/jhillyerd/enmime/v2"
@@ -203,3 +204,24 @@ func TestExtractReply(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractMailCommands(t *testing.T) {
+	content, cmds := extractMailCommands("Looks good.\n /Approve \n\nThanks")
+	assert.Equal(t, "Looks good.\n\nThanks", content)
+	assert.Equal(t, issues_model.ReviewTypeApprove, cmds.Review)
+	assert.Empty(t, cmds.Status)
+
+	content, cmds = extractMailCommands("/request-changes\nplease fix the tests\n/close")
+	assert.Equal(t, "please fix the tests", content)
+	assert.Equal(t, issues_model.ReviewTypeReject, cmds.Review)
+	assert.Equal(t, "close", cmds.Status)
+
+	// commands must be on their own line
+	content, cmds = extractMailCommands("I will /approve later")
+	assert.Equal(t, "I will /approve later", content)
+	assert.Equal(t, issues_model.ReviewTypeUnknown, cmds.Review)
+
+	content, cmds = extractMailCommands("/reopen")
+	assert.Empty(t, content)
+	assert.Equal(t, "reopen", cmds.Status)
+}
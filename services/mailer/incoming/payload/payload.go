@@ -5,12 +5,17 @@ package payload
 
 import (
 	"context"
+	"encoding/hex"
 
 	issues_model "gitea.dev/models/issues"
 	"gitea.dev/modules/util"
 )
 
-const replyPayloadVersion1 byte = 1
+const (
+	replyPayloadVersion1 byte = 1
+	// replyPayloadVersion2 also contains the head commit of the pull request
+	replyPayloadVersion2 byte = 2
+)
 
 type payloadReferenceType byte
 
@@ -19,20 +24,22 @@ const (
 	payloadReferenceComment
 )
 
-// CreateReferencePayload creates data which GetReferenceFromPayload resolves to the reference again.
-func CreateReferencePayload(reference any) ([]byte, error) {
-	var refType payloadReferenceType
-	var refID int64
-
+func getReferenceTypeAndID(reference any) (payloadReferenceType, int64, error) {
 	switch r := reference.(type) {
 	case *issues_model.Issue:
-		refType = payloadReferenceIssue
-		refID = r.ID
+		return payloadReferenceIssue, r.ID, nil
 	case *issues_model.Comment:
-		refType = payloadReferenceComment
-		refID = r.ID
+		return payloadReferenceComment, r.ID, nil
 	default:
-		return nil, util.NewInvalidArgumentErrorf("unsupported reference type: %T", r)
+		return 0, 0, util.NewInvalidArgumentErrorf("unsupported reference type: %T", r)
+	}
+}
+
+// CreateReferencePayload creates data which GetReferenceFromPayload resolves to the reference again.
+func CreateReferencePayload(reference any) ([]byte, error) {
+	refType, refID, err := getReferenceTypeAndID(reference)
+	if err != nil {
+		return nil, err
 	}
 
 	payload, err := util.PackData(refType, refID)
@@ -43,28 +50,69 @@ func CreateReferencePayload(reference any) ([]byte, error) {
 	return append([]byte{replyPayloadVersion1}, payload...), nil
 }
 
+// CreatePullReferencePayload creates data which GetPullReferenceFromPayload resolves to the reference
+// and to the head commit the pull request had when the mail has been sent.
+func CreatePullReferencePayload(reference any, headCommitID string) ([]byte, error) {
+	refType, refID, err := getReferenceTypeAndID(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	// the commit is stored as raw bytes to keep the token short
+	commit, err := hex.DecodeString(headCommitID)
+	if err != nil || len(commit) == 0 {
+		return nil, util.NewInvalidArgumentErrorf("invalid head commit: %q", headCommitID)
+	}
+
+	payload, err := util.PackData(refType, refID, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{replyPayloadVersion2}, payload...), nil
+}
+
 // GetReferenceFromPayload resolves the reference from the payload
 func GetReferenceFromPayload(ctx context.Context, payload []byte) (any, error) {
-	if len(payload) < 1 {
-		return nil, util.NewInvalidArgumentErrorf("payload to small")
-	}
+	ref, _, err := GetPullReferenceFromPayload(ctx, payload)
+	return ref, err
+}
 
-	if payload[0] != replyPayloadVersion1 {
-		return nil, util.NewInvalidArgumentErrorf("unsupported payload version")
+// GetPullReferenceFromPayload resolves the reference and the head commit of the pull request from the payload,
+// the head commit is empty if the payload doesn't contain it
+func GetPullReferenceFromPayload(ctx context.Context, payload []byte) (any, string, error) {
+	if len(payload) < 1 {
+		return nil, "", util.NewInvalidArgumentErrorf("payload to small")
 	}
 
 	var ref payloadReferenceType
 	var id int64
-	if err := util.UnpackData(payload[1:], &ref, &id); err != nil {
-		return nil, err
+	var commit []byte
+	switch payload[0] {
+	case replyPayloadVersion1:
+		if err := util.UnpackData(payload[1:], &ref, &id); err != nil {
+			return nil, "", err
+		}
+	case replyPayloadVersion2:
+		if err := util.UnpackData(payload[1:], &ref, &id, &commit); err != nil {
+			return nil, "", err
+		}
+	default:
+		return nil, "", util.NewInvalidArgumentErrorf("unsupported payload version")
 	}
 
+	var reference any
+	var err error
 	switch ref {
 	case payloadReferenceIssue:
-		return issues_model.GetIssueByID(ctx, id)
+		reference, err = issues_model.GetIssueByID(ctx, id)
 	case payloadReferenceComment:
-		return issues_model.GetCommentByID(ctx, id)
+		reference, err = issues_model.GetCommentByID(ctx, id)
 	default:
-		return nil, util.NewInvalidArgumentErrorf("unsupported reference type: %T", ref)
+		return nil, "", util.NewInvalidArgumentErrorf("unsupported reference type: %T", ref)
+	}
+	if err != nil {
+		return nil, "", err
 	}
+	return reference, hex.EncodeToString(commit), nil
 }
@@ -0,0 +1,293 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	activities_model "gitea.dev/models/activities"
+	issues_model "gitea.dev/models/issues"
+	access_model "gitea.dev/models/perm/access"
+	repo_model "gitea.dev/models/repo"
+	"gitea.dev/models/unit"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/container"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/templates"
+	"gitea.dev/modules/translation"
+	"gitea.dev/modules/util"
+	sender_service "gitea.dev/services/mailer/sender"
+)
+
+const mailNotificationDigest templates.TplName = "user/notification_digest"
+
+// digestSendTolerance allows a digest to be sent a bit earlier than its period, so that a daily
+// cron task doesn't skip a day because the previous run took some time
+const digestSendTolerance = time.Hour
+
+// mailEventOfComment returns the event type the mail of the issue comment belongs to
+func mailEventOfComment(comment *mailComment) user_model.MailEventType {
+	switch comment.ActionType {
+	case activities_model.ActionApprovePullRequest, activities_model.ActionRejectPullRequest:
+		return user_model.MailEventPullReviews
+	}
+	if comment.Comment != nil {
+		switch comment.Comment.Type {
+		case issues_model.CommentTypeReview, issues_model.CommentTypeCode,
+			issues_model.CommentTypeReviewRequest, issues_model.CommentTypeDismissReview:
+			return user_model.MailEventPullReviews
+		}
+	}
+	return user_model.MailEventIssues
+}
+
+// addToMailDigest queues a notification for the next mail digest of the users,
+// issueID is the issue or pull request of the notification if there is one
+func addToMailDigest(ctx context.Context, users []*user_model.User, repoID, issueID int64, event user_model.MailEventType, subject, link string, doerID int64) {
+	items := make([]*user_model.MailDigestItem, 0, len(users))
+	for _, u := range users {
+		items = append(items, &user_model.MailDigestItem{
+			UserID:    u.ID,
+			RepoID:    repoID,
+			IssueID:   issueID,
+			EventType: event,
+			Subject:   subject,
+			Link:      link,
+			DoerID:    doerID,
+		})
+	}
+	if err := user_model.AddMailDigestItems(ctx, items...); err != nil {
+		log.Error("AddMailDigestItems: %v", err)
+	}
+}
+
+// splitRecipientsByDelivery drops the recipients who don't want mails of the event type for the repository
+// and separates the recipients who want the mail now from those who want it in their digest
+func splitRecipientsByDelivery(ctx context.Context, recipients []*user_model.User, repo *repo_model.Repository, event user_model.MailEventType) (instant, digest []*user_model.User, err error) {
+	prefs, err := user_model.GetEffectiveMailPreferences(ctx, recipients, repo.OwnerID, repo.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, u := range recipients {
+		switch prefs[u.ID].Delivery(event) {
+		case user_model.MailDeliveryInstant:
+			instant = append(instant, u)
+		case user_model.MailDeliveryDigest:
+			digest = append(digest, u)
+		}
+	}
+	return instant, digest, nil
+}
+
+// mailDigestGroup are the digest entries of one repository
+type mailDigestGroup struct {
+	Repo    string
+	Link    string
+	Entries []*mailDigestEntry
+}
+
+type mailDigestEntry struct {
+	Subject string
+	Link    string
+	Doer    string
+	Time    string
+}
+
+// SendMailDigests sends the pending notifications of the users whose digest is due as a single summary mail
+func SendMailDigests(ctx context.Context) error {
+	if setting.MailService == nil {
+		// No mail service configured
+		return nil
+	}
+
+	userIDs, err := user_model.GetMailDigestUserIDs(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, userID := range userIDs {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if err := sendMailDigest(ctx, userID, now); err != nil {
+			log.Error("sendMailDigest [user: %d]: %v", userID, err)
+		}
+	}
+	return nil
+}
+
+func isMailDigestDue(frequency string, lastSent, now time.Time) bool {
+	period := 24 * time.Hour
+	if frequency == user_model.SettingEmailDigestWeekly {
+		period = 7 * 24 * time.Hour
+	}
+	return now.Sub(lastSent) >= period-digestSendTolerance
+}
+
+func sendMailDigest(ctx context.Context, userID int64, now time.Time) error {
+	u, err := user_model.GetUserByID(ctx, userID)
+	if err != nil {
+		if user_model.IsErrUserNotExist(err) {
+			return user_model.DeleteMailDigestItems(ctx, userID, math.MaxInt64)
+		}
+		return err
+	}
+
+	settings, err := user_model.GetSettings(ctx, u.ID, []string{user_model.SettingsKeyEmailDigestFrequency, user_model.SettingsKeyEmailDigestLastSent})
+	if err != nil {
+		return err
+	}
+	frequency := user_model.SettingEmailDigestDaily
+	if s, ok := settings[user_model.SettingsKeyEmailDigestFrequency]; ok {
+		frequency = s.SettingValue
+	}
+	var lastSent time.Time
+	if s, ok := settings[user_model.SettingsKeyEmailDigestLastSent]; ok {
+		if unix, err := strconv.ParseInt(s.SettingValue, 10, 64); err == nil {
+			lastSent = time.Unix(unix, 0)
+		}
+	}
+	if !isMailDigestDue(frequency, lastSent, now) {
+		return nil
+	}
+
+	items, err := user_model.GetMailDigestItems(ctx, u.ID)
+	if err != nil || len(items) == 0 {
+		return err
+	}
+	maxID := items[len(items)-1].ID
+
+	if u.IsMailable() {
+		repos, err := repo_model.GetRepositoriesMapByIDs(ctx, container.FilterSlice(items, func(item *user_model.MailDigestItem) (int64, bool) {
+			return item.RepoID, true
+		}))
+		if err != nil {
+			return err
+		}
+		// the access of the user may have changed since the items have been queued
+		if items, err = filterReadableMailDigestItems(ctx, u, items, repos); err != nil {
+			return err
+		}
+		if len(items) > 0 {
+			if err := mailDigestToUser(ctx, u, frequency, items, repos); err != nil {
+				return err
+			}
+		}
+	}
+	if err := user_model.SetUserSetting(ctx, u.ID, user_model.SettingsKeyEmailDigestLastSent, strconv.FormatInt(now.Unix(), 10)); err != nil {
+		return err
+	}
+	return user_model.DeleteMailDigestItems(ctx, u.ID, maxID)
+}
+
+// filterReadableMailDigestItems removes the items the user can't read anymore,
+// the items of deleted repositories and issues are removed too
+func filterReadableMailDigestItems(ctx context.Context, u *user_model.User, items []*user_model.MailDigestItem, repos map[int64]*repo_model.Repository) ([]*user_model.MailDigestItem, error) {
+	issues, err := issues_model.GetIssuesByIDs(ctx, container.FilterSlice(items, func(item *user_model.MailDigestItem) (int64, bool) {
+		return item.IssueID, item.IssueID != 0
+	}))
+	if err != nil {
+		return nil, err
+	}
+	issuesByID := make(map[int64]*issues_model.Issue, len(issues))
+	for _, issue := range issues {
+		issuesByID[issue.ID] = issue
+	}
+
+	type repoUnit struct {
+		repoID   int64
+		unitType unit.Type
+	}
+	canRead := make(map[repoUnit]bool)
+	return slices.DeleteFunc(items, func(item *user_model.MailDigestItem) bool {
+		repo := repos[item.RepoID]
+		if repo == nil {
+			return true
+		}
+		var unitType unit.Type
+		switch item.EventType {
+		case user_model.MailEventIssues, user_model.MailEventPullReviews:
+			issue := issuesByID[item.IssueID]
+			if issue == nil || issue.RepoID != item.RepoID {
+				return true
+			}
+			unitType = util.Iif(issue.IsPull, unit.TypePullRequests, unit.TypeIssues)
+		case user_model.MailEventActions:
+			unitType = unit.TypeActions
+		case user_model.MailEventReleases:
+			unitType = unit.TypeReleases
+		default:
+			return true
+		}
+		key := repoUnit{repo.ID, unitType}
+		ok, checked := canRead[key]
+		if !checked {
+			ok = access_model.CheckRepoUnitUser(ctx, repo, u, unitType)
+			canRead[key] = ok
+		}
+		return !ok
+	}), nil
+}
+
+func mailDigestToUser(ctx context.Context, u *user_model.User, frequency string, items []*user_model.MailDigestItem, repos map[int64]*repo_model.Repository) error {
+	if err := user_model.LoadMailDigestItemDoers(ctx, items); err != nil {
+		return err
+	}
+
+	groups := make([]*mailDigestGroup, 0, len(repos))
+	groupIndex := make(map[int64]int, len(repos))
+	for _, item := range items {
+		idx, ok := groupIndex[item.RepoID]
+		if !ok {
+			group := &mailDigestGroup{}
+			if repo := repos[item.RepoID]; repo != nil {
+				group.Repo = repo.FullName()
+				group.Link = repo.HTMLURL(ctx)
+			}
+			idx = len(groups)
+			groupIndex[item.RepoID] = idx
+			groups = append(groups, group)
+		}
+		entry := &mailDigestEntry{
+			Subject: item.Subject,
+			Link:    item.Link,
+			Time:    item.CreatedUnix.FormatInLocation("2006-01-02 15:04 MST", setting.DefaultUILocation),
+		}
+		if item.Doer != nil {
+			entry.Doer = item.Doer.Name
+		}
+		groups[idx].Entries = append(groups[idx].Entries, entry)
+	}
+
+	locale := translation.NewLocale(u.Language)
+	subject := locale.TrString("mail.notification_digest."+frequency+".subject", len(items))
+	data := map[string]any{
+		"locale":    locale,
+		"Subject":   subject,
+		"Frequency": frequency,
+		"Count":     len(items),
+		"Groups":    groups,
+		"Link":      setting.AppURL + "user/settings/notifications",
+		"Language":  locale.Language(),
+	}
+
+	var content bytes.Buffer
+	if err := LoadedTemplates().BodyTemplates.ExecuteTemplate(&content, string(mailNotificationDigest), data); err != nil {
+		return err
+	}
+
+	msg := sender_service.NewMessage(u.EmailTo(), subject, content.String())
+	msg.Info = fmt.Sprintf("UID: %d, notification digest with %d items", u.ID, len(items))
+	SendAsync(msg)
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package mailer
+
+import (
+	"testing"
+	"time"
+
+	activities_model "gitea.dev/models/activities"
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/container"
+	"gitea.dev/modules/test"
+	sender_service "gitea.dev/services/mailer/sender"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsMailDigestDue(t *testing.T) {
+	now := time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+	assert.True(t, isMailDigestDue(user_model.SettingEmailDigestDaily, time.Time{}, now))
+	assert.True(t, isMailDigestDue(user_model.SettingEmailDigestDaily, now.Add(-23*time.Hour-30*time.Minute), now))
+	assert.False(t, isMailDigestDue(user_model.SettingEmailDigestDaily, now.Add(-12*time.Hour), now))
+	assert.False(t, isMailDigestDue(user_model.SettingEmailDigestWeekly, now.Add(-3*24*time.Hour), now))
+	assert.True(t, isMailDigestDue(user_model.SettingEmailDigestWeekly, now.Add(-7*24*time.Hour), now))
+}
+
+func TestMailEventOfComment(t *testing.T) {
+	assert.Equal(t, user_model.MailEventIssues, mailEventOfComment(&mailComment{ActionType: activities_model.ActionCreateIssue}))
+	assert.Equal(t, user_model.MailEventPullReviews, mailEventOfComment(&mailComment{ActionType: activities_model.ActionApprovePullRequest}))
+	assert.Equal(t, user_model.MailEventPullReviews, mailEventOfComment(&mailComment{
+		ActionType: activities_model.ActionCommentPull,
+		Comment:    &issues_model.Comment{Type: issues_model.CommentTypeCode},
+	}))
+	assert.Equal(t, user_model.MailEventIssues, mailEventOfComment(&mailComment{
+		ActionType: activities_model.ActionCommentPull,
+		Comment:    &issues_model.Comment{Type: issues_model.CommentTypeComment},
+	}))
+}
+
+func TestMailDigestOfRepoOverride(t *testing.T) {
+	doer, _, issue, comment := prepareMailerTest(t)
+	defer mockMailTemplates(string(mailNotificationDigest), "{{.Subject}}",
+		`{{range .Groups}}{{.Repo}}:{{range .Entries}} {{.Subject}} by {{.Doer}};{{end}}{{end}}`)()
+
+	var sent []*sender_service.Message
+	defer test.MockVariableValue(&SendAsync, func(msgs ...*sender_service.Message) {
+		sent = append(sent, msgs...)
+	})()
+
+	// user4 only gets mails on mention, the override of the repository mails them the issue events in a digest
+	recipient := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 4})
+	require.NoError(t, user_model.SetMailPreference(t.Context(), &user_model.MailPreference{
+		UserID: recipient.ID,
+		RepoID: issue.RepoID,
+		Level:  user_model.EmailNotificationsEnabled,
+		Events: map[user_model.MailEventType]user_model.MailDelivery{user_model.MailEventIssues: user_model.MailDeliveryDigest},
+	}))
+
+	require.NoError(t, mailIssueCommentBatch(t.Context(), &mailComment{
+		Issue: issue, Doer: doer, ActionType: activities_model.ActionCommentIssue, Content: "test", Comment: comment,
+	}, []*user_model.User{recipient}, make(container.Set[int64]), false))
+	assert.Empty(t, sent)
+
+	// the recipient can't read the issue of the private repository when the digest is sent
+	require.NoError(t, user_model.AddMailDigestItems(t.Context(), &user_model.MailDigestItem{
+		UserID:    recipient.ID,
+		RepoID:    2,
+		IssueID:   7,
+		EventType: user_model.MailEventIssues,
+		Subject:   "[user2/repo2] private issue (#2)",
+	}))
+
+	require.NoError(t, sendMailDigest(t.Context(), recipient.ID, time.Now()))
+	require.Len(t, sent, 1)
+	assert.Equal(t, recipient.EmailTo(), sent[0].To)
+	assert.Equal(t, "user2/repo1: [user2/repo1] issue1 (#1) by user2;", sent[0].Body)
+
+	items, err := user_model.GetMailDigestItems(t.Context(), recipient.ID)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
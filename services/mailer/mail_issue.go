@@ -92,7 +92,7 @@ func mailIssueCommentToParticipants(ctx context.Context, comment *mailComment, m
 	}
 	visited.AddMultiple(ids...)
 
-	unfilteredUsers, err := user_model.GetMailableUsersByIDs(ctx, unfiltered, false)
+	unfilteredUsers, err := user_model.GetMailCandidatesByIDs(ctx, unfiltered)
 	if err != nil {
 		return err
 	}
@@ -109,7 +109,14 @@ func mailIssueCommentBatch(ctx context.Context, comment *mailComment, users []*u
 		checkUnit = unit.TypePullRequests
 	}
 
+	prefs, err := user_model.GetEffectiveMailPreferences(ctx, users, comment.Issue.Repo.OwnerID, comment.Issue.RepoID)
+	if err != nil {
+		return err
+	}
+	event := mailEventOfComment(comment)
+
 	langMap := make(map[string][]*user_model.User)
+	var digest []*user_model.User
 	for _, user := range users {
 		if !user.IsActive {
 			// Exclude deactivated users
@@ -117,9 +124,11 @@ func mailIssueCommentBatch(ctx context.Context, comment *mailComment, users []*u
 		}
 		// At this point we exclude:
 		// user that don't have all mails enabled or users only get mail on mention and this is one ...
-		if !(user.EmailNotificationsPreference == user_model.EmailNotificationsEnabled ||
-			user.EmailNotificationsPreference == user_model.EmailNotificationsAndYourOwn ||
-			fromMention && user.EmailNotificationsPreference == user_model.EmailNotificationsOnMention) {
+		// the global preference of the user may be overridden for the repository or its owner
+		pref := prefs[user.ID]
+		if !(pref.Level == user_model.EmailNotificationsEnabled ||
+			pref.Level == user_model.EmailNotificationsAndYourOwn ||
+			fromMention && pref.Level == user_model.EmailNotificationsOnMention) {
 			continue
 		}
 
@@ -133,9 +142,20 @@ func mailIssueCommentBatch(ctx context.Context, comment *mailComment, users []*u
 			continue
 		}
 
+		switch pref.Delivery(event) {
+		case user_model.MailDeliveryOff:
+			continue
+		case user_model.MailDeliveryDigest:
+			digest = append(digest, user)
+			continue
+		}
 		langMap[user.Language] = append(langMap[user.Language], user)
 	}
 
+	if len(digest) > 0 {
+		addToMailDigest(ctx, digest, comment.Issue.RepoID, comment.Issue.ID, event, fallbackIssueMailSubject(comment.Issue), comment.link(ctx), comment.Doer.ID)
+	}
+
 	for lang, receivers := range langMap {
 		// because we know that the len(receivers) > 0 and we don't care about the order particularly
 		// working backwards from the last (possibly) incomplete batch. If len(receivers) can be 0 this
@@ -194,8 +214,24 @@ func SendIssueAssignedMail(ctx context.Context, issue *issues_model.Issue, doer
 		return err
 	}
 
+	mc := &mailComment{
+		Issue:      issue,
+		Doer:       doer,
+		ActionType: activities_model.ActionType(0),
+		Content:    content,
+		Comment:    comment,
+	}
+	event := mailEventOfComment(mc)
+	instant, digest, err := splitRecipientsByDelivery(ctx, recipients, issue.Repo, event)
+	if err != nil {
+		return err
+	}
+	if len(digest) > 0 {
+		addToMailDigest(ctx, digest, issue.RepoID, issue.ID, event, fallbackIssueMailSubject(issue), mc.link(ctx), doer.ID)
+	}
+
 	langMap := make(map[string][]*user_model.User)
-	for _, user := range recipients {
+	for _, user := range instant {
 		if !user.IsActive {
 			// don't send emails to inactive users
 			continue
@@ -204,13 +240,7 @@ func SendIssueAssignedMail(ctx context.Context, issue *issues_model.Issue, doer
 	}
 
 	for lang, tos := range langMap {
-		msgs, err := composeIssueCommentMessages(ctx, &mailComment{
-			Issue:      issue,
-			Doer:       doer,
-			ActionType: activities_model.ActionType(0),
-			Content:    content,
-			Comment:    comment,
-		}, lang, tos, false, "issue assigned")
+		msgs, err := composeIssueCommentMessages(ctx, mc, lang, tos, false, "issue assigned")
 		if err != nil {
 			return err
 		}
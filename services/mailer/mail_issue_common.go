@@ -17,6 +17,7 @@ import (
 	"gitea.dev/models/renderhelper"
 	user_model "gitea.dev/models/user"
 	"gitea.dev/modules/emoji"
+	"gitea.dev/modules/gitrepo"
 	"gitea.dev/modules/log"
 	"gitea.dev/modules/markup/markdown"
 	"gitea.dev/modules/setting"
@@ -31,6 +32,24 @@ import (
 // Many e-mail service providers have limitations on the size of the email body, it's usually from 10MB to 25MB
 const maxEmailBodySize = 9_000_000
 
+// createReplyPayload creates the payload of the reply token, the payload of a pull request contains its head commit
+// so that a review submitted by replying to the mail is made for the commit the recipient has been notified about
+func createReplyPayload(ctx context.Context, issue *issues_model.Issue, ref any) ([]byte, error) {
+	if !issue.IsPull {
+		return incoming_payload.CreateReferencePayload(ref)
+	}
+	if err := issue.LoadPullRequest(ctx); err != nil {
+		return nil, err
+	}
+	headCommitID, err := gitrepo.GetRefCommitID(ctx, issue.Repo, issue.PullRequest.GetGitHeadRefName())
+	if err != nil {
+		// the reply can still be used to comment, only a review is refused without the head commit
+		log.Error("GetRefCommitID [pull: %d]: %v", issue.PullRequest.ID, err)
+		return incoming_payload.CreateReferencePayload(ref)
+	}
+	return incoming_payload.CreatePullReferencePayload(ref, headCommitID)
+}
+
 func fallbackIssueMailSubject(issue *issues_model.Issue) string {
 	return fmt.Sprintf("[%s] %s (#%d)", issue.Repo.FullName(), issue.Title, issue.Index)
 }
@@ -44,6 +63,14 @@ type mailComment struct {
 	ForceDoerNotification bool
 }
 
+// link returns the URL of the comment, or of the issue if there is no comment
+func (comment *mailComment) link(ctx context.Context) string {
+	if comment.Comment != nil {
+		return comment.Issue.HTMLURL(ctx) + "#" + comment.Comment.HashTag()
+	}
+	return comment.Issue.HTMLURL(ctx)
+}
+
 func composeIssueCommentMessages(ctx context.Context, comment *mailComment, lang string, recipients []*user_model.User, fromMention bool, info string) ([]*sender_service.Message, error) {
 	var (
 		subject string
@@ -57,10 +84,8 @@ func composeIssueCommentMessages(ctx context.Context, comment *mailComment, lang
 	commentType := issues_model.CommentTypeComment
 	if comment.Comment != nil {
 		commentType = comment.Comment.Type
-		link = comment.Issue.HTMLURL(ctx) + "#" + comment.Comment.HashTag()
-	} else {
-		link = comment.Issue.HTMLURL(ctx)
 	}
+	link = comment.link(ctx)
 
 	reviewType := issues_model.ReviewTypeComment
 	if comment.Comment != nil && comment.Comment.Review != nil {
@@ -143,15 +168,20 @@ func composeIssueCommentMessages(ctx context.Context, comment *mailComment, lang
 	reference := generateMessageIDForIssue(comment.Issue, nil, activities_model.ActionType(0))
 
 	var replyPayload []byte
-	if comment.Comment != nil {
-		if comment.Comment.Type.HasMailReplySupport() {
-			replyPayload, err = incoming_payload.CreateReferencePayload(comment.Comment)
+	if setting.IncomingEmail.Enabled {
+		var replyRef any
+		switch {
+		case comment.Comment == nil:
+			replyRef = comment.Issue
+		case comment.Comment.Type.HasMailReplySupport():
+			replyRef = comment.Comment
+		}
+		if replyRef != nil {
+			replyPayload, err = createReplyPayload(ctx, comment.Issue, replyRef)
+			if err != nil {
+				return nil, err
+			}
 		}
-	} else {
-		replyPayload, err = incoming_payload.CreateReferencePayload(comment.Issue)
-	}
-	if err != nil {
-		return nil, err
 	}
 
 	unsubscribePayload, err := incoming_payload.CreateReferencePayload(comment.Issue)
@@ -41,9 +41,9 @@ func MailNewRelease(ctx context.Context, rel *repo_model.Release) {
 		return
 	}
 
-	recipients, err := user_model.GetMailableUsersByIDs(ctx, watcherIDList, false)
+	recipients, err := user_model.GetMailCandidatesByIDs(ctx, watcherIDList)
 	if err != nil {
-		log.Error("user_model.GetMailableUsersByIDs: %v", err)
+		log.Error("user_model.GetMailCandidatesByIDs: %v", err)
 		return
 	}
 
@@ -52,11 +52,28 @@ func MailNewRelease(ctx context.Context, rel *repo_model.Release) {
 		return
 	}
 
-	// delete publisher or any users with no permission
+	prefs, err := user_model.GetEffectiveMailPreferences(ctx, recipients, rel.Repo.OwnerID, rel.RepoID)
+	if err != nil {
+		log.Error("user_model.GetEffectiveMailPreferences: %v", err)
+		return
+	}
+
+	// delete publisher, any users with no permission or who don't want all mails of the repository
 	recipients = slices.DeleteFunc(recipients, func(u *user_model.User) bool {
-		return u.ID == rel.PublisherID || !access_model.CheckRepoUnitUser(ctx, rel.Repo, u, unit.TypeReleases)
+		level := prefs[u.ID].Level
+		return u.ID == rel.PublisherID || !access_model.CheckRepoUnitUser(ctx, rel.Repo, u, unit.TypeReleases) ||
+			(level != user_model.EmailNotificationsEnabled && level != user_model.EmailNotificationsAndYourOwn)
 	})
 
+	recipients, digest, err := splitRecipientsByDelivery(ctx, recipients, rel.Repo, user_model.MailEventReleases)
+	if err != nil {
+		log.Error("splitRecipientsByDelivery: %v", err)
+		return
+	}
+	if len(digest) > 0 {
+		addToMailDigest(ctx, digest, rel.RepoID, 0, user_model.MailEventReleases, fmt.Sprintf("[%s] %s", rel.Repo.FullName(), rel.TagName), rel.HTMLURL(), rel.PublisherID)
+	}
+
 	langMap := make(map[string][]*user_model.User)
 	for _, user := range recipients {
 		if user.ID != rel.PublisherID {
@@ -0,0 +1,85 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	issues_model "gitea.dev/models/issues"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/templates"
+	"gitea.dev/modules/translation"
+	sender_service "gitea.dev/services/mailer/sender"
+)
+
+const mailReviewReminder templates.TplName = "user/review_reminder"
+
+type reviewReminderEntry struct {
+	Title  string
+	Link   string
+	Ref    string
+	Poster string
+	Opened string
+}
+
+// SendReviewReminderMail mails the list of the open pull requests awaiting the review of the user, the oldest first.
+// teamName is the name of the team the reviews are requested from, it's empty for a personal reminder.
+// manageLink is the page to manage the reminder, it's empty for a team reminder which is managed by the organization owners.
+func SendReviewReminderMail(ctx context.Context, to *user_model.User, teamName string, pulls issues_model.IssueList, manageLink string) error {
+	if setting.MailService == nil {
+		// No mail service configured
+		return nil
+	}
+	if len(pulls) == 0 || !to.IsMailable() || to.EmailNotificationsPreference == user_model.EmailNotificationsDisabled {
+		return nil
+	}
+
+	if _, err := pulls.LoadRepositories(ctx); err != nil {
+		return err
+	}
+	if err := pulls.LoadPosters(ctx); err != nil {
+		return err
+	}
+	loc, err := user_model.GetUserLocation(ctx, to.ID)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]*reviewReminderEntry, 0, len(pulls))
+	for _, pull := range pulls {
+		entries = append(entries, &reviewReminderEntry{
+			Title:  pull.Title,
+			Link:   pull.HTMLURL(ctx),
+			Ref:    fmt.Sprintf("%s#%d", pull.Repo.FullName(), pull.Index),
+			Poster: pull.Poster.Name,
+			Opened: pull.CreatedUnix.FormatInLocation("2006-01-02", loc),
+		})
+	}
+
+	locale := translation.NewLocale(to.Language)
+	subject := locale.TrString("mail.review_reminder.subject", len(pulls))
+	data := map[string]any{
+		"locale":    locale,
+		"Subject":   subject,
+		"TeamName":  teamName,
+		"Count":     len(pulls),
+		"Entries":   entries,
+		"PullsLink": setting.AppURL + "pulls?type=review_requested&sort=oldest",
+		"Link":      manageLink,
+		"Language":  locale.Language(),
+	}
+
+	var content bytes.Buffer
+	if err := LoadedTemplates().BodyTemplates.ExecuteTemplate(&content, string(mailReviewReminder), data); err != nil {
+		return err
+	}
+
+	msg := sender_service.NewMessage(to.EmailTo(), subject, content.String())
+	msg.Info = fmt.Sprintf("UID: %d, review reminder with %d pull requests", to.ID, len(pulls))
+	SendAsync(msg)
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	issues_model "gitea.dev/models/issues"
+	repo_model "gitea.dev/models/repo"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/templates"
+	"gitea.dev/modules/translation"
+	sender_service "gitea.dev/services/mailer/sender"
+)
+
+const mailIssueSLAReminder templates.TplName = "repo/issue/sla_reminder"
+
+// SendSLAReminderMail reminds the assignees of an issue that the next target of the SLA is due soon,
+// the users who can be assigned are reminded if nobody is assigned
+func SendSLAReminderMail(ctx context.Context, s *issues_model.IssueSLA) error {
+	if setting.MailService == nil {
+		// No mail service configured
+		return nil
+	}
+
+	issue := s.Issue
+	if err := issue.LoadRepo(ctx); err != nil {
+		return err
+	}
+	if err := issue.LoadAssignees(ctx); err != nil {
+		return err
+	}
+	recipients := issue.Assignees
+	if len(recipients) == 0 {
+		var err error
+		if recipients, err = repo_model.GetRepoAssignees(ctx, issue.Repo); err != nil {
+			return err
+		}
+	}
+
+	langMap := make(map[string][]*user_model.User)
+	for _, user := range recipients {
+		if !user.IsMailable() || user.EmailNotificationsPreference == user_model.EmailNotificationsDisabled {
+			continue
+		}
+		langMap[user.Language] = append(langMap[user.Language], user)
+	}
+
+	for lang, tos := range langMap {
+		if err := sendSLAReminderMailPerLang(ctx, lang, s, tos); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sendSLAReminderMailPerLang(ctx context.Context, lang string, s *issues_model.IssueSLA, tos []*user_model.User) error {
+	var (
+		locale  = translation.NewLocale(lang)
+		issue   = s.Issue
+		content bytes.Buffer
+	)
+
+	target := s.NextTarget()
+	subject := locale.TrString("mail.issue.sla."+string(target)+".subject", issue.Index, issue.Repo.FullName())
+	data := map[string]any{
+		"locale":   locale,
+		"Subject":  subject,
+		"Issue":    issue,
+		"Policy":   s.Policy,
+		"Target":   string(target),
+		"Due":      s.NextDue().AsTime().Format("2006-01-02 15:04 MST"),
+		"Link":     issue.HTMLURL(ctx),
+		"Language": locale.Language(),
+	}
+
+	if err := LoadedTemplates().BodyTemplates.ExecuteTemplate(&content, string(mailIssueSLAReminder), data); err != nil {
+		return err
+	}
+
+	for _, to := range tos {
+		msg := sender_service.NewMessage(to.EmailTo(), subject, content.String())
+		msg.Info = fmt.Sprintf("UID: %d, SLA reminder of issue %d", to.ID, issue.ID)
+
+		SendAsync(msg)
+	}
+	return nil
+}
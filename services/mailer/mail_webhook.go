@@ -0,0 +1,109 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+
+	"gitea.dev/models/organization"
+	"gitea.dev/models/perm"
+	access_model "gitea.dev/models/perm/access"
+	repo_model "gitea.dev/models/repo"
+	"gitea.dev/models/unit"
+	user_model "gitea.dev/models/user"
+	webhook_model "gitea.dev/models/webhook"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/templates"
+	"gitea.dev/modules/translation"
+	sender_service "gitea.dev/services/mailer/sender"
+)
+
+const mailWebhookDisabled templates.TplName = "repo/webhook_disabled"
+
+// getWebhookAdmins returns the users who can change a repository or owner webhook, its name and the link to its settings
+func getWebhookAdmins(ctx context.Context, w *webhook_model.Webhook) (admins []*user_model.User, target, link string, err error) {
+	if w.RepoID > 0 {
+		repo, err := repo_model.GetRepositoryByID(ctx, w.RepoID)
+		if err != nil {
+			return nil, "", "", err
+		}
+		admins, err = access_model.GetUsersWithUnitAccess(ctx, repo, perm.AccessModeAdmin, unit.TypeCode)
+		return admins, repo.FullName(), fmt.Sprintf("%s/settings/hooks/%d", repo.HTMLURL(ctx), w.ID), err
+	}
+
+	owner, err := user_model.GetUserByID(ctx, w.OwnerID)
+	if err != nil {
+		return nil, "", "", err
+	}
+	if !owner.IsOrganization() {
+		return []*user_model.User{owner}, owner.Name, fmt.Sprintf("%suser/settings/hooks/%d", setting.AppURL, w.ID), nil
+	}
+	team, err := organization.OrgFromUser(owner).GetOwnerTeam(ctx)
+	if err != nil {
+		return nil, "", "", err
+	}
+	if err := team.LoadMembers(ctx); err != nil {
+		return nil, "", "", err
+	}
+	return team.Members, owner.Name, fmt.Sprintf("%sorg/%s/settings/hooks/%d", setting.AppURL, url.PathEscape(owner.Name), w.ID), nil
+}
+
+// SendWebhookDisabledMail notifies the administrators of a repository or an owner that its webhook
+// has been deactivated after too many failed deliveries
+func SendWebhookDisabledMail(ctx context.Context, w *webhook_model.Webhook, failures int) error {
+	if setting.MailService == nil {
+		// No mail service configured
+		return nil
+	}
+
+	admins, target, link, err := getWebhookAdmins(ctx, w)
+	if err != nil {
+		return err
+	}
+
+	langMap := make(map[string][]*user_model.User)
+	for _, user := range admins {
+		if !user.IsMailable() {
+			continue
+		}
+		langMap[user.Language] = append(langMap[user.Language], user)
+	}
+
+	for lang, tos := range langMap {
+		if err := sendWebhookDisabledMailPerLang(lang, w, failures, target, link, tos); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sendWebhookDisabledMailPerLang(lang string, w *webhook_model.Webhook, failures int, target, link string, tos []*user_model.User) error {
+	locale := translation.NewLocale(lang)
+	subject := locale.TrString("mail.repo.webhook.disabled.subject", target)
+	data := map[string]any{
+		"locale":   locale,
+		"Subject":  subject,
+		"Target":   target,
+		"HookID":   w.ID,
+		"HookName": w.Name,
+		"Failures": failures,
+		"Link":     link,
+		"Language": locale.Language(),
+	}
+
+	var content bytes.Buffer
+	if err := LoadedTemplates().BodyTemplates.ExecuteTemplate(&content, string(mailWebhookDisabled), data); err != nil {
+		return err
+	}
+
+	for _, to := range tos {
+		msg := sender_service.NewMessage(to.EmailTo(), subject, content.String())
+		msg.Info = fmt.Sprintf("UID: %d, webhook %d disabled", to.ID, w.ID)
+		SendAsync(msg)
+	}
+	return nil
+}
@@ -174,6 +174,23 @@ func MailActionsTrigger(ctx context.Context, recipient *user_model.User, repo *r
 		return nil
 	}
 
+	// the global mail level has never applied to workflow runs, only the delivery of the event type does
+	prefs, err := user_model.GetEffectiveMailPreferences(ctx, []*user_model.User{recipient}, repo.OwnerID, repo.ID)
+	if err != nil {
+		return err
+	}
+	switch prefs[recipient.ID].Events[user_model.MailEventActions] {
+	case user_model.MailDeliveryOff:
+		return nil
+	case user_model.MailDeliveryDigest:
+		if run.Repo == nil {
+			run.Repo = repo
+		}
+		addToMailDigest(ctx, []*user_model.User{recipient}, repo.ID, 0, user_model.MailEventActions,
+			fmt.Sprintf("[%s] %s: %s", repo.FullName(), run.Title, run.Status.String()), run.HTMLURL(ctx), run.TriggerUserID)
+		return nil
+	}
+
 	log.Debug("MailActionsTrigger: Initiate email composition")
 	return composeAndSendActionsWorkflowRunStatusEmail(ctx, repo, run, recipient, []*user_model.User{recipient})
 }
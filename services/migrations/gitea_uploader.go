@@ -16,6 +16,7 @@ import (
 	"gitea.dev/models"
 	"gitea.dev/models/db"
 	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/organization"
 	repo_model "gitea.dev/models/repo"
 	user_model "gitea.dev/models/user"
 	"gitea.dev/modules/git"
@@ -47,6 +48,7 @@ type GiteaLocalUploader struct {
 	repo           *repo_model.Repository
 	labels         map[string]*issues_model.Label
 	milestones     map[string]int64
+	issueTypes     map[string]int64
 	issues         map[int64]*issues_model.Issue
 	gitRepo        *git.Repository
 	prHeadCache    map[string]string
@@ -64,6 +66,7 @@ func NewGiteaLocalUploader(_ context.Context, doer *user_model.User, repoOwner,
 		repoName:    repoName,
 		labels:      make(map[string]*issues_model.Label),
 		milestones:  make(map[string]int64),
+		issueTypes:  make(map[string]int64),
 		issues:      make(map[int64]*issues_model.Issue),
 		prHeadCache: make(map[string]string),
 		userMap:     make(map[int64]int64),
@@ -376,6 +379,59 @@ func (g *GiteaLocalUploader) SyncBranches(ctx context.Context) error {
 	return err
 }
 
+// getIssueTypeID returns the ID of the issue type of the organization owning the repository with the name of the migrated type.
+// The type is created if the organization doesn't have it and the doer is allowed to manage its types, otherwise the issue has no type.
+// Issue types are only available to the repositories of organizations.
+func (g *GiteaLocalUploader) getIssueTypeID(ctx context.Context, issueType *base.IssueType) (int64, error) {
+	if issueType == nil || issueType.Name == "" {
+		return 0, nil
+	}
+	key := strings.ToLower(issueType.Name)
+	if id, ok := g.issueTypes[key]; ok {
+		return id, nil
+	}
+
+	if err := g.repo.LoadOwner(ctx); err != nil {
+		return 0, err
+	}
+	if !g.repo.Owner.IsOrganization() {
+		g.issueTypes[key] = 0
+		return 0, nil
+	}
+
+	t, err := issues_model.GetIssueTypeByOrgIDAndName(ctx, g.repo.OwnerID, issueType.Name)
+	if issues_model.IsErrIssueTypeNotExist(err) {
+		canCreate := g.doer.IsAdmin
+		if !canCreate {
+			if canCreate, err = organization.IsOrganizationOwner(ctx, g.repo.OwnerID, g.doer.ID); err != nil {
+				return 0, err
+			}
+		}
+		if !canCreate {
+			log.Debug("Issue type %q doesn't exist in %s and %s can't create it", issueType.Name, g.repoOwner, g.doer.Name)
+			g.issueTypes[key] = 0
+			return 0, nil
+		}
+		t = &issues_model.IssueType{
+			OrgID:       g.repo.OwnerID,
+			Name:        issueType.Name,
+			Description: issueType.Description,
+			Color:       issueType.Color,
+		}
+		err = issues_model.NewIssueType(ctx, t)
+		if errors.Is(err, util.ErrInvalidArgument) {
+			log.Warn("Invalid issue type %q in %s/%s: %v", issueType.Name, g.repoOwner, g.repoName, err)
+			g.issueTypes[key] = 0
+			return 0, nil
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	g.issueTypes[key] = t.ID
+	return t.ID, nil
+}
+
 // CreateIssues creates issues
 func (g *GiteaLocalUploader) CreateIssues(ctx context.Context, issues ...*base.Issue) error {
 	iss := make([]*issues_model.Issue, 0, len(issues))
@@ -390,6 +446,11 @@ func (g *GiteaLocalUploader) CreateIssues(ctx context.Context, issues ...*base.I
 
 		milestoneID := g.milestones[issue.Milestone]
 
+		typeID, err := g.getIssueTypeID(ctx, issue.Type)
+		if err != nil {
+			return err
+		}
+
 		if issue.Created.IsZero() {
 			if issue.Closed != nil {
 				issue.Created = *issue.Closed
@@ -421,6 +482,7 @@ func (g *GiteaLocalUploader) CreateIssues(ctx context.Context, issues ...*base.I
 			IsClosed:    issue.State == "closed",
 			IsLocked:    issue.IsLocked,
 			MilestoneID: milestoneID,
+			TypeID:      typeID,
 			Labels:      labels,
 			CreatedUnix: timeutil.TimeStamp(issue.Created.Unix()),
 			UpdatedUnix: timeutil.TimeStamp(issue.Updated.Unix()),
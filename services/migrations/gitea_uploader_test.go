@@ -172,6 +172,40 @@ func TestGiteaUploadRemapLocalUser(t *testing.T) {
 	assert.Equal(t, user.ID, target.GetUserID())
 }
 
+func TestGiteaUploadIssueTypes(t *testing.T) {
+	unittest.PrepareTestEnv(t)
+	doer := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 1})
+	ctx := t.Context()
+
+	// the types are created in the organization owning the repository, or reused by name
+	uploader := NewGiteaLocalUploader(ctx, doer, "org3", "repo3")
+	uploader.repo = unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 3})
+	bug := &issues_model.IssueType{OrgID: 3, Name: "Bug"}
+	require.NoError(t, issues_model.NewIssueType(ctx, bug))
+
+	typeID, err := uploader.getIssueTypeID(ctx, &base.IssueType{Name: "bug"})
+	require.NoError(t, err)
+	assert.Equal(t, bug.ID, typeID)
+
+	typeID, err = uploader.getIssueTypeID(ctx, &base.IssueType{Name: "Feature", Color: convertGithubIssueTypeColor("BLUE")})
+	require.NoError(t, err)
+	feature := unittest.AssertExistsAndLoadBean(t, &issues_model.IssueType{ID: typeID})
+	assert.Equal(t, "Feature", feature.Name)
+	assert.Equal(t, "#0969da", feature.Color)
+
+	typeID, err = uploader.getIssueTypeID(ctx, nil)
+	require.NoError(t, err)
+	assert.Zero(t, typeID)
+
+	// the repositories of users don't have issue types
+	uploader = NewGiteaLocalUploader(ctx, doer, "user2", "repo1")
+	uploader.repo = unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 1})
+	typeID, err = uploader.getIssueTypeID(ctx, &base.IssueType{Name: "Bug"})
+	require.NoError(t, err)
+	assert.Zero(t, typeID)
+	unittest.AssertNotExistsBean(t, &issues_model.IssueType{OrgID: 2})
+}
+
 func TestGiteaUploadRemapExternalUser(t *testing.T) {
 	unittest.PrepareTestEnv(t)
 	doer := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 1})
@@ -273,6 +273,22 @@ func (g *GithubDownloaderV3) GetMilestones(ctx context.Context) ([]*base.Milesto
 	return milestones, nil
 }
 
+// githubIssueTypeColors maps the named colors of GitHub issue types to the colors of their labels
+var githubIssueTypeColors = map[string]string{
+	"gray":   "#59636e",
+	"blue":   "#0969da",
+	"green":  "#1a7f37",
+	"yellow": "#9a6700",
+	"orange": "#bc4c00",
+	"red":    "#d1242f",
+	"pink":   "#bf3989",
+	"purple": "#8250df",
+}
+
+func convertGithubIssueTypeColor(color string) string {
+	return githubIssueTypeColors[strings.ToLower(color)]
+}
+
 func convertGithubLabel(label *github.Label) *base.Label {
 	return &base.Label{
 		Name:        label.GetName(),
@@ -482,6 +498,15 @@ func (g *GithubDownloaderV3) GetIssues(ctx context.Context, page, perPage int) (
 			assignees = append(assignees, issue.Assignees[i].GetLogin())
 		}
 
+		var issueType *base.IssueType
+		if issue.Type != nil {
+			issueType = &base.IssueType{
+				Name:        issue.Type.GetName(),
+				Description: issue.Type.GetDescription(),
+				Color:       convertGithubIssueTypeColor(issue.Type.GetColor()),
+			}
+		}
+
 		allIssues = append(allIssues, &base.Issue{
 			Title:        *issue.Title,
 			Number:       int64(*issue.Number),
@@ -490,6 +515,7 @@ func (g *GithubDownloaderV3) GetIssues(ctx context.Context, page, perPage int) (
 			PosterEmail:  issue.GetUser().GetEmail(),
 			Content:      issue.GetBody(),
 			Milestone:    issue.GetMilestone().GetTitle(),
+			Type:         issueType,
 			State:        issue.GetState(),
 			Created:      issue.GetCreatedAt().Time,
 			Updated:      issue.GetUpdatedAt().Time,
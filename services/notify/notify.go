@@ -10,6 +10,7 @@ import (
 	git_model "gitea.dev/models/git"
 	issues_model "gitea.dev/models/issues"
 	packages_model "gitea.dev/models/packages"
+	project_model "gitea.dev/models/project"
 	repo_model "gitea.dev/models/repo"
 	user_model "gitea.dev/models/user"
 	"gitea.dev/modules/git"
@@ -216,6 +217,27 @@ func DeleteRelease(ctx context.Context, doer *user_model.User, rel *repo_model.R
 	}
 }
 
+// NewProjectColumn notifies new project column to notifiers
+func NewProjectColumn(ctx context.Context, doer *user_model.User, column *project_model.Column) {
+	for _, notifier := range notifiers {
+		notifier.NewProjectColumn(ctx, doer, column)
+	}
+}
+
+// UpdateProjectColumn notifies update project column to notifiers
+func UpdateProjectColumn(ctx context.Context, doer *user_model.User, column *project_model.Column) {
+	for _, notifier := range notifiers {
+		notifier.UpdateProjectColumn(ctx, doer, column)
+	}
+}
+
+// DeleteProjectColumn notifies delete project column to notifiers
+func DeleteProjectColumn(ctx context.Context, doer *user_model.User, column *project_model.Column) {
+	for _, notifier := range notifiers {
+		notifier.DeleteProjectColumn(ctx, doer, column)
+	}
+}
+
 // IssueChangeMilestone notifies change milestone to notifiers
 func IssueChangeMilestone(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, oldMilestoneID int64) {
 	for _, notifier := range notifiers {
@@ -274,6 +296,13 @@ func IssueChangeLabels(ctx context.Context, doer *user_model.User, issue *issues
 	}
 }
 
+// IssueChangeProjectColumn notifies an issue moved to another column of a project to notifiers
+func IssueChangeProjectColumn(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, column *project_model.Column, oldColumnID int64) {
+	for _, notifier := range notifiers {
+		notifier.IssueChangeProjectColumn(ctx, doer, issue, column, oldColumnID)
+	}
+}
+
 // CreateRepository notifies create repository to notifiers
 func CreateRepository(ctx context.Context, doer, u *user_model.User, repo *repo_model.Repository) {
 	for _, notifier := range notifiers {
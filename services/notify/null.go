@@ -10,6 +10,7 @@ import (
 	git_model "gitea.dev/models/git"
 	issues_model "gitea.dev/models/issues"
 	packages_model "gitea.dev/models/packages"
+	project_model "gitea.dev/models/project"
 	repo_model "gitea.dev/models/repo"
 	user_model "gitea.dev/models/user"
 	"gitea.dev/modules/git"
@@ -110,6 +111,18 @@ func (*NullNotifier) UpdateRelease(ctx context.Context, doer *user_model.User, r
 func (*NullNotifier) DeleteRelease(ctx context.Context, doer *user_model.User, rel *repo_model.Release) {
 }
 
+// NewProjectColumn places a place holder function
+func (*NullNotifier) NewProjectColumn(ctx context.Context, doer *user_model.User, column *project_model.Column) {
+}
+
+// UpdateProjectColumn places a place holder function
+func (*NullNotifier) UpdateProjectColumn(ctx context.Context, doer *user_model.User, column *project_model.Column) {
+}
+
+// DeleteProjectColumn places a place holder function
+func (*NullNotifier) DeleteProjectColumn(ctx context.Context, doer *user_model.User, column *project_model.Column) {
+}
+
 // IssueChangeMilestone places a place holder function
 func (*NullNotifier) IssueChangeMilestone(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, oldMilestoneID int64) {
 }
@@ -143,6 +156,10 @@ func (*NullNotifier) IssueChangeLabels(ctx context.Context, doer *user_model.Use
 	addedLabels, removedLabels []*issues_model.Label) {
 }
 
+// IssueChangeProjectColumn places a place holder function
+func (*NullNotifier) IssueChangeProjectColumn(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, column *project_model.Column, oldColumnID int64) {
+}
+
 // CreateRepository places a place holder function
 func (*NullNotifier) CreateRepository(ctx context.Context, doer, u *user_model.User, repo *repo_model.Repository) {
 }
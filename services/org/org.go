@@ -10,6 +10,7 @@ import (
 	actions_model "gitea.dev/models/actions"
 	activities_model "gitea.dev/models/activities"
 	"gitea.dev/models/db"
+	issues_model "gitea.dev/models/issues"
 	org_model "gitea.dev/models/organization"
 	packages_model "gitea.dev/models/packages"
 	access_model "gitea.dev/models/perm/access"
@@ -40,10 +41,28 @@ func deleteOrganization(ctx context.Context, org *org_model.Organization) error
 		&actions_model.ActionRunner{OwnerID: org.ID},
 		&actions_model.ActionRunnerToken{OwnerID: org.ID},
 		&actions_model.ActionScopedWorkflowSource{OwnerID: org.ID},
+		&user_model.MailPreference{OwnerID: org.ID},
+		&issues_model.ReviewReminder{OwnerID: org.ID},
 	); err != nil {
 		return fmt.Errorf("DeleteBeans: %w", err)
 	}
 
+	if err := issues_model.DeleteSavedSearchesByOwner(ctx, org.ID); err != nil {
+		return err
+	}
+
+	if err := issues_model.DeleteCustomFieldsByScope(ctx, org.ID, 0); err != nil {
+		return err
+	}
+
+	if err := issues_model.DeleteSLAPoliciesByScope(ctx, org.ID, 0); err != nil {
+		return err
+	}
+
+	if err := issues_model.DeleteIssueTypesByOrgID(ctx, org.ID); err != nil {
+		return err
+	}
+
 	if _, err := db.GetEngine(ctx).ID(org.ID).Delete(new(user_model.User)); err != nil {
 		return fmt.Errorf("Delete: %w", err)
 	}
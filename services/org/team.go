@@ -192,6 +192,7 @@ func DeleteTeam(ctx context.Context, t *organization.Team) error {
 			&organization.TeamUnit{TeamID: t.ID},
 			&organization.TeamInvite{TeamID: t.ID},
 			&issues_model.Review{Type: issues_model.ReviewTypeRequest, ReviewerTeamID: t.ID}, // batch delete the binding relationship between team and PR (request review from team)
+			&issues_model.ReviewReminder{TeamID: t.ID},
 		); err != nil {
 			return err
 		}
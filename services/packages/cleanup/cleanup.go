@@ -6,6 +6,7 @@ package container
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"gitea.dev/models/db"
@@ -32,7 +33,9 @@ func CleanupTask(ctx context.Context, olderThan time.Duration) error {
 	return CleanupExpiredData(ctx, olderThan)
 }
 
-func executeCleanupOneRulePackage(ctx context.Context, pcr *packages_model.PackageCleanupRule, p *packages_model.Package) (versionDeleted bool, err error) {
+// getVersionsToRemove returns the versions of the package which are removed by the rule.
+// Versions protected by a package protection rule are never returned.
+func getVersionsToRemove(ctx context.Context, pcr *packages_model.PackageCleanupRule, p *packages_model.Package) ([]*packages_model.PackageVersion, error) {
 	olderThan := time.Now().AddDate(0, 0, -pcr.RemoveDays)
 	pvs, _, err := packages_model.SearchVersions(ctx, &packages_model.PackageSearchOptions{
 		PackageID:  p.ID,
@@ -40,7 +43,7 @@ func executeCleanupOneRulePackage(ctx context.Context, pcr *packages_model.Packa
 		Sort:       packages_model.SortCreatedDesc,
 	})
 	if err != nil {
-		return false, fmt.Errorf("CleanupRule [%d]: SearchVersions failed: %w", pcr.ID, err)
+		return nil, fmt.Errorf("CleanupRule [%d]: SearchVersions failed: %w", pcr.ID, err)
 	}
 	if pcr.KeepCount > 0 {
 		if pcr.KeepCount < len(pvs) {
@@ -49,10 +52,15 @@ func executeCleanupOneRulePackage(ctx context.Context, pcr *packages_model.Packa
 			pvs = nil
 		}
 	}
+	pprs, err := packages_model.GetProtectionRulesForPackage(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf("CleanupRule [%d]: GetProtectionRulesForPackage failed: %w", pcr.ID, err)
+	}
+	versionsToRemove := make([]*packages_model.PackageVersion, 0, len(pvs))
 	for _, pv := range pvs {
 		if pcr.Type == packages_model.TypeContainer {
 			if skip, err := container_service.ShouldBeSkipped(ctx, pcr, p, pv); err != nil {
-				return false, fmt.Errorf("CleanupRule [%d]: container.ShouldBeSkipped failed: %w", pcr.ID, err)
+				return nil, fmt.Errorf("CleanupRule [%d]: container.ShouldBeSkipped failed: %w", pcr.ID, err)
 			} else if skip {
 				log.Debug("Rule[%d]: keep '%s/%s' (container)", pcr.ID, p.Name, pv.Version)
 				continue
@@ -74,14 +82,99 @@ func executeCleanupOneRulePackage(ctx context.Context, pcr *packages_model.Packa
 			log.Debug("Rule[%d]: keep '%s/%s' (remove pattern)", pcr.ID, p.Name, pv.Version)
 			continue
 		}
+		if slices.ContainsFunc(pprs, func(ppr *packages_model.PackageProtectionRule) bool { return ppr.IsVersionProtected(p, pv) }) {
+			log.Debug("Rule[%d]: keep '%s/%s' (protected)", pcr.ID, p.Name, pv.Version)
+			continue
+		}
+		if inSnapshot, err := packages_model.IsVersionInSnapshot(ctx, pv.ID); err != nil {
+			return nil, fmt.Errorf("CleanupRule [%d]: IsVersionInSnapshot failed: %w", pcr.ID, err)
+		} else if inSnapshot {
+			log.Debug("Rule[%d]: keep '%s/%s' (snapshot)", pcr.ID, p.Name, pv.Version)
+			continue
+		}
+		versionsToRemove = append(versionsToRemove, pv)
+	}
+	return versionsToRemove, nil
+}
+
+// executeCleanupOneRulePackage removes the versions of the package matched by the rule and returns the descriptors
+// of the removed versions, which are loaded before the removal
+func executeCleanupOneRulePackage(ctx context.Context, pcr *packages_model.PackageCleanupRule, p *packages_model.Package) ([]*packages_model.PackageDescriptor, error) {
+	pvs, err := getVersionsToRemove(ctx, pcr, p)
+	if err != nil {
+		return nil, err
+	}
+	removed := make([]*packages_model.PackageDescriptor, 0, len(pvs))
+	for _, pv := range pvs {
+		pd, err := packages_model.GetPackageDescriptor(ctx, pv)
+		if err != nil {
+			log.Error("CleanupRule [%d]: GetPackageDescriptor failed: %v", pcr.ID, err)
+			continue
+		}
 		log.Debug("Rule[%d]: remove '%s/%s'", pcr.ID, p.Name, pv.Version)
 		if err := packages_service.DeletePackageVersionAndReferences(ctx, pv); err != nil {
 			log.Error("CleanupRule [%d]: DeletePackageVersionAndReferences failed: %v", pcr.ID, err)
 			continue
 		}
-		versionDeleted = true
+		removed = append(removed, pd)
+	}
+	return removed, nil
+}
+
+// PreviewCleanupRule returns the package versions which would be removed if the rule gets executed
+func PreviewCleanupRule(ctx context.Context, pcr *packages_model.PackageCleanupRule) ([]*packages_model.PackageDescriptor, error) {
+	if err := pcr.CompiledPattern(); err != nil {
+		return nil, fmt.Errorf("CleanupRule [%d]: CompilePattern failed: %w", pcr.ID, err)
+	}
+
+	packages, err := packages_model.GetPackagesByType(ctx, pcr.OwnerID, pcr.Type)
+	if err != nil {
+		return nil, fmt.Errorf("CleanupRule [%d]: GetPackagesByType failed: %w", pcr.ID, err)
+	}
+
+	pds := make([]*packages_model.PackageDescriptor, 0, 10)
+	for _, p := range packages {
+		pvs, err := getVersionsToRemove(ctx, pcr, p)
+		if err != nil {
+			return nil, err
+		}
+		for _, pv := range pvs {
+			pd, err := packages_model.GetPackageDescriptor(ctx, pv)
+			if err != nil {
+				return nil, err
+			}
+			pds = append(pds, pd)
+		}
+	}
+	return pds, nil
+}
+
+// PreviewCleanupRules returns the package versions of the owner which would be removed by ExecuteCleanupRules.
+// A version matched by several rules is returned only once.
+func PreviewCleanupRules(ctx context.Context, ownerID int64) ([]*packages_model.PackageDescriptor, error) {
+	pcrs, err := packages_model.GetCleanupRulesByOwner(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	pds := make([]*packages_model.PackageDescriptor, 0, 10)
+	seen := make(map[int64]bool)
+	for _, pcr := range pcrs {
+		if !pcr.Enabled {
+			continue
+		}
+		rulePds, err := PreviewCleanupRule(ctx, pcr)
+		if err != nil {
+			return nil, err
+		}
+		for _, pd := range rulePds {
+			if !seen[pd.Version.ID] {
+				seen[pd.Version.ID] = true
+				pds = append(pds, pd)
+			}
+		}
 	}
-	return versionDeleted, nil
+	return pds, nil
 }
 
 func executeCleanupOneRule(ctx context.Context, pcr *packages_model.PackageCleanupRule) error {
@@ -94,24 +187,31 @@ func executeCleanupOneRule(ctx context.Context, pcr *packages_model.PackageClean
 		return fmt.Errorf("CleanupRule [%d]: GetPackagesByType failed: %w", pcr.ID, err)
 	}
 
+	// the removals are attributed to the owner of the rule
+	owner, err := user_model.GetUserByID(ctx, pcr.OwnerID)
+	if err != nil {
+		return fmt.Errorf("GetUserByID failed: %w", err)
+	}
+
 	anyVersionDeleted := false
 	for _, p := range packages {
-		versionDeleted := false
+		var removed []*packages_model.PackageDescriptor
 		err = db.WithTx(ctx, func(ctx context.Context) (err error) {
-			versionDeleted, err = executeCleanupOneRulePackage(ctx, pcr, p)
+			removed, err = executeCleanupOneRulePackage(ctx, pcr, p)
 			return err
 		})
 		if err != nil {
 			log.Error("CleanupRule [%d]: executeCleanupOneRulePackage(%d) failed: %v", pcr.ID, p.ID, err)
 			continue
 		}
+		// the removals are only audited, a cleanup doesn't trigger webhooks and notifications for every pruned version
+		for _, pd := range removed {
+			packages_service.RecordAuditEvent(ctx, owner, pd, packages_model.PackageAuditActionDelete)
+		}
+		versionDeleted := len(removed) > 0
 		anyVersionDeleted = anyVersionDeleted || versionDeleted
 		if versionDeleted {
 			if pcr.Type == packages_model.TypeCargo {
-				owner, err := user_model.GetUserByID(ctx, pcr.OwnerID)
-				if err != nil {
-					return fmt.Errorf("GetUserByID failed: %w", err)
-				}
 				if err := cargo_service.UpdatePackageIndexIfExists(ctx, owner, owner, p.ID); err != nil {
 					return fmt.Errorf("CleanupRule [%d]: cargo.UpdatePackageIndexIfExists failed: %w", pcr.ID, err)
 				}
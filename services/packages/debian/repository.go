@@ -165,7 +165,17 @@ func buildRepositoryFiles(ctx context.Context, ownerID int64, repoVersion *packa
 		return err
 	}
 
-	return buildReleaseFiles(ctx, ownerID, repoVersion, distribution)
+	components, err := debian_model.GetComponents(ctx, ownerID, distribution)
+	if err != nil {
+		return err
+	}
+
+	architectures, err := debian_model.GetArchitectures(ctx, ownerID, distribution)
+	if err != nil {
+		return err
+	}
+
+	return buildReleaseFiles(ctx, ownerID, repoVersion, distribution, components, architectures)
 }
 
 // https://wiki.debian.org/DebianRepository/Format#A.22Packages.22_Indices
@@ -273,7 +283,7 @@ func buildPackagesIndices(ctx context.Context, ownerID int64, repoVersion *packa
 }
 
 // https://wiki.debian.org/DebianRepository/Format#A.22Release.22_files
-func buildReleaseFiles(ctx context.Context, ownerID int64, repoVersion *packages_model.PackageVersion, distribution string) error {
+func buildReleaseFiles(ctx context.Context, ownerID int64, repoVersion *packages_model.PackageVersion, distribution string, components, architectures []string) error {
 	pfs, _, err := packages_model.SearchFiles(ctx, &packages_model.PackageFileSearchOptions{
 		VersionID: repoVersion.ID,
 		Properties: map[string]string{
@@ -303,18 +313,7 @@ func buildReleaseFiles(ctx context.Context, ownerID int64, repoVersion *packages
 		return nil
 	}
 
-	components, err := debian_model.GetComponents(ctx, ownerID, distribution)
-	if err != nil {
-		return err
-	}
-
 	sort.Strings(components)
-
-	architectures, err := debian_model.GetArchitectures(ctx, ownerID, distribution)
-	if err != nil {
-		return err
-	}
-
 	sort.Strings(architectures)
 
 	priv, _, err := GetOrCreateKeyPair(ctx, ownerID)
@@ -0,0 +1,148 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package debian
+
+import (
+	"context"
+	"strings"
+
+	"gitea.dev/models/db"
+	packages_model "gitea.dev/models/packages"
+	debian_model "gitea.dev/models/packages/debian"
+	"gitea.dev/modules/container"
+	debian_module "gitea.dev/modules/packages/debian"
+	"gitea.dev/modules/util"
+	packages_service "gitea.dev/services/packages"
+)
+
+var (
+	ErrInvalidDistributionOrComponent = util.NewInvalidArgumentErrorf("invalid distribution or component")
+	ErrVersionNotInDistribution       = util.NewNotExistErrorf("package version is not contained in the distribution")
+)
+
+// CreateSnapshot creates an immutable snapshot of the distribution.
+// If component is not empty only the packages of this component are included.
+func CreateSnapshot(ctx context.Context, ownerID, creatorID int64, name, distribution, component string) (*packages_model.PackageSnapshot, error) {
+	if !debian_module.IsValidDistributionOrComponent(distribution) || (component != "" && !debian_module.IsValidDistributionOrComponent(component)) {
+		return nil, ErrInvalidDistributionOrComponent
+	}
+
+	ps := &packages_model.PackageSnapshot{
+		OwnerID:      ownerID,
+		Type:         packages_model.TypeDebian,
+		Name:         name,
+		Distribution: distribution,
+		Component:    component,
+		CreatorID:    creatorID,
+	}
+
+	err := db.WithTx(ctx, func(ctx context.Context) error {
+		pfds, err := debian_model.SearchPackages(ctx, &debian_model.PackageSearchOptions{
+			OwnerID:      ownerID,
+			Distribution: distribution,
+			Component:    component,
+		})
+		if err != nil {
+			return err
+		}
+
+		pfs := make([]*packages_model.PackageFile, 0, len(pfds))
+		components := make(container.Set[string])
+		architectures := make(container.Set[string])
+		for _, pfd := range pfds {
+			pfs = append(pfs, pfd.File)
+			components.Add(pfd.Properties.GetByName(debian_module.PropertyComponent))
+			architectures.Add(pfd.Properties.GetByName(debian_module.PropertyArchitecture))
+		}
+
+		return packages_service.CreateSnapshot(ctx, ps, debian_module.RepositoryPackage, pfs, func(ctx context.Context, pv *packages_model.PackageVersion) error {
+			for c := range components {
+				for a := range architectures {
+					if err := buildPackagesIndices(ctx, ownerID, pv, distribution, c, a); err != nil {
+						return err
+					}
+				}
+			}
+			return buildReleaseFiles(ctx, ownerID, pv, distribution, components.Values(), architectures.Values())
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+// DeleteSnapshot deletes the snapshot and its repository files
+func DeleteSnapshot(ctx context.Context, ps *packages_model.PackageSnapshot) error {
+	return packages_service.DeleteSnapshot(ctx, ps, debian_module.RepositoryPackage)
+}
+
+// GetSnapshotRepositoryVersion gets the internal package version which stores the repository files of the snapshot
+func GetSnapshotRepositoryVersion(ctx context.Context, ps *packages_model.PackageSnapshot) (*packages_model.PackageVersion, error) {
+	return packages_model.GetInternalVersionByNameAndVersion(ctx, ps.OwnerID, packages_model.TypeDebian, debian_module.RepositoryPackage, ps.InternalVersion())
+}
+
+// PromotePackageVersions copies the package files of the versions from one distribution to another one and
+// rebuilds the (re-signed) repository files of the target distribution.
+// If component is not empty only the files of this component are promoted.
+func PromotePackageVersions(ctx context.Context, ownerID int64, pvs []*packages_model.PackageVersion, from, to, component string) error {
+	if !debian_module.IsValidDistributionOrComponent(from) || !debian_module.IsValidDistributionOrComponent(to) ||
+		(component != "" && !debian_module.IsValidDistributionOrComponent(component)) {
+		return ErrInvalidDistributionOrComponent
+	}
+
+	type target struct {
+		Component    string
+		Architecture string
+	}
+	targets := make(container.Set[target])
+
+	err := db.WithTx(ctx, func(ctx context.Context) error {
+		for _, pv := range pvs {
+			pfs, err := packages_model.GetFilesByVersionID(ctx, pv.ID)
+			if err != nil {
+				return err
+			}
+
+			promoted := false
+			for _, pf := range pfs {
+				dist, comp, _ := strings.Cut(pf.CompositeKey, "|")
+				if dist != from || (component != "" && comp != component) {
+					continue
+				}
+
+				pps, err := packages_model.GetPropertiesByName(ctx, packages_model.PropertyTypeFile, pf.ID, debian_module.PropertyArchitecture)
+				if err != nil {
+					return err
+				}
+				if len(pps) != 1 {
+					continue
+				}
+
+				if _, err := packages_service.CopyPackageFile(ctx, pf, to+"|"+comp, map[string]string{
+					debian_module.PropertyDistribution: to,
+				}); err != nil {
+					return err
+				}
+
+				targets.Add(target{comp, pps[0].Value})
+				promoted = true
+			}
+			if !promoted {
+				return ErrVersionNotInDistribution
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for t := range targets {
+		if err := BuildSpecificRepositoryFiles(ctx, ownerID, to, t.Component, t.Architecture); err != nil {
+			return err
+		}
+	}
+	return nil
+}
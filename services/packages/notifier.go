@@ -0,0 +1,65 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package packages
+
+import (
+	"context"
+
+	packages_model "gitea.dev/models/packages"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/reqctx"
+	notify_service "gitea.dev/services/notify"
+)
+
+func init() {
+	notify_service.RegisterNotifier(&auditNotifier{})
+}
+
+// auditNotifier records the package audit trail
+type auditNotifier struct {
+	notify_service.NullNotifier
+}
+
+var _ notify_service.Notifier = &auditNotifier{}
+
+func (n *auditNotifier) PackageCreate(ctx context.Context, doer *user_model.User, pd *packages_model.PackageDescriptor) {
+	RecordAuditEvent(ctx, doer, pd, packages_model.PackageAuditActionPublish)
+}
+
+func (n *auditNotifier) PackageDelete(ctx context.Context, doer *user_model.User, pd *packages_model.PackageDescriptor) {
+	RecordAuditEvent(ctx, doer, pd, packages_model.PackageAuditActionDelete)
+}
+
+// RecordAuditEvent records an event in the package audit trail, without notifying it.
+// It is used directly by the changes which don't trigger webhooks and notifications, like the cleanup rule removals.
+func RecordAuditEvent(ctx context.Context, doer *user_model.User, pd *packages_model.PackageDescriptor, action packages_model.PackageAuditAction) {
+	if pd.Version.IsInternal {
+		return
+	}
+
+	pae := &packages_model.PackageAuditEvent{
+		OwnerID:      pd.Owner.ID,
+		PackageID:    pd.Package.ID,
+		VersionID:    pd.Version.ID,
+		Type:         pd.Package.Type,
+		Name:         pd.Package.Name,
+		LowerName:    pd.Package.LowerName,
+		Version:      pd.Version.Version,
+		LowerVersion: pd.Version.LowerVersion,
+		Action:       action,
+	}
+	if doer != nil {
+		pae.DoerID = doer.ID
+		pae.ActionsTaskID, _ = user_model.GetActionsUserTaskID(doer)
+	}
+	// the access token is only known if the event was triggered by a request authenticated with one
+	if ds := reqctx.GetRequestDataStore(ctx); ds != nil {
+		pae.AccessTokenID, _ = ds.GetData()["ApiTokenID"].(int64)
+	}
+
+	if err := packages_model.InsertAuditEvent(ctx, pae); err != nil {
+		log.Error("Error inserting package audit event: %v", err)
+	}
+}
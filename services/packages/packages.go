@@ -24,6 +24,7 @@ import (
 	packages_module "gitea.dev/modules/packages"
 	"gitea.dev/modules/setting"
 	"gitea.dev/modules/storage"
+	"gitea.dev/modules/util"
 	notify_service "gitea.dev/services/notify"
 )
 
@@ -31,6 +32,8 @@ var (
 	ErrQuotaTypeSize   = errors.New("maximum allowed package type size exceeded")
 	ErrQuotaTotalSize  = errors.New("maximum allowed package storage quota exceeded")
 	ErrQuotaTotalCount = errors.New("maximum allowed package count exceeded")
+
+	ErrPackageVersionProtected = util.NewPermissionDeniedErrorf("package version is protected and can not be overwritten or deleted")
 )
 
 type Specialization interface {
@@ -297,6 +300,10 @@ func addFileToPackageVersionUnchecked(ctx context.Context, pv *packages_model.Pa
 				return pf, pb, !exists, nil
 			}
 
+			if err := CheckPackageVersionNotProtected(ctx, pv); err != nil {
+				return nil, pb, !exists, err
+			}
+
 			if err := packages_model.DeleteAllProperties(ctx, packages_model.PropertyTypeFile, pf.ID); err != nil {
 				return nil, pb, !exists, err
 			}
@@ -408,6 +415,10 @@ func CheckSizeQuotaExceeded(ctx context.Context, doer, owner *user_model.User, p
 		typeSpecificSize = setting.Packages.LimitSizeSwift
 	case packages_model.TypeTerraformState:
 		typeSpecificSize = setting.Packages.LimitSizeTerraformState
+	case packages_model.TypeTerraformModule:
+		typeSpecificSize = setting.Packages.LimitSizeTerraformModule
+	case packages_model.TypeTerraformProvider:
+		typeSpecificSize = setting.Packages.LimitSizeTerraformProvider
 	case packages_model.TypeVagrant:
 		typeSpecificSize = setting.Packages.LimitSizeVagrant
 	}
@@ -487,6 +498,9 @@ func RemovePackageVersion(ctx context.Context, doer *user_model.User, pv *packag
 	if err != nil {
 		return err
 	}
+	if err := checkPackageVersionNotProtected(ctx, pd.Package, pv); err != nil {
+		return wrapPackageVersionProtectedError(err)
+	}
 	if err := GetSpecManager().Get(pd.Package.Type).OnBeforeRemovePackageVersion(ctx, doer, pd); err != nil {
 		return err
 	}
@@ -509,6 +523,14 @@ func RemovePackageFileAndVersionIfUnreferenced(ctx context.Context, doer *user_m
 	var pd *packages_model.PackageDescriptor
 
 	if err := db.WithTx(ctx, func(ctx context.Context) error {
+		pv, err := packages_model.GetVersionByID(ctx, pf.VersionID)
+		if err != nil {
+			return err
+		}
+		if err := CheckPackageVersionNotProtected(ctx, pv); err != nil {
+			return err
+		}
+
 		if err := DeletePackageFile(ctx, pf); err != nil {
 			return err
 		}
@@ -518,11 +540,6 @@ func RemovePackageFileAndVersionIfUnreferenced(ctx context.Context, doer *user_m
 			return err
 		}
 		if !has {
-			pv, err := packages_model.GetVersionByID(ctx, pf.VersionID)
-			if err != nil {
-				return err
-			}
-
 			pd, err = packages_model.GetPackageDescriptor(ctx, pv)
 			if err != nil {
 				return err
@@ -545,6 +562,58 @@ func RemovePackageFileAndVersionIfUnreferenced(ctx context.Context, doer *user_m
 	return nil
 }
 
+// CheckPackageVersionNotProtected returns ErrPackageVersionProtected if a protection rule of the owner forbids
+// to overwrite or delete the package version or if the version is contained in a repository snapshot.
+// Internal package versions are never protected.
+func CheckPackageVersionNotProtected(ctx context.Context, pv *packages_model.PackageVersion) error {
+	if pv.IsInternal {
+		return nil
+	}
+	p, err := packages_model.GetPackageByID(ctx, pv.PackageID)
+	if err != nil {
+		return err
+	}
+	return checkPackageVersionNotProtected(ctx, p, pv)
+}
+
+// CheckPackageVersionsNotProtected returns ErrPackageVersionProtected if any of the package versions is protected.
+// It has to be called before several versions are deleted, so that none of them is deleted if one is protected.
+func CheckPackageVersionsNotProtected(ctx context.Context, pvs []*packages_model.PackageVersion) error {
+	for _, pv := range pvs {
+		if err := CheckPackageVersionNotProtected(ctx, pv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkPackageVersionNotProtected(ctx context.Context, p *packages_model.Package, pv *packages_model.PackageVersion) error {
+	protected, err := packages_model.IsPackageVersionProtected(ctx, p, pv)
+	if err != nil {
+		return err
+	}
+	if protected {
+		return ErrPackageVersionProtected
+	}
+
+	inSnapshot, err := packages_model.IsVersionInSnapshot(ctx, pv.ID)
+	if err != nil {
+		return err
+	}
+	if inSnapshot {
+		return ErrPackageVersionProtected
+	}
+	return nil
+}
+
+// wrapPackageVersionProtectedError makes ErrPackageVersionProtected translatable for the web UI
+func wrapPackageVersionProtectedError(err error) error {
+	if errors.Is(err, ErrPackageVersionProtected) {
+		return util.ErrorWrapTranslatable(err, "packages.settings.delete.error.protected")
+	}
+	return err
+}
+
 // DeletePackageVersionAndReferences deletes the package version and its properties and files
 func DeletePackageVersionAndReferences(ctx context.Context, pv *packages_model.PackageVersion) error {
 	if err := packages_model.DeleteAllProperties(ctx, packages_model.PropertyTypeVersion, pv.ID); err != nil {
@@ -556,6 +625,12 @@ func DeletePackageVersionAndReferences(ctx context.Context, pv *packages_model.P
 	if err := packages_model.DeleteFilesByVersionID(ctx, pv.ID); err != nil {
 		return err
 	}
+	if err := packages_model.DeleteDownloadStatsByVersionID(ctx, pv.ID); err != nil {
+		return err
+	}
+	if err := packages_model.DeleteVulnerabilitiesByVersionID(ctx, pv.ID); err != nil {
+		return err
+	}
 
 	return packages_model.DeleteVersionByID(ctx, pv.ID)
 }
@@ -638,6 +713,9 @@ func OpenBlobForDownload(ctx context.Context, pf *packages_model.PackageFile, pb
 		if err := packages_model.IncrementDownloadCounter(ctx, pf.VersionID); err != nil {
 			log.Error("Error incrementing download counter: %v", err)
 		}
+		if err := packages_model.IncrementDownloadStat(ctx, pf.VersionID); err != nil {
+			log.Error("Error incrementing download stat: %v", err)
+		}
 	}
 	return s, u, pf, nil
 }
@@ -648,6 +726,11 @@ func RemovePackage(ctx context.Context, doer *user_model.User, p *packages_model
 	if err != nil {
 		return err
 	}
+	for _, pd := range pds {
+		if err := checkPackageVersionNotProtected(ctx, p, pd.Version); err != nil {
+			return wrapPackageVersionProtectedError(err)
+		}
+	}
 	if err := GetSpecManager().Get(p.Type).OnBeforeRemovePackageAll(ctx, doer, p, pds); err != nil {
 		return err
 	}
@@ -670,6 +753,14 @@ func RemovePackage(ctx context.Context, doer *user_model.User, p *packages_model
 		if err != nil {
 			return err
 		}
+		err = packages_model.DeleteDownloadStatsByPackageID(ctx, p.ID)
+		if err != nil {
+			return err
+		}
+		err = packages_model.DeleteVulnerabilitiesByPackageID(ctx, p.ID)
+		if err != nil {
+			return err
+		}
 		err = packages_model.DeleteVersionsByPackageID(ctx, p.ID)
 		if err != nil {
 			return err
@@ -166,12 +166,7 @@ func BuildSpecificRepositoryFiles(ctx context.Context, ownerID int64, group stri
 		return err
 	}
 
-	pfs, _, err := packages_model.SearchFiles(ctx, &packages_model.PackageFileSearchOptions{
-		OwnerID:      ownerID,
-		PackageType:  packages_model.TypeRpm,
-		Query:        "%.rpm",
-		CompositeKey: group,
-	})
+	pfs, err := getGroupPackageFiles(ctx, ownerID, group)
 	if err != nil {
 		return err
 	}
@@ -191,6 +186,21 @@ func BuildSpecificRepositoryFiles(ctx context.Context, ownerID int64, group stri
 		return nil
 	}
 
+	return buildRepositoryFiles(ctx, ownerID, pv, pfs, group)
+}
+
+// getGroupPackageFiles gets the RPM files of all packages in the group
+func getGroupPackageFiles(ctx context.Context, ownerID int64, group string) ([]*packages_model.PackageFile, error) {
+	pfs, _, err := packages_model.SearchFiles(ctx, &packages_model.PackageFileSearchOptions{
+		OwnerID:      ownerID,
+		PackageType:  packages_model.TypeRpm,
+		Query:        "%.rpm",
+		CompositeKey: group,
+	})
+	return pfs, err
+}
+
+func buildRepositoryFiles(ctx context.Context, ownerID int64, pv *packages_model.PackageVersion, pfs []*packages_model.PackageFile, group string) error {
 	// Cache data needed for all repository files
 	cache := make(packageCache)
 	for _, pf := range pfs {
@@ -0,0 +1,95 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package rpm
+
+import (
+	"context"
+
+	"gitea.dev/models/db"
+	packages_model "gitea.dev/models/packages"
+	rpm_module "gitea.dev/modules/packages/rpm"
+	"gitea.dev/modules/util"
+	packages_service "gitea.dev/services/packages"
+)
+
+var (
+	ErrVersionNotInGroup = util.NewNotExistErrorf("package version is not contained in the group")
+	ErrInvalidGroup      = util.NewInvalidArgumentErrorf("invalid group")
+)
+
+// CreateSnapshot creates an immutable snapshot of the group
+func CreateSnapshot(ctx context.Context, ownerID, creatorID int64, name, group string) (*packages_model.PackageSnapshot, error) {
+	ps := &packages_model.PackageSnapshot{
+		OwnerID:   ownerID,
+		Type:      packages_model.TypeRpm,
+		Name:      name,
+		GroupName: group,
+		CreatorID: creatorID,
+	}
+
+	err := db.WithTx(ctx, func(ctx context.Context) error {
+		pfs, err := getGroupPackageFiles(ctx, ownerID, group)
+		if err != nil {
+			return err
+		}
+
+		return packages_service.CreateSnapshot(ctx, ps, rpm_module.RepositoryPackage, pfs, func(ctx context.Context, pv *packages_model.PackageVersion) error {
+			return buildRepositoryFiles(ctx, ownerID, pv, pfs, group)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+// DeleteSnapshot deletes the snapshot and its repository files
+func DeleteSnapshot(ctx context.Context, ps *packages_model.PackageSnapshot) error {
+	return packages_service.DeleteSnapshot(ctx, ps, rpm_module.RepositoryPackage)
+}
+
+// GetSnapshotRepositoryVersion gets the internal package version which stores the repository files of the snapshot
+func GetSnapshotRepositoryVersion(ctx context.Context, ps *packages_model.PackageSnapshot) (*packages_model.PackageVersion, error) {
+	return packages_model.GetInternalVersionByNameAndVersion(ctx, ps.OwnerID, packages_model.TypeRpm, rpm_module.RepositoryPackage, ps.InternalVersion())
+}
+
+// PromotePackageVersions copies the package files of the versions from one group to another one and
+// rebuilds the (re-signed) repository files of the target group
+func PromotePackageVersions(ctx context.Context, ownerID int64, pvs []*packages_model.PackageVersion, from, to string) error {
+	if !rpm_module.IsValidGroup(from) || !rpm_module.IsValidGroup(to) {
+		return ErrInvalidGroup
+	}
+
+	err := db.WithTx(ctx, func(ctx context.Context) error {
+		for _, pv := range pvs {
+			pfs, err := packages_model.GetFilesByVersionID(ctx, pv.ID)
+			if err != nil {
+				return err
+			}
+
+			promoted := false
+			for _, pf := range pfs {
+				if pf.CompositeKey != from {
+					continue
+				}
+
+				if _, err := packages_service.CopyPackageFile(ctx, pf, to, map[string]string{
+					rpm_module.PropertyGroup: to,
+				}); err != nil {
+					return err
+				}
+				promoted = true
+			}
+			if !promoted {
+				return ErrVersionNotInGroup
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return BuildSpecificRepositoryFiles(ctx, ownerID, to)
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package packages
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"gitea.dev/models/db"
+	packages_model "gitea.dev/models/packages"
+	"gitea.dev/modules/util"
+)
+
+var (
+	ErrInvalidSnapshotName = util.NewInvalidArgumentErrorf("snapshot name is invalid")
+	ErrEmptySnapshot       = util.NewInvalidArgumentErrorf("snapshot would not contain any package")
+
+	snapshotNamePattern = regexp.MustCompile(`\A[a-zA-Z0-9][a-zA-Z0-9._-]*\z`)
+)
+
+// IsValidSnapshotName checks if the name can be used as snapshot name and URL segment
+func IsValidSnapshotName(name string) bool {
+	return len(name) <= 100 && snapshotNamePattern.MatchString(name)
+}
+
+// CreateSnapshot inserts the snapshot and records the contained package files. The build function is called
+// with the internal package version which should receive the repository index files of the snapshot.
+func CreateSnapshot(ctx context.Context, ps *packages_model.PackageSnapshot, repositoryPackage string, pfs []*packages_model.PackageFile, build func(context.Context, *packages_model.PackageVersion) error) error {
+	if !IsValidSnapshotName(ps.Name) {
+		return ErrInvalidSnapshotName
+	}
+	if len(pfs) == 0 {
+		return ErrEmptySnapshot
+	}
+
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if err := packages_model.InsertSnapshot(ctx, ps); err != nil {
+			return err
+		}
+		if err := packages_model.InsertSnapshotFiles(ctx, ps.ID, pfs); err != nil {
+			return err
+		}
+
+		pv, err := GetOrCreateInternalPackageVersion(ctx, ps.OwnerID, ps.Type, repositoryPackage, ps.InternalVersion())
+		if err != nil {
+			return err
+		}
+
+		// remove leftovers of a deleted snapshot with the same name
+		existing, err := packages_model.GetFilesByVersionID(ctx, pv.ID)
+		if err != nil {
+			return err
+		}
+		for _, pf := range existing {
+			if err := DeletePackageFile(ctx, pf); err != nil {
+				return err
+			}
+		}
+
+		return build(ctx, pv)
+	})
+}
+
+// DeleteSnapshot deletes the snapshot and its repository index files.
+// The package versions contained in the snapshot are not deleted.
+func DeleteSnapshot(ctx context.Context, ps *packages_model.PackageSnapshot, repositoryPackage string) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		pv, err := packages_model.GetInternalVersionByNameAndVersion(ctx, ps.OwnerID, ps.Type, repositoryPackage, ps.InternalVersion())
+		if err != nil && !errors.Is(err, util.ErrNotExist) {
+			return err
+		}
+		if pv != nil {
+			if err := DeletePackageVersionAndReferences(ctx, pv); err != nil {
+				return err
+			}
+		}
+
+		return packages_model.DeleteSnapshotByID(ctx, ps.ID)
+	})
+}
+
+// CopyPackageFile adds a copy of the package file with a different composite key to the same package version.
+// The copy references the same blob. Properties not contained in the properties argument are copied as well.
+// If a file with the same name and key exists already and references the same blob, the existing file is returned.
+func CopyPackageFile(ctx context.Context, pf *packages_model.PackageFile, compositeKey string, properties map[string]string) (*packages_model.PackageFile, error) {
+	var copied *packages_model.PackageFile
+
+	err := db.WithTx(ctx, func(ctx context.Context) error {
+		pfNew := &packages_model.PackageFile{
+			VersionID:    pf.VersionID,
+			BlobID:       pf.BlobID,
+			Name:         pf.Name,
+			LowerName:    strings.ToLower(pf.Name),
+			CompositeKey: compositeKey,
+			IsLead:       pf.IsLead,
+		}
+
+		var err error
+		if copied, err = packages_model.TryInsertFile(ctx, pfNew); err != nil {
+			if errors.Is(err, packages_model.ErrDuplicatePackageFile) && copied.BlobID == pf.BlobID {
+				return nil
+			}
+			return err
+		}
+
+		pps, err := packages_model.GetProperties(ctx, packages_model.PropertyTypeFile, pf.ID)
+		if err != nil {
+			return err
+		}
+		for _, pp := range pps {
+			if _, ok := properties[pp.Name]; ok {
+				continue
+			}
+			if _, err := packages_model.InsertProperty(ctx, packages_model.PropertyTypeFile, copied.ID, pp.Name, pp.Value); err != nil {
+				return err
+			}
+		}
+		for name, value := range properties {
+			if _, err := packages_model.InsertProperty(ctx, packages_model.PropertyTypeFile, copied.ID, name, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return copied, nil
+}
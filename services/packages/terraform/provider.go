@@ -0,0 +1,170 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package terraform
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	packages_model "gitea.dev/models/packages"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/globallock"
+	terraform_registry "gitea.dev/modules/packages/terraform/registry"
+	"gitea.dev/modules/util"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+)
+
+// GetOrCreateKeyPair gets or creates the PGP keys used to sign the SHA256SUMS files of providers
+func GetOrCreateKeyPair(ctx context.Context, ownerID int64) (string, string, error) {
+	priv, pub, err := getKeyPair(ctx, ownerID)
+	if err != nil || (priv != "" && pub != "") {
+		return priv, pub, err
+	}
+
+	// the keys are created on the first request, concurrent requests must not create different keys
+	releaser, err := globallock.Lock(ctx, fmt.Sprintf("packages_terraform_keys_%d", ownerID))
+	if err != nil {
+		return "", "", err
+	}
+	defer releaser()
+
+	// the keys may have been created while waiting for the lock
+	if priv, pub, err = getKeyPair(ctx, ownerID); err != nil || (priv != "" && pub != "") {
+		return priv, pub, err
+	}
+
+	priv, pub, err = generateKeypair()
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := user_model.SetUserSetting(ctx, ownerID, terraform_registry.SettingKeyPrivate, priv); err != nil {
+		return "", "", err
+	}
+
+	if err := user_model.SetUserSetting(ctx, ownerID, terraform_registry.SettingKeyPublic, pub); err != nil {
+		return "", "", err
+	}
+
+	return priv, pub, nil
+}
+
+func getKeyPair(ctx context.Context, ownerID int64) (string, string, error) {
+	priv, err := user_model.GetSetting(ctx, ownerID, terraform_registry.SettingKeyPrivate)
+	if err != nil && !errors.Is(err, util.ErrNotExist) {
+		return "", "", err
+	}
+
+	pub, err := user_model.GetSetting(ctx, ownerID, terraform_registry.SettingKeyPublic)
+	if err != nil && !errors.Is(err, util.ErrNotExist) {
+		return "", "", err
+	}
+
+	return priv, pub, nil
+}
+
+func generateKeypair() (string, string, error) {
+	// Provider signing keys are long-lived and there is currently no rotation mechanism, choose stronger algorithms
+	cfg := &packet.Config{
+		RSABits:       4096,
+		DefaultHash:   crypto.SHA256,
+		DefaultCipher: packet.CipherAES256,
+	}
+
+	e, err := openpgp.NewEntity("", "Automatically generated Terraform Registry Key; created "+time.Now().UTC().Format(time.RFC3339), "", cfg)
+	if err != nil {
+		return "", "", err
+	}
+
+	var priv strings.Builder
+	var pub strings.Builder
+
+	w, err := armor.Encode(&priv, openpgp.PrivateKeyType, nil)
+	if err != nil {
+		return "", "", err
+	}
+	if err := e.SerializePrivate(w, nil); err != nil {
+		return "", "", err
+	}
+	w.Close()
+
+	w, err = armor.Encode(&pub, openpgp.PublicKeyType, nil)
+	if err != nil {
+		return "", "", err
+	}
+	if err := e.Serialize(w); err != nil {
+		return "", "", err
+	}
+	w.Close()
+
+	return priv.String(), pub.String(), nil
+}
+
+// GetPublicKey returns the id and the ASCII armored public key used to sign the providers of the owner
+func GetPublicKey(ctx context.Context, ownerID int64) (string, string, error) {
+	_, pub, err := GetOrCreateKeyPair(ctx, ownerID)
+	if err != nil {
+		return "", "", err
+	}
+
+	e, err := readEntity(pub)
+	if err != nil {
+		return "", "", err
+	}
+
+	return e.PrimaryKey.KeyIdString(), pub, nil
+}
+
+// BuildShasums builds the SHA256SUMS file of the provider version and its binary detached signature
+func BuildShasums(ctx context.Context, ownerID int64, pd *packages_model.PackageDescriptor) ([]byte, []byte, error) {
+	entries := make([]terraform_registry.ShasumsEntry, 0, len(pd.Files))
+	for _, pfd := range pd.Files {
+		entries = append(entries, terraform_registry.ShasumsEntry{
+			Filename: pfd.File.Name,
+			SHA256:   pfd.Blob.HashSHA256,
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Filename < entries[j].Filename
+	})
+
+	var shasums bytes.Buffer
+	if err := terraform_registry.WriteShasums(&shasums, entries); err != nil {
+		return nil, nil, err
+	}
+
+	priv, _, err := GetOrCreateKeyPair(ctx, ownerID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e, err := readEntity(priv)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var signature bytes.Buffer
+	if err := openpgp.DetachSign(&signature, e, bytes.NewReader(shasums.Bytes()), nil); err != nil {
+		return nil, nil, err
+	}
+
+	return shasums.Bytes(), signature.Bytes(), nil
+}
+
+func readEntity(armored string) (*openpgp.Entity, error) {
+	block, err := armor.Decode(strings.NewReader(armored))
+	if err != nil {
+		return nil, err
+	}
+	return openpgp.ReadEntity(packet.NewReader(block.Body))
+}
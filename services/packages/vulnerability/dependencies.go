@@ -0,0 +1,173 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package vulnerability
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	packages_model "gitea.dev/models/packages"
+	cargo_module "gitea.dev/modules/packages/cargo"
+	composer_module "gitea.dev/modules/packages/composer"
+	goproxy_module "gitea.dev/modules/packages/goproxy"
+	maven_module "gitea.dev/modules/packages/maven"
+	npm_module "gitea.dev/modules/packages/npm"
+	pypi_module "gitea.dev/modules/packages/pypi"
+
+	"golang.org/x/mod/modfile"
+)
+
+// ecosystems maps the package types to the OSV ecosystem names
+// https://ossf.github.io/osv-schema/#affectedpackage-field
+var ecosystems = map[packages_model.Type]string{
+	packages_model.TypeCargo:    "crates.io",
+	packages_model.TypeComposer: "Packagist",
+	packages_model.TypeGo:       "Go",
+	packages_model.TypeMaven:    "Maven",
+	packages_model.TypeNpm:      "npm",
+	packages_model.TypeNuGet:    "NuGet",
+	packages_model.TypePub:      "Pub",
+	packages_model.TypePyPI:     "PyPI",
+	packages_model.TypeRubyGems: "RubyGems",
+}
+
+// Ecosystem returns the OSV ecosystem of the package type or an empty string if there is none
+func Ecosystem(pt packages_model.Type) string {
+	return ecosystems[pt]
+}
+
+// Dependency is a dependency declared in the metadata of a package version
+type Dependency struct {
+	Ecosystem   string
+	Name        string
+	Requirement string // the requirement as declared
+	Version     string // the lowest version satisfying the requirement, empty if unknown
+}
+
+// ExtractDependencies returns the runtime dependencies declared by the package version
+func ExtractDependencies(pd *packages_model.PackageDescriptor) []*Dependency {
+	ecosystem := Ecosystem(pd.Package.Type)
+	if ecosystem == "" {
+		return nil
+	}
+
+	requirements := map[string]string{}
+	switch m := pd.Metadata.(type) {
+	case *npm_module.Metadata:
+		for name, req := range m.Dependencies {
+			requirements[name] = req
+		}
+		for name, req := range m.OptionalDependencies {
+			requirements[name] = req
+		}
+	case *pypi_module.Metadata:
+		for _, rd := range m.RequiresDist {
+			if name, req, ok := parsePythonRequirement(rd); ok {
+				requirements[name] = req
+			}
+		}
+	case *maven_module.Metadata:
+		for _, d := range m.Dependencies {
+			if d.GroupID != "" && d.ArtifactID != "" {
+				requirements[d.GroupID+":"+d.ArtifactID] = d.Version
+			}
+		}
+	case *cargo_module.Metadata:
+		for _, d := range m.Dependencies {
+			if d.Kind != "" && d.Kind != "normal" {
+				continue
+			}
+			name := d.Name
+			if d.Package != nil && *d.Package != "" {
+				name = *d.Package
+			}
+			requirements[name] = d.Req
+		}
+	case *composer_module.Metadata:
+		for name, req := range m.Require {
+			// platform packages like php or ext-json are no Packagist packages
+			if strings.Contains(name, "/") {
+				requirements[name] = req
+			}
+		}
+	}
+
+	if pd.Package.Type == packages_model.TypeGo {
+		if f, err := modfile.ParseLax("go.mod", []byte(pd.VersionProperties.GetByName(goproxy_module.PropertyGoMod)), nil); err == nil {
+			for _, r := range f.Require {
+				requirements[r.Mod.Path] = r.Mod.Version
+			}
+		}
+	}
+
+	deps := make([]*Dependency, 0, len(requirements))
+	for name, req := range requirements {
+		deps = append(deps, &Dependency{
+			Ecosystem:   ecosystem,
+			Name:        name,
+			Requirement: req,
+			Version:     lowestVersion(req),
+		})
+	}
+	sort.Slice(deps, func(i, j int) bool { return deps[i].Name < deps[j].Name })
+	return deps
+}
+
+// PackageName returns the name of the package as used by the OSV ecosystem
+func PackageName(pd *packages_model.PackageDescriptor) string {
+	if m, ok := pd.Metadata.(*maven_module.Metadata); ok && m.GroupID != "" && m.ArtifactID != "" {
+		return m.GroupID + ":" + m.ArtifactID
+	}
+	return pd.Package.Name
+}
+
+// normalizeName returns the key used to compare package names of an ecosystem
+func normalizeName(ecosystem, name string) string {
+	name = strings.ToLower(name)
+	if ecosystem == "PyPI" {
+		// https://peps.python.org/pep-0503/#normalized-names
+		name = pythonNameSeparators.ReplaceAllString(name, "-")
+	}
+	return ecosystem + "|" + name
+}
+
+var (
+	pythonNameSeparators  = regexp.MustCompile(`[-_.]+`)
+	pythonRequirement     = regexp.MustCompile(`^\s*([A-Za-z0-9][A-Za-z0-9._-]*)\s*(?:\[[^\]]*\])?\s*\(?([^;)]*)\)?\s*(?:;(.*))?$`)
+	versionConstraint     = regexp.MustCompile(`(\^|~>|~=|~|>=|>|<=|<|===|==|=|!=|\[|\()?\s*v?(\d+(?:\.(?:\d+|[xX*]))*(?:-[0-9A-Za-z.-]+)?)`)
+	wildcardVersionSuffix = regexp.MustCompile(`(?:\.[xX*])+$`)
+)
+
+// parsePythonRequirement parses a Requires-Dist entry. Requirements of extras are skipped.
+// https://packaging.python.org/en/latest/specifications/dependency-specifiers/
+func parsePythonRequirement(s string) (name, req string, ok bool) {
+	m := pythonRequirement.FindStringSubmatch(s)
+	if m == nil || strings.Contains(m[3], "extra") {
+		return "", "", false
+	}
+	return m[1], strings.TrimSpace(m[2]), true
+}
+
+// lowestVersion returns the lowest version allowed by a version requirement.
+// Requirements without lower bound like "*" or "<2.0" return an empty string.
+func lowestVersion(req string) string {
+	req = strings.TrimSpace(req)
+	if alternative, _, ok := strings.Cut(req, "||"); ok {
+		req = strings.TrimSpace(alternative)
+	}
+	// maven ranges without lower bound: "(,1.0]"
+	if strings.HasPrefix(req, "(,") || strings.HasPrefix(req, "[,") {
+		return ""
+	}
+
+	for _, m := range versionConstraint.FindAllStringSubmatch(req, -1) {
+		switch m[1] {
+		case "<", "<=", "!=", ">", "(":
+			continue
+		}
+		return wildcardVersionSuffix.ReplaceAllString(m[2], "")
+	}
+	return ""
+}
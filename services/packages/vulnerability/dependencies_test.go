@@ -0,0 +1,63 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package vulnerability
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLowestVersion(t *testing.T) {
+	cases := map[string]string{
+		"1.2.3":          "1.2.3",
+		"^1.2.3":         "1.2.3",
+		"~1.2":           "1.2",
+		">=2.0, <3.0":    "2.0",
+		"~> 5.1":         "5.1",
+		"==4.0.1":        "4.0.1",
+		"1.x":            "1",
+		"^1.0 || ^2.0":   "1.0",
+		"v0.3.0":         "0.3.0",
+		"[1.0,2.0)":      "1.0",
+		"(,1.0]":         "",
+		"<2.0":           "",
+		"*":              "",
+		"":               "",
+		"1.0.0-beta.1":   "1.0.0-beta.1",
+		"!=1.5, >=1.0.0": "1.0.0",
+		"<2.0, >1.0":     "",
+	}
+	for req, expected := range cases {
+		assert.Equal(t, expected, lowestVersion(req), "requirement %q", req)
+	}
+}
+
+func TestParsePythonRequirement(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Name        string
+		Requirement string
+		OK          bool
+	}{
+		{"requests", "requests", "", true},
+		{"requests>=2.8.1", "requests", ">=2.8.1", true},
+		{"requests (>=2.8.1)", "requests", ">=2.8.1", true},
+		{"requests[security]>=2.8.1 ; python_version < '3.8'", "requests", ">=2.8.1", true},
+		{"pytest ; extra == 'test'", "", "", false},
+		{"", "", "", false},
+	}
+	for _, c := range cases {
+		name, req, ok := parsePythonRequirement(c.Input)
+		assert.Equal(t, c.OK, ok, c.Input)
+		assert.Equal(t, c.Name, name, c.Input)
+		assert.Equal(t, c.Requirement, req, c.Input)
+	}
+}
+
+func TestNormalizeName(t *testing.T) {
+	assert.Equal(t, "PyPI|zope-interface", normalizeName("PyPI", "Zope.Interface"))
+	assert.Equal(t, "PyPI|zope-interface", normalizeName("PyPI", "zope__interface"))
+	assert.Equal(t, "npm|@scope/lib_name", normalizeName("npm", "@Scope/Lib_Name"))
+}
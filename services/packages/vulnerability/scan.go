@@ -0,0 +1,178 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package vulnerability
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"gitea.dev/models/db"
+	packages_model "gitea.dev/models/packages"
+	"gitea.dev/modules/container"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/packages/osv"
+)
+
+const scanBatchSize = 100
+
+// subject is a package name and version which is checked against the advisories
+type subject struct {
+	key        string
+	dependency *Dependency // nil if the package version itself
+	version    string
+}
+
+type versionSubjects struct {
+	versionID int64
+	subjects  []*subject
+}
+
+type advisoryEntry struct {
+	advisory *osv.Advisory
+	affected *osv.Affected
+}
+
+// ScanTask matches all stored package versions and their dependencies against the OSV export at path.
+// Only the package types with an OSV ecosystem are scanned. Container images and the distribution packages
+// (Debian, Alpine, RPM, ...) are skipped because their contents would have to be inspected to find the
+// installed software, which isn't recorded in their metadata.
+func ScanTask(ctx context.Context, path string) error {
+	if path == "" {
+		return nil
+	}
+
+	// the package versions are iterated twice in batches: first to collect the names to look up in the advisories,
+	// then to match the versions against the advisories, so that they don't have to be kept in memory at once
+	wanted := make(container.Set[string])
+	if err := iterateVersionSubjects(ctx, func(ctx context.Context, vs *versionSubjects) error {
+		for _, s := range vs.subjects {
+			wanted.Add(s.key)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	index := make(map[string][]*advisoryEntry)
+	err := osv.ReadDatabase(path, func(a *osv.Advisory) error {
+		for _, affected := range a.Affected {
+			key := normalizeName(affected.Package.Ecosystem, affected.Package.Name)
+			if wanted.Contains(key) {
+				index[key] = append(index[key], &advisoryEntry{advisory: a, affected: affected})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("ReadDatabase: %w", err)
+	}
+
+	scanned := 0
+	if err := iterateVersionSubjects(ctx, func(ctx context.Context, vs *versionSubjects) error {
+		scanned++
+		return updateVersionVulnerabilities(ctx, vs, index)
+	}); err != nil {
+		return err
+	}
+
+	log.Info("Scanned %d package versions for vulnerabilities, the package types without an OSV ecosystem like container images were skipped", scanned)
+	return nil
+}
+
+// iterateVersionSubjects calls f with the subjects of every package version of the types with an OSV ecosystem
+func iterateVersionSubjects(ctx context.Context, f func(ctx context.Context, vs *versionSubjects) error) error {
+	for _, pt := range slices.Sorted(maps.Keys(ecosystems)) {
+		err := packages_model.IterateVersionsByType(ctx, pt, scanBatchSize, func(ctx context.Context, pvs []*packages_model.PackageVersion) error {
+			select {
+			case <-ctx.Done():
+				return db.ErrCancelledf("While scanning package vulnerabilities")
+			default:
+			}
+
+			pds, err := packages_model.GetPackageDescriptors(ctx, pvs)
+			if err != nil {
+				return err
+			}
+			for _, pd := range pds {
+				if err := f(ctx, getVersionSubjects(pd)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getVersionSubjects(pd *packages_model.PackageDescriptor) *versionSubjects {
+	ecosystem := Ecosystem(pd.Package.Type)
+
+	vs := &versionSubjects{
+		versionID: pd.Version.ID,
+		subjects: []*subject{
+			{
+				key:     normalizeName(ecosystem, PackageName(pd)),
+				version: pd.Version.Version,
+			},
+		},
+	}
+	for _, dep := range ExtractDependencies(pd) {
+		if dep.Version == "" {
+			continue
+		}
+		vs.subjects = append(vs.subjects, &subject{
+			key:        normalizeName(dep.Ecosystem, dep.Name),
+			dependency: dep,
+			version:    dep.Version,
+		})
+	}
+	return vs
+}
+
+func updateVersionVulnerabilities(ctx context.Context, vs *versionSubjects, index map[string][]*advisoryEntry) error {
+	found := make([]*packages_model.PackageVulnerability, 0, 2)
+	for _, s := range vs.subjects {
+		for _, e := range index[s.key] {
+			if !e.affected.IsVersionAffected(s.version) {
+				continue
+			}
+			pv := &packages_model.PackageVulnerability{
+				AdvisoryID: e.advisory.ID,
+				Summary:    e.advisory.Summary,
+				Severity:   e.advisory.Severity(),
+			}
+			if s.dependency != nil {
+				pv.DependencyName = s.dependency.Name
+				pv.DependencyVersion = s.dependency.Version
+			}
+			found = append(found, pv)
+		}
+	}
+
+	existing, err := packages_model.GetVulnerabilitiesByVersionID(ctx, vs.versionID)
+	if err != nil {
+		return err
+	}
+	if vulnerabilitiesEqual(existing, found) {
+		return nil
+	}
+	return packages_model.ReplaceVulnerabilitiesOfVersion(ctx, vs.versionID, found)
+}
+
+func vulnerabilitiesEqual(a, b []*packages_model.PackageVulnerability) bool {
+	keys := func(pvs []*packages_model.PackageVulnerability) []string {
+		ks := make([]string, 0, len(pvs))
+		for _, pv := range pvs {
+			ks = append(ks, pv.AdvisoryID+"|"+pv.DependencyName+"|"+pv.DependencyVersion+"|"+pv.Severity)
+		}
+		slices.Sort(ks)
+		return ks
+	}
+	return slices.Equal(keys(a), keys(b))
+}
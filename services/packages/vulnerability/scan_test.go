@@ -0,0 +1,120 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package vulnerability
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	packages_model "gitea.dev/models/packages"
+	"gitea.dev/models/unittest"
+	"gitea.dev/modules/packages/osv"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateVersionVulnerabilities(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	const versionID = 1000
+
+	advisory := &osv.Advisory{
+		ID:               "GHSA-test-0001",
+		Summary:          "Prototype pollution",
+		DatabaseSpecific: &osv.DatabaseSpecific{Severity: "HIGH"},
+	}
+	affected := &osv.Affected{
+		Package: osv.AffectedPackage{Ecosystem: "npm", Name: "lib"},
+		Ranges: []*osv.Range{
+			{Type: osv.RangeTypeSemver, Events: []*osv.Event{{Introduced: "0"}, {Fixed: "1.2.0"}}},
+		},
+	}
+	index := map[string][]*advisoryEntry{
+		normalizeName("npm", "lib"): {{advisory: advisory, affected: affected}},
+	}
+
+	vs := &versionSubjects{
+		versionID: versionID,
+		subjects: []*subject{
+			{key: normalizeName("npm", "app"), version: "1.0.0"},
+			{key: normalizeName("npm", "lib"), dependency: &Dependency{Ecosystem: "npm", Name: "lib", Version: "1.1.0"}, version: "1.1.0"},
+		},
+	}
+	require.NoError(t, updateVersionVulnerabilities(t.Context(), vs, index))
+
+	pvs, err := packages_model.GetVulnerabilitiesByVersionID(t.Context(), versionID)
+	require.NoError(t, err)
+	require.Len(t, pvs, 1)
+	assert.Equal(t, "GHSA-test-0001", pvs[0].AdvisoryID)
+	assert.Equal(t, "HIGH", pvs[0].Severity)
+	assert.Equal(t, "lib", pvs[0].DependencyName)
+	assert.Equal(t, "1.1.0", pvs[0].DependencyVersion)
+
+	// the stored vulnerabilities are kept if nothing changed
+	require.NoError(t, updateVersionVulnerabilities(t.Context(), vs, index))
+	unchanged, err := packages_model.GetVulnerabilitiesByVersionID(t.Context(), versionID)
+	require.NoError(t, err)
+	require.Len(t, unchanged, 1)
+	assert.Equal(t, pvs[0].ID, unchanged[0].ID)
+
+	// a fixed dependency version isn't affected anymore
+	vs.subjects[1].version = "1.2.0"
+	require.NoError(t, updateVersionVulnerabilities(t.Context(), vs, index))
+	pvs, err = packages_model.GetVulnerabilitiesByVersionID(t.Context(), versionID)
+	require.NoError(t, err)
+	assert.Empty(t, pvs)
+}
+
+func TestScanTask(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	insertVersion := func(t *testing.T, packageType packages_model.Type, name, version, metadataJSON string) *packages_model.PackageVersion {
+		p, err := packages_model.TryInsertPackage(t.Context(), &packages_model.Package{
+			OwnerID:   2,
+			Type:      packageType,
+			Name:      name,
+			LowerName: name,
+		})
+		require.NoError(t, err)
+		pv, err := packages_model.GetOrInsertVersion(t.Context(), &packages_model.PackageVersion{
+			PackageID:    p.ID,
+			CreatorID:    2,
+			Version:      version,
+			LowerVersion: version,
+			MetadataJSON: metadataJSON,
+		})
+		require.NoError(t, err)
+		return pv
+	}
+
+	npmVersion := insertVersion(t, packages_model.TypeNpm, "app", "1.0.0", `{"dependencies":{"lib":"^1.0.0"}}`)
+	containerVersion := insertVersion(t, packages_model.TypeContainer, "lib", "1.0.0", `{}`)
+
+	path := filepath.Join(t.TempDir(), "advisory.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"id": "GHSA-test-0002",
+		"summary": "Remote code execution",
+		"affected": [{
+			"package": {"ecosystem": "npm", "name": "lib"},
+			"ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "1.2.0"}]}]
+		}],
+		"database_specific": {"severity": "CRITICAL"}
+	}`), 0o644))
+
+	require.NoError(t, ScanTask(t.Context(), path))
+
+	pvs, err := packages_model.GetVulnerabilitiesByVersionID(t.Context(), npmVersion.ID)
+	require.NoError(t, err)
+	require.Len(t, pvs, 1)
+	assert.Equal(t, "GHSA-test-0002", pvs[0].AdvisoryID)
+	assert.Equal(t, "lib", pvs[0].DependencyName)
+	assert.Equal(t, "1.0.0", pvs[0].DependencyVersion)
+
+	// container images are not scanned
+	pvs, err = packages_model.GetVulnerabilitiesByVersionID(t.Context(), containerVersion.ID)
+	require.NoError(t, err)
+	assert.Empty(t, pvs)
+}
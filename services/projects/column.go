@@ -0,0 +1,49 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	"context"
+
+	project_model "gitea.dev/models/project"
+	user_model "gitea.dev/models/user"
+	notify_service "gitea.dev/services/notify"
+)
+
+// NewColumn adds a new column to a project
+func NewColumn(ctx context.Context, doer *user_model.User, column *project_model.Column) error {
+	if err := project_model.NewColumn(ctx, column); err != nil {
+		return err
+	}
+	notify_service.NewProjectColumn(ctx, doer, column)
+	return nil
+}
+
+// UpdateColumn updates the title, the color and the sorting of a column
+func UpdateColumn(ctx context.Context, doer *user_model.User, column *project_model.Column) error {
+	if err := project_model.UpdateColumn(ctx, column); err != nil {
+		return err
+	}
+	notify_service.UpdateProjectColumn(ctx, doer, column)
+	return nil
+}
+
+// SetDefaultColumn makes a column the default column of its project
+func SetDefaultColumn(ctx context.Context, doer *user_model.User, column *project_model.Column) error {
+	if err := project_model.SetDefaultColumn(ctx, column.ProjectID, column.ID); err != nil {
+		return err
+	}
+	column.Default = true
+	notify_service.UpdateProjectColumn(ctx, doer, column)
+	return nil
+}
+
+// DeleteColumn deletes a column, its issues are moved to the default column of the project
+func DeleteColumn(ctx context.Context, doer *user_model.User, column *project_model.Column) error {
+	if err := project_model.DeleteColumnByID(ctx, column.ID); err != nil {
+		return err
+	}
+	notify_service.DeleteProjectColumn(ctx, doer, column)
+	return nil
+}
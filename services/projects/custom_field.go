@@ -0,0 +1,51 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	"context"
+
+	issues_model "gitea.dev/models/issues"
+	project_model "gitea.dev/models/project"
+)
+
+// GroupIssuesByCustomField regroups the issues of a project board by the value of a single select custom field.
+// It returns one virtual column per option of the field, their IDs are the positions of the options starting at 1,
+// the issues without value are put into an additional first column whose ID is 0.
+func GroupIssuesByCustomField(ctx context.Context, field *issues_model.CustomField, columns project_model.ColumnList, issuesMap map[int64]issues_model.IssueList, noValueTitle string) (project_model.ColumnList, map[int64]issues_model.IssueList, error) {
+	var issueList issues_model.IssueList
+	for _, column := range columns {
+		issueList = append(issueList, issuesMap[column.ID]...)
+	}
+	issueIDs := make([]int64, 0, len(issueList))
+	for _, issue := range issueList {
+		issueIDs = append(issueIDs, issue.ID)
+	}
+
+	values, err := issues_model.GetCustomFieldValuesByIssueIDs(ctx, field.ID, issueIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groupColumns := make(project_model.ColumnList, 0, len(field.Options)+1)
+	groupColumns = append(groupColumns, &project_model.Column{ID: 0, Title: noValueTitle})
+	optionColumnIDs := make(map[string]int64, len(field.Options))
+	for i, option := range field.Options {
+		groupColumns = append(groupColumns, &project_model.Column{ID: int64(i + 1), Title: option, Sorting: int8(i + 1)})
+		optionColumnIDs[option] = int64(i + 1)
+	}
+
+	groupIssues := make(map[int64]issues_model.IssueList, len(groupColumns))
+	for _, issue := range issueList {
+		var columnID int64
+		if issueValues := values[issue.ID]; len(issueValues) > 0 {
+			columnID = optionColumnIDs[issueValues[0]]
+		}
+		groupIssues[columnID] = append(groupIssues[columnID], issue)
+	}
+	for _, column := range groupColumns {
+		column.NumIssues = int64(len(groupIssues[column.ID]))
+	}
+	return groupColumns, groupIssues, nil
+}
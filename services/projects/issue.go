@@ -15,89 +15,197 @@ import (
 	user_model "gitea.dev/models/user"
 	"gitea.dev/modules/container"
 	"gitea.dev/modules/optional"
+	"gitea.dev/modules/util"
+	notify_service "gitea.dev/services/notify"
 )
 
 // MoveIssuesOnProjectColumn moves or keeps issues in a column and sorts them inside that column
 func MoveIssuesOnProjectColumn(ctx context.Context, doer *user_model.User, column *project_model.Column, sortedIssueIDs map[int64]int64) error {
-	return db.WithTx(ctx, func(ctx context.Context) error {
-		issueIDs := make([]int64, 0, len(sortedIssueIDs))
-		for _, issueID := range sortedIssueIDs {
-			issueIDs = append(issueIDs, issueID)
-		}
-		count, err := db.GetEngine(ctx).
-			Where("project_id=?", column.ProjectID).
-			In("issue_id", issueIDs).
-			Count(new(project_model.ProjectIssue))
-		if err != nil {
-			return err
-		}
-		if int(count) != len(sortedIssueIDs) {
-			return errors.New("all issues have to be added to a project first")
+	var movedIssues []*movedIssue
+	if err := db.WithTx(ctx, func(ctx context.Context) (err error) {
+		movedIssues, err = moveIssuesOnProjectColumn(ctx, doer, column, sortedIssueIDs)
+		return err
+	}); err != nil {
+		return err
+	}
+	notifyMovedIssues(ctx, doer, column, movedIssues)
+	return nil
+}
+
+// movedIssue is an issue which has been moved to another column of a project
+type movedIssue struct {
+	issue       *issues_model.Issue
+	oldColumnID int64
+}
+
+func notifyMovedIssues(ctx context.Context, doer *user_model.User, column *project_model.Column, movedIssues []*movedIssue) {
+	for _, moved := range movedIssues {
+		notify_service.IssueChangeProjectColumn(ctx, doer, moved.issue, column, moved.oldColumnID)
+	}
+}
+
+// moveIssuesOnProjectColumn has to be called in a transaction, it returns the issues which have been moved from another column
+func moveIssuesOnProjectColumn(ctx context.Context, doer *user_model.User, column *project_model.Column, sortedIssueIDs map[int64]int64) ([]*movedIssue, error) {
+	var movedIssues []*movedIssue
+	issueIDs := make([]int64, 0, len(sortedIssueIDs))
+	for _, issueID := range sortedIssueIDs {
+		issueIDs = append(issueIDs, issueID)
+	}
+	count, err := db.GetEngine(ctx).
+		Where("project_id=?", column.ProjectID).
+		In("issue_id", issueIDs).
+		Count(new(project_model.ProjectIssue))
+	if err != nil {
+		return nil, err
+	}
+	if int(count) != len(sortedIssueIDs) {
+		return nil, errors.New("all issues have to be added to a project first")
+	}
+
+	issues, err := issues_model.GetIssuesByIDs(ctx, issueIDs)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := issues.LoadRepositories(ctx); err != nil {
+		return nil, err
+	}
+
+	project, err := project_model.GetProjectByID(ctx, column.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	issuesMap := make(map[int64]*issues_model.Issue, len(issues))
+	for _, issue := range issues {
+		issuesMap[issue.ID] = issue
+	}
+
+	for sorting, issueID := range sortedIssueIDs {
+		curIssue := issuesMap[issueID]
+		if curIssue == nil {
+			continue
 		}
 
-		issues, err := issues_model.GetIssuesByIDs(ctx, issueIDs)
+		projectColumnMap, err := curIssue.ProjectColumnMap(ctx)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		if _, err := issues.LoadRepositories(ctx); err != nil {
-			return err
+
+		projectColumnID := projectColumnMap[column.ProjectID]
+
+		if projectColumnID != column.ID {
+			movedIssues = append(movedIssues, &movedIssue{issue: curIssue, oldColumnID: projectColumnID})
+
+			// add timeline to issue
+			if _, err := issues_model.CreateComment(ctx, &issues_model.CreateCommentOptions{
+				Type:               issues_model.CommentTypeProjectColumn,
+				Doer:               doer,
+				Repo:               curIssue.Repo,
+				Issue:              curIssue,
+				ProjectID:          column.ProjectID,
+				ProjectTitle:       project.Title,
+				ProjectColumnID:    column.ID,
+				ProjectColumnTitle: column.Title,
+			}); err != nil {
+				return nil, err
+			}
 		}
 
-		project, err := project_model.GetProjectByID(ctx, column.ProjectID)
+		// Update the column and sorting for this specific issue in this specific project.
+		// IMPORTANT: The WHERE clause must include both issue_id AND project_id to ensure
+		// that moving an issue's column in one project doesn't affect its column in other
+		// projects when the issue is assigned to multiple projects.
+		_, err = db.GetEngine(ctx).Table("project_issue").
+			Where("issue_id = ? AND project_id = ?", issueID, column.ProjectID).
+			Update(map[string]any{
+				"project_board_id": column.ID,
+				"sorting":          sorting,
+			})
 		if err != nil {
-			return err
+			return nil, err
 		}
+	}
+	return movedIssues, nil
+}
 
-		issuesMap := make(map[int64]*issues_model.Issue, len(issues))
-		for _, issue := range issues {
-			issuesMap[issue.ID] = issue
+// AddIssueToProject adds an issue to a project and puts it into the column, or into the default column if columnID is zero.
+// An issue which is already in the project is moved to the column. The issue is put at the position (sorting) in the column,
+// or at the end of the column if the sorting isn't given.
+func AddIssueToProject(ctx context.Context, doer *user_model.User, project *project_model.Project, issue *issues_model.Issue, columnID int64, sorting optional.Option[int64]) error {
+	var column *project_model.Column
+	var movedIssues []*movedIssue
+	if err := db.WithTx(ctx, func(ctx context.Context) error {
+		if columnID > 0 {
+			var err error
+			if column, err = project_model.GetColumnByIDAndProjectID(ctx, columnID, project.ID); err != nil {
+				return err
+			}
 		}
 
-		for sorting, issueID := range sortedIssueIDs {
-			curIssue := issuesMap[issueID]
-			if curIssue == nil {
-				continue
+		if err := issue.LoadProjects(ctx); err != nil {
+			return err
+		}
+		projectIDs := make([]int64, 0, len(issue.Projects)+1)
+		for _, p := range issue.Projects {
+			projectIDs = append(projectIDs, p.ID)
+		}
+		if !slices.Contains(projectIDs, project.ID) {
+			if err := issues_model.IssueAssignOrRemoveProject(ctx, issue, doer, append(projectIDs, project.ID)); err != nil {
+				return err
 			}
+		}
 
-			projectColumnMap, err := curIssue.ProjectColumnMap(ctx)
+		projectColumnMap, err := issue.ProjectColumnMap(ctx)
+		if err != nil {
+			return err
+		}
+		if column == nil {
+			if !sorting.Has() {
+				return nil
+			}
+			if projectColumnMap[project.ID] > 0 {
+				column, err = project_model.GetColumnByIDAndProjectID(ctx, projectColumnMap[project.ID], project.ID)
+			} else {
+				column, err = project.MustDefaultColumn(ctx)
+			}
 			if err != nil {
 				return err
 			}
+		}
+		if projectColumnMap[project.ID] == column.ID && !sorting.Has() {
+			return nil
+		}
 
-			projectColumnID := projectColumnMap[column.ProjectID]
-
-			if projectColumnID != column.ID {
-				// add timeline to issue
-				if _, err := issues_model.CreateComment(ctx, &issues_model.CreateCommentOptions{
-					Type:               issues_model.CommentTypeProjectColumn,
-					Doer:               doer,
-					Repo:               curIssue.Repo,
-					Issue:              curIssue,
-					ProjectID:          column.ProjectID,
-					ProjectTitle:       project.Title,
-					ProjectColumnID:    column.ID,
-					ProjectColumnTitle: column.Title,
-				}); err != nil {
-					return err
-				}
-			}
-
-			// Update the column and sorting for this specific issue in this specific project.
-			// IMPORTANT: The WHERE clause must include both issue_id AND project_id to ensure
-			// that moving an issue's column in one project doesn't affect its column in other
-			// projects when the issue is assigned to multiple projects.
-			_, err = db.GetEngine(ctx).Table("project_issue").
-				Where("issue_id = ? AND project_id = ?", issueID, column.ProjectID).
-				Update(map[string]any{
-					"project_board_id": column.ID,
-					"sorting":          sorting,
-				})
-			if err != nil {
+		newSorting := sorting.ValueOrDefault(0)
+		if !sorting.Has() {
+			if newSorting, err = project_model.GetColumnIssueNextSorting(ctx, project.ID, column.ID); err != nil {
 				return err
 			}
 		}
-		return nil
-	})
+		movedIssues, err = moveIssuesOnProjectColumn(ctx, doer, column, map[int64]int64{newSorting: issue.ID})
+		return err
+	}); err != nil {
+		return err
+	}
+	notifyMovedIssues(ctx, doer, column, movedIssues)
+	return nil
+}
+
+// RemoveIssueFromProject removes an issue from a project
+func RemoveIssueFromProject(ctx context.Context, doer *user_model.User, project *project_model.Project, issue *issues_model.Issue) error {
+	if err := issue.LoadProjects(ctx); err != nil {
+		return err
+	}
+	projectIDs := make([]int64, 0, len(issue.Projects))
+	for _, p := range issue.Projects {
+		if p.ID != project.ID {
+			projectIDs = append(projectIDs, p.ID)
+		}
+	}
+	if len(projectIDs) == len(issue.Projects) {
+		return util.NewNotExistErrorf("issue %d isn't in project %d", issue.ID, project.ID)
+	}
+	return issues_model.IssueAssignOrRemoveProject(ctx, issue, doer, projectIDs)
 }
 
 func LoadIssuesAssigneesForProject(ctx context.Context, issuesMap map[int64]issues_model.IssueList) ([]*user_model.User, error) {
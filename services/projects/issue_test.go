@@ -4,6 +4,7 @@
 package project
 
 import (
+	"errors"
 	"testing"
 
 	"gitea.dev/models/db"
@@ -13,6 +14,8 @@ import (
 	repo_model "gitea.dev/models/repo"
 	"gitea.dev/models/unittest"
 	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/optional"
+	"gitea.dev/modules/util"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -213,3 +216,25 @@ func Test_Projects(t *testing.T) {
 		require.Equal(t, "user2", assignees[2].Name)
 	})
 }
+
+func TestAddAndRemoveIssueFromProject(t *testing.T) {
+	assert.NoError(t, unittest.PrepareTestDatabase())
+
+	user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	project1 := unittest.AssertExistsAndLoadBean(t, &project_model.Project{ID: 1})
+
+	issue := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 1})
+	require.NoError(t, RemoveIssueFromProject(t.Context(), user2, project1, issue))
+	unittest.AssertNotExistsBean(t, &project_model.ProjectIssue{ProjectID: 1, IssueID: 1})
+
+	issue = unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 1})
+	assert.True(t, errors.Is(RemoveIssueFromProject(t.Context(), user2, project1, issue), util.ErrNotExist))
+
+	// the issue is put into the default column at the position
+	require.NoError(t, AddIssueToProject(t.Context(), user2, project1, issue, 0, optional.Some[int64](3)))
+	unittest.AssertExistsAndLoadBean(t, &project_model.ProjectIssue{ProjectID: 1, IssueID: 1, ProjectColumnID: 1, Sorting: 3})
+
+	// the column has to belong to the project
+	issue = unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 1})
+	assert.True(t, project_model.IsErrProjectColumnNotExist(AddIssueToProject(t.Context(), user2, project1, issue, 4, optional.None[int64]())))
+}
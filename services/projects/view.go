@@ -0,0 +1,355 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	issues_model "gitea.dev/models/issues"
+	project_model "gitea.dev/models/project"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+	issue_service "gitea.dev/services/issue"
+)
+
+// ViewField is a column of a table view
+type ViewField struct {
+	Key         string
+	CustomField *issues_model.CustomField // nil for the built-in fields
+}
+
+// ViewGroup is a group of the items of a view, e.g. a milestone of a roadmap
+type ViewGroup struct {
+	Key     string
+	Title   string
+	Color   string
+	DueDate timeutil.TimeStamp
+	// IsEmpty is true for the group of the items without value
+	IsEmpty   bool
+	Issues    issues_model.IssueList
+	NumClosed int
+
+	rank int64
+}
+
+// ViewData contains the items of a project arranged by a view
+type ViewData struct {
+	View   *project_model.View
+	Fields []*ViewField
+	Groups []*ViewGroup
+	// FilterError is set when the filter of the view is invalid, then no item matches
+	FilterError string
+	NumIssues   int
+
+	issueColumns      map[int64]*project_model.Column
+	customFieldValues map[int64]map[int64][]string
+}
+
+// IssueColumn returns the column of the project board containing the issue
+func (d *ViewData) IssueColumn(issueID int64) *project_model.Column {
+	return d.issueColumns[issueID]
+}
+
+// CustomFieldValues returns the values of a custom field of the issue
+func (d *ViewData) CustomFieldValues(fieldID, issueID int64) []string {
+	return d.customFieldValues[fieldID][issueID]
+}
+
+// ValidateViewCustomFields checks that the custom fields the view refers to are available to the project,
+// fields are the custom fields available to the items of the project.
+func ValidateViewCustomFields(view *project_model.View, fields []*issues_model.CustomField) error {
+	for _, id := range view.CustomFieldIDs() {
+		if !slices.ContainsFunc(fields, func(f *issues_model.CustomField) bool { return f.ID == id }) {
+			return util.NewInvalidArgumentErrorf("custom field %d is not available to the project", id)
+		}
+	}
+	return nil
+}
+
+// GetProjectCustomFields returns the custom fields available to the items of a project,
+// these are the fields of the repository and of its owner for a repository project and the fields of the organization otherwise.
+func GetProjectCustomFields(ctx context.Context, project *project_model.Project) ([]*issues_model.CustomField, error) {
+	if project.RepoID == 0 {
+		return issues_model.GetCustomFieldsByOrgID(ctx, project.OwnerID)
+	}
+	if err := project.LoadRepo(ctx); err != nil {
+		return nil, err
+	}
+	return issues_model.GetAvailableCustomFields(ctx, project.Repo.OwnerID, project.RepoID)
+}
+
+// BuildViewData arranges the issues of the columns of a project board as defined by the view.
+// The issues have to be loaded with their attributes, issuesMap contains the issues of each column in the order of the board.
+func BuildViewData(ctx context.Context, doer *user_model.User, view *project_model.View, customFields []*issues_model.CustomField, columns project_model.ColumnList, issuesMap map[int64]issues_model.IssueList) (*ViewData, error) {
+	data := &ViewData{
+		View:              view,
+		issueColumns:      make(map[int64]*project_model.Column),
+		customFieldValues: make(map[int64]map[int64][]string),
+	}
+
+	var issues issues_model.IssueList
+	for _, column := range columns {
+		for _, issue := range issuesMap[column.ID] {
+			data.issueColumns[issue.ID] = column
+			issues = append(issues, issue)
+		}
+	}
+
+	if view.Filter != "" {
+		var err error
+		issues, err = issue_service.FilterIssuesByQuery(ctx, doer, view.Filter, issues)
+		if err != nil {
+			if !errors.Is(err, util.ErrInvalidArgument) {
+				return nil, err
+			}
+			data.FilterError = err.Error()
+			issues = nil
+		}
+	}
+	data.NumIssues = len(issues)
+
+	issueIDs := make([]int64, 0, len(issues))
+	for _, issue := range issues {
+		issueIDs = append(issueIDs, issue.ID)
+	}
+	fieldsByID := make(map[int64]*issues_model.CustomField, len(customFields))
+	for _, field := range customFields {
+		fieldsByID[field.ID] = field
+	}
+	for _, id := range view.CustomFieldIDs() {
+		if fieldsByID[id] == nil {
+			// the field has been deleted since the view was saved
+			continue
+		}
+		values, err := issues_model.GetCustomFieldValuesByIssueIDs(ctx, id, issueIDs)
+		if err != nil {
+			return nil, err
+		}
+		data.customFieldValues[id] = values
+	}
+
+	for _, key := range view.Fields {
+		field := &ViewField{Key: key}
+		if id := project_model.ParseViewCustomFieldKey(key); id > 0 {
+			if field.CustomField = fieldsByID[id]; field.CustomField == nil {
+				continue
+			}
+		}
+		data.Fields = append(data.Fields, field)
+	}
+
+	sortViewIssues(issues, view.SortBy, view.SortDesc, fieldsByID[project_model.ParseViewCustomFieldKey(view.SortBy)], data.customFieldValues)
+	data.Groups = data.groupIssues(issues, columns, fieldsByID[project_model.ParseViewCustomFieldKey(view.GroupBy)])
+	return data, nil
+}
+
+func sortViewIssues(issues issues_model.IssueList, sortBy string, desc bool, field *issues_model.CustomField, fieldValues map[int64]map[int64][]string) {
+	var compare func(a, b *issues_model.Issue) int
+	switch sortBy {
+	case project_model.ViewFieldNumber:
+		compare = func(a, b *issues_model.Issue) int {
+			return cmp.Or(cmp.Compare(a.RepoID, b.RepoID), cmp.Compare(a.Index, b.Index))
+		}
+	case project_model.ViewFieldTitle:
+		compare = func(a, b *issues_model.Issue) int {
+			return cmp.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+		}
+	case project_model.ViewFieldCreated:
+		compare = func(a, b *issues_model.Issue) int {
+			return cmp.Compare(a.CreatedUnix, b.CreatedUnix)
+		}
+	case project_model.ViewFieldUpdated:
+		compare = func(a, b *issues_model.Issue) int {
+			return cmp.Compare(a.UpdatedUnix, b.UpdatedUnix)
+		}
+	case project_model.ViewFieldDeadline:
+		compare = func(a, b *issues_model.Issue) int {
+			return compareMissingLast(a.DeadlineUnix == 0, b.DeadlineUnix == 0, desc, func() int {
+				return cmp.Compare(a.DeadlineUnix, b.DeadlineUnix)
+			})
+		}
+	default:
+		if field == nil {
+			// keep the order of the board
+			if desc {
+				slices.Reverse(issues)
+			}
+			return
+		}
+		values := fieldValues[field.ID]
+		compare = func(a, b *issues_model.Issue) int {
+			va, vb := values[a.ID], values[b.ID]
+			return compareMissingLast(len(va) == 0, len(vb) == 0, desc, func() int {
+				return compareCustomFieldValues(field, va[0], vb[0])
+			})
+		}
+	}
+	slices.SortStableFunc(issues, func(a, b *issues_model.Issue) int {
+		if desc {
+			return compare(b, a)
+		}
+		return compare(a, b)
+	})
+}
+
+// compareMissingLast keeps the items without value at the end whatever the direction of the sorting is
+func compareMissingLast(aMissing, bMissing, desc bool, compare func() int) int {
+	switch {
+	case aMissing && bMissing:
+		return 0
+	case aMissing != bMissing:
+		// the result is inverted by descending sorts
+		if aMissing != desc {
+			return 1
+		}
+		return -1
+	}
+	return compare()
+}
+
+func compareCustomFieldValues(field *issues_model.CustomField, a, b string) int {
+	switch {
+	case field.Type == issues_model.CustomFieldTypeNumber:
+		na, _ := strconv.ParseFloat(a, 64)
+		nb, _ := strconv.ParseFloat(b, 64)
+		return cmp.Compare(na, nb)
+	case field.Type.HasOptions():
+		return cmp.Compare(slices.Index(field.Options, a), slices.Index(field.Options, b))
+	}
+	return cmp.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// groupIssues puts the sorted issues into the groups of the view, an issue is in several groups when it has several values,
+// e.g. several assignees. The group of the issues without value comes last.
+func (d *ViewData) groupIssues(issues issues_model.IssueList, columns project_model.ColumnList, field *issues_model.CustomField) []*ViewGroup {
+	groupBy := d.View.GroupBy
+	if groupBy == "" || project_model.ParseViewCustomFieldKey(groupBy) > 0 && field == nil {
+		group := &ViewGroup{Key: "all", Issues: issues}
+		group.countClosed()
+		return []*ViewGroup{group}
+	}
+
+	groups := make(map[string]*ViewGroup)
+	if groupBy == project_model.ViewFieldStatus {
+		// all columns are shown even without items, like on the board
+		for i, column := range columns {
+			groups[viewColumnGroupKey(column)] = &ViewGroup{Key: viewColumnGroupKey(column), Title: column.Title, Color: column.Color, rank: int64(i)}
+		}
+	}
+	empty := &ViewGroup{Key: "none", IsEmpty: true}
+	for _, issue := range issues {
+		issueGroups := d.issueGroups(issue, groupBy, field)
+		if len(issueGroups) == 0 {
+			empty.Issues = append(empty.Issues, issue)
+			continue
+		}
+		for _, g := range issueGroups {
+			group := groups[g.Key]
+			if group == nil {
+				group = g
+				groups[g.Key] = group
+			}
+			group.Issues = append(group.Issues, issue)
+		}
+	}
+
+	result := make([]*ViewGroup, 0, len(groups)+1)
+	for _, group := range groups {
+		result = append(result, group)
+	}
+	slices.SortFunc(result, func(a, b *ViewGroup) int {
+		return cmp.Or(cmp.Compare(a.rank, b.rank), cmp.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title)), cmp.Compare(a.Key, b.Key))
+	})
+	if len(empty.Issues) > 0 {
+		result = append(result, empty)
+	}
+	for _, group := range result {
+		group.countClosed()
+	}
+	return result
+}
+
+func (g *ViewGroup) countClosed() {
+	for _, issue := range g.Issues {
+		if issue.IsClosed {
+			g.NumClosed++
+		}
+	}
+}
+
+// Completeness returns the percentage of the closed items of the group
+func (g *ViewGroup) Completeness() int {
+	if len(g.Issues) == 0 {
+		return 0
+	}
+	return g.NumClosed * 100 / len(g.Issues)
+}
+
+func viewColumnGroupKey(column *project_model.Column) string {
+	return "column:" + strconv.FormatInt(column.ID, 10)
+}
+
+// rankDueDate sorts the groups by due date, the groups without due date come last
+func rankDueDate(ts timeutil.TimeStamp) int64 {
+	if ts == 0 {
+		return math.MaxInt64
+	}
+	return int64(ts)
+}
+
+func (d *ViewData) issueGroups(issue *issues_model.Issue, groupBy string, field *issues_model.CustomField) []*ViewGroup {
+	switch groupBy {
+	case project_model.ViewFieldStatus:
+		if column := d.issueColumns[issue.ID]; column != nil {
+			return []*ViewGroup{{Key: viewColumnGroupKey(column)}}
+		}
+	case project_model.ViewFieldMilestone:
+		if m := issue.Milestone; m != nil {
+			return []*ViewGroup{{Key: "milestone:" + strconv.FormatInt(m.ID, 10), Title: m.Name, DueDate: m.DeadlineUnix, rank: rankDueDate(m.DeadlineUnix)}}
+		}
+	case project_model.ViewFieldDeadline:
+		if issue.DeadlineUnix != 0 {
+			month := issue.DeadlineUnix.AsTime()
+			month = month.AddDate(0, 0, 1-month.Day())
+			return []*ViewGroup{{Key: month.Format("2006-01"), Title: month.Format("2006-01"), DueDate: timeutil.TimeStamp(month.AddDate(0, 1, -1).Unix()), rank: month.Unix()}}
+		}
+	case project_model.ViewFieldAssignees:
+		groups := make([]*ViewGroup, 0, len(issue.Assignees))
+		for _, assignee := range issue.Assignees {
+			groups = append(groups, &ViewGroup{Key: "user:" + strconv.FormatInt(assignee.ID, 10), Title: assignee.GetDisplayName()})
+		}
+		return groups
+	case project_model.ViewFieldType:
+		if t := issue.Type; t != nil {
+			return []*ViewGroup{{Key: "type:" + strconv.FormatInt(t.ID, 10), Title: t.Name, Color: t.Color, rank: int64(t.Sort)}}
+		}
+	case project_model.ViewFieldRepository:
+		if issue.Repo != nil {
+			return []*ViewGroup{{Key: "repo:" + strconv.FormatInt(issue.Repo.ID, 10), Title: issue.Repo.FullName()}}
+		}
+	default:
+		values := d.customFieldValues[field.ID][issue.ID]
+		groups := make([]*ViewGroup, 0, len(values))
+		for _, value := range values {
+			group := &ViewGroup{Key: "value:" + value, Title: value}
+			switch {
+			case field.Type.HasOptions():
+				group.rank = int64(slices.Index(field.Options, value))
+			case field.Type == issues_model.CustomFieldTypeNumber:
+				n, _ := strconv.ParseFloat(value, 64)
+				group.rank = int64(n)
+			}
+			groups = append(groups, group)
+		}
+		return groups
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	"testing"
+
+	issues_model "gitea.dev/models/issues"
+	project_model "gitea.dev/models/project"
+	"gitea.dev/models/unittest"
+	user_model "gitea.dev/models/user"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildViewData(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	project := unittest.AssertExistsAndLoadBean(t, &project_model.Project{ID: 1})
+
+	columns, err := project.GetColumns(t.Context())
+	require.NoError(t, err)
+	issuesMap, err := LoadIssuesFromProject(t.Context(), project, &issues_model.IssuesOptions{RepoIDs: []int64{project.RepoID}})
+	require.NoError(t, err)
+
+	field := &issues_model.CustomField{RepoID: project.RepoID, Name: "Priority", Type: issues_model.CustomFieldTypeSingleSelect, Options: []string{"High", "Low"}}
+	require.NoError(t, issues_model.NewCustomField(t.Context(), field))
+	require.NoError(t, issues_model.SetIssueCustomFieldValues(t.Context(), 3, field.ID, []string{"Low"}))
+	require.NoError(t, issues_model.SetIssueCustomFieldValues(t.Context(), 1, field.ID, []string{"High"}))
+	customFields, err := GetProjectCustomFields(t.Context(), project)
+	require.NoError(t, err)
+
+	build := func(t *testing.T, view *project_model.View) *ViewData {
+		require.NoError(t, view.Validate())
+		require.NoError(t, ValidateViewCustomFields(view, customFields))
+		data, err := BuildViewData(t.Context(), user2, view, customFields, columns, issuesMap)
+		require.NoError(t, err)
+		return data
+	}
+	type group struct {
+		Title    string
+		IssueIDs []int64
+	}
+	groups := func(data *ViewData) (result []group) {
+		for _, g := range data.Groups {
+			ids := make([]int64, 0, len(g.Issues))
+			for _, issue := range g.Issues {
+				ids = append(ids, issue.ID)
+			}
+			result = append(result, group{g.Title, ids})
+		}
+		return result
+	}
+
+	t.Run("GroupByStatus", func(t *testing.T) {
+		data := build(t, &project_model.View{Name: "Table", Layout: project_model.ViewLayoutTable, GroupBy: project_model.ViewFieldStatus, SortBy: project_model.ViewFieldNumber, SortDesc: true})
+		assert.Equal(t, []group{{"To Do", []int64{2, 1}}, {"In Progress", []int64{3}}, {"Done", []int64{5}}}, groups(data))
+		assert.Equal(t, 1, data.Groups[2].NumClosed)
+		assert.Equal(t, "In Progress", data.IssueColumn(3).Title)
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		data := build(t, &project_model.View{Name: "Table", Layout: project_model.ViewLayoutTable, SortBy: project_model.ViewFieldNumber, Filter: "label:label1"})
+		assert.Equal(t, []group{{"", []int64{1, 2}}}, groups(data))
+
+		data = build(t, &project_model.View{Name: "Table", Layout: project_model.ViewLayoutTable, Filter: "is:closed"})
+		assert.Equal(t, []group{{"", []int64{5}}}, groups(data))
+
+		data = build(t, &project_model.View{Name: "Table", Layout: project_model.ViewLayoutTable, Filter: "label:unknown"})
+		assert.NotEmpty(t, data.FilterError)
+		assert.Zero(t, data.NumIssues)
+	})
+
+	t.Run("CustomField", func(t *testing.T) {
+		key := project_model.ViewCustomFieldKey(field.ID)
+		data := build(t, &project_model.View{Name: "Table", Layout: project_model.ViewLayoutTable, Fields: []string{key}, GroupBy: key, SortBy: project_model.ViewFieldNumber})
+		assert.Equal(t, []group{{"High", []int64{1}}, {"Low", []int64{3}}, {"", []int64{2, 5}}}, groups(data))
+		assert.True(t, data.Groups[2].IsEmpty)
+		if assert.Len(t, data.Fields, 1) {
+			assert.Equal(t, field.ID, data.Fields[0].CustomField.ID)
+		}
+		assert.Equal(t, []string{"Low"}, data.CustomFieldValues(field.ID, 3))
+
+		data = build(t, &project_model.View{Name: "Table", Layout: project_model.ViewLayoutTable, SortBy: key, SortDesc: true})
+		assert.Equal(t, []int64{3, 1}, groups(data)[0].IssueIDs[:2])
+
+		assert.Error(t, ValidateViewCustomFields(&project_model.View{Fields: []string{project_model.ViewCustomFieldKey(field.ID + 1)}}, customFields))
+	})
+
+	t.Run("Roadmap", func(t *testing.T) {
+		data := build(t, &project_model.View{Name: "Roadmap", Layout: project_model.ViewLayoutRoadmap, SortBy: project_model.ViewFieldNumber})
+		assert.Equal(t, []group{{"milestone1", []int64{2}}, {"milestone3", []int64{3}}, {"", []int64{1, 5}}}, groups(data))
+		assert.NotZero(t, data.Groups[0].DueDate)
+		assert.Equal(t, 50, data.Groups[2].Completeness())
+	})
+}
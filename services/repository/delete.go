@@ -30,6 +30,7 @@ import (
 	"gitea.dev/modules/storage"
 	actions_service "gitea.dev/services/actions"
 	asymkey_service "gitea.dev/services/asymkey"
+	codenav_service "gitea.dev/services/codenav"
 	issue_service "gitea.dev/services/issue"
 
 	"xorm.io/builder"
@@ -181,6 +182,10 @@ func DeleteRepositoryDirectly(ctx context.Context, repoID int64, ignoreOrgTeams
 		&actions_model.ActionRunnerToken{RepoID: repoID},
 		&actions_model.ActionScopedWorkflowSource{SourceRepoID: repoID},
 		&issues_model.IssuePin{RepoID: repoID},
+		&issues_model.AutomationRule{RepoID: repoID},
+		&issues_model.AutomationRuleLog{RepoID: repoID},
+		&user_model.MailPreference{RepoID: repoID},
+		&user_model.MailDigestItem{RepoID: repoID},
 	); err != nil {
 		return fmt.Errorf("deleteBeans: %w", err)
 	}
@@ -190,6 +195,16 @@ func DeleteRepositoryDirectly(ctx context.Context, repoID int64, ignoreOrgTeams
 		return err
 	}
 
+	// Delete custom fields and their values
+	if err := issues_model.DeleteCustomFieldsByScope(ctx, 0, repoID); err != nil {
+		return err
+	}
+
+	// Delete SLA policies and their reminders
+	if err := issues_model.DeleteSLAPoliciesByScope(ctx, 0, repoID); err != nil {
+		return err
+	}
+
 	// Delete Pulls and related objects
 	if err := issues_model.DeletePullsByBaseRepoID(ctx, repoID); err != nil {
 		return err
@@ -331,6 +346,11 @@ func DeleteRepositoryDirectly(ctx context.Context, repoID int64, ignoreOrgTeams
 		system_model.RemoveStorageWithNotice(ctx, storage.RepoArchives, "Delete repo archive file", archive)
 	}
 
+	// Remove code navigation indexes
+	if err := codenav_service.DeleteRepositoryIndexes(repoID); err != nil {
+		log.Error("DeleteRepositoryIndexes(%d): %v", repoID, err)
+	}
+
 	// Remove lfs objects
 	for _, lfsObj := range lfsPaths {
 		system_model.RemoveStorageWithNotice(ctx, storage.LFS, "Delete orphaned LFS file", lfsObj)
@@ -228,6 +228,11 @@ func transferOwnership(ctx context.Context, doer *user_model.User, newOwnerName
 		}
 	}
 
+	// Sub-issues must belong to repositories of the same owner
+	if err := issues_model.DeleteCrossRepoSubIssues(ctx, repo.ID); err != nil {
+		return fmt.Errorf("DeleteCrossRepoSubIssues: %w", err)
+	}
+
 	if newOwner.IsOrganization() {
 		teams, err := organization.FindOrgTeams(ctx, newOwner.ID)
 		if err != nil {
@@ -299,6 +304,16 @@ func transferOwnership(ctx context.Context, doer *user_model.User, newOwnerName
 		) AS il_too)`, issues_model.CommentTypeLabel, repo.ID, newOwner.ID); err != nil {
 			return fmt.Errorf("Unable to remove old org label comments: %w", err)
 		}
+
+		// Issue types belong to the old organization
+		if _, err := sess.Exec("UPDATE issue SET type_id = 0 WHERE repo_id = ?", repo.ID); err != nil {
+			return fmt.Errorf("Unable to remove old org issue types: %w", err)
+		}
+
+		// Custom fields belong to the old organization, their values are kept for the fields of the new owner with the same name and type
+		if err := issues_model.TransferCustomFieldValues(ctx, repo.ID, oldOwner.ID, newOwner.ID); err != nil {
+			return fmt.Errorf("Unable to transfer old org custom field values: %w", err)
+		}
 	}
 
 	// Rename remote repository to new path and delete local copy.
@@ -0,0 +1,198 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package reviewreminder
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"gitea.dev/models/db"
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/organization"
+	access_model "gitea.dev/models/perm/access"
+	"gitea.dev/models/unit"
+	user_model "gitea.dev/models/user"
+	issue_indexer "gitea.dev/modules/indexer/issues"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/optional"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/services/mailer"
+)
+
+// maxPulls is the maximum number of pull requests listed by a reminder, the oldest ones are listed
+const maxPulls = 50
+
+// SendReminders sends the review reminders which are due, each reminder is evaluated in its own time zone
+func SendReminders(ctx context.Context) error {
+	reminders, err := issues_model.GetAllReviewReminders(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, r := range reminders {
+		select {
+		case <-ctx.Done():
+			return db.ErrCancelledf("before sending review reminder %d", r.ID)
+		default:
+		}
+
+		loc, err := reminderLocation(ctx, r)
+		if err != nil {
+			return err
+		}
+		if !r.IsDue(now, loc) {
+			continue
+		}
+		// the reminder is marked as sent first, so that a failing delivery isn't repeated by every run of the task
+		if err := issues_model.UpdateReviewReminderLastSent(ctx, r, timeutil.TimeStamp(now.Unix())); err != nil {
+			return err
+		}
+		if err := sendReminder(ctx, r); err != nil {
+			log.Error("Send review reminder %d: %v", r.ID, err)
+		}
+	}
+	return nil
+}
+
+// reminderLocation returns the time zone of the schedule of the reminder: its own time zone if it has one,
+// otherwise the time zone of the user for a personal reminder and the default time zone for a team reminder
+func reminderLocation(ctx context.Context, r *issues_model.ReviewReminder) (*time.Location, error) {
+	if r.TimeZone != "" {
+		if loc, err := time.LoadLocation(r.TimeZone); err == nil {
+			return loc, nil
+		}
+	}
+	if r.IsTeamReminder() {
+		return setting.DefaultUILocation, nil
+	}
+	return user_model.GetUserLocation(ctx, r.OwnerID)
+}
+
+func sendReminder(ctx context.Context, r *issues_model.ReviewReminder) error {
+	if r.IsTeamReminder() {
+		return sendTeamReminder(ctx, r)
+	}
+
+	u, err := user_model.GetUserByID(ctx, r.OwnerID)
+	if err != nil {
+		return err
+	}
+	if !u.IsActive || u.ProhibitLogin {
+		return nil
+	}
+	pulls, err := GetPendingReviewsOfUser(ctx, u, r.SkipDrafts)
+	if err != nil || len(pulls) == 0 {
+		return err
+	}
+	if r.Delivery == issues_model.ReviewReminderDeliveryWebhook {
+		return deliverWebhook(ctx, r.WebhookURL, "@"+u.Name, pulls)
+	}
+	return mailer.SendReviewReminderMail(ctx, u, "", pulls, setting.AppURL+"user/settings/review_reminders")
+}
+
+func sendTeamReminder(ctx context.Context, r *issues_model.ReviewReminder) error {
+	team, err := organization.GetTeamByID(ctx, r.TeamID)
+	if err != nil {
+		return err
+	}
+	org, err := organization.GetOrgByID(ctx, team.OrgID)
+	if err != nil {
+		return err
+	}
+	teamName := org.Name + "/" + team.Name
+
+	pulls, err := GetPendingReviewsOfTeam(ctx, team, r.SkipDrafts)
+	if err != nil || len(pulls) == 0 {
+		return err
+	}
+	if r.Delivery == issues_model.ReviewReminderDeliveryWebhook {
+		return deliverWebhook(ctx, r.WebhookURL, teamName, pulls)
+	}
+
+	members, err := organization.GetTeamMembers(ctx, &organization.SearchMembersOptions{TeamID: team.ID})
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		// the members may have less access than the team, e.g. if they are restricted users
+		visible := slices.DeleteFunc(slices.Clone(pulls), func(pull *issues_model.Issue) bool {
+			return !access_model.CheckRepoUnitUser(ctx, pull.Repo, member, unit.TypePullRequests)
+		})
+		// a failing mail doesn't prevent the other members from being reminded
+		if err := mailer.SendReviewReminderMail(ctx, member, teamName, visible, ""); err != nil {
+			log.Error("Send review reminder %d to team member %d: %v", r.ID, member.ID, err)
+		}
+	}
+	return nil
+}
+
+// filterPulls loads the repositories of the pull requests and removes the ones which can't be reviewed:
+// the pull requests of archived repositories, the ones the reviewer can't access and the drafts if skipDrafts is set
+func filterPulls(ctx context.Context, pulls issues_model.IssueList, skipDrafts bool, canAccess func(*issues_model.Issue) bool) (issues_model.IssueList, error) {
+	if _, err := pulls.LoadRepositories(ctx); err != nil {
+		return nil, err
+	}
+	pulls = slices.DeleteFunc(pulls, func(pull *issues_model.Issue) bool {
+		return pull.Repo.IsArchived ||
+			(skipDrafts && issues_model.HasWorkInProgressPrefix(pull.Title)) ||
+			!canAccess(pull)
+	})
+	if len(pulls) > maxPulls {
+		pulls = pulls[:maxPulls]
+	}
+	return pulls, nil
+}
+
+// GetPendingReviewsOfUser returns the open pull requests whose review is requested from the user
+// or from one of their teams, the oldest first
+func GetPendingReviewsOfUser(ctx context.Context, u *user_model.User, skipDrafts bool) (issues_model.IssueList, error) {
+	ids, _, err := issue_indexer.SearchIssues(ctx, &issue_indexer.SearchOptions{
+		IsPull:            optional.Some(true),
+		IsClosed:          optional.Some(false),
+		ReviewRequestedID: optional.Some(u.ID),
+		SortBy:            issue_indexer.SortByCreatedAsc,
+		Paginator:         &db.ListOptions{Page: 1, PageSize: 2 * maxPulls},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the issue indexers only index the individual review requests, the team ones are merged in from the database
+	teamIDs, err := issues_model.GetOpenPullIDsRequestingUserTeamsReview(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	pulls, err := issues_model.GetIssuesByIDs(ctx, append(ids, teamIDs...))
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(pulls, func(a, b *issues_model.Issue) int {
+		return cmp.Or(cmp.Compare(a.CreatedUnix, b.CreatedUnix), cmp.Compare(a.ID, b.ID))
+	})
+	return filterPulls(ctx, pulls, skipDrafts, func(pull *issues_model.Issue) bool {
+		return access_model.CheckRepoUnitUser(ctx, pull.Repo, u, unit.TypePullRequests)
+	})
+}
+
+// GetPendingReviewsOfTeam returns the open pull requests whose review is requested from the team, the oldest first
+func GetPendingReviewsOfTeam(ctx context.Context, team *organization.Team, skipDrafts bool) (issues_model.IssueList, error) {
+	if !team.UnitEnabled(ctx, unit.TypePullRequests) {
+		return nil, nil
+	}
+	ids, err := issues_model.GetOpenPullIDsRequestingTeamReview(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	pulls, err := issues_model.GetIssuesByIDs(ctx, ids, true)
+	if err != nil {
+		return nil, err
+	}
+	return filterPulls(ctx, pulls, skipDrafts, func(pull *issues_model.Issue) bool {
+		return pull.Repo.OwnerID == team.OrgID &&
+			(team.IncludesAllRepositories || organization.HasTeamRepo(ctx, team.OrgID, team.ID, pull.RepoID))
+	})
+}
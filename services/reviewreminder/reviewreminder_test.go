@@ -0,0 +1,65 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package reviewreminder
+
+import (
+	"testing"
+
+	"gitea.dev/models/organization"
+	"gitea.dev/models/unittest"
+	user_model "gitea.dev/models/user"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPendingReviewsOfTeam(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	team := unittest.AssertExistsAndLoadBean(t, &organization.Team{ID: 5})
+	pulls, err := GetPendingReviewsOfTeam(t.Context(), team, false)
+	require.NoError(t, err)
+	require.Len(t, pulls, 1)
+	assert.EqualValues(t, 20, pulls[0].ID)
+
+	// the team doesn't have access to the pull requests
+	team = unittest.AssertExistsAndLoadBean(t, &organization.Team{ID: 7})
+	pulls, err = GetPendingReviewsOfTeam(t.Context(), team, false)
+	require.NoError(t, err)
+	assert.Empty(t, pulls)
+}
+
+func TestGetPendingReviewsOfUser(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	// the review is requested from user15 and from their team
+	user := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 15})
+	pulls, err := GetPendingReviewsOfUser(t.Context(), user, false)
+	require.NoError(t, err)
+	require.Len(t, pulls, 1)
+	assert.EqualValues(t, 20, pulls[0].ID)
+
+	// the review is only requested from the team of user18
+	user = unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 18})
+	pulls, err = GetPendingReviewsOfUser(t.Context(), user, false)
+	require.NoError(t, err)
+	require.Len(t, pulls, 1)
+	assert.EqualValues(t, 20, pulls[0].ID)
+}
+
+func TestWebhookMessage(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	team := unittest.AssertExistsAndLoadBean(t, &organization.Team{ID: 5})
+	pulls, err := GetPendingReviewsOfTeam(t.Context(), team, false)
+	require.NoError(t, err)
+	require.Len(t, pulls, 1)
+	pulls[0].Title = "<script> & friends"
+
+	text, err := webhookMessage(t.Context(), "org17/Owners", pulls)
+	require.NoError(t, err)
+	assert.Equal(t, "1 pull requests are awaiting the review of org17/Owners:\n"+
+		"• <"+pulls[0].HTMLURL(t.Context())+"|org17/big_test_public_4#1 &lt;script&gt; &amp; friends> by user2, opened "+
+		pulls[0].CreatedUnix.Format("2006-01-02"), text)
+}
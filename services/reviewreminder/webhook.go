@@ -0,0 +1,91 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package reviewreminder
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/modules/hostmatcher"
+	"gitea.dev/modules/json"
+	"gitea.dev/modules/proxy"
+	"gitea.dev/modules/setting"
+)
+
+// webhookClient delivers the reminders to the chat webhooks, it's restricted to the allowed hosts of the webhooks
+var webhookClient = sync.OnceValue(func() *http.Client {
+	allowedHostListValue := setting.Webhook.AllowedHostList
+	if allowedHostListValue == "" {
+		allowedHostListValue = hostmatcher.MatchBuiltinExternal
+	}
+	allowedHostMatcher := hostmatcher.ParseHostMatchList("webhook.ALLOWED_HOST_LIST", allowedHostListValue)
+
+	return &http.Client{
+		Timeout: time.Duration(setting.Webhook.DeliverTimeout) * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: setting.Webhook.SkipTLSVerify},
+			Proxy:           proxy.Proxy(),
+			DialContext:     hostmatcher.NewDialContext("review reminder", allowedHostMatcher, nil, setting.Webhook.ProxyURLFixed),
+		},
+	}
+})
+
+// slackEscaper escapes the control characters of the Slack message formatting
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// webhookMessage formats the reminder with the Slack message formatting, which is understood by Mattermost,
+// Google Chat, Rocket.Chat and the other Slack compatible incoming webhooks too
+func webhookMessage(ctx context.Context, reviewer string, pulls issues_model.IssueList) (string, error) {
+	if err := pulls.LoadPosters(ctx); err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d pull requests are awaiting the review of %s:", len(pulls), slackEscaper.Replace(reviewer))
+	for _, pull := range pulls {
+		fmt.Fprintf(&sb, "\n• <%s|%s#%d %s> by %s, opened %s",
+			pull.HTMLURL(ctx),
+			slackEscaper.Replace(pull.Repo.FullName()), pull.Index, slackEscaper.Replace(pull.Title),
+			slackEscaper.Replace(pull.Poster.Name),
+			pull.CreatedUnix.Format("2006-01-02"))
+	}
+	return sb.String(), nil
+}
+
+func deliverWebhook(ctx context.Context, webhookURL, reviewer string, pulls issues_model.IssueList) error {
+	text, err := webhookMessage(ctx, reviewer, pulls)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Gitea "+setting.AppVer)
+
+	resp, err := webhookClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package sla
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gitea.dev/models/db"
+	issues_model "gitea.dev/models/issues"
+	repo_model "gitea.dev/models/repo"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/optional"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/services/mailer"
+)
+
+// validatePolicyLabel checks that the label of the policy belongs to its organization,
+// the policies of a repository can use the labels of its organization too
+func validatePolicyLabel(ctx context.Context, p *issues_model.SLAPolicy) error {
+	if p.OrgID > 0 {
+		_, err := issues_model.GetLabelInOrgByID(ctx, p.OrgID, p.LabelID)
+		return err
+	}
+	_, err := issues_model.GetLabelInRepoByID(ctx, p.RepoID, p.LabelID)
+	if !issues_model.IsErrRepoLabelNotExist(err) {
+		return err
+	}
+	repo, err := repo_model.GetRepositoryByID(ctx, p.RepoID)
+	if err != nil {
+		return err
+	}
+	if err := repo.LoadOwner(ctx); err != nil {
+		return err
+	}
+	if !repo.Owner.IsOrganization() {
+		return issues_model.ErrRepoLabelNotExist{LabelID: p.LabelID, RepoID: p.RepoID}
+	}
+	_, err = issues_model.GetLabelInOrgByID(ctx, repo.OwnerID, p.LabelID)
+	return err
+}
+
+// CreatePolicy validates and creates an SLA policy
+func CreatePolicy(ctx context.Context, p *issues_model.SLAPolicy) error {
+	p.Name = strings.TrimSpace(p.Name)
+	if err := validatePolicyLabel(ctx, p); err != nil {
+		return fmt.Errorf("the label of the SLA policy: %w", err)
+	}
+	return issues_model.CreateSLAPolicy(ctx, p)
+}
+
+// UpdatePolicy validates and updates an SLA policy
+func UpdatePolicy(ctx context.Context, p *issues_model.SLAPolicy) error {
+	p.Name = strings.TrimSpace(p.Name)
+	if err := validatePolicyLabel(ctx, p); err != nil {
+		return fmt.Errorf("the label of the SLA policy: %w", err)
+	}
+	return issues_model.UpdateSLAPolicy(ctx, p)
+}
+
+// SendReminders reminds the assignees of the open issues whose next SLA target is due before the reminder time,
+// each target of an issue is only reminded once
+func SendReminders(ctx context.Context) error {
+	policies, err := issues_model.GetAllSLAPolicies(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := timeutil.TimeStampNow()
+	remindBefore := now.AddDuration(setting.SLA.ReminderBefore)
+	for _, policy := range policies {
+		select {
+		case <-ctx.Done():
+			return db.ErrCancelledf("before sending the reminders of SLA policy %d", policy.ID)
+		default:
+		}
+
+		issueIDs, err := issues_model.GetOpenIssueIDsBySLAPolicy(ctx, policy)
+		if err != nil {
+			return err
+		}
+		issues, err := issues_model.GetIssuesByIDs(ctx, issueIDs)
+		if err != nil {
+			return err
+		}
+		issuesSLA, err := issues_model.GetIssuesSLA(ctx, issues)
+		if err != nil {
+			return err
+		}
+
+		for _, issue := range issues {
+			for _, s := range issuesSLA[issue.ID] {
+				if s.Policy.ID != policy.ID {
+					continue
+				}
+				// the breached targets are not reminded, they can't be met anymore
+				if due := s.NextDue(); due == 0 || due <= now || due > remindBefore {
+					continue
+				}
+				if err := sendReminder(ctx, s); err != nil {
+					log.Error("Send the SLA reminder of issue %d: %v", issue.ID, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func sendReminder(ctx context.Context, s *issues_model.IssueSLA) error {
+	target := s.NextTarget()
+	has, err := issues_model.HasSLAReminder(ctx, s.Policy.ID, s.Issue.ID, target)
+	if err != nil || has {
+		return err
+	}
+	if err := issues_model.CreateSLAReminder(ctx, s.Policy.ID, s.Issue.ID, target); err != nil {
+		return err
+	}
+	return mailer.SendSLAReminderMail(ctx, s)
+}
+
+// GetBreachedIssuesSLA returns the SLA of the issues of a repository which are breached, the newest issues first
+func GetBreachedIssuesSLA(ctx context.Context, repo *repo_model.Repository, isClosed optional.Option[bool]) ([]*issues_model.IssueSLA, error) {
+	policies, err := issues_model.GetAvailableSLAPolicies(ctx, repo.OwnerID, repo.ID)
+	if err != nil || len(policies) == 0 {
+		return nil, err
+	}
+	labelIDs := make([]int64, 0, len(policies))
+	for _, policy := range policies {
+		labelIDs = append(labelIDs, policy.LabelID)
+	}
+	issueIDs, err := issues_model.GetIssueIDsByRepoAndLabels(ctx, repo.ID, labelIDs, isClosed)
+	if err != nil {
+		return nil, err
+	}
+	issues, err := issues_model.GetIssuesByIDs(ctx, issueIDs, true)
+	if err != nil {
+		return nil, err
+	}
+	issuesSLA, err := issues_model.GetIssuesSLA(ctx, issues)
+	if err != nil {
+		return nil, err
+	}
+
+	breached := make([]*issues_model.IssueSLA, 0, len(issuesSLA))
+	for _, issue := range issues {
+		for _, s := range issuesSLA[issue.ID] {
+			if s.IsBreached() {
+				breached = append(breached, s)
+			}
+		}
+	}
+	return breached, nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package sla
+
+import (
+	"testing"
+	"time"
+
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/test"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePolicy(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	policy := &issues_model.SLAPolicy{RepoID: 1, Name: " Bugs ", LabelID: 1, FirstResponseHours: 4}
+	require.NoError(t, CreatePolicy(t.Context(), policy))
+	assert.Equal(t, "Bugs", policy.Name)
+
+	// the repository of user2 can't use the labels of an organization
+	err := CreatePolicy(t.Context(), &issues_model.SLAPolicy{RepoID: 1, Name: "Bugs", LabelID: 3, FirstResponseHours: 4})
+	assert.ErrorIs(t, err, util.ErrNotExist)
+	// the repository of org3 can use its labels and the labels of org3
+	require.NoError(t, CreatePolicy(t.Context(), &issues_model.SLAPolicy{RepoID: 3, Name: "Bugs", LabelID: 10, FirstResponseHours: 4}))
+	require.NoError(t, CreatePolicy(t.Context(), &issues_model.SLAPolicy{RepoID: 3, Name: "Bugs", LabelID: 3, FirstResponseHours: 4}))
+	// an organization can only use its own labels
+	err = CreatePolicy(t.Context(), &issues_model.SLAPolicy{OrgID: 3, Name: "Bugs", LabelID: 1, FirstResponseHours: 4})
+	assert.ErrorIs(t, err, util.ErrNotExist)
+	require.NoError(t, CreatePolicy(t.Context(), &issues_model.SLAPolicy{OrgID: 3, Name: "Bugs", LabelID: 4, FirstResponseHours: 4}))
+}
+
+func TestSendReminders(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	defer test.MockVariableValue(&setting.DefaultUILocation, time.UTC)()
+
+	policy := &issues_model.SLAPolicy{RepoID: 1, Name: "Bugs", LabelID: 1, ResolutionDays: 2}
+	require.NoError(t, CreatePolicy(t.Context(), policy))
+
+	// the resolution of the issues with label1 is due on 2000-01-04 at 17:00, nothing is reminded a day before
+	defer timeutil.MockSet(time.Date(2000, 1, 3, 16, 0, 0, 0, time.UTC))()
+	require.NoError(t, SendReminders(t.Context()))
+	unittest.AssertCount(t, &issues_model.SLAReminder{PolicyID: policy.ID}, 0)
+
+	timeutil.MockSet(time.Date(2000, 1, 4, 16, 0, 0, 0, time.UTC))
+	require.NoError(t, SendReminders(t.Context()))
+	unittest.AssertExistsAndLoadBean(t, &issues_model.SLAReminder{PolicyID: policy.ID, IssueID: 1, Target: issues_model.SLATargetResolution})
+	unittest.AssertCount(t, &issues_model.SLAReminder{PolicyID: policy.ID}, 2)
+
+	// each target is only reminded once
+	require.NoError(t, SendReminders(t.Context()))
+	unittest.AssertCount(t, &issues_model.SLAReminder{PolicyID: policy.ID}, 2)
+
+	// the breached targets aren't reminded
+	require.NoError(t, issues_model.DeleteSLAPolicy(t.Context(), policy))
+	policy = &issues_model.SLAPolicy{RepoID: 1, Name: "Bugs", LabelID: 1, ResolutionDays: 2}
+	require.NoError(t, CreatePolicy(t.Context(), policy))
+	timeutil.MockSet(time.Date(2000, 1, 5, 16, 0, 0, 0, time.UTC))
+	require.NoError(t, SendReminders(t.Context()))
+	unittest.AssertCount(t, &issues_model.SLAReminder{PolicyID: policy.ID}, 0)
+}
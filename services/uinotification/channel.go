@@ -0,0 +1,263 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package uinotification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	actions_model "gitea.dev/models/actions"
+	activities_model "gitea.dev/models/activities"
+	issues_model "gitea.dev/models/issues"
+	repo_model "gitea.dev/models/repo"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/graceful"
+	"gitea.dev/modules/hostmatcher"
+	"gitea.dev/modules/json"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/proxy"
+	"gitea.dev/modules/queue"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/timeutil"
+	"gitea.dev/modules/util"
+)
+
+// personalNotification is a notification pushed to the personal channels of a user
+type personalNotification struct {
+	UserID     int64                               `json:"-"`
+	Reason     activities_model.NotificationReason `json:"reason"`
+	Title      string                              `json:"title"`
+	Body       string                              `json:"body"`
+	URL        string                              `json:"url"`
+	Repository string                              `json:"repository"`
+	Sender     string                              `json:"sender"`
+	Created    timeutil.TimeStamp                  `json:"created"`
+}
+
+var (
+	channelQueue      *queue.WorkerPoolQueue[personalNotification]
+	channelHTTPClient *http.Client
+)
+
+func initChannelDelivery() error {
+	allowedHostListValue := setting.Webhook.AllowedHostList
+	if allowedHostListValue == "" {
+		allowedHostListValue = hostmatcher.MatchBuiltinExternal
+	}
+	allowedHostMatcher := hostmatcher.ParseHostMatchList("webhook.ALLOWED_HOST_LIST", allowedHostListValue)
+
+	channelHTTPClient = &http.Client{
+		Timeout: time.Duration(setting.Webhook.DeliverTimeout) * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: setting.Webhook.SkipTLSVerify},
+			Proxy:           proxy.Proxy(),
+			DialContext:     hostmatcher.NewDialContext("personal notification", allowedHostMatcher, nil, setting.Webhook.ProxyURLFixed),
+		},
+	}
+
+	channelQueue = queue.CreateSimpleQueue(graceful.GetManager().ShutdownContext(), "notification-channel", channelHandler)
+	if channelQueue == nil {
+		return errors.New("unable to create notification-channel queue")
+	}
+	go graceful.GetManager().RunWithCancel(channelQueue)
+	return nil
+}
+
+func channelHandler(items ...personalNotification) []personalNotification {
+	ctx := graceful.GetManager().ShutdownContext()
+	for _, n := range items {
+		channels, err := activities_model.GetNotificationChannelsForReason(ctx, n.UserID, n.Reason)
+		if err != nil {
+			log.Error("GetNotificationChannelsForReason: %v", err)
+			continue
+		}
+		for _, c := range channels {
+			if err := deliverToChannel(ctx, c, &n); err != nil {
+				log.Warn("Unable to deliver notification to channel[%d] of user[%d]: %v", c.ID, c.UserID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// pushIssueNotification pushes the issue notification of each receiver to their personal channels and browsers,
+// with the reason they are notified for
+func pushIssueNotification(ctx context.Context, opts issueNotificationOpts, receivers map[int64]activities_model.NotificationReason) {
+	if (channelQueue == nil && webPushQueue == nil) || len(receivers) == 0 {
+		return
+	}
+
+	issue, err := issues_model.GetIssueByID(ctx, opts.IssueID)
+	if err != nil {
+		log.Error("GetIssueByID: %v", err)
+		return
+	}
+	if err := issue.LoadRepo(ctx); err != nil {
+		log.Error("LoadRepo: %v", err)
+		return
+	}
+	_, sender, err := user_model.GetPossibleUserByID(ctx, opts.NotificationAuthorID)
+	if err != nil {
+		log.Error("GetPossibleUserByID: %v", err)
+		return
+	}
+
+	link := issue.HTMLURL(ctx)
+	if opts.CommentID != 0 {
+		comment, err := issues_model.GetCommentByID(ctx, opts.CommentID)
+		if err != nil && !issues_model.IsErrCommentNotExist(err) {
+			log.Error("GetCommentByID: %v", err)
+			return
+		}
+		if comment != nil {
+			link = comment.HTMLURL(ctx)
+		}
+	}
+
+	for receiverID, reason := range receivers {
+		n := personalNotification{
+			UserID:     receiverID,
+			Reason:     reason,
+			Title:      fmt.Sprintf("[%s] %s (#%d)", issue.Repo.FullName(), issue.Title, issue.Index),
+			Body:       issueNotificationBody(reason, sender.Name, issue.IsPull),
+			URL:        link,
+			Repository: issue.Repo.FullName(),
+			Sender:     sender.Name,
+			Created:    timeutil.TimeStampNow(),
+		}
+		if channelQueue != nil {
+			_ = channelQueue.Push(n)
+		}
+		if webPushQueue != nil {
+			_ = webPushQueue.Push(n)
+		}
+	}
+}
+
+func issueNotificationBody(reason activities_model.NotificationReason, sender string, isPull bool) string {
+	switch reason {
+	case activities_model.NotificationReasonMention:
+		return fmt.Sprintf("@%s mentioned you", sender)
+	case activities_model.NotificationReasonReviewRequested:
+		return fmt.Sprintf("@%s requested your review", sender)
+	case activities_model.NotificationReasonAssigned:
+		return fmt.Sprintf("@%s assigned you", sender)
+	}
+	if isPull {
+		return fmt.Sprintf("New activity by @%s on a pull request you are subscribed to", sender)
+	}
+	return fmt.Sprintf("New activity by @%s on an issue you are subscribed to", sender)
+}
+
+func deliverToChannel(ctx context.Context, c *activities_model.NotificationChannel, n *personalNotification) error {
+	req, err := newChannelRequest(c, n)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", "Gitea "+setting.AppVer)
+
+	resp, err := channelHTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, body)
+	}
+	return nil
+}
+
+// matrixMessage is the content of a m.room.message event
+type matrixMessage struct {
+	MsgType       string `json:"msgtype"`
+	Body          string `json:"body"`
+	Format        string `json:"format"`
+	FormattedBody string `json:"formatted_body"`
+}
+
+func newChannelRequest(c *activities_model.NotificationChannel, n *personalNotification) (*http.Request, error) {
+	token, err := c.Token()
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt token: %w", err)
+	}
+
+	var req *http.Request
+	switch c.Type {
+	case activities_model.NotificationChannelWebhook:
+		body, err := json.Marshal(n)
+		if err != nil {
+			return nil, err
+		}
+		if req, err = http.NewRequest(http.MethodPost, c.URL, bytes.NewReader(body)); err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+	case activities_model.NotificationChannelNtfy:
+		if req, err = http.NewRequest(http.MethodPost, c.URL, strings.NewReader(n.Body)); err != nil {
+			return nil, err
+		}
+		req.Header.Set("Title", n.Title)
+		req.Header.Set("Click", n.URL)
+		req.Header.Set("Tags", string(n.Reason))
+	case activities_model.NotificationChannelMatrix:
+		body, err := json.Marshal(matrixMessage{
+			MsgType:       "m.notice",
+			Body:          fmt.Sprintf("%s\n%s\n%s", n.Title, n.Body, n.URL),
+			Format:        "org.matrix.custom.html",
+			FormattedBody: fmt.Sprintf(`<a href="%s">%s</a><br>%s`, html.EscapeString(n.URL), html.EscapeString(n.Title), html.EscapeString(n.Body)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		endpoint := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s",
+			strings.TrimSuffix(c.URL, "/"), url.PathEscape(c.RoomID), util.CryptoRandomString(16))
+		if req, err = http.NewRequest(http.MethodPut, endpoint, bytes.NewReader(body)); err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+	default:
+		return nil, fmt.Errorf("unsupported notification channel type: %s", c.Type)
+	}
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return req, nil
+}
+
+// pushWorkflowRunFailure pushes a failed workflow run to the personal channels and browsers of the user who triggered it
+func pushWorkflowRunFailure(repo *repo_model.Repository, triggerUser *user_model.User, run *actions_model.ActionRun) {
+	if (channelQueue == nil && webPushQueue == nil) || triggerUser.IsGhost() || triggerUser.ID <= 0 {
+		return
+	}
+	if run.Repo == nil {
+		run.Repo = repo
+	}
+	n := personalNotification{
+		UserID:     triggerUser.ID,
+		Reason:     activities_model.NotificationReasonCIFailed,
+		Title:      fmt.Sprintf("[%s] %s", repo.FullName(), run.Title),
+		Body:       fmt.Sprintf("Workflow %s failed on %s", run.WorkflowID, run.PrettyRef()),
+		URL:        run.HTMLURL(),
+		Repository: repo.FullName(),
+		Sender:     triggerUser.Name,
+		Created:    timeutil.TimeStampNow(),
+	}
+	if channelQueue != nil {
+		_ = channelQueue.Push(n)
+	}
+	if webPushQueue != nil {
+		_ = webPushQueue.Push(n)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package uinotification
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	activities_model "gitea.dev/models/activities"
+	"gitea.dev/modules/json"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChannelRequest(t *testing.T) {
+	n := &personalNotification{
+		UserID:     2,
+		Reason:     activities_model.NotificationReasonMention,
+		Title:      "[user2/repo1] issue1 (#1)",
+		Body:       "@user1 mentioned you",
+		URL:        "http://localhost:3000/user2/repo1/issues/1",
+		Repository: "user2/repo1",
+		Sender:     "user1",
+	}
+
+	t.Run("Webhook", func(t *testing.T) {
+		req, err := newChannelRequest(&activities_model.NotificationChannel{Type: activities_model.NotificationChannelWebhook, URL: "https://example.com/hook"}, n)
+		require.NoError(t, err)
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		assert.Empty(t, req.Header.Get("Authorization"))
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		var payload map[string]any
+		require.NoError(t, json.Unmarshal(body, &payload))
+		assert.Equal(t, "mention", payload["reason"])
+		assert.Equal(t, n.URL, payload["url"])
+		assert.NotContains(t, payload, "UserID")
+	})
+
+	t.Run("Ntfy", func(t *testing.T) {
+		req, err := newChannelRequest(&activities_model.NotificationChannel{Type: activities_model.NotificationChannelNtfy, URL: "https://ntfy.sh/topic"}, n)
+		require.NoError(t, err)
+		assert.Equal(t, "https://ntfy.sh/topic", req.URL.String())
+		assert.Equal(t, n.Title, req.Header.Get("Title"))
+		assert.Equal(t, n.URL, req.Header.Get("Click"))
+		assert.Equal(t, "mention", req.Header.Get("Tags"))
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.Equal(t, n.Body, string(body))
+	})
+
+	t.Run("Matrix", func(t *testing.T) {
+		c := &activities_model.NotificationChannel{Type: activities_model.NotificationChannelMatrix, URL: "https://matrix.example.com/", RoomID: "!room:example.com"}
+		require.NoError(t, c.SetToken("access-token"))
+		req, err := newChannelRequest(c, n)
+		require.NoError(t, err)
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.True(t, strings.HasPrefix(req.URL.String(), "https://matrix.example.com/_matrix/client/v3/rooms/%21room:example.com/send/m.room.message/"), req.URL.String())
+		assert.Equal(t, "Bearer access-token", req.Header.Get("Authorization"))
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		var msg matrixMessage
+		require.NoError(t, json.Unmarshal(body, &msg))
+		assert.Equal(t, "m.notice", msg.MsgType)
+		assert.Contains(t, msg.FormattedBody, `<a href="http://localhost:3000/user2/repo1/issues/1">`)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := newChannelRequest(&activities_model.NotificationChannel{Type: "email", URL: "https://example.com"}, n)
+		assert.Error(t, err)
+	})
+}
+
+func TestIssueNotificationBody(t *testing.T) {
+	assert.Equal(t, "@user1 requested your review", issueNotificationBody(activities_model.NotificationReasonReviewRequested, "user1", true))
+	assert.Equal(t, "@user1 assigned you", issueNotificationBody(activities_model.NotificationReasonAssigned, "user1", false))
+	assert.Equal(t, "New activity by @user1 on a pull request you are subscribed to", issueNotificationBody(activities_model.NotificationReasonSubscribed, "user1", true))
+}
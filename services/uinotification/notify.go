@@ -4,8 +4,10 @@
 package uinotification
 
 import (
+	"cmp"
 	"context"
 
+	actions_model "gitea.dev/models/actions"
 	activities_model "gitea.dev/models/activities"
 	"gitea.dev/models/db"
 	issues_model "gitea.dev/models/issues"
@@ -15,6 +17,7 @@ import (
 	"gitea.dev/modules/graceful"
 	"gitea.dev/modules/log"
 	"gitea.dev/modules/queue"
+	issue_service "gitea.dev/services/issue"
 	notify_service "gitea.dev/services/notify"
 )
 
@@ -28,14 +31,22 @@ type (
 		IssueID              int64
 		CommentID            int64
 		NotificationAuthorID int64
-		ReceiverID           int64 // 0 -- ALL Watcher
+		ReceiverID           int64   // 0 -- ALL Watcher
+		WatcherIDs           []int64 // the watchers to notify if not nil, else the ones of the issue
+		MentionIDs           []int64 // the mentioned users, notified as such rather than as watchers
+		MatchSavedSearches   bool    // also notify the subscribers of matching saved searches
+		IsNewIssue           bool    // the assignees and the requested reviewers are notified by their own events
+		Reason               activities_model.NotificationReason
 	}
 )
 
 func Init() error {
 	notify_service.RegisterNotifier(NewNotifier())
 
-	return nil
+	if err := initChannelDelivery(); err != nil {
+		return err
+	}
+	return initWebPush()
 }
 
 var _ notify_service.Notifier = &notificationService{}
@@ -51,10 +62,100 @@ func NewNotifier() notify_service.Notifier {
 }
 
 func handler(items ...issueNotificationOpts) []issueNotificationOpts {
+	ctx := graceful.GetManager().ShutdownContext()
 	for _, opts := range items {
-		if err := activities_model.CreateOrUpdateIssueNotifications(graceful.GetManager().ShutdownContext(), opts.IssueID, opts.CommentID, opts.NotificationAuthorID, opts.ReceiverID); err != nil {
+		receivers, err := createIssueNotifications(ctx, opts)
+		if err != nil {
 			log.Error("Was unable to create issue notification: %v", err)
+			continue
+		}
+		pushIssueNotification(ctx, opts, receivers)
+	}
+	return nil
+}
+
+// createIssueNotifications creates or updates the notifications of an issue event and returns the receivers to push it to,
+// each receiver only once with the most specific reason
+func createIssueNotifications(ctx context.Context, opts issueNotificationOpts) (map[int64]activities_model.NotificationReason, error) {
+	receivers := make(map[int64]activities_model.NotificationReason)
+	notify := func(receiverID int64, reason activities_model.NotificationReason) error {
+		receiverIDs, err := activities_model.CreateOrUpdateIssueNotificationsWithReceivers(ctx, opts.IssueID, opts.CommentID, opts.NotificationAuthorID, receiverID)
+		if err != nil {
+			return err
+		}
+		for _, id := range receiverIDs {
+			if _, ok := receivers[id]; !ok || reason != activities_model.NotificationReasonSubscribed {
+				receivers[id] = reason
+			}
+		}
+		return nil
+	}
+
+	if opts.WatcherIDs != nil {
+		for _, watcherID := range opts.WatcherIDs {
+			if err := notify(watcherID, activities_model.NotificationReasonSubscribed); err != nil {
+				return nil, err
+			}
+		}
+	} else if err := notify(opts.ReceiverID, cmp.Or(opts.Reason, activities_model.NotificationReasonSubscribed)); err != nil {
+		return nil, err
+	}
+	if opts.ReceiverID > 0 {
+		return receivers, nil
+	}
+
+	var issue *issues_model.Issue
+	if opts.MatchSavedSearches || opts.IsNewIssue {
+		var err error
+		if issue, err = issues_model.GetIssueByID(ctx, opts.IssueID); err != nil {
+			return nil, err
+		}
+	}
+	if opts.MatchSavedSearches {
+		subscriberIDs, err := issue_service.GetSavedSearchSubscriberIDs(ctx, issue)
+		if err != nil {
+			return nil, err
 		}
+		for _, subscriberID := range subscriberIDs {
+			if subscriberID == opts.NotificationAuthorID {
+				continue
+			}
+			if err := notify(subscriberID, activities_model.NotificationReasonSubscribed); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, mentionID := range opts.MentionIDs {
+		if err := notify(mentionID, activities_model.NotificationReasonMention); err != nil {
+			return nil, err
+		}
+	}
+	if opts.IsNewIssue {
+		if err := excludeAssigneesAndReviewers(ctx, issue, receivers); err != nil {
+			return nil, err
+		}
+	}
+	return receivers, nil
+}
+
+// excludeAssigneesAndReviewers removes the assignees and the requested reviewers of a new issue from the receivers to push it to,
+// they are pushed the more specific assignment and review request events instead
+func excludeAssigneesAndReviewers(ctx context.Context, issue *issues_model.Issue, receivers map[int64]activities_model.NotificationReason) error {
+	if err := issue.LoadAssignees(ctx); err != nil {
+		return err
+	}
+	for _, assignee := range issue.Assignees {
+		delete(receivers, assignee.ID)
+	}
+	if !issue.IsPull {
+		return nil
+	}
+	reviewerIDs, err := issues_model.GetReviewRequestedUserIDs(ctx, issue.ID)
+	if err != nil {
+		return err
+	}
+	for _, reviewerID := range reviewerIDs {
+		delete(receivers, reviewerID)
 	}
 	return nil
 }
@@ -69,36 +170,30 @@ func (ns *notificationService) CreateIssueComment(ctx context.Context, doer *use
 	opts := issueNotificationOpts{
 		IssueID:              issue.ID,
 		NotificationAuthorID: doer.ID,
+		MentionIDs:           mentionIDs(mentions),
 	}
 	if comment != nil {
 		opts.CommentID = comment.ID
 	}
 	_ = ns.issueQueue.Push(opts)
+}
+
+func mentionIDs(mentions []*user_model.User) []int64 {
+	ids := make([]int64, 0, len(mentions))
 	for _, mention := range mentions {
-		opts := issueNotificationOpts{
-			IssueID:              issue.ID,
-			NotificationAuthorID: doer.ID,
-			ReceiverID:           mention.ID,
-		}
-		if comment != nil {
-			opts.CommentID = comment.ID
-		}
-		_ = ns.issueQueue.Push(opts)
+		ids = append(ids, mention.ID)
 	}
+	return ids
 }
 
 func (ns *notificationService) NewIssue(ctx context.Context, issue *issues_model.Issue, mentions []*user_model.User) {
 	_ = ns.issueQueue.Push(issueNotificationOpts{
 		IssueID:              issue.ID,
 		NotificationAuthorID: issue.Poster.ID,
+		MentionIDs:           mentionIDs(mentions),
+		MatchSavedSearches:   true,
+		IsNewIssue:           true,
 	})
-	for _, mention := range mentions {
-		_ = ns.issueQueue.Push(issueNotificationOpts{
-			IssueID:              issue.ID,
-			NotificationAuthorID: issue.Poster.ID,
-			ReceiverID:           mention.ID,
-		})
-	}
 }
 
 func (ns *notificationService) IssueChangeStatus(ctx context.Context, doer *user_model.User, commitID string, issue *issues_model.Issue, actionComment *issues_model.Comment, isClosed bool) {
@@ -156,38 +251,26 @@ func (ns *notificationService) NewPullRequest(ctx context.Context, pr *issues_mo
 		toNotify.Add(id)
 	}
 	delete(toNotify, pr.Issue.PosterID)
-	for _, mention := range mentions {
-		toNotify.Add(mention.ID)
-	}
-	for receiverID := range toNotify {
-		_ = ns.issueQueue.Push(issueNotificationOpts{
-			IssueID:              pr.Issue.ID,
-			NotificationAuthorID: pr.Issue.PosterID,
-			ReceiverID:           receiverID,
-		})
-	}
+	_ = ns.issueQueue.Push(issueNotificationOpts{
+		IssueID:              pr.Issue.ID,
+		NotificationAuthorID: pr.Issue.PosterID,
+		WatcherIDs:           toNotify.Values(),
+		MentionIDs:           mentionIDs(mentions),
+		MatchSavedSearches:   true,
+		IsNewIssue:           true,
+	})
 }
 
 func (ns *notificationService) PullRequestReview(ctx context.Context, pr *issues_model.PullRequest, r *issues_model.Review, c *issues_model.Comment, mentions []*user_model.User) {
 	opts := issueNotificationOpts{
 		IssueID:              pr.Issue.ID,
 		NotificationAuthorID: r.Reviewer.ID,
+		MentionIDs:           mentionIDs(mentions),
 	}
 	if c != nil {
 		opts.CommentID = c.ID
 	}
 	_ = ns.issueQueue.Push(opts)
-	for _, mention := range mentions {
-		opts := issueNotificationOpts{
-			IssueID:              pr.Issue.ID,
-			NotificationAuthorID: r.Reviewer.ID,
-			ReceiverID:           mention.ID,
-		}
-		if c != nil {
-			opts.CommentID = c.ID
-		}
-		_ = ns.issueQueue.Push(opts)
-	}
 }
 
 func (ns *notificationService) PullRequestCodeComment(ctx context.Context, pr *issues_model.PullRequest, c *issues_model.Comment, mentions []*user_model.User) {
@@ -197,6 +280,7 @@ func (ns *notificationService) PullRequestCodeComment(ctx context.Context, pr *i
 			NotificationAuthorID: c.Poster.ID,
 			CommentID:            c.ID,
 			ReceiverID:           mention.ID,
+			Reason:               activities_model.NotificationReasonMention,
 		})
 	}
 }
@@ -225,6 +309,7 @@ func (ns *notificationService) IssueChangeAssignee(ctx context.Context, doer *us
 			IssueID:              issue.ID,
 			NotificationAuthorID: doer.ID,
 			ReceiverID:           assignee.ID,
+			Reason:               activities_model.NotificationReasonAssigned,
 		}
 
 		if comment != nil {
@@ -241,6 +326,7 @@ func (ns *notificationService) PullRequestReviewRequest(ctx context.Context, doe
 			IssueID:              issue.ID,
 			NotificationAuthorID: doer.ID,
 			ReceiverID:           reviewer.ID,
+			Reason:               activities_model.NotificationReasonReviewRequested,
 		}
 
 		if comment != nil {
@@ -251,6 +337,12 @@ func (ns *notificationService) PullRequestReviewRequest(ctx context.Context, doe
 	}
 }
 
+func (ns *notificationService) WorkflowRunStatusUpdate(ctx context.Context, repo *repo_model.Repository, sender *user_model.User, run *actions_model.ActionRun) {
+	if run.Status.IsFailure() {
+		pushWorkflowRunFailure(repo, sender, run)
+	}
+}
+
 func (ns *notificationService) RepoPendingTransfer(ctx context.Context, doer, newOwner *user_model.User, repo *repo_model.Repository) {
 	err := db.WithTx(ctx, func(ctx context.Context) error {
 		return activities_model.CreateRepoTransferNotification(ctx, doer, newOwner, repo)
@@ -0,0 +1,45 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package uinotification
+
+import (
+	"testing"
+
+	activities_model "gitea.dev/models/activities"
+	"gitea.dev/models/unittest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateIssueNotifications(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	// user4 watches the repository and is mentioned, they are only notified of the mention
+	opts := issueNotificationOpts{IssueID: 1, NotificationAuthorID: 2, MentionIDs: []int64{4}}
+	receivers, err := createIssueNotifications(t.Context(), opts)
+	require.NoError(t, err)
+	assert.Equal(t, activities_model.NotificationReasonMention, receivers[4])
+	assert.Equal(t, activities_model.NotificationReasonSubscribed, receivers[8])
+	assert.Equal(t, activities_model.NotificationReasonSubscribed, receivers[1])
+	assert.NotContains(t, receivers, int64(2))
+
+	// the assignee of a new issue is notified by the assignment instead
+	opts.IsNewIssue = true
+	receivers, err = createIssueNotifications(t.Context(), opts)
+	require.NoError(t, err)
+	assert.Equal(t, activities_model.NotificationReasonMention, receivers[4])
+	assert.Equal(t, activities_model.NotificationReasonSubscribed, receivers[8])
+	assert.NotContains(t, receivers, int64(1))
+
+	// a single receiver is notified with the reason of the event
+	receivers, err = createIssueNotifications(t.Context(), issueNotificationOpts{
+		IssueID:              1,
+		NotificationAuthorID: 2,
+		ReceiverID:           4,
+		Reason:               activities_model.NotificationReasonAssigned,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]activities_model.NotificationReason{4: activities_model.NotificationReasonAssigned}, receivers)
+}
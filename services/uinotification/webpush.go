@@ -0,0 +1,159 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package uinotification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	activities_model "gitea.dev/models/activities"
+	"gitea.dev/modules/graceful"
+	"gitea.dev/modules/json"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/queue"
+	"gitea.dev/modules/secret"
+	"gitea.dev/modules/setting"
+	"gitea.dev/modules/system"
+	"gitea.dev/modules/webpush"
+)
+
+// vapidKeysState is the app state item storing the VAPID keys of the instance
+type vapidKeysState struct {
+	PrivateKeyEncrypted string `json:"private_key_encrypted"`
+	PublicKey           string `json:"public_key"`
+}
+
+// Name returns the item name
+func (vapidKeysState) Name() string {
+	return "web-push-vapid-keys"
+}
+
+var (
+	webPushQueue *queue.WorkerPoolQueue[personalNotification]
+
+	vapidKeysMu sync.Mutex
+	vapidKeys   *webpush.VAPIDKeys
+)
+
+func initWebPush() error {
+	if !setting.WebPush.Enabled {
+		return nil
+	}
+	webPushQueue = queue.CreateSimpleQueue(graceful.GetManager().ShutdownContext(), "web-push", webPushHandler)
+	if webPushQueue == nil {
+		return errors.New("unable to create web-push queue")
+	}
+	go graceful.GetManager().RunWithCancel(webPushQueue)
+	return nil
+}
+
+// getVAPIDKeys returns the VAPID keys of the instance, they are generated on first use
+func getVAPIDKeys(ctx context.Context) (*webpush.VAPIDKeys, error) {
+	vapidKeysMu.Lock()
+	defer vapidKeysMu.Unlock()
+	if vapidKeys != nil {
+		return vapidKeys, nil
+	}
+
+	state := &vapidKeysState{}
+	if err := system.AppState.Get(ctx, state); err != nil {
+		return nil, err
+	}
+	if state.PublicKey != "" {
+		privateKey, err := secret.DecryptSecret(setting.SecretKey, state.PrivateKeyEncrypted)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decrypt the VAPID private key: %w", err)
+		}
+		vapidKeys = &webpush.VAPIDKeys{PrivateKey: privateKey, PublicKey: state.PublicKey}
+		return vapidKeys, nil
+	}
+
+	keys, err := webpush.GenerateVAPIDKeys()
+	if err != nil {
+		return nil, err
+	}
+	state.PublicKey = keys.PublicKey
+	if state.PrivateKeyEncrypted, err = secret.EncryptSecret(setting.SecretKey, keys.PrivateKey); err != nil {
+		return nil, err
+	}
+	if err := system.AppState.Set(ctx, state); err != nil {
+		return nil, err
+	}
+	vapidKeys = keys
+	return vapidKeys, nil
+}
+
+// WebPushPublicKey returns the VAPID public key the browsers have to subscribe with
+func WebPushPublicKey(ctx context.Context) (string, error) {
+	keys, err := getVAPIDKeys(ctx)
+	if err != nil {
+		return "", err
+	}
+	return keys.PublicKey, nil
+}
+
+func webPushHandler(items ...personalNotification) []personalNotification {
+	ctx := graceful.GetManager().ShutdownContext()
+	keys, err := getVAPIDKeys(ctx)
+	if err != nil {
+		log.Error("Unable to get the VAPID keys: %v", err)
+		return nil
+	}
+	for _, n := range items {
+		subs, err := activities_model.GetPushSubscriptionsByUserID(ctx, n.UserID)
+		if err != nil {
+			log.Error("GetPushSubscriptionsByUserID: %v", err)
+			continue
+		}
+		if len(subs) == 0 {
+			continue
+		}
+		payload, err := json.Marshal(n)
+		if err != nil {
+			log.Error("Marshal: %v", err)
+			continue
+		}
+		for _, sub := range subs {
+			if err := deliverWebPush(ctx, keys, sub, payload); err != nil {
+				log.Warn("Unable to deliver web push to subscription[%d] of user[%d]: %v", sub.ID, sub.UserID, err)
+			}
+		}
+	}
+	return nil
+}
+
+func deliverWebPush(ctx context.Context, keys *webpush.VAPIDKeys, sub *activities_model.PushSubscription, payload []byte) error {
+	req, err := webpush.NewRequest(&webpush.Subscription{
+		Endpoint: sub.Endpoint,
+		P256DH:   sub.P256DH,
+		Auth:     sub.Auth,
+	}, keys, payload, webpush.Options{
+		Subject: setting.WebPush.Subject,
+		TTL:     setting.WebPush.TTL,
+	})
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", "Gitea "+setting.AppVer)
+
+	resp, err := channelHTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		// the subscription has expired or the user has revoked the permission
+		log.Trace("Removing expired push subscription[%d] of user[%d]", sub.ID, sub.UserID)
+		return activities_model.DeletePushSubscription(ctx, sub.UserID, sub.ID)
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, body)
+	}
+	return nil
+}
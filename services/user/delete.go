@@ -96,10 +96,20 @@ func deleteUser(ctx context.Context, u *user_model.User, purge bool) (err error)
 		&user_model.Blocking{BlockeeID: u.ID},
 		&actions_model.ActionRunnerToken{OwnerID: u.ID},
 		&actions_model.ActionScopedWorkflowSource{OwnerID: u.ID},
+		&issues_model.SavedSearchSubscription{UserID: u.ID},
+		&activities_model.NotificationChannel{UserID: u.ID},
+		&activities_model.PushSubscription{UserID: u.ID},
+		&user_model.MailPreference{UserID: u.ID},
+		&user_model.MailDigestItem{UserID: u.ID},
+		&issues_model.ReviewReminder{OwnerID: u.ID},
 	); err != nil {
 		return fmt.Errorf("deleteBeans: %w", err)
 	}
 
+	if err := issues_model.DeleteSavedSearchesByOwner(ctx, u.ID); err != nil {
+		return err
+	}
+
 	if err := auth_model.DeleteOAuth2RelictsByUserID(ctx, u.ID); err != nil {
 		return err
 	}
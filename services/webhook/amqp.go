@@ -0,0 +1,88 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	webhook_module "gitea.dev/modules/webhook"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// publishAMQP publishes a persistent message to an AMQP 0-9-1 exchange with the topic as routing key,
+// the message is mandatory so it fails when no queue is bound to the routing key
+func publishAMQP(ctx context.Context, rt *brokerRoundTripper, msg *brokerMessage) (string, error) {
+	conn, err := amqp.DialConfig(msg.URL.String(), amqp.Config{
+		Dial: func(network, addr string) (net.Conn, error) {
+			conn, err := rt.dial(ctx, network, addr)
+			if err != nil {
+				return nil, err
+			}
+			// the deadline bounds the handshakes, it is cleared once the connection is open
+			if deadline, ok := ctx.Deadline(); ok {
+				if err := conn.SetDeadline(deadline); err != nil {
+					conn.Close()
+					return nil, err
+				}
+			}
+			return conn, nil
+		},
+		TLSClientConfig: rt.tlsConfig(msg.URL),
+		Properties:      amqp.Table{"connection_name": "Gitea webhook"},
+	})
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return "", err
+	}
+	if err := ch.Confirm(false); err != nil {
+		return "", err
+	}
+	returns := ch.NotifyReturn(make(chan amqp.Return, 1))
+
+	headers := amqp.Table{}
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+	confirmation, err := ch.PublishWithDeferredConfirmWithContext(ctx, msg.Exchange, msg.Topic, true, false, amqp.Publishing{
+		Headers:      headers,
+		ContentType:  msg.ContentType,
+		DeliveryMode: amqp.Persistent,
+		MessageId:    msg.ID,
+		Timestamp:    time.Now(),
+		AppId:        "gitea",
+		Body:         msg.Body,
+	})
+	if err != nil {
+		return "", err
+	}
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !acked {
+		return "", errors.New("the message was rejected by the broker")
+	}
+	// the broker returns an unroutable message before acknowledging it
+	select {
+	case r := <-returns:
+		return "", fmt.Errorf("the message was returned by the broker: %d %s", r.ReplyCode, r.ReplyText)
+	default:
+	}
+	return fmt.Sprintf("Published to exchange %q with routing key %q", msg.Exchange, msg.Topic), nil
+}
+
+func init() {
+	RegisterWebhookRequester(webhook_module.AMQP, newBrokerRequest)
+	registerBrokerPublisher(publishAMQP, "amqp", "amqps")
+}
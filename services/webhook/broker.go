@@ -0,0 +1,227 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	webhook_model "gitea.dev/models/webhook"
+	"gitea.dev/modules/json"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/setting"
+	api "gitea.dev/modules/structs"
+	webhook_module "gitea.dev/modules/webhook"
+)
+
+// DefaultBrokerTopic is the topic template of the message broker webhooks which don't define one
+const DefaultBrokerTopic = "gitea.{owner}.{repo}.{event}"
+
+// The headers which pass the destination of a message from the requester to the broker round tripper,
+// they are recorded in the delivery history but not published with the message
+const (
+	brokerTopicHeader    = "X-Gitea-Broker-Topic"
+	brokerExchangeHeader = "X-Gitea-Broker-Exchange"
+	brokerKeyHeader      = "X-Gitea-Broker-Key"
+)
+
+// BrokerMeta contains the metadata of the webhooks which publish to a message broker
+type BrokerMeta struct {
+	// Topic is the template of the topic, subject, stream or routing key of the messages
+	Topic string `json:"topic"`
+	// Exchange is the AMQP exchange, empty for the default exchange
+	Exchange    string `json:"exchange,omitempty"`
+	CloudEvents bool   `json:"cloudevents"`
+}
+
+// GetBrokerHook returns message broker metadata
+func GetBrokerHook(w *webhook_model.Webhook) *BrokerMeta {
+	s := &BrokerMeta{}
+	if err := json.Unmarshal([]byte(w.Meta), s); err != nil {
+		log.Error("webhook.GetBrokerHook(%d): %v", w.ID, err)
+	}
+	return s
+}
+
+var brokerSchemes = map[webhook_module.HookType][]string{
+	webhook_module.AMQP:  {"amqp", "amqps"},
+	webhook_module.KAFKA: {"kafka"},
+	webhook_module.NATS:  {"nats", "tls"},
+	webhook_module.REDIS: {"redis", "rediss"},
+}
+
+// IsBrokerHookType returns true if the webhooks of the type publish to a message broker
+func IsBrokerHookType(hookType webhook_module.HookType) bool {
+	_, ok := brokerSchemes[hookType]
+	return ok
+}
+
+// IsValidBrokerURL returns true if the URL can be used to connect to the message broker of the webhook type
+func IsValidBrokerURL(hookType webhook_module.HookType, uri string) bool {
+	u, err := url.Parse(uri)
+	return err == nil && u.Host != "" && slices.Contains(brokerSchemes[hookType], u.Scheme)
+}
+
+// brokerTopic renders a topic template, the placeholders of the repository are empty for the events without repository
+func brokerTopic(template string, t *webhook_model.HookTask, repo *api.Repository) string {
+	if template == "" {
+		template = DefaultBrokerTopic
+	}
+	var owner, name string
+	if repo != nil {
+		owner, name, _ = strings.Cut(repo.FullName, "/")
+	}
+	return strings.NewReplacer(
+		"{event}", t.EventType.Event(),
+		"{event_type}", string(t.EventType),
+		"{owner}", owner,
+		"{repo}", name,
+	).Replace(template)
+}
+
+// newBrokerRequest creates the request of a message broker webhook, the request is sent by the broker round tripper
+// registered for the scheme of the broker URL which publishes the body to the topic
+func newBrokerRequest(_ context.Context, w *webhook_model.Webhook, t *webhook_model.HookTask) (*http.Request, []byte, error) {
+	meta := &BrokerMeta{}
+	if err := json.Unmarshal([]byte(w.Meta), meta); err != nil {
+		return nil, nil, fmt.Errorf("newBrokerRequest meta json: %w", err)
+	}
+
+	repo := payloadRepository(t)
+	body := []byte(t.PayloadContent)
+	contentType := "application/json"
+	if meta.CloudEvents {
+		var err error
+		if body, err = json.Marshal(newCloudEvent(t, repo, body)); err != nil {
+			return nil, nil, err
+		}
+		contentType = CloudEventsContentType
+	}
+
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set(brokerTopicHeader, brokerTopic(meta.Topic, t, repo))
+	if meta.Exchange != "" {
+		req.Header.Set(brokerExchangeHeader, meta.Exchange)
+	}
+	if repo != nil {
+		req.Header.Set(brokerKeyHeader, repo.FullName)
+	}
+	return req, body, addDefaultHeaders(req, []byte(w.Secret), w, t, body)
+}
+
+// brokerMessage is a message to publish, built from a request of a message broker webhook
+type brokerMessage struct {
+	URL      *url.URL
+	ID       string
+	Topic    string
+	Exchange string
+	// Key keeps the messages of a repository in order on the brokers which partition the topics
+	Key         string
+	ContentType string
+	// Headers are the default webhook headers, with the event and the signatures of the body
+	Headers map[string]string
+	Body    []byte
+}
+
+func newBrokerMessage(req *http.Request) (*brokerMessage, error) {
+	msg := &brokerMessage{
+		URL:         req.URL,
+		ID:          req.Header.Get("X-Gitea-Delivery"),
+		Topic:       req.Header.Get(brokerTopicHeader),
+		Exchange:    req.Header.Get(brokerExchangeHeader),
+		Key:         req.Header.Get(brokerKeyHeader),
+		ContentType: req.Header.Get("Content-Type"),
+		Headers:     map[string]string{},
+	}
+	if msg.Topic == "" {
+		return nil, fmt.Errorf("no topic to publish to %s", req.URL.Redacted())
+	}
+	for k, vals := range req.Header {
+		switch k {
+		case brokerTopicHeader, brokerExchangeHeader, brokerKeyHeader, "Authorization", "Content-Type":
+			continue
+		}
+		msg.Headers[k] = strings.Join(vals, ",")
+	}
+
+	if req.Body != nil {
+		defer req.Body.Close()
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		msg.Body = body
+	}
+	return msg, nil
+}
+
+type brokerDialContext func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// brokerPublisher publishes a message, it returns a description of the published message for the delivery history
+type brokerPublisher func(ctx context.Context, rt *brokerRoundTripper, msg *brokerMessage) (string, error)
+
+var brokerPublishers = map[string]brokerPublisher{}
+
+func registerBrokerPublisher(publisher brokerPublisher, schemes ...string) {
+	for _, scheme := range schemes {
+		brokerPublishers[scheme] = publisher
+	}
+}
+
+// brokerRoundTripper publishes the requests of the message broker webhooks, so they are delivered, retried and
+// recorded like the HTTP webhooks. A connection is opened for every delivery, the brokers are dialed with the
+// dialer of the webhook transport to only connect to the allowed hosts.
+type brokerRoundTripper struct {
+	dial    brokerDialContext
+	publish brokerPublisher
+}
+
+// tlsConfig returns the TLS configuration of the connections to a broker host
+func (rt *brokerRoundTripper) tlsConfig(u *url.URL) *tls.Config {
+	return &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: setting.Webhook.SkipTLSVerify,
+	}
+}
+
+// RoundTrip implements http.RoundTripper, the response of a published message is a 200 OK with a description of the message
+func (rt *brokerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	msg, err := newBrokerMessage(req)
+	if err != nil {
+		return nil, err
+	}
+	published, err := rt.publish(req.Context(), rt, msg)
+	if err != nil {
+		return nil, fmt.Errorf("publish to %s: %w", req.URL.Redacted(), err)
+	}
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Content-Type": []string{"text/plain; charset=utf-8"}},
+		Body:       io.NopCloser(strings.NewReader(published)),
+		Request:    req,
+	}, nil
+}
+
+// registerBrokerProtocols makes the transport publish the requests sent to the message broker URLs
+func registerBrokerProtocols(transport *http.Transport, dial brokerDialContext) {
+	for scheme, publish := range brokerPublishers {
+		transport.RegisterProtocol(scheme, &brokerRoundTripper{dial: dial, publish: publish})
+	}
+}
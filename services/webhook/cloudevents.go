@@ -0,0 +1,101 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"bytes"
+	"net/http"
+	"time"
+
+	webhook_model "gitea.dev/models/webhook"
+	"gitea.dev/modules/json"
+	"gitea.dev/modules/setting"
+	api "gitea.dev/modules/structs"
+)
+
+// CloudEventsContentType is the media type of an event in the structured JSON format of CloudEvents
+const CloudEventsContentType = "application/cloudevents+json"
+
+// CloudEvent is a CloudEvents 1.0 event in the structured JSON format,
+// see https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/formats/json-format.md
+type CloudEvent struct {
+	SpecVersion     string     `json:"specversion"`
+	ID              string     `json:"id"`
+	Source          string     `json:"source"`
+	Type            string     `json:"type"`
+	Subject         string     `json:"subject,omitempty"`
+	Time            time.Time  `json:"time"`
+	DataContentType string     `json:"datacontenttype"`
+	Data            json.Value `json:"data"`
+}
+
+// payloadRepository returns the repository of the payload of a hook task, or nil for the events without repository
+func payloadRepository(t *webhook_model.HookTask) *api.Repository {
+	var p struct {
+		Repository *api.Repository `json:"repository"`
+	}
+	if err := json.Unmarshal([]byte(t.PayloadContent), &p); err != nil {
+		return nil
+	}
+	return p.Repository
+}
+
+// newCloudEvent wraps the JSON payload of a hook task in a CloudEvent,
+// the delivery UUID is the event ID so the consumers can detect the redeliveries
+func newCloudEvent(t *webhook_model.HookTask, repo *api.Repository, data []byte) *CloudEvent {
+	event := &CloudEvent{
+		SpecVersion:     "1.0",
+		ID:              t.UUID,
+		Source:          setting.AppURL,
+		Type:            "io.gitea." + string(t.EventType),
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		Data:            data,
+	}
+	if repo != nil {
+		event.Source = repo.HTMLURL
+		event.Subject = repo.FullName
+	}
+	return event
+}
+
+// setCloudEventHeaders sets the attributes of an event as the headers of the binary content mode of the HTTP binding,
+// the data is the body of the request
+func setCloudEventHeaders(h http.Header, event *CloudEvent) {
+	h.Set("Ce-Specversion", event.SpecVersion)
+	h.Set("Ce-Id", event.ID)
+	h.Set("Ce-Source", event.Source)
+	h.Set("Ce-Type", event.Type)
+	if event.Subject != "" {
+		h.Set("Ce-Subject", event.Subject)
+	}
+	h.Set("Ce-Time", event.Time.Format(time.RFC3339))
+	h.Set("Content-Type", event.DataContentType)
+}
+
+// newCloudEventsRequest creates the request of a webhook which sends the payload as a CloudEvent
+func newCloudEventsRequest(w *webhook_model.Webhook, t *webhook_model.HookTask) (*http.Request, []byte, error) {
+	event := newCloudEvent(t, payloadRepository(t), []byte(t.PayloadContent))
+
+	var body []byte
+	if w.ContentType == webhook_model.ContentTypeCloudEventsBinary {
+		body = event.Data
+	} else {
+		var err error
+		if body, err = json.Marshal(event); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	if w.ContentType == webhook_model.ContentTypeCloudEventsBinary {
+		setCloudEventHeaders(req.Header, event)
+	} else {
+		req.Header.Set("Content-Type", CloudEventsContentType)
+	}
+	return req, body, addDefaultHeaders(req, []byte(w.Secret), w, t, body)
+}
@@ -19,6 +19,7 @@ import (
 	"sync"
 	"time"
 
+	system_model "gitea.dev/models/system"
 	user_model "gitea.dev/models/user"
 	webhook_model "gitea.dev/models/webhook"
 	"gitea.dev/modules/glob"
@@ -32,6 +33,7 @@ import (
 	"gitea.dev/modules/timeutil"
 	"gitea.dev/modules/util"
 	webhook_module "gitea.dev/modules/webhook"
+	"gitea.dev/services/mailer"
 )
 
 func newDefaultRequest(ctx context.Context, w *webhook_model.Webhook, t *webhook_model.HookTask) (req *http.Request, body []byte, err error) {
@@ -56,6 +58,10 @@ func newDefaultRequest(ctx context.Context, w *webhook_model.Webhook, t *webhook
 			}
 
 			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		case webhook_model.ContentTypeTemplate:
+			return newTemplateRequest(w, t)
+		case webhook_model.ContentTypeCloudEvents, webhook_model.ContentTypeCloudEventsBinary:
+			return newCloudEventsRequest(w, t)
 		default:
 			return nil, nil, fmt.Errorf("invalid content type: %v", w.ContentType)
 		}
@@ -163,6 +169,7 @@ func Deliver(ctx context.Context, t *webhook_model.HookTask) error {
 	}()
 
 	t.IsDelivered = true
+	t.NextAttemptUnix = 0
 
 	newRequest := webhookRequesters[w.Type]
 	if t.PayloadVersion == 1 || newRequest == nil {
@@ -176,7 +183,7 @@ func Deliver(ctx context.Context, t *webhook_model.HookTask) error {
 
 	// Record delivery information.
 	t.RequestInfo = &webhook_model.HookRequest{
-		URL:        req.URL.String(),
+		URL:        req.URL.Redacted(),
 		HTTPMethod: req.Method,
 		Headers:    map[string]string{},
 		Body:       string(body),
@@ -212,6 +219,9 @@ func Deliver(ctx context.Context, t *webhook_model.HookTask) error {
 		return nil
 	}
 
+	// attempted is set once the request is sent, only the failures of the receiver are retried
+	attempted := false
+
 	// All code from this point will update the hook task
 	defer func() {
 		t.Delivered = timeutil.TimeStampNanoNow()
@@ -222,6 +232,9 @@ func Deliver(ctx context.Context, t *webhook_model.HookTask) error {
 		} else {
 			log.Trace("Hook delivery failed: %s", t.UUID)
 		}
+		if attempted && !t.IsSucceed {
+			scheduleRetry(t)
+		}
 
 		if err := webhook_model.UpdateHookTask(ctx, t); err != nil {
 			log.Error("UpdateHookTask [%d]: %v", t.ID, err)
@@ -237,6 +250,11 @@ func Deliver(ctx context.Context, t *webhook_model.HookTask) error {
 			log.Error("UpdateWebhookLastStatus: %v", err)
 			return
 		}
+		if attempted && !t.IsTest {
+			if err := updateWebhookFailures(ctx, w, t.IsSucceed); err != nil {
+				log.Error("updateWebhookFailures [%d]: %v", w.ID, err)
+			}
+		}
 	}()
 
 	if setting.DisableWebhooks {
@@ -248,6 +266,8 @@ func Deliver(ctx context.Context, t *webhook_model.HookTask) error {
 		return nil
 	}
 
+	t.Attempts++
+	attempted = true
 	resp, err := webhookHTTPClient.Do(req.WithContext(ctx))
 	if err != nil {
 		t.ResponseInfo.Body = fmt.Sprintf("Delivery: %v", err)
@@ -271,6 +291,64 @@ func Deliver(ctx context.Context, t *webhook_model.HookTask) error {
 	return nil
 }
 
+// retryBackoff returns the delay before the next attempt of a task which failed the given number of times
+func retryBackoff(attempts int) time.Duration {
+	backoff := setting.Webhook.RetryBackoff
+	for i := 1; i < attempts && backoff < setting.Webhook.MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, setting.Webhook.MaxRetryBackoff)
+}
+
+// isPermanentDeliveryFailure reports whether the receiver rejected the delivery in a way a retry doesn't change,
+// the client errors except for timeouts and rate limits
+func isPermanentDeliveryFailure(status int) bool {
+	return status >= 400 && status < 500 && status != http.StatusRequestTimeout && status != http.StatusTooManyRequests
+}
+
+// scheduleRetry makes a failed task deliverable again after the backoff,
+// or a dead letter after the last attempt or if the receiver rejected it permanently
+func scheduleRetry(t *webhook_model.HookTask) {
+	if t.ResponseInfo != nil && isPermanentDeliveryFailure(t.ResponseInfo.Status) {
+		t.IsDeadLetter = true
+		log.Trace("Hook delivery rejected with status %d: %s", t.ResponseInfo.Status, t.UUID)
+		return
+	}
+	if t.Attempts > setting.Webhook.MaxRetries {
+		t.IsDeadLetter = true
+		log.Trace("Hook delivery failed after %d attempts: %s", t.Attempts, t.UUID)
+		return
+	}
+	t.IsDelivered = false
+	t.NextAttemptUnix = timeutil.TimeStampNow().AddDuration(retryBackoff(t.Attempts))
+}
+
+// updateWebhookFailures counts the consecutive failed attempts of a webhook and deactivates it after too many of them
+func updateWebhookFailures(ctx context.Context, w *webhook_model.Webhook, succeeded bool) error {
+	if succeeded {
+		if w.FailureCount == 0 {
+			return nil
+		}
+		return webhook_model.ResetWebhookFailureCount(ctx, w)
+	}
+	if err := webhook_model.IncreaseWebhookFailureCount(ctx, w); err != nil {
+		return err
+	}
+	if setting.Webhook.DisableAfterFailures <= 0 || w.FailureCount < setting.Webhook.DisableAfterFailures {
+		return nil
+	}
+
+	failures := w.FailureCount
+	if err := webhook_model.DeactivateWebhook(ctx, w); err != nil {
+		return err
+	}
+	log.Warn("Webhook[%d] %s has been deactivated after %d consecutive failed deliveries", w.ID, util.SanitizeCredentialURLs(w.URL), failures)
+	if w.RepoID == 0 && w.OwnerID == 0 {
+		return system_model.CreateNotice(ctx, system_model.NoticeTask, "System or default webhook [%d] has been deactivated after %d consecutive failed deliveries", w.ID, failures)
+	}
+	return mailer.SendWebhookDisabledMail(ctx, w, failures)
+}
+
 var (
 	webhookHTTPClient *http.Client
 	once              sync.Once
@@ -315,13 +393,15 @@ func Init() error {
 	}
 	allowedHostMatcher := hostmatcher.ParseHostMatchList("webhook.ALLOWED_HOST_LIST", allowedHostListValue)
 
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: setting.Webhook.SkipTLSVerify},
+		Proxy:           webhookProxy(allowedHostMatcher),
+		DialContext:     hostmatcher.NewDialContext("webhook", allowedHostMatcher, nil, setting.Webhook.ProxyURLFixed),
+	}
+	registerBrokerProtocols(transport, transport.DialContext)
 	webhookHTTPClient = &http.Client{
-		Timeout: timeout,
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: setting.Webhook.SkipTLSVerify},
-			Proxy:           webhookProxy(allowedHostMatcher),
-			DialContext:     hostmatcher.NewDialContext("webhook", allowedHostMatcher, nil, setting.Webhook.ProxyURLFixed),
-		},
+		Timeout:   timeout,
+		Transport: transport,
 	}
 
 	hookQueue = queue.CreateUniqueQueue(graceful.GetManager().ShutdownContext(), "webhook_sender", handler)
@@ -331,6 +411,7 @@ func Init() error {
 	go graceful.GetManager().RunWithCancel(hookQueue)
 
 	go graceful.GetManager().RunWithShutdownContext(populateWebhookSendingQueue)
+	go graceful.GetManager().RunWithShutdownContext(retryHookTasks)
 
 	return nil
 }
@@ -364,3 +445,43 @@ func populateWebhookSendingQueue(ctx context.Context) {
 		}
 	}
 }
+
+// retryCheckInterval is how often the hook tasks whose retry is due are pushed to the sending queue
+const retryCheckInterval = 10 * time.Second
+
+// retryHookTasks pushes the failed hook tasks to the sending queue once their backoff has elapsed,
+// so the workers never wait for the retries of a webhook
+func retryHookTasks(ctx context.Context) {
+	ctx, _, finished := process.GetManager().AddTypedContext(ctx, "Webhook: Retry failed deliveries", process.SystemProcessType, true)
+	defer finished()
+
+	ticker := time.NewTicker(retryCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := enqueueDueRetries(ctx); err != nil {
+			log.Error("Unable to push the hook tasks to retry to the Webhook Sending queue: %v", err)
+		}
+	}
+}
+
+func enqueueDueRetries(ctx context.Context) error {
+	now := timeutil.TimeStampNow()
+	lowerID := int64(0)
+	for {
+		taskIDs, err := webhook_model.FindDueRetryHookTaskIDs(ctx, now, lowerID)
+		if err != nil || len(taskIDs) == 0 {
+			return err
+		}
+		lowerID = taskIDs[len(taskIDs)-1]
+		for _, taskID := range taskIDs {
+			if err := enqueueHookTask(taskID); err != nil {
+				return err
+			}
+		}
+	}
+}
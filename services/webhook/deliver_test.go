@@ -16,6 +16,8 @@ import (
 	webhook_model "gitea.dev/models/webhook"
 	"gitea.dev/modules/hostmatcher"
 	"gitea.dev/modules/setting"
+	"gitea.dev/modules/test"
+	"gitea.dev/modules/timeutil"
 	"gitea.dev/modules/util"
 	webhook_module "gitea.dev/modules/webhook"
 
@@ -290,6 +292,8 @@ func TestWebhookDeliverSpecificTypes(t *testing.T) {
 		webhook_module.MATRIX:     {httpMethod: "PUT"},
 		webhook_module.WECHATWORK: {},
 		webhook_module.PACKAGIST:  {},
+		webhook_module.GOOGLECHAT: {},
+		webhook_module.MATTERMOST: {},
 	}
 
 	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -344,3 +348,183 @@ func TestWebhookDeliverSpecificTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestWebhookRetryBackoff(t *testing.T) {
+	defer test.MockVariableValue(&setting.Webhook.RetryBackoff, time.Minute)()
+	defer test.MockVariableValue(&setting.Webhook.MaxRetryBackoff, 5*time.Minute)()
+
+	assert.Equal(t, time.Minute, retryBackoff(1))
+	assert.Equal(t, 2*time.Minute, retryBackoff(2))
+	assert.Equal(t, 4*time.Minute, retryBackoff(3))
+	assert.Equal(t, 5*time.Minute, retryBackoff(4))
+	assert.Equal(t, 5*time.Minute, retryBackoff(100))
+}
+
+func TestWebhookDeliverRetry(t *testing.T) {
+	assert.NoError(t, unittest.PrepareTestDatabase())
+	defer test.MockVariableValue(&setting.Webhook.MaxRetries, 2)()
+	defer test.MockVariableValue(&setting.Webhook.RetryBackoff, time.Minute)()
+	defer test.MockVariableValue(&setting.Webhook.MaxRetryBackoff, time.Hour)()
+	defer test.MockVariableValue(&setting.Webhook.DisableAfterFailures, 0)()
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	defer timeutil.MockSet(now)()
+
+	status := http.StatusInternalServerError
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+
+	hook := &webhook_model.Webhook{
+		RepoID:      3,
+		URL:         s.URL + "/webhook",
+		ContentType: webhook_model.ContentTypeJSON,
+		IsActive:    true,
+		Type:        webhook_module.GITEA,
+	}
+	require.NoError(t, webhook_model.CreateWebhook(t.Context(), hook))
+	hookTask, err := webhook_model.CreateHookTask(t.Context(), &webhook_model.HookTask{
+		HookID:         hook.ID,
+		EventType:      webhook_module.HookEventPush,
+		PayloadVersion: 2,
+	})
+	require.NoError(t, err)
+
+	// each failed attempt is retried after twice the previous backoff
+	for attempts := 1; attempts <= 2; attempts++ {
+		require.NoError(t, Deliver(t.Context(), hookTask))
+		hookTask = unittest.AssertExistsAndLoadBean(t, &webhook_model.HookTask{ID: hookTask.ID})
+		assert.False(t, hookTask.IsDelivered)
+		assert.False(t, hookTask.IsSucceed)
+		assert.Equal(t, attempts, hookTask.Attempts)
+		assert.Equal(t, now.Add(time.Duration(attempts)*time.Minute).Unix(), int64(hookTask.NextAttemptUnix))
+	}
+
+	ids, err := webhook_model.FindDueRetryHookTaskIDs(t.Context(), hookTask.NextAttemptUnix-1, 0)
+	require.NoError(t, err)
+	assert.NotContains(t, ids, hookTask.ID)
+	ids, err = webhook_model.FindDueRetryHookTaskIDs(t.Context(), hookTask.NextAttemptUnix, 0)
+	require.NoError(t, err)
+	assert.Contains(t, ids, hookTask.ID)
+
+	// the last attempt makes a dead letter
+	require.NoError(t, Deliver(t.Context(), hookTask))
+	hookTask = unittest.AssertExistsAndLoadBean(t, &webhook_model.HookTask{ID: hookTask.ID})
+	assert.True(t, hookTask.IsDelivered)
+	assert.True(t, hookTask.IsDeadLetter)
+	assert.Equal(t, 3, hookTask.Attempts)
+	assert.Zero(t, hookTask.NextAttemptUnix)
+	hook = unittest.AssertExistsAndLoadBean(t, &webhook_model.Webhook{ID: hook.ID})
+	assert.Equal(t, 3, hook.FailureCount)
+	assert.True(t, hook.IsActive)
+
+	// a successful delivery resets the failures of the webhook
+	status = http.StatusOK
+	hookTask, err = webhook_model.CreateHookTask(t.Context(), &webhook_model.HookTask{
+		HookID:         hook.ID,
+		EventType:      webhook_module.HookEventPush,
+		PayloadVersion: 2,
+	})
+	require.NoError(t, err)
+	require.NoError(t, Deliver(t.Context(), hookTask))
+	assert.True(t, hookTask.IsSucceed)
+	assert.Equal(t, 1, hookTask.Attempts)
+	hook = unittest.AssertExistsAndLoadBean(t, &webhook_model.Webhook{ID: hook.ID})
+	assert.Zero(t, hook.FailureCount)
+}
+
+func TestWebhookDeliverDisableAfterFailures(t *testing.T) {
+	assert.NoError(t, unittest.PrepareTestDatabase())
+	defer test.MockVariableValue(&setting.Webhook.MaxRetries, 0)()
+	defer test.MockVariableValue(&setting.Webhook.DisableAfterFailures, 2)()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(s.Close)
+
+	hook := &webhook_model.Webhook{
+		RepoID:      3,
+		URL:         s.URL + "/webhook",
+		ContentType: webhook_model.ContentTypeJSON,
+		IsActive:    true,
+		Type:        webhook_module.GITEA,
+	}
+	require.NoError(t, webhook_model.CreateWebhook(t.Context(), hook))
+
+	deliver := func() *webhook_model.HookTask {
+		hookTask, err := webhook_model.CreateHookTask(t.Context(), &webhook_model.HookTask{
+			HookID:         hook.ID,
+			EventType:      webhook_module.HookEventPush,
+			PayloadVersion: 2,
+		})
+		require.NoError(t, err)
+		require.NoError(t, Deliver(t.Context(), hookTask))
+		return hookTask
+	}
+
+	hookTask := deliver()
+	assert.True(t, hookTask.IsDeadLetter)
+	assert.True(t, unittest.AssertExistsAndLoadBean(t, &webhook_model.Webhook{ID: hook.ID}).IsActive)
+
+	deliver()
+	hook = unittest.AssertExistsAndLoadBean(t, &webhook_model.Webhook{ID: hook.ID})
+	assert.False(t, hook.IsActive)
+	assert.Zero(t, hook.FailureCount)
+
+	// the tasks of an inactive webhook are not attempted
+	hookTask = deliver()
+	assert.Zero(t, hookTask.Attempts)
+	assert.False(t, hookTask.IsDeadLetter)
+}
+
+func TestWebhookDeliverClientError(t *testing.T) {
+	assert.NoError(t, unittest.PrepareTestDatabase())
+	defer test.MockVariableValue(&setting.Webhook.MaxRetries, 2)()
+	defer test.MockVariableValue(&setting.Webhook.DisableAfterFailures, 0)()
+
+	status := http.StatusNotFound
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+
+	hook := &webhook_model.Webhook{
+		RepoID:      3,
+		URL:         s.URL + "/webhook",
+		ContentType: webhook_model.ContentTypeJSON,
+		IsActive:    true,
+		Type:        webhook_module.GITEA,
+	}
+	require.NoError(t, webhook_model.CreateWebhook(t.Context(), hook))
+
+	deliver := func(isTest bool) *webhook_model.HookTask {
+		hookTask, err := webhook_model.CreateHookTask(t.Context(), &webhook_model.HookTask{
+			HookID:         hook.ID,
+			EventType:      webhook_module.HookEventPush,
+			PayloadVersion: 2,
+			IsTest:         isTest,
+		})
+		require.NoError(t, err)
+		require.NoError(t, Deliver(t.Context(), hookTask))
+		return unittest.AssertExistsAndLoadBean(t, &webhook_model.HookTask{ID: hookTask.ID})
+	}
+
+	// a client error is not retried
+	hookTask := deliver(false)
+	assert.True(t, hookTask.IsDeadLetter)
+	assert.Equal(t, 1, hookTask.Attempts)
+	assert.Equal(t, 1, unittest.AssertExistsAndLoadBean(t, &webhook_model.Webhook{ID: hook.ID}).FailureCount)
+
+	// except for the rate limits and timeouts
+	status = http.StatusTooManyRequests
+	hookTask = deliver(false)
+	assert.False(t, hookTask.IsDeadLetter)
+	assert.False(t, hookTask.IsDelivered)
+	assert.Equal(t, 2, unittest.AssertExistsAndLoadBean(t, &webhook_model.Webhook{ID: hook.ID}).FailureCount)
+
+	// the failed test deliveries don't count against the webhook
+	hookTask = deliver(true)
+	assert.False(t, hookTask.IsSucceed)
+	assert.Equal(t, 2, unittest.AssertExistsAndLoadBean(t, &webhook_model.Webhook{ID: hook.ID}).FailureCount)
+}
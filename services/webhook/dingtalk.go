@@ -176,6 +176,12 @@ func (dc dingtalkConvertor) Status(p *api.CommitStatusPayload) (DingtalkPayload,
 	return createDingtalkPayload(text, text, "Status Changed", p.TargetURL), nil
 }
 
+func (dc dingtalkConvertor) ProjectColumn(p *api.ProjectColumnPayload) (DingtalkPayload, error) {
+	text, _ := getProjectColumnPayloadInfo(p, noneLinkFormatter, true)
+
+	return createDingtalkPayload(text, text, "view project", p.Project.HTMLURL), nil
+}
+
 func (dingtalkConvertor) WorkflowRun(p *api.WorkflowRunPayload) (DingtalkPayload, error) {
 	text, _ := getWorkflowRunPayloadInfo(p, noneLinkFormatter, true)
 
@@ -278,6 +278,12 @@ func (d discordConvertor) Status(p *api.CommitStatusPayload) (DiscordPayload, er
 	return d.createPayload(p.Sender, text, "", p.TargetURL, color), nil
 }
 
+func (d discordConvertor) ProjectColumn(p *api.ProjectColumnPayload) (DiscordPayload, error) {
+	text, color := getProjectColumnPayloadInfo(p, noneLinkFormatter, false)
+
+	return d.createPayload(p.Sender, text, "", p.Project.HTMLURL, color), nil
+}
+
 func (d discordConvertor) WorkflowRun(p *api.WorkflowRunPayload) (DiscordPayload, error) {
 	text, color := getWorkflowRunPayloadInfo(p, noneLinkFormatter, false)
 
@@ -179,6 +179,12 @@ func (fc feishuConvertor) Status(p *api.CommitStatusPayload) (FeishuPayload, err
 	return newFeishuTextPayload(text), nil
 }
 
+func (fc feishuConvertor) ProjectColumn(p *api.ProjectColumnPayload) (FeishuPayload, error) {
+	text, _ := getProjectColumnPayloadInfo(p, noneLinkFormatter, true)
+
+	return newFeishuTextPayload(text), nil
+}
+
 func (feishuConvertor) WorkflowRun(p *api.WorkflowRunPayload) (FeishuPayload, error) {
 	text, _ := getWorkflowRunPayloadInfo(p, noneLinkFormatter, true)
 
@@ -0,0 +1,256 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"gitea.dev/models/organization"
+	repo_model "gitea.dev/models/repo"
+	user_model "gitea.dev/models/user"
+	webhook_model "gitea.dev/models/webhook"
+	"gitea.dev/modules/git"
+	"gitea.dev/modules/gitrepo"
+	"gitea.dev/modules/glob"
+	"gitea.dev/modules/json"
+	"gitea.dev/modules/log"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/util"
+	webhook_module "gitea.dev/modules/webhook"
+)
+
+func normalizeFilterList(list []string) []string {
+	var res []string
+	for _, s := range list {
+		if s = strings.TrimSpace(s); s != "" && !slices.Contains(res, s) {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// NormalizeHookFilters trims the filters of the hook events and returns an invalid argument error if they are invalid
+func NormalizeHookFilters(e *webhook_module.HookEvent) error {
+	e.PathFilter = strings.TrimSpace(e.PathFilter)
+	if _, err := glob.Compile(e.PathFilter, '/'); err != nil {
+		return util.NewInvalidArgumentErrorf("invalid path filter %q: %v", e.PathFilter, err)
+	}
+	e.LabelFilter = normalizeFilterList(e.LabelFilter)
+	e.ActionFilter = normalizeFilterList(e.ActionFilter)
+	for i, action := range e.ActionFilter {
+		e.ActionFilter[i] = strings.ToLower(action)
+	}
+	e.IncludeSenders = normalizeFilterList(e.IncludeSenders)
+	e.ExcludeSenders = normalizeFilterList(e.ExcludeSenders)
+	return nil
+}
+
+// payloadFilterFields are the fields of the payloads checked by the webhook filters,
+// they are decoded from the JSON payload as their types differ between the events
+type payloadFilterFields struct {
+	Action string    `json:"action"`
+	Sender *api.User `json:"sender"`
+	Issue  *struct {
+		Labels []*api.Label `json:"labels"`
+	} `json:"issue"`
+	PullRequest *struct {
+		Labels []*api.Label `json:"labels"`
+	} `json:"pull_request"`
+}
+
+// labels returns the labels of the issue or pull request of the payload, or false if the payload has none
+func (f *payloadFilterFields) labels() ([]*api.Label, bool) {
+	if f.PullRequest != nil {
+		return f.PullRequest.Labels, true
+	}
+	if f.Issue != nil {
+		return f.Issue.Labels, true
+	}
+	return nil, false
+}
+
+// hasPayloadFilters returns true if the webhook has a filter which needs the fields of the payload
+func hasPayloadFilters(w *webhook_model.Webhook) bool {
+	return len(w.LabelFilter) > 0 || len(w.ActionFilter) > 0 || len(w.IncludeSenders) > 0 || len(w.ExcludeSenders) > 0
+}
+
+// checkPayloadFilters returns true if the payload passes the label, action and sender filters of the webhook.
+// Like the branch filter, a filter has no effect on the events without the field it checks.
+func checkPayloadFilters(ctx context.Context, w *webhook_model.Webhook, payload []byte) (bool, error) {
+	if !hasPayloadFilters(w) {
+		return true, nil
+	}
+	fields := &payloadFilterFields{}
+	if err := json.Unmarshal(payload, fields); err != nil {
+		return false, err
+	}
+
+	if len(w.ActionFilter) > 0 && fields.Action != "" && !slices.Contains(w.ActionFilter, fields.Action) {
+		return false, nil
+	}
+
+	if labels, ok := fields.labels(); ok && len(w.LabelFilter) > 0 {
+		if !slices.ContainsFunc(labels, func(label *api.Label) bool {
+			return slices.ContainsFunc(w.LabelFilter, func(name string) bool { return strings.EqualFold(name, label.Name) })
+		}) {
+			return false, nil
+		}
+	}
+
+	if fields.Sender != nil && fields.Sender.ID != 0 {
+		if len(w.ExcludeSenders) > 0 {
+			excluded, err := matchSender(ctx, w.ExcludeSenders, fields.Sender)
+			if err != nil || excluded {
+				return false, err
+			}
+		}
+		if len(w.IncludeSenders) > 0 {
+			return matchSender(ctx, w.IncludeSenders, fields.Sender)
+		}
+	}
+	return true, nil
+}
+
+// matchSender returns true if the sender is one of the users or a member of one of the "org/team" teams
+func matchSender(ctx context.Context, entries []string, sender *api.User) (bool, error) {
+	for _, entry := range entries {
+		orgName, teamName, isTeam := strings.Cut(entry, "/")
+		if !isTeam {
+			if strings.EqualFold(entry, sender.UserName) {
+				return true, nil
+			}
+			continue
+		}
+
+		org, err := user_model.GetUserByName(ctx, orgName)
+		if errors.Is(err, util.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return false, err
+		}
+		team, err := organization.GetTeam(ctx, org.ID, teamName)
+		if errors.Is(err, util.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return false, err
+		}
+		isMember, err := organization.IsTeamMember(ctx, org.ID, team.ID, sender.ID)
+		if err != nil {
+			return false, err
+		} else if isMember {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getPayloadChangedFiles returns the files changed by a push or a pull request, or false for the other events
+func getPayloadChangedFiles(ctx context.Context, p api.Payloader) ([]string, bool) {
+	switch pp := p.(type) {
+	case *api.PushPayload:
+		// a deleted ref doesn't change any file
+		if git.IsEmptyCommitID(pp.After) {
+			return nil, false
+		}
+		files, err := getPushChangedFiles(ctx, pp)
+		if err != nil {
+			// the commits of the payload are truncated, they are only used if the repository can't be diffed
+			log.Warn("Unable to diff the push to %s for the webhook path filters: %v", pp.Ref, err)
+			for _, commit := range pp.Commits {
+				files = append(files, commit.Added...)
+				files = append(files, commit.Removed...)
+				files = append(files, commit.Modified...)
+			}
+		}
+		return files, true
+	case *api.PullRequestPayload:
+		pr := pp.PullRequest
+		if pr == nil || pr.Base == nil || pr.Head == nil {
+			return nil, false
+		}
+		files, err := getPullRequestChangedFiles(ctx, pr)
+		if err != nil {
+			// the path filter has no effect when the changes of the pull request can't be listed
+			log.Warn("Unable to list the changed files of pull request %s for the webhook path filters: %v", pr.HTMLURL, err)
+			return nil, false
+		}
+		return files, true
+	}
+	return nil, false
+}
+
+// getPushChangedFiles returns the files changed between the old and the new commit of the pushed ref.
+// A new ref changes the files of its commits which aren't on the default branch, all its files if it is the default branch.
+func getPushChangedFiles(ctx context.Context, pp *api.PushPayload) ([]string, error) {
+	if pp.Repo == nil {
+		return nil, errors.New("the payload has no repository")
+	}
+	repo, err := repo_model.GetRepositoryByID(ctx, pp.Repo.ID)
+	if err != nil {
+		return nil, err
+	}
+	gitRepo, err := gitrepo.OpenRepository(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	defer gitRepo.Close()
+
+	base := pp.Before
+	if git.IsEmptyCommitID(base) {
+		base = git.ObjectFormatFromName(repo.ObjectFormatName).EmptyTree().String()
+		if git.RefName(pp.Ref).BranchName() != repo.DefaultBranch {
+			defaultCommitID, err := gitrepo.GetBranchCommitID(ctx, repo, repo.DefaultBranch)
+			if err != nil && !git.IsErrNotExist(err) {
+				return nil, err
+			}
+			if defaultCommitID != "" {
+				mergeBase, err := gitrepo.MergeBase(ctx, repo, defaultCommitID, pp.After)
+				if err != nil && !errors.Is(err, util.ErrNotExist) {
+					return nil, err
+				}
+				if mergeBase != "" {
+					base = mergeBase
+				}
+			}
+		}
+	}
+	return gitRepo.GetFilesChangedBetween(base, pp.After)
+}
+
+func getPullRequestChangedFiles(ctx context.Context, pr *api.PullRequest) ([]string, error) {
+	repo, err := repo_model.GetRepositoryByID(ctx, pr.Base.RepoID)
+	if err != nil {
+		return nil, err
+	}
+	gitRepo, err := gitrepo.OpenRepository(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	defer gitRepo.Close()
+
+	base := pr.MergeBase
+	if base == "" {
+		base = pr.Base.Sha
+	}
+	return gitRepo.GetFilesChangedBetween(base, pr.Head.Sha)
+}
+
+// checkPathFilter returns true if one of the files matches the path filter, the separator of the paths
+// is "/" so "*" matches a file name and "**" matches the files of the sub-directories
+func checkPathFilter(pathFilter string, files []string) bool {
+	if pathFilter == "" || pathFilter == "**" {
+		return true
+	}
+
+	g, err := glob.Compile(pathFilter, '/')
+	if err != nil {
+		// should not really happen as PathFilter is validated
+		log.Debug("checkPathFilter failed to compile filter %q, err: %s", pathFilter, err)
+		return false
+	}
+	return slices.ContainsFunc(files, g.Match)
+}
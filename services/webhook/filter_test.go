@@ -0,0 +1,164 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"testing"
+
+	"gitea.dev/models/db"
+	repo_model "gitea.dev/models/repo"
+	"gitea.dev/models/unittest"
+	webhook_model "gitea.dev/models/webhook"
+	"gitea.dev/modules/git"
+	api "gitea.dev/modules/structs"
+	webhook_module "gitea.dev/modules/webhook"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPathFilter(t *testing.T) {
+	cases := []struct {
+		filter string
+		files  []string
+		match  bool
+	}{
+		{"", nil, true},
+		{"**", []string{"README.md"}, true},
+
+		{"*.md", []string{"README.md"}, true},
+		{"*.md", []string{"docs/README.md"}, false},
+		{"**.md", []string{"docs/README.md"}, true},
+		{"docs/**", []string{"main.go", "docs/usage/webhooks.md"}, true},
+		{"docs/**", []string{"main.go"}, false},
+		{"{docs/**,*.md}", []string{"CHANGELOG.md"}, true},
+		{"docs/**", nil, false},
+	}
+	for _, v := range cases {
+		assert.Equal(t, v.match, checkPathFilter(v.filter, v.files), "filter: %q files: %v", v.filter, v.files)
+	}
+}
+
+func TestNormalizeHookFilters(t *testing.T) {
+	e := &webhook_module.HookEvent{
+		PathFilter:     " docs/** ",
+		LabelFilter:    []string{" bug", "", "bug", "kind/feature"},
+		ActionFilter:   []string{"Opened ", "closed"},
+		ExcludeSenders: []string{""},
+	}
+	require.NoError(t, NormalizeHookFilters(e))
+	assert.Equal(t, "docs/**", e.PathFilter)
+	assert.Equal(t, []string{"bug", "kind/feature"}, e.LabelFilter)
+	assert.Equal(t, []string{"opened", "closed"}, e.ActionFilter)
+	assert.Nil(t, e.IncludeSenders)
+	assert.Nil(t, e.ExcludeSenders)
+
+	assert.Error(t, NormalizeHookFilters(&webhook_module.HookEvent{PathFilter: "docs/{a,b"}))
+}
+
+func TestCheckPayloadFilters(t *testing.T) {
+	assert.NoError(t, unittest.PrepareTestDatabase())
+
+	issuePayload := func(action api.HookIssueAction, sender string, senderID int64, labels ...string) []byte {
+		p := issueTestPayload()
+		p.Action = action
+		p.Sender = &api.User{ID: senderID, UserName: sender}
+		p.Issue.Labels = nil
+		for _, name := range labels {
+			p.Issue.Labels = append(p.Issue.Labels, &api.Label{Name: name})
+		}
+		data, err := p.JSONPayload()
+		require.NoError(t, err)
+		return data
+	}
+	pushPayload, err := pushTestPayload().JSONPayload()
+	require.NoError(t, err)
+
+	cases := []struct {
+		name    string
+		filters webhook_module.HookEvent
+		payload []byte
+		pass    bool
+	}{
+		{"NoFilters", webhook_module.HookEvent{}, issuePayload(api.HookIssueOpened, "user2", 2), true},
+
+		{"Action", webhook_module.HookEvent{ActionFilter: []string{"opened", "closed"}}, issuePayload(api.HookIssueClosed, "user2", 2), true},
+		{"ActionNoMatch", webhook_module.HookEvent{ActionFilter: []string{"opened", "closed"}}, issuePayload(api.HookIssueEdited, "user2", 2), false},
+		{"ActionNoAction", webhook_module.HookEvent{ActionFilter: []string{"opened"}}, pushPayload, true},
+
+		{"Label", webhook_module.HookEvent{LabelFilter: []string{"bug"}}, issuePayload(api.HookIssueOpened, "user2", 2, "Bug", "ui"), true},
+		{"LabelNoMatch", webhook_module.HookEvent{LabelFilter: []string{"bug"}}, issuePayload(api.HookIssueOpened, "user2", 2, "ui"), false},
+		{"LabelNoIssue", webhook_module.HookEvent{LabelFilter: []string{"bug"}}, pushPayload, true},
+
+		{"IncludeUser", webhook_module.HookEvent{IncludeSenders: []string{"user4", "User2"}}, issuePayload(api.HookIssueOpened, "user2", 2), true},
+		{"IncludeUserNoMatch", webhook_module.HookEvent{IncludeSenders: []string{"user4"}}, issuePayload(api.HookIssueOpened, "user2", 2), false},
+		{"ExcludeUser", webhook_module.HookEvent{ExcludeSenders: []string{"renovate-bot"}}, issuePayload(api.HookIssueOpened, "renovate-bot", 40), false},
+		{"ExcludeUserNoMatch", webhook_module.HookEvent{ExcludeSenders: []string{"renovate-bot"}}, issuePayload(api.HookIssueOpened, "user2", 2), true},
+		{"IncludeTeam", webhook_module.HookEvent{IncludeSenders: []string{"org3/team1"}}, issuePayload(api.HookIssueOpened, "user4", 4), true},
+		{"IncludeTeamNoMatch", webhook_module.HookEvent{IncludeSenders: []string{"org3/owners"}}, issuePayload(api.HookIssueOpened, "user4", 4), false},
+		{"ExcludeTeam", webhook_module.HookEvent{ExcludeSenders: []string{"org3/team1"}}, issuePayload(api.HookIssueOpened, "user2", 2), false},
+		{"UnknownTeam", webhook_module.HookEvent{ExcludeSenders: []string{"org3/unknown", "unknown/team"}}, issuePayload(api.HookIssueOpened, "user2", 2), true},
+		{"ExcludeWinsOverInclude", webhook_module.HookEvent{IncludeSenders: []string{"user2"}, ExcludeSenders: []string{"org3/owners"}}, issuePayload(api.HookIssueOpened, "user2", 2), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			w := &webhook_model.Webhook{HookEvent: &c.filters}
+			pass, err := checkPayloadFilters(t.Context(), w, c.payload)
+			require.NoError(t, err)
+			assert.Equal(t, c.pass, pass)
+		})
+	}
+}
+
+func TestPrepareWebhookPathFilter(t *testing.T) {
+	assert.NoError(t, unittest.PrepareTestDatabase())
+
+	repo := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 2})
+	newHook := func(pathFilter string) *webhook_model.HookTask {
+		hook := &webhook_model.Webhook{
+			RepoID:      repo.ID,
+			URL:         "http://localhost/gitea-webhook-test-path_filter",
+			ContentType: webhook_model.ContentTypeJSON,
+			Events:      `{"push_only":true,"path_filter":"` + pathFilter + `"}`,
+			IsActive:    true,
+		}
+		require.NoError(t, db.Insert(t.Context(), hook))
+		return &webhook_model.HookTask{HookID: hook.ID, EventType: webhook_module.HookEventPush}
+	}
+	mdHookTask := newHook("*.md")
+	xmlHookTask := newHook("*.xml")
+
+	push := func(t *testing.T, before, after string) {
+		require.NoError(t, db.TruncateBeans(t.Context(), &webhook_model.HookTask{}))
+		err := PrepareWebhooks(t.Context(), EventSource{Repository: repo}, webhook_module.HookEventPush, &api.PushPayload{
+			Ref:    "refs/heads/master",
+			Before: before,
+			After:  after,
+			// the changed files are listed with git, not from the truncated commits of the payload
+			Commits: []*api.PayloadCommit{{Modified: []string{"Home.md"}}},
+			Repo:    &api.Repository{ID: repo.ID, FullName: repo.FullName()},
+		})
+		require.NoError(t, err)
+	}
+	emptyCommitID := git.Sha1ObjectFormat.EmptyObjectID().String()
+
+	t.Run("Update", func(t *testing.T) {
+		// adds line.svg and test.xml
+		push(t, "2c54faec6c45d31c1abfaecdab471eac6633738a", "1032bbf17fbc0d9c95bb5418dabe8f8c99278700")
+		unittest.AssertNotExistsBean(t, mdHookTask)
+		unittest.AssertExistsAndLoadBean(t, xmlHookTask)
+	})
+	t.Run("Create", func(t *testing.T) {
+		// the default branch changes all its files
+		push(t, emptyCommitID, "1032bbf17fbc0d9c95bb5418dabe8f8c99278700")
+		unittest.AssertExistsAndLoadBean(t, mdHookTask)
+		unittest.AssertExistsAndLoadBean(t, xmlHookTask)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		// a deletion doesn't change any file, the path filters don't apply
+		push(t, "1032bbf17fbc0d9c95bb5418dabe8f8c99278700", emptyCommitID)
+		unittest.AssertExistsAndLoadBean(t, mdHookTask)
+		unittest.AssertExistsAndLoadBean(t, xmlHookTask)
+	})
+}
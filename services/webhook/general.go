@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"html"
 	"net/url"
+	"strconv"
 	"strings"
 
 	user_model "gitea.dev/models/user"
@@ -327,6 +328,31 @@ func getStatusPayloadInfo(p *api.CommitStatusPayload, linkFormatter linkFormatte
 	return text, color
 }
 
+func getProjectColumnPayloadInfo(p *api.ProjectColumnPayload, linkFormatter linkFormatter, withSender bool) (text string, color int) {
+	projectLink := linkFormatter(p.Project.HTMLURL, p.Project.Title)
+
+	switch p.Action {
+	case api.HookProjectColumnCreated:
+		text = fmt.Sprintf("[%s] Column created: %s", projectLink, p.Column.Title)
+		color = greenColor
+	case api.HookProjectColumnEdited:
+		text = fmt.Sprintf("[%s] Column edited: %s", projectLink, p.Column.Title)
+		color = yellowColor
+	case api.HookProjectColumnDeleted:
+		text = fmt.Sprintf("[%s] Column deleted: %s", projectLink, p.Column.Title)
+		color = redColor
+	case api.HookProjectColumnItemMoved:
+		issueLink := linkFormatter(p.Issue.HTMLURL, fmt.Sprintf("#%d %s", p.Issue.Index, p.Issue.Title))
+		text = fmt.Sprintf("[%s] %s moved to column %s", projectLink, issueLink, p.Column.Title)
+		color = purpleColor
+	}
+	if withSender {
+		text += " by " + linkFormatter(setting.AppURL+url.PathEscape(p.Sender.UserName), p.Sender.UserName)
+	}
+
+	return text, color
+}
+
 func getWorkflowRunPayloadInfo(p *api.WorkflowRunPayload, linkFormatter linkFormatter, withSender bool) (text string, color int) {
 	description := p.WorkflowRun.Conclusion
 	if description == "" {
@@ -396,12 +422,25 @@ func ToHook(repoLink string, w *webhook_model.Webhook) (*api.Hook, error) {
 		"url":          w.URL,
 		"content_type": w.ContentType.Name(),
 	}
+	if w.ContentType == webhook_model.ContentTypeTemplate {
+		config["payload_template"] = w.PayloadTemplate
+	}
 	if w.Type == webhook_module.SLACK {
 		s := GetSlackHook(w)
 		config["channel"] = s.Channel
 		config["username"] = s.Username
 		config["icon_url"] = s.IconURL
 		config["color"] = s.Color
+	} else if w.Type == webhook_module.MATTERMOST {
+		s := GetMattermostHook(w)
+		config["channel"] = s.Channel
+		config["username"] = s.Username
+		config["icon_url"] = s.IconURL
+	} else if IsBrokerHookType(w.Type) {
+		s := GetBrokerHook(w)
+		config["topic"] = s.Topic
+		config["exchange"] = s.Exchange
+		config["cloudevents"] = strconv.FormatBool(s.CloudEvents)
 	}
 
 	authorizationHeader, err := w.HeaderAuthorization()
@@ -421,5 +460,12 @@ func ToHook(repoLink string, w *webhook_model.Webhook) (*api.Hook, error) {
 		Updated:             w.UpdatedUnix.AsTime(),
 		Created:             w.CreatedUnix.AsTime(),
 		BranchFilter:        w.BranchFilter,
+		HookFilters: api.HookFilters{
+			PathFilter:     w.PathFilter,
+			LabelFilter:    w.LabelFilter,
+			ActionFilter:   w.ActionFilter,
+			IncludeSenders: w.IncludeSenders,
+			ExcludeSenders: w.ExcludeSenders,
+		},
 	}, nil
 }
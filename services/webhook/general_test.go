@@ -341,6 +341,96 @@ func packageTestPayload() *api.PackagePayload {
 	}
 }
 
+func statusTestPayload() *api.CommitStatusPayload {
+	return &api.CommitStatusPayload{
+		Context:     "ci/test",
+		Description: "tests passed",
+		SHA:         "2020558fe2e34debb818a514715839cabd25e778",
+		State:       "success",
+		TargetURL:   "http://localhost:3000/test/repo/actions/runs/1",
+		Sender: &api.User{
+			UserName:  "user1",
+			AvatarURL: "http://localhost:3000/user1/avatar",
+		},
+		Repo: &api.Repository{
+			HTMLURL:  "http://localhost:3000/test/repo",
+			Name:     "repo",
+			FullName: "test/repo",
+		},
+	}
+}
+
+func projectColumnTestPayload() *api.ProjectColumnPayload {
+	return &api.ProjectColumnPayload{
+		Action: api.HookProjectColumnCreated,
+		Project: &api.Project{
+			ID:      1,
+			Title:   "Roadmap",
+			HTMLURL: "http://localhost:3000/test/repo/projects/1",
+		},
+		Column: &api.ProjectColumn{
+			ID:    1,
+			Title: "Done",
+		},
+		Sender: &api.User{
+			UserName:  "user1",
+			AvatarURL: "http://localhost:3000/user1/avatar",
+		},
+		Repository: &api.Repository{
+			HTMLURL:  "http://localhost:3000/test/repo",
+			Name:     "repo",
+			FullName: "test/repo",
+		},
+	}
+}
+
+func workflowRunTestPayload() *api.WorkflowRunPayload {
+	return &api.WorkflowRunPayload{
+		Action: "completed",
+		WorkflowRun: &api.ActionWorkflowRun{
+			ID:           1,
+			DisplayTitle: "CI",
+			HTMLURL:      "http://localhost:3000/test/repo/actions/runs/1",
+			HeadSha:      "2020558fe2e34debb818a514715839cabd25e778",
+			Status:       "completed",
+			Conclusion:   "success",
+		},
+		Sender: &api.User{
+			UserName:  "user1",
+			AvatarURL: "http://localhost:3000/user1/avatar",
+		},
+		Repo: &api.Repository{
+			HTMLURL:  "http://localhost:3000/test/repo",
+			Name:     "repo",
+			FullName: "test/repo",
+		},
+	}
+}
+
+func workflowJobTestPayload() *api.WorkflowJobPayload {
+	return &api.WorkflowJobPayload{
+		Action: "completed",
+		WorkflowJob: &api.ActionWorkflowJob{
+			ID:         1,
+			RunID:      1,
+			Name:       "test",
+			HTMLURL:    "http://localhost:3000/test/repo/actions/runs/1/jobs/0",
+			HeadSha:    "2020558fe2e34debb818a514715839cabd25e778",
+			Status:     "completed",
+			Conclusion: "failure",
+		},
+		Sender: &api.User{
+			UserName:  "user1",
+			AvatarURL: "http://localhost:3000/user1/avatar",
+		},
+		Repo: &api.Repository{
+			HTMLURL:  "http://localhost:3000/test/repo",
+			Name:     "repo",
+			FullName: "test/repo",
+		},
+	}
+}
+
 func TestGetIssuesPayloadInfo(t *testing.T) {
 	p := issueTestPayload()
 
@@ -0,0 +1,459 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	webhook_model "gitea.dev/models/webhook"
+	"gitea.dev/modules/git"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/util"
+	webhook_module "gitea.dev/modules/webhook"
+)
+
+type (
+	// GoogleChatPayload is a Google Chat message with a card, see https://developers.google.com/workspace/chat/api/reference/rest/v1/cards
+	GoogleChatPayload struct {
+		CardsV2 []GoogleChatCardV2 `json:"cardsV2"`
+	}
+
+	// GoogleChatCardV2 is a card of a message
+	GoogleChatCardV2 struct {
+		CardID string         `json:"cardId"`
+		Card   GoogleChatCard `json:"card"`
+	}
+
+	// GoogleChatCard is the content of a card
+	GoogleChatCard struct {
+		Header   GoogleChatCardHeader `json:"header"`
+		Sections []GoogleChatSection  `json:"sections"`
+	}
+
+	// GoogleChatCardHeader is the header of a card
+	GoogleChatCardHeader struct {
+		Title     string `json:"title"`
+		Subtitle  string `json:"subtitle,omitempty"`
+		ImageURL  string `json:"imageUrl,omitempty"`
+		ImageType string `json:"imageType,omitempty"`
+	}
+
+	// GoogleChatSection is a section of a card
+	GoogleChatSection struct {
+		Widgets []GoogleChatWidget `json:"widgets"`
+	}
+
+	// GoogleChatWidget is a widget of a section, only one of its fields is set
+	GoogleChatWidget struct {
+		TextParagraph *GoogleChatTextParagraph `json:"textParagraph,omitempty"`
+		DecoratedText *GoogleChatDecoratedText `json:"decoratedText,omitempty"`
+		ButtonList    *GoogleChatButtonList    `json:"buttonList,omitempty"`
+	}
+
+	// GoogleChatTextParagraph is a paragraph of text, formatted with the HTML tags supported by Google Chat
+	GoogleChatTextParagraph struct {
+		Text string `json:"text"`
+	}
+
+	// GoogleChatDecoratedText is a text with a label
+	GoogleChatDecoratedText struct {
+		TopLabel string `json:"topLabel"`
+		Text     string `json:"text"`
+	}
+
+	// GoogleChatButtonList is a list of buttons
+	GoogleChatButtonList struct {
+		Buttons []GoogleChatButton `json:"buttons"`
+	}
+
+	// GoogleChatButton is a button which opens a link
+	GoogleChatButton struct {
+		Text    string            `json:"text"`
+		Color   *GoogleChatColor  `json:"color,omitempty"`
+		OnClick GoogleChatOnClick `json:"onClick"`
+	}
+
+	// GoogleChatColor is a RGB color with components between 0 and 1
+	GoogleChatColor struct {
+		Red   float64 `json:"red"`
+		Green float64 `json:"green"`
+		Blue  float64 `json:"blue"`
+	}
+
+	// GoogleChatOnClick is the action of a button
+	GoogleChatOnClick struct {
+		OpenLink GoogleChatOpenLink `json:"openLink"`
+	}
+
+	// GoogleChatOpenLink is the link opened by a button
+	GoogleChatOpenLink struct {
+		URL string `json:"url"`
+	}
+)
+
+// googleChatTextFormatter escapes the text for a text paragraph, Google Chat only supports a few HTML tags
+func googleChatTextFormatter(s string) string {
+	return strings.ReplaceAll(html.EscapeString(strings.TrimRight(s, "\r\n")), "\n", "<br>")
+}
+
+type googlechatConvertor struct{}
+
+// Create implements PayloadConvertor Create method
+func (gc googlechatConvertor) Create(p *api.CreatePayload) (GoogleChatPayload, error) {
+	refName := git.RefName(p.Ref).ShortName()
+	title := fmt.Sprintf("[%s] %s %s created", p.Repo.FullName, p.RefType, refName)
+
+	return createGoogleChatPayload(
+		p.Repo,
+		p.Sender,
+		title,
+		"",
+		p.Repo.HTMLURL+"/src/"+util.PathEscapeSegments(refName),
+		greenColor,
+		&GoogleChatDecoratedText{p.RefType, refName},
+	), nil
+}
+
+// Delete implements PayloadConvertor Delete method
+func (gc googlechatConvertor) Delete(p *api.DeletePayload) (GoogleChatPayload, error) {
+	refName := git.RefName(p.Ref).ShortName()
+	title := fmt.Sprintf("[%s] %s %s deleted", p.Repo.FullName, p.RefType, refName)
+
+	return createGoogleChatPayload(
+		p.Repo,
+		p.Sender,
+		title,
+		"",
+		p.Repo.HTMLURL,
+		yellowColor,
+		&GoogleChatDecoratedText{p.RefType, refName},
+	), nil
+}
+
+// Fork implements PayloadConvertor Fork method
+func (gc googlechatConvertor) Fork(p *api.ForkPayload) (GoogleChatPayload, error) {
+	title := fmt.Sprintf("%s is forked to %s", p.Forkee.FullName, p.Repo.FullName)
+
+	return createGoogleChatPayload(
+		p.Repo,
+		p.Sender,
+		title,
+		"",
+		p.Repo.HTMLURL,
+		greenColor,
+		&GoogleChatDecoratedText{"Forkee", p.Forkee.FullName},
+	), nil
+}
+
+// Push implements PayloadConvertor Push method
+func (gc googlechatConvertor) Push(p *api.PushPayload) (GoogleChatPayload, error) {
+	branchName := git.RefName(p.Ref).ShortName()
+
+	var commitDesc, titleLink string
+	if p.TotalCommits == 1 {
+		commitDesc = "1 new commit"
+		titleLink = p.Commits[0].URL
+	} else {
+		commitDesc = fmt.Sprintf("%d new commits", p.TotalCommits)
+		titleLink = p.CompareURL
+	}
+	if titleLink == "" {
+		titleLink = p.Repo.HTMLURL + "/src/" + util.PathEscapeSegments(branchName)
+	}
+
+	title := fmt.Sprintf("[%s:%s] %s", p.Repo.FullName, branchName, commitDesc)
+
+	var text strings.Builder
+	for i, commit := range p.Commits {
+		message, _, _ := strings.Cut(commit.Message, "\n")
+		fmt.Fprintf(&text, "%s %s - %s", htmlLinkFormatter(commit.URL, commit.ID[:7]),
+			html.EscapeString(strings.TrimRight(message, "\r")), html.EscapeString(commit.Author.Name))
+		if i < len(p.Commits)-1 {
+			text.WriteString("<br>")
+		}
+	}
+
+	return createGoogleChatPayload(
+		p.Repo,
+		p.Sender,
+		title,
+		text.String(),
+		titleLink,
+		greenColor,
+		&GoogleChatDecoratedText{"Commit count", strconv.Itoa(p.TotalCommits)},
+	), nil
+}
+
+// Issue implements PayloadConvertor Issue method
+func (gc googlechatConvertor) Issue(p *api.IssuePayload) (GoogleChatPayload, error) {
+	title, _, extraMarkdown, color := getIssuesPayloadInfo(p, noneLinkFormatter, false)
+
+	return createGoogleChatPayload(
+		p.Repository,
+		p.Sender,
+		title,
+		googleChatTextFormatter(extraMarkdown),
+		p.Issue.HTMLURL,
+		color,
+		&GoogleChatDecoratedText{"Issue", "#" + strconv.FormatInt(p.Issue.Index, 10)},
+	), nil
+}
+
+// IssueComment implements PayloadConvertor IssueComment method
+func (gc googlechatConvertor) IssueComment(p *api.IssueCommentPayload) (GoogleChatPayload, error) {
+	title, _, color := getIssueCommentPayloadInfo(p, noneLinkFormatter, false)
+
+	label := "Issue"
+	if p.IsPull {
+		label = "Pull request"
+	}
+	return createGoogleChatPayload(
+		p.Repository,
+		p.Sender,
+		title,
+		googleChatTextFormatter(p.Comment.Body),
+		p.Comment.HTMLURL,
+		color,
+		&GoogleChatDecoratedText{label, "#" + strconv.FormatInt(p.Issue.Index, 10)},
+	), nil
+}
+
+// PullRequest implements PayloadConvertor PullRequest method
+func (gc googlechatConvertor) PullRequest(p *api.PullRequestPayload) (GoogleChatPayload, error) {
+	title, _, extraMarkdown, color := getPullRequestPayloadInfo(p, noneLinkFormatter, false)
+
+	return createGoogleChatPayload(
+		p.Repository,
+		p.Sender,
+		title,
+		googleChatTextFormatter(extraMarkdown),
+		p.PullRequest.HTMLURL,
+		color,
+		&GoogleChatDecoratedText{"Pull request", "#" + strconv.FormatInt(p.PullRequest.Index, 10)},
+	), nil
+}
+
+// Review implements PayloadConvertor Review method
+func (gc googlechatConvertor) Review(p *api.PullRequestPayload, event webhook_module.HookEventType) (GoogleChatPayload, error) {
+	var text, title string
+	var color int
+	if p.Action == api.HookIssueReviewed {
+		action, err := parseHookPullRequestEventType(event)
+		if err != nil {
+			return GoogleChatPayload{}, err
+		}
+
+		title = fmt.Sprintf("[%s] Pull request review %s: #%d %s", p.Repository.FullName, action, p.Index, p.PullRequest.Title)
+		text = googleChatTextFormatter(p.Review.Content)
+
+		switch event {
+		case webhook_module.HookEventPullRequestReviewApproved:
+			color = greenColor
+		case webhook_module.HookEventPullRequestReviewRejected:
+			color = redColor
+		case webhook_module.HookEventPullRequestReviewComment:
+			color = greyColor
+		default:
+			color = yellowColor
+		}
+	}
+
+	return createGoogleChatPayload(
+		p.Repository,
+		p.Sender,
+		title,
+		text,
+		p.PullRequest.HTMLURL,
+		color,
+		&GoogleChatDecoratedText{"Pull request", "#" + strconv.FormatInt(p.PullRequest.Index, 10)},
+	), nil
+}
+
+// Repository implements PayloadConvertor Repository method
+func (gc googlechatConvertor) Repository(p *api.RepositoryPayload) (GoogleChatPayload, error) {
+	var title, link string
+	var color int
+	switch p.Action {
+	case api.HookRepoCreated:
+		title = fmt.Sprintf("[%s] Repository created", p.Repository.FullName)
+		link = p.Repository.HTMLURL
+		color = greenColor
+	case api.HookRepoDeleted:
+		title = fmt.Sprintf("[%s] Repository deleted", p.Repository.FullName)
+		color = yellowColor
+	}
+
+	return createGoogleChatPayload(
+		p.Repository,
+		p.Sender,
+		title,
+		"",
+		link,
+		color,
+		nil,
+	), nil
+}
+
+// Wiki implements PayloadConvertor Wiki method
+func (gc googlechatConvertor) Wiki(p *api.WikiPayload) (GoogleChatPayload, error) {
+	title, color, _ := getWikiPayloadInfo(p, noneLinkFormatter, false)
+
+	return createGoogleChatPayload(
+		p.Repository,
+		p.Sender,
+		title,
+		"",
+		p.Repository.HTMLURL+"/wiki/"+url.PathEscape(p.Page),
+		color,
+		&GoogleChatDecoratedText{"Page", p.Page},
+	), nil
+}
+
+// Release implements PayloadConvertor Release method
+func (gc googlechatConvertor) Release(p *api.ReleasePayload) (GoogleChatPayload, error) {
+	title, color := getReleasePayloadInfo(p, noneLinkFormatter, false)
+
+	return createGoogleChatPayload(
+		p.Repository,
+		p.Sender,
+		title,
+		googleChatTextFormatter(p.Release.Note),
+		p.Release.HTMLURL,
+		color,
+		&GoogleChatDecoratedText{"Tag", p.Release.TagName},
+	), nil
+}
+
+// Package implements PayloadConvertor Package method
+func (gc googlechatConvertor) Package(p *api.PackagePayload) (GoogleChatPayload, error) {
+	title, color := getPackagePayloadInfo(p, noneLinkFormatter, false)
+
+	return createGoogleChatPayload(
+		p.Repository,
+		p.Sender,
+		title,
+		"",
+		p.Package.HTMLURL,
+		color,
+		&GoogleChatDecoratedText{"Package", p.Package.Name},
+	), nil
+}
+
+// Status implements PayloadConvertor Status method
+func (gc googlechatConvertor) Status(p *api.CommitStatusPayload) (GoogleChatPayload, error) {
+	title, color := getStatusPayloadInfo(p, noneLinkFormatter, false)
+
+	return createGoogleChatPayload(
+		p.Repo,
+		p.Sender,
+		title,
+		"",
+		p.TargetURL,
+		color,
+		&GoogleChatDecoratedText{"Commit status", p.Context},
+	), nil
+}
+
+// ProjectColumn implements PayloadConvertor ProjectColumn method
+func (gc googlechatConvertor) ProjectColumn(p *api.ProjectColumnPayload) (GoogleChatPayload, error) {
+	title, color := getProjectColumnPayloadInfo(p, noneLinkFormatter, false)
+
+	return createGoogleChatPayload(
+		p.Repository,
+		p.Sender,
+		title,
+		"",
+		p.Project.HTMLURL,
+		color,
+		&GoogleChatDecoratedText{"Column", p.Column.Title},
+	), nil
+}
+
+// WorkflowRun implements PayloadConvertor WorkflowRun method
+func (gc googlechatConvertor) WorkflowRun(p *api.WorkflowRunPayload) (GoogleChatPayload, error) {
+	title, color := getWorkflowRunPayloadInfo(p, noneLinkFormatter, false)
+
+	return createGoogleChatPayload(
+		p.Repo,
+		p.Sender,
+		title,
+		"",
+		p.WorkflowRun.HTMLURL,
+		color,
+		&GoogleChatDecoratedText{"Workflow run", p.WorkflowRun.DisplayTitle},
+	), nil
+}
+
+// WorkflowJob implements PayloadConvertor WorkflowJob method
+func (gc googlechatConvertor) WorkflowJob(p *api.WorkflowJobPayload) (GoogleChatPayload, error) {
+	title, color := getWorkflowJobPayloadInfo(p, noneLinkFormatter, false)
+
+	return createGoogleChatPayload(
+		p.Repo,
+		p.Sender,
+		title,
+		"",
+		p.WorkflowJob.HTMLURL,
+		color,
+		&GoogleChatDecoratedText{"Workflow job", p.WorkflowJob.Name},
+	), nil
+}
+
+// createGoogleChatPayload creates a card with the sender in the header, the text, the repository and the fact
+// as widgets and a button of the color of the event to open the link
+func createGoogleChatPayload(r *api.Repository, s *api.User, title, text, link string, color int, fact *GoogleChatDecoratedText) GoogleChatPayload {
+	widgets := make([]GoogleChatWidget, 0, 4)
+	if text != "" {
+		widgets = append(widgets, GoogleChatWidget{TextParagraph: &GoogleChatTextParagraph{Text: text}})
+	}
+	if r != nil {
+		widgets = append(widgets, GoogleChatWidget{DecoratedText: &GoogleChatDecoratedText{TopLabel: "Repository", Text: r.FullName}})
+	}
+	if fact != nil {
+		widgets = append(widgets, GoogleChatWidget{DecoratedText: fact})
+	}
+	if link != "" {
+		widgets = append(widgets, GoogleChatWidget{ButtonList: &GoogleChatButtonList{
+			Buttons: []GoogleChatButton{{
+				Text: "View in Gitea",
+				Color: &GoogleChatColor{
+					Red:   float64(color>>16&0xff) / 255,
+					Green: float64(color>>8&0xff) / 255,
+					Blue:  float64(color&0xff) / 255,
+				},
+				OnClick: GoogleChatOnClick{OpenLink: GoogleChatOpenLink{URL: link}},
+			}},
+		}})
+	}
+
+	return GoogleChatPayload{
+		CardsV2: []GoogleChatCardV2{{
+			CardID: "gitea",
+			Card: GoogleChatCard{
+				Header: GoogleChatCardHeader{
+					Title:     title,
+					Subtitle:  s.UserName,
+					ImageURL:  s.AvatarURL,
+					ImageType: "CIRCLE",
+				},
+				Sections: []GoogleChatSection{{Widgets: widgets}},
+			},
+		}},
+	}
+}
+
+func newGoogleChatRequest(_ context.Context, w *webhook_model.Webhook, t *webhook_model.HookTask) (*http.Request, []byte, error) {
+	var pc payloadConvertor[GoogleChatPayload] = googlechatConvertor{}
+	return newJSONRequest(pc, w, t, true)
+}
+
+func init() {
+	RegisterWebhookRequester(webhook_module.GOOGLECHAT, newGoogleChatRequest)
+}
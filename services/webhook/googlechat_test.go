@@ -0,0 +1,237 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"testing"
+
+	webhook_model "gitea.dev/models/webhook"
+	"gitea.dev/modules/json"
+	api "gitea.dev/modules/structs"
+	webhook_module "gitea.dev/modules/webhook"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// assertGoogleChatCard checks the title, the text paragraph, the decorated texts and the link of the button of the card
+func assertGoogleChatCard(t *testing.T, pl GoogleChatPayload, title, text string, facts map[string]string, link string) {
+	t.Helper()
+	require.Len(t, pl.CardsV2, 1)
+	card := pl.CardsV2[0].Card
+	assert.Equal(t, title, card.Header.Title)
+	assert.Equal(t, "user1", card.Header.Subtitle)
+	assert.Equal(t, "http://localhost:3000/user1/avatar", card.Header.ImageURL)
+	require.Len(t, card.Sections, 1)
+
+	var paragraph, buttonURL string
+	decorated := map[string]string{}
+	for _, widget := range card.Sections[0].Widgets {
+		switch {
+		case widget.TextParagraph != nil:
+			paragraph = widget.TextParagraph.Text
+		case widget.DecoratedText != nil:
+			decorated[widget.DecoratedText.TopLabel] = widget.DecoratedText.Text
+		case widget.ButtonList != nil:
+			require.Len(t, widget.ButtonList.Buttons, 1)
+			buttonURL = widget.ButtonList.Buttons[0].OnClick.OpenLink.URL
+		}
+	}
+	assert.Equal(t, text, paragraph)
+	assert.Equal(t, facts, decorated)
+	assert.Equal(t, link, buttonURL)
+}
+
+func TestGoogleChatPayload(t *testing.T) {
+	gc := googlechatConvertor{}
+	repoFact := func(label, value string) map[string]string {
+		return map[string]string{"Repository": "test/repo", label: value}
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		pl, err := gc.Create(createTestPayload())
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "[test/repo] branch test created", "", repoFact("branch", "test"), "http://localhost:3000/test/repo/src/test")
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		pl, err := gc.Delete(deleteTestPayload())
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "[test/repo] branch test deleted", "", repoFact("branch", "test"), "http://localhost:3000/test/repo")
+	})
+
+	t.Run("Fork", func(t *testing.T) {
+		pl, err := gc.Fork(forkTestPayload())
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "test/repo2 is forked to test/repo", "", repoFact("Forkee", "test/repo2"), "http://localhost:3000/test/repo")
+	})
+
+	t.Run("Push", func(t *testing.T) {
+		pl, err := gc.Push(pushTestPayloadWithCommitMessage("fix <script>\n\ndescription"))
+		require.NoError(t, err)
+
+		commit := `<a href="http://localhost:3000/test/repo/commit/2020558fe2e34debb818a514715839cabd25e778">2020558</a> fix &lt;script&gt; - user1`
+		assertGoogleChatCard(t, pl, "[test/repo:test] 2 new commits", commit+"<br>"+commit, repoFact("Commit count", "2"), "http://localhost:3000/test/repo/src/test")
+	})
+
+	t.Run("Issue", func(t *testing.T) {
+		p := issueTestPayload()
+
+		p.Action = api.HookIssueOpened
+		pl, err := gc.Issue(p)
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "[test/repo] Issue opened: #2 crash", "issue body", repoFact("Issue", "#2"), "http://localhost:3000/test/repo/issues/2")
+
+		p.Action = api.HookIssueClosed
+		pl, err = gc.Issue(p)
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "[test/repo] Issue closed: #2 crash", "", repoFact("Issue", "#2"), "http://localhost:3000/test/repo/issues/2")
+	})
+
+	t.Run("IssueComment", func(t *testing.T) {
+		pl, err := gc.IssueComment(issueCommentTestPayload())
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "[test/repo] New comment on issue #2 crash", "more info needed", repoFact("Issue", "#2"), "http://localhost:3000/test/repo/issues/2#issuecomment-4")
+	})
+
+	t.Run("PullRequest", func(t *testing.T) {
+		pl, err := gc.PullRequest(pullRequestTestPayload())
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "[test/repo] Pull request opened: #12 Fix bug", "fixes bug #2", repoFact("Pull request", "#12"), "http://localhost:3000/test/repo/pulls/12")
+	})
+
+	t.Run("PullRequestComment", func(t *testing.T) {
+		pl, err := gc.IssueComment(pullRequestCommentTestPayload())
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "[test/repo] New comment on pull request #12 Fix bug", "changes requested", repoFact("Pull request", "#12"), "http://localhost:3000/test/repo/pulls/12#issuecomment-4")
+	})
+
+	t.Run("Review", func(t *testing.T) {
+		p := pullRequestTestPayload()
+		p.Action = api.HookIssueReviewed
+
+		pl, err := gc.Review(p, webhook_module.HookEventPullRequestReviewApproved)
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "[test/repo] Pull request review approved: #12 Fix bug", "good job", repoFact("Pull request", "#12"), "http://localhost:3000/test/repo/pulls/12")
+		color := pl.CardsV2[0].Card.Sections[0].Widgets[len(pl.CardsV2[0].Card.Sections[0].Widgets)-1].ButtonList.Buttons[0].Color
+		assert.Equal(t, &GoogleChatColor{Red: float64(0x1a) / 255, Green: float64(0xc6) / 255, Blue: float64(0x00) / 255}, color)
+	})
+
+	t.Run("Repository", func(t *testing.T) {
+		pl, err := gc.Repository(repositoryTestPayload())
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "[test/repo] Repository created", "", map[string]string{"Repository": "test/repo"}, "http://localhost:3000/test/repo")
+	})
+
+	t.Run("Package", func(t *testing.T) {
+		pl, err := gc.Package(packageTestPayload())
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "Package created: GiteaContainer:latest", "", map[string]string{"Package": "GiteaContainer"}, "http://localhost:3000/user1/-/packages/container/GiteaContainer/latest")
+	})
+
+	t.Run("Wiki", func(t *testing.T) {
+		p := wikiTestPayload()
+
+		p.Action = api.HookWikiCreated
+		pl, err := gc.Wiki(p)
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "[test/repo] New wiki page 'index' (Wiki change comment)", "", repoFact("Page", "index"), "http://localhost:3000/test/repo/wiki/index")
+
+		p.Action = api.HookWikiEdited
+		pl, err = gc.Wiki(p)
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "[test/repo] Wiki page 'index' edited (Wiki change comment)", "", repoFact("Page", "index"), "http://localhost:3000/test/repo/wiki/index")
+
+		p.Action = api.HookWikiDeleted
+		pl, err = gc.Wiki(p)
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "[test/repo] Wiki page 'index' deleted", "", repoFact("Page", "index"), "http://localhost:3000/test/repo/wiki/index")
+	})
+
+	t.Run("Release", func(t *testing.T) {
+		pl, err := gc.Release(pullReleaseTestPayload())
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "[test/repo] Release created: v1.0", "Note of first stable release", repoFact("Tag", "v1.0"), "http://localhost:3000/test/repo/releases/tag/v1.0")
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		pl, err := gc.Status(statusTestPayload())
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "Commit Status changed: ci/test [2020558fe2] - tests passed", "", repoFact("Commit status", "ci/test"), "http://localhost:3000/test/repo/actions/runs/1")
+	})
+
+	t.Run("ProjectColumn", func(t *testing.T) {
+		pl, err := gc.ProjectColumn(projectColumnTestPayload())
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "[Roadmap] Column created: Done", "", repoFact("Column", "Done"), "http://localhost:3000/test/repo/projects/1")
+	})
+
+	t.Run("WorkflowRun", func(t *testing.T) {
+		pl, err := gc.WorkflowRun(workflowRunTestPayload())
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "Workflow Run completed: CI(#1)[2020558fe2]:success", "", repoFact("Workflow run", "CI"), "http://localhost:3000/test/repo/actions/runs/1")
+	})
+
+	t.Run("WorkflowJob", func(t *testing.T) {
+		pl, err := gc.WorkflowJob(workflowJobTestPayload())
+		require.NoError(t, err)
+
+		assertGoogleChatCard(t, pl, "Workflow Job completed: test(#1)[2020558fe2]:failure", "", repoFact("Workflow job", "test"), "http://localhost:3000/test/repo/actions/runs/1/jobs/0")
+	})
+}
+
+func TestGoogleChatJSONPayload(t *testing.T) {
+	p := pushTestPayload()
+	data, err := p.JSONPayload()
+	require.NoError(t, err)
+
+	hook := &webhook_model.Webhook{
+		RepoID:     3,
+		IsActive:   true,
+		Type:       webhook_module.GOOGLECHAT,
+		URL:        "https://chat.googleapis.com/v1/spaces/AAAA/messages?key=k&token=t",
+		Meta:       ``,
+		HTTPMethod: "POST",
+	}
+	task := &webhook_model.HookTask{
+		HookID:         hook.ID,
+		EventType:      webhook_module.HookEventPush,
+		PayloadContent: string(data),
+		PayloadVersion: 2,
+	}
+
+	req, reqBody, err := newGoogleChatRequest(t.Context(), hook, task)
+	require.NotNil(t, req)
+	require.NotNil(t, reqBody)
+	require.NoError(t, err)
+
+	assert.Equal(t, "POST", req.Method)
+	assert.Equal(t, "https://chat.googleapis.com/v1/spaces/AAAA/messages?key=k&token=t", req.URL.String())
+	assert.Equal(t, "sha256=", req.Header.Get("X-Hub-Signature-256"))
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	var body GoogleChatPayload
+	err = json.NewDecoder(req.Body).Decode(&body)
+	assert.NoError(t, err)
+	require.Len(t, body.CardsV2, 1)
+	assert.Equal(t, "gitea", body.CardsV2[0].CardID)
+	assert.Equal(t, "[test/repo:test] 2 new commits", body.CardsV2[0].Card.Header.Title)
+}
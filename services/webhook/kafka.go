@@ -0,0 +1,70 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	webhook_module "gitea.dev/modules/webhook"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl/plain"
+)
+
+// publishKafka writes a message to a Kafka topic through the bootstrap broker of the URL "kafka://[user:password@]host:port[?tls=true]",
+// the user and password authenticate with SASL/PLAIN. The messages are keyed by repository, so the events of a
+// repository stay in order in their partition.
+func publishKafka(ctx context.Context, rt *brokerRoundTripper, msg *brokerMessage) (string, error) {
+	transport := &kafka.Transport{
+		Dial:     rt.dial,
+		ClientID: "gitea",
+	}
+	defer transport.CloseIdleConnections()
+
+	if v := msg.URL.Query().Get("tls"); v != "" {
+		useTLS, err := strconv.ParseBool(v)
+		if err != nil {
+			return "", fmt.Errorf("invalid tls option: %w", err)
+		}
+		if useTLS {
+			transport.TLS = rt.tlsConfig(msg.URL)
+		}
+	}
+	if msg.URL.User != nil {
+		password, _ := msg.URL.User.Password()
+		transport.SASL = plain.Mechanism{Username: msg.URL.User.Username(), Password: password}
+	}
+
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP(msg.URL.Host),
+		Topic:        msg.Topic,
+		Balancer:     &kafka.Hash{},
+		MaxAttempts:  1, // the failed deliveries are retried by the webhook queue
+		BatchSize:    1,
+		RequiredAcks: kafka.RequireAll,
+		Transport:    transport,
+	}
+	defer writer.Close()
+
+	headers := make([]kafka.Header, 0, len(msg.Headers))
+	for k, v := range msg.Headers {
+		headers = append(headers, kafka.Header{Key: k, Value: []byte(v)})
+	}
+	headers = append(headers, kafka.Header{Key: "Content-Type", Value: []byte(msg.ContentType)})
+	if err := writer.WriteMessages(ctx, kafka.Message{
+		Key:     []byte(msg.Key),
+		Value:   msg.Body,
+		Headers: headers,
+	}); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Published to topic %q with key %q", msg.Topic, msg.Key), nil
+}
+
+func init() {
+	RegisterWebhookRequester(webhook_module.KAFKA, newBrokerRequest)
+	registerBrokerPublisher(publishKafka, "kafka")
+}
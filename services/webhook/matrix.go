@@ -253,6 +253,12 @@ func (m matrixConvertor) Status(p *api.CommitStatusPayload) (MatrixPayload, erro
 	return m.newPayload(text)
 }
 
+func (m matrixConvertor) ProjectColumn(p *api.ProjectColumnPayload) (MatrixPayload, error) {
+	text, _ := getProjectColumnPayloadInfo(p, htmlLinkFormatter, true)
+
+	return m.newPayload(text)
+}
+
 func (m matrixConvertor) WorkflowRun(p *api.WorkflowRunPayload) (MatrixPayload, error) {
 	text, _ := getWorkflowRunPayloadInfo(p, htmlLinkFormatter, true)
 
@@ -0,0 +1,336 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	webhook_model "gitea.dev/models/webhook"
+	"gitea.dev/modules/git"
+	"gitea.dev/modules/json"
+	"gitea.dev/modules/log"
+	"gitea.dev/modules/setting"
+	api "gitea.dev/modules/structs"
+	webhook_module "gitea.dev/modules/webhook"
+)
+
+// MattermostMeta contains the Mattermost metadata
+type MattermostMeta struct {
+	Channel  string `json:"channel"`
+	Username string `json:"username"`
+	IconURL  string `json:"icon_url"`
+}
+
+// GetMattermostHook returns Mattermost metadata
+func GetMattermostHook(w *webhook_model.Webhook) *MattermostMeta {
+	s := &MattermostMeta{}
+	if err := json.Unmarshal([]byte(w.Meta), s); err != nil {
+		log.Error("webhook.GetMattermostHook(%d): %v", w.ID, err)
+	}
+	return s
+}
+
+// MattermostPayload is a message of a Mattermost incoming webhook,
+// see https://developers.mattermost.com/integrate/webhooks/incoming/
+type MattermostPayload struct {
+	Text        string                 `json:"text"`
+	Channel     string                 `json:"channel,omitempty"`
+	Username    string                 `json:"username,omitempty"`
+	IconURL     string                 `json:"icon_url,omitempty"`
+	Attachments []MattermostAttachment `json:"attachments,omitempty"`
+	Props       *MattermostProps       `json:"props,omitempty"`
+}
+
+// MattermostAttachment is a message attachment, its text is rendered as markdown
+type MattermostAttachment struct {
+	Fallback  string `json:"fallback"`
+	Color     string `json:"color"`
+	Title     string `json:"title,omitempty"`
+	TitleLink string `json:"title_link,omitempty"`
+	Text      string `json:"text,omitempty"`
+}
+
+// MattermostProps are the properties stored with the post, they can be read by the other integrations
+type MattermostProps struct {
+	Event      string `json:"gitea_event"`
+	Repository string `json:"gitea_repository,omitempty"`
+}
+
+var mattermostMarkdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	"*", `\*`,
+	"_", `\_`,
+	"~", `\~`,
+	"[", `\[`,
+	"]", `\]`,
+)
+
+// MattermostLinkFormatter creates a markdown link, the markdown of the text is escaped
+func MattermostLinkFormatter(url, text string) string {
+	return fmt.Sprintf("[%s](%s)", mattermostMarkdownEscaper.Replace(text), url)
+}
+
+type mattermostConvertor struct {
+	Channel  string
+	Username string
+	IconURL  string
+}
+
+// Create implements payloadConvertor Create method
+func (m mattermostConvertor) Create(p *api.CreatePayload) (MattermostPayload, error) {
+	refName := git.RefName(p.Ref)
+	repoLink := MattermostLinkFormatter(p.Repo.HTMLURL, p.Repo.FullName)
+	refLink := MattermostLinkFormatter(p.Repo.HTMLURL+"/src/"+refName.RefWebLinkPath(), refName.ShortName())
+	text := fmt.Sprintf("[%s:%s] %s created by %s", repoLink, refLink, p.RefType, mattermostSenderLink(p.Sender))
+
+	return m.createPayload(text, nil), nil
+}
+
+// Delete implements payloadConvertor Delete method
+func (m mattermostConvertor) Delete(p *api.DeletePayload) (MattermostPayload, error) {
+	refName := git.RefName(p.Ref).ShortName()
+	repoLink := MattermostLinkFormatter(p.Repo.HTMLURL, p.Repo.FullName)
+	text := fmt.Sprintf("[%s:%s] %s deleted by %s", repoLink, mattermostMarkdownEscaper.Replace(refName), p.RefType, mattermostSenderLink(p.Sender))
+
+	return m.createPayload(text, nil), nil
+}
+
+// Fork implements payloadConvertor Fork method
+func (m mattermostConvertor) Fork(p *api.ForkPayload) (MattermostPayload, error) {
+	baseLink := MattermostLinkFormatter(p.Forkee.HTMLURL, p.Forkee.FullName)
+	forkLink := MattermostLinkFormatter(p.Repo.HTMLURL, p.Repo.FullName)
+	text := fmt.Sprintf("%s is forked to %s", baseLink, forkLink)
+
+	return m.createPayload(text, nil), nil
+}
+
+// Push implements payloadConvertor Push method
+func (m mattermostConvertor) Push(p *api.PushPayload) (MattermostPayload, error) {
+	refName := git.RefName(p.Ref)
+
+	commitDesc := "1 new commit"
+	if p.TotalCommits != 1 {
+		commitDesc = fmt.Sprintf("%d new commits", p.TotalCommits)
+	}
+	commitLink := commitDesc
+	if p.CompareURL != "" {
+		commitLink = MattermostLinkFormatter(p.CompareURL, commitDesc)
+	}
+
+	repoLink := MattermostLinkFormatter(p.Repo.HTMLURL, p.Repo.FullName)
+	branchLink := MattermostLinkFormatter(p.Repo.HTMLURL+"/src/"+refName.RefWebLinkPath(), refName.ShortName())
+	text := fmt.Sprintf("[%s:%s] %s pushed by %s", repoLink, branchLink, commitLink, mattermostSenderLink(p.Sender))
+
+	// one line for each commit with the summary of its message
+	var attachmentText strings.Builder
+	for i, commit := range p.Commits {
+		message, _, _ := strings.Cut(commit.Message, "\n")
+		fmt.Fprintf(&attachmentText, "%s: %s - %s", MattermostLinkFormatter(commit.URL, commit.ID[:7]),
+			mattermostMarkdownEscaper.Replace(strings.TrimRight(message, "\r")), mattermostMarkdownEscaper.Replace(commit.Author.Name))
+		if i < len(p.Commits)-1 {
+			attachmentText.WriteString("\n")
+		}
+	}
+
+	return m.createPayload(text, &MattermostAttachment{
+		Fallback: commitDesc,
+		Color:    mattermostColor(greenColor),
+		Text:     attachmentText.String(),
+	}), nil
+}
+
+// Issue implements payloadConvertor Issue method
+func (m mattermostConvertor) Issue(p *api.IssuePayload) (MattermostPayload, error) {
+	text, issueTitle, extraMarkdown, color := getIssuesPayloadInfo(p, MattermostLinkFormatter, true)
+
+	return m.createPayload(text, &MattermostAttachment{
+		Fallback:  issueTitle,
+		Color:     mattermostColor(color),
+		Title:     issueTitle,
+		TitleLink: p.Issue.HTMLURL,
+		Text:      extraMarkdown,
+	}), nil
+}
+
+// IssueComment implements payloadConvertor IssueComment method
+func (m mattermostConvertor) IssueComment(p *api.IssueCommentPayload) (MattermostPayload, error) {
+	text, issueTitle, color := getIssueCommentPayloadInfo(p, MattermostLinkFormatter, true)
+
+	return m.createPayload(text, &MattermostAttachment{
+		Fallback:  issueTitle,
+		Color:     mattermostColor(color),
+		Title:     issueTitle,
+		TitleLink: p.Comment.HTMLURL,
+		Text:      p.Comment.Body,
+	}), nil
+}
+
+// PullRequest implements payloadConvertor PullRequest method
+func (m mattermostConvertor) PullRequest(p *api.PullRequestPayload) (MattermostPayload, error) {
+	text, issueTitle, extraMarkdown, color := getPullRequestPayloadInfo(p, MattermostLinkFormatter, true)
+
+	return m.createPayload(text, &MattermostAttachment{
+		Fallback:  issueTitle,
+		Color:     mattermostColor(color),
+		Title:     issueTitle,
+		TitleLink: p.PullRequest.HTMLURL,
+		Text:      extraMarkdown,
+	}), nil
+}
+
+// Review implements payloadConvertor Review method
+func (m mattermostConvertor) Review(p *api.PullRequestPayload, event webhook_module.HookEventType) (MattermostPayload, error) {
+	var text string
+	var attachment *MattermostAttachment
+	if p.Action == api.HookIssueReviewed {
+		action, err := parseHookPullRequestEventType(event)
+		if err != nil {
+			return MattermostPayload{}, err
+		}
+
+		repoLink := MattermostLinkFormatter(p.Repository.HTMLURL, p.Repository.FullName)
+		title := fmt.Sprintf("#%d %s", p.Index, p.PullRequest.Title)
+		titleLink := MattermostLinkFormatter(p.PullRequest.HTMLURL, title)
+		text = fmt.Sprintf("[%s] Pull request review %s: %s by %s", repoLink, action, titleLink, mattermostSenderLink(p.Sender))
+
+		color := yellowColor
+		switch event {
+		case webhook_module.HookEventPullRequestReviewApproved:
+			color = greenColor
+		case webhook_module.HookEventPullRequestReviewRejected:
+			color = redColor
+		case webhook_module.HookEventPullRequestReviewComment:
+			color = greyColor
+		}
+		attachment = &MattermostAttachment{
+			Fallback:  title,
+			Color:     mattermostColor(color),
+			Title:     title,
+			TitleLink: p.PullRequest.HTMLURL,
+			Text:      p.Review.Content,
+		}
+	}
+
+	return m.createPayload(text, attachment), nil
+}
+
+// Repository implements payloadConvertor Repository method
+func (m mattermostConvertor) Repository(p *api.RepositoryPayload) (MattermostPayload, error) {
+	var text string
+	switch p.Action {
+	case api.HookRepoCreated:
+		text = fmt.Sprintf("[%s] Repository created by %s", MattermostLinkFormatter(p.Repository.HTMLURL, p.Repository.FullName), mattermostSenderLink(p.Sender))
+	case api.HookRepoDeleted:
+		text = fmt.Sprintf("[%s] Repository deleted by %s", mattermostMarkdownEscaper.Replace(p.Repository.FullName), mattermostSenderLink(p.Sender))
+	}
+
+	return m.createPayload(text, nil), nil
+}
+
+// Wiki implements payloadConvertor Wiki method
+func (m mattermostConvertor) Wiki(p *api.WikiPayload) (MattermostPayload, error) {
+	text, _, _ := getWikiPayloadInfo(p, MattermostLinkFormatter, true)
+
+	return m.createPayload(text, nil), nil
+}
+
+// Release implements payloadConvertor Release method
+func (m mattermostConvertor) Release(p *api.ReleasePayload) (MattermostPayload, error) {
+	text, color := getReleasePayloadInfo(p, MattermostLinkFormatter, true)
+
+	return m.createPayload(text, &MattermostAttachment{
+		Fallback:  p.Release.TagName,
+		Color:     mattermostColor(color),
+		Title:     p.Release.Title,
+		TitleLink: p.Release.HTMLURL,
+		Text:      p.Release.Note,
+	}), nil
+}
+
+// Package implements payloadConvertor Package method
+func (m mattermostConvertor) Package(p *api.PackagePayload) (MattermostPayload, error) {
+	text, _ := getPackagePayloadInfo(p, MattermostLinkFormatter, true)
+
+	return m.createPayload(text, nil), nil
+}
+
+// Status implements payloadConvertor Status method
+func (m mattermostConvertor) Status(p *api.CommitStatusPayload) (MattermostPayload, error) {
+	text, _ := getStatusPayloadInfo(p, MattermostLinkFormatter, true)
+
+	return m.createPayload(text, nil), nil
+}
+
+// ProjectColumn implements payloadConvertor ProjectColumn method
+func (m mattermostConvertor) ProjectColumn(p *api.ProjectColumnPayload) (MattermostPayload, error) {
+	text, _ := getProjectColumnPayloadInfo(p, MattermostLinkFormatter, true)
+
+	return m.createPayload(text, nil), nil
+}
+
+// WorkflowRun implements payloadConvertor WorkflowRun method
+func (m mattermostConvertor) WorkflowRun(p *api.WorkflowRunPayload) (MattermostPayload, error) {
+	text, _ := getWorkflowRunPayloadInfo(p, MattermostLinkFormatter, true)
+
+	return m.createPayload(text, nil), nil
+}
+
+// WorkflowJob implements payloadConvertor WorkflowJob method
+func (m mattermostConvertor) WorkflowJob(p *api.WorkflowJobPayload) (MattermostPayload, error) {
+	text, _ := getWorkflowJobPayloadInfo(p, MattermostLinkFormatter, true)
+
+	return m.createPayload(text, nil), nil
+}
+
+func (m mattermostConvertor) createPayload(text string, attachment *MattermostAttachment) MattermostPayload {
+	payload := MattermostPayload{
+		Text:     text,
+		Channel:  m.Channel,
+		Username: m.Username,
+		IconURL:  m.IconURL,
+	}
+	if attachment != nil {
+		payload.Attachments = []MattermostAttachment{*attachment}
+	}
+	return payload
+}
+
+func mattermostSenderLink(sender *api.User) string {
+	return MattermostLinkFormatter(setting.AppURL+url.PathEscape(sender.UserName), sender.UserName)
+}
+
+func mattermostColor(color int) string {
+	return fmt.Sprintf("#%06x", color)
+}
+
+func newMattermostRequest(_ context.Context, w *webhook_model.Webhook, t *webhook_model.HookTask) (*http.Request, []byte, error) {
+	meta := &MattermostMeta{}
+	if err := json.Unmarshal([]byte(w.Meta), meta); err != nil {
+		return nil, nil, fmt.Errorf("newMattermostRequest meta json: %w", err)
+	}
+	var pc payloadConvertor[MattermostPayload] = mattermostConvertor{
+		Channel:  meta.Channel,
+		Username: meta.Username,
+		IconURL:  meta.IconURL,
+	}
+	payload, err := newPayload(pc, []byte(t.PayloadContent), t.EventType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payload.Props = &MattermostProps{Event: string(t.EventType)}
+	if repo := payloadRepository(t); repo != nil {
+		payload.Props.Repository = repo.FullName
+	}
+	return prepareJSONRequest(payload, w, t, true)
+}
+
+func init() {
+	RegisterWebhookRequester(webhook_module.MATTERMOST, newMattermostRequest)
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"testing"
+
+	webhook_model "gitea.dev/models/webhook"
+	"gitea.dev/modules/json"
+	api "gitea.dev/modules/structs"
+	webhook_module "gitea.dev/modules/webhook"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMattermostPayload(t *testing.T) {
+	mc := mattermostConvertor{Channel: "town-square", Username: "gitea"}
+
+	t.Run("Create", func(t *testing.T) {
+		pl, err := mc.Create(createTestPayload())
+		require.NoError(t, err)
+
+		assert.Equal(t, "[[test/repo](http://localhost:3000/test/repo):[test](http://localhost:3000/test/repo/src/branch/test)] branch created by [user1](https://try.gitea.io/user1)", pl.Text)
+		assert.Equal(t, "town-square", pl.Channel)
+		assert.Equal(t, "gitea", pl.Username)
+		assert.Empty(t, pl.Attachments)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		pl, err := mc.Delete(deleteTestPayload())
+		require.NoError(t, err)
+
+		assert.Equal(t, "[[test/repo](http://localhost:3000/test/repo):test] branch deleted by [user1](https://try.gitea.io/user1)", pl.Text)
+		assert.Empty(t, pl.Attachments)
+	})
+
+	t.Run("Fork", func(t *testing.T) {
+		pl, err := mc.Fork(forkTestPayload())
+		require.NoError(t, err)
+
+		assert.Equal(t, "[test/repo2](http://localhost:3000/test/repo2) is forked to [test/repo](http://localhost:3000/test/repo)", pl.Text)
+		assert.Empty(t, pl.Attachments)
+	})
+
+	t.Run("Push", func(t *testing.T) {
+		pl, err := mc.Push(pushTestPayloadWithCommitMessage("fix *all* the [bugs]\n\ndescription"))
+		require.NoError(t, err)
+
+		assert.Equal(t, "[[test/repo](http://localhost:3000/test/repo):[test](http://localhost:3000/test/repo/src/branch/test)] 2 new commits pushed by [user1](https://try.gitea.io/user1)", pl.Text)
+		require.Len(t, pl.Attachments, 1)
+		commit := `[2020558](http://localhost:3000/test/repo/commit/2020558fe2e34debb818a514715839cabd25e778): fix \*all\* the \[bugs\] - user1`
+		assert.Equal(t, commit+"\n"+commit, pl.Attachments[0].Text)
+		assert.Equal(t, "#1ac600", pl.Attachments[0].Color)
+	})
+
+	t.Run("Issue", func(t *testing.T) {
+		p := issueTestPayload()
+
+		p.Action = api.HookIssueOpened
+		pl, err := mc.Issue(p)
+		require.NoError(t, err)
+
+		assert.Equal(t, "[[test/repo](http://localhost:3000/test/repo)] Issue opened: [#2 crash](http://localhost:3000/test/repo/issues/2) by [user1](https://try.gitea.io/user1)", pl.Text)
+		require.Len(t, pl.Attachments, 1)
+		assert.Equal(t, "#2 crash", pl.Attachments[0].Title)
+		assert.Equal(t, "http://localhost:3000/test/repo/issues/2", pl.Attachments[0].TitleLink)
+		assert.Equal(t, "issue body", pl.Attachments[0].Text)
+
+		p.Action = api.HookIssueClosed
+		pl, err = mc.Issue(p)
+		require.NoError(t, err)
+
+		assert.Equal(t, "[[test/repo](http://localhost:3000/test/repo)] Issue closed: [#2 crash](http://localhost:3000/test/repo/issues/2) by [user1](https://try.gitea.io/user1)", pl.Text)
+		require.Len(t, pl.Attachments, 1)
+		assert.Empty(t, pl.Attachments[0].Text)
+	})
+
+	t.Run("IssueComment", func(t *testing.T) {
+		pl, err := mc.IssueComment(issueCommentTestPayload())
+		require.NoError(t, err)
+
+		assert.Equal(t, "[[test/repo](http://localhost:3000/test/repo)] New comment on issue [#2 crash](http://localhost:3000/test/repo/issues/2) by [user1](https://try.gitea.io/user1)", pl.Text)
+		require.Len(t, pl.Attachments, 1)
+		assert.Equal(t, "http://localhost:3000/test/repo/issues/2#issuecomment-4", pl.Attachments[0].TitleLink)
+		assert.Equal(t, "more info needed", pl.Attachments[0].Text)
+	})
+
+	t.Run("PullRequest", func(t *testing.T) {
+		pl, err := mc.PullRequest(pullRequestTestPayload())
+		require.NoError(t, err)
+
+		assert.Equal(t, "[[test/repo](http://localhost:3000/test/repo)] Pull request opened: [#12 Fix bug](http://localhost:3000/test/repo/pulls/12) by [user1](https://try.gitea.io/user1)", pl.Text)
+		require.Len(t, pl.Attachments, 1)
+		assert.Equal(t, "#12 Fix bug", pl.Attachments[0].Title)
+		assert.Equal(t, "fixes bug #2", pl.Attachments[0].Text)
+	})
+
+	t.Run("PullRequestComment", func(t *testing.T) {
+		pl, err := mc.IssueComment(pullRequestCommentTestPayload())
+		require.NoError(t, err)
+
+		assert.Equal(t, "[[test/repo](http://localhost:3000/test/repo)] New comment on pull request [#12 Fix bug](http://localhost:3000/test/repo/pulls/12) by [user1](https://try.gitea.io/user1)", pl.Text)
+		require.Len(t, pl.Attachments, 1)
+		assert.Equal(t, "changes requested", pl.Attachments[0].Text)
+	})
+
+	t.Run("Review", func(t *testing.T) {
+		p := pullRequestTestPayload()
+		p.Action = api.HookIssueReviewed
+
+		pl, err := mc.Review(p, webhook_module.HookEventPullRequestReviewRejected)
+		require.NoError(t, err)
+
+		assert.Equal(t, "[[test/repo](http://localhost:3000/test/repo)] Pull request review requested changes: [#12 Fix bug](http://localhost:3000/test/repo/pulls/12) by [user1](https://try.gitea.io/user1)", pl.Text)
+		require.Len(t, pl.Attachments, 1)
+		assert.Equal(t, "good job", pl.Attachments[0].Text)
+		assert.Equal(t, "#ff3232", pl.Attachments[0].Color)
+	})
+
+	t.Run("Repository", func(t *testing.T) {
+		pl, err := mc.Repository(repositoryTestPayload())
+		require.NoError(t, err)
+
+		assert.Equal(t, "[[test/repo](http://localhost:3000/test/repo)] Repository created by [user1](https://try.gitea.io/user1)", pl.Text)
+	})
+
+	t.Run("Package", func(t *testing.T) {
+		pl, err := mc.Package(packageTestPayload())
+		require.NoError(t, err)
+
+		assert.Equal(t, "Package created: [GiteaContainer:latest](http://localhost:3000/user1/-/packages/container/GiteaContainer/latest) by [user1](https://try.gitea.io/user1)", pl.Text)
+	})
+
+	t.Run("Wiki", func(t *testing.T) {
+		p := wikiTestPayload()
+
+		p.Action = api.HookWikiCreated
+		pl, err := mc.Wiki(p)
+		require.NoError(t, err)
+
+		assert.Equal(t, "[[test/repo](http://localhost:3000/test/repo)] New wiki page '[index](http://localhost:3000/test/repo/wiki/index)' (Wiki change comment) by [user1](https://try.gitea.io/user1)", pl.Text)
+
+		p.Action = api.HookWikiEdited
+		pl, err = mc.Wiki(p)
+		require.NoError(t, err)
+
+		assert.Equal(t, "[[test/repo](http://localhost:3000/test/repo)] Wiki page '[index](http://localhost:3000/test/repo/wiki/index)' edited (Wiki change comment) by [user1](https://try.gitea.io/user1)", pl.Text)
+
+		p.Action = api.HookWikiDeleted
+		pl, err = mc.Wiki(p)
+		require.NoError(t, err)
+
+		assert.Equal(t, "[[test/repo](http://localhost:3000/test/repo)] Wiki page '[index](http://localhost:3000/test/repo/wiki/index)' deleted by [user1](https://try.gitea.io/user1)", pl.Text)
+	})
+
+	t.Run("Release", func(t *testing.T) {
+		pl, err := mc.Release(pullReleaseTestPayload())
+		require.NoError(t, err)
+
+		assert.Equal(t, "[[test/repo](http://localhost:3000/test/repo)] Release created: [v1.0](http://localhost:3000/test/repo/releases/tag/v1.0) by [user1](https://try.gitea.io/user1)", pl.Text)
+		require.Len(t, pl.Attachments, 1)
+		assert.Equal(t, "First stable release", pl.Attachments[0].Title)
+		assert.Equal(t, "Note of first stable release", pl.Attachments[0].Text)
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		pl, err := mc.Status(statusTestPayload())
+		require.NoError(t, err)
+
+		assert.Equal(t, "Commit Status changed: [ci/test \\[2020558fe2\\]](http://localhost:3000/test/repo/actions/runs/1) - tests passed by [user1](https://try.gitea.io/user1)", pl.Text)
+	})
+
+	t.Run("ProjectColumn", func(t *testing.T) {
+		pl, err := mc.ProjectColumn(projectColumnTestPayload())
+		require.NoError(t, err)
+
+		assert.Equal(t, "[[Roadmap](http://localhost:3000/test/repo/projects/1)] Column created: Done by [user1](https://try.gitea.io/user1)", pl.Text)
+	})
+
+	t.Run("WorkflowRun", func(t *testing.T) {
+		pl, err := mc.WorkflowRun(workflowRunTestPayload())
+		require.NoError(t, err)
+
+		assert.Equal(t, "Workflow Run completed: [CI(#1)\\[2020558fe2\\]:success](http://localhost:3000/test/repo/actions/runs/1) by [user1](https://try.gitea.io/user1)", pl.Text)
+	})
+
+	t.Run("WorkflowJob", func(t *testing.T) {
+		pl, err := mc.WorkflowJob(workflowJobTestPayload())
+		require.NoError(t, err)
+
+		assert.Equal(t, "Workflow Job completed: [test(#1)\\[2020558fe2\\]:failure](http://localhost:3000/test/repo/actions/runs/1/jobs/0) by [user1](https://try.gitea.io/user1)", pl.Text)
+	})
+}
+
+func TestMattermostJSONPayload(t *testing.T) {
+	p := pushTestPayload()
+	data, err := p.JSONPayload()
+	require.NoError(t, err)
+
+	hook := &webhook_model.Webhook{
+		RepoID:     3,
+		IsActive:   true,
+		Type:       webhook_module.MATTERMOST,
+		URL:        "https://mattermost.example.com/hooks/xxx",
+		Meta:       `{"channel":"town-square","username":"gitea","icon_url":"https://example.com/gitea.png"}`,
+		HTTPMethod: "POST",
+	}
+	task := &webhook_model.HookTask{
+		HookID:         hook.ID,
+		EventType:      webhook_module.HookEventPush,
+		PayloadContent: string(data),
+		PayloadVersion: 2,
+	}
+
+	req, reqBody, err := newMattermostRequest(t.Context(), hook, task)
+	require.NotNil(t, req)
+	require.NotNil(t, reqBody)
+	require.NoError(t, err)
+
+	assert.Equal(t, "POST", req.Method)
+	assert.Equal(t, "https://mattermost.example.com/hooks/xxx", req.URL.String())
+	assert.Equal(t, "sha256=", req.Header.Get("X-Hub-Signature-256"))
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	var body MattermostPayload
+	err = json.NewDecoder(req.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "[[test/repo](http://localhost:3000/test/repo):[test](http://localhost:3000/test/repo/src/branch/test)] 2 new commits pushed by [user1](https://try.gitea.io/user1)", body.Text)
+	assert.Equal(t, "town-square", body.Channel)
+	assert.Equal(t, "gitea", body.Username)
+	assert.Equal(t, "https://example.com/gitea.png", body.IconURL)
+	assert.Equal(t, &MattermostProps{Event: "push", Repository: "test/repo"}, body.Props)
+}
@@ -318,6 +318,20 @@ func (m msteamsConvertor) Status(p *api.CommitStatusPayload) (MSTeamsPayload, er
 	), nil
 }
 
+func (m msteamsConvertor) ProjectColumn(p *api.ProjectColumnPayload) (MSTeamsPayload, error) {
+	title, color := getProjectColumnPayloadInfo(p, noneLinkFormatter, false)
+
+	return createMSTeamsPayload(
+		p.Repository,
+		p.Sender,
+		title,
+		"",
+		p.Project.HTMLURL,
+		color,
+		&MSTeamsFact{"Column:", p.Column.Title},
+	), nil
+}
+
 func (msteamsConvertor) WorkflowRun(p *api.WorkflowRunPayload) (MSTeamsPayload, error) {
 	title, color := getWorkflowRunPayloadInfo(p, noneLinkFormatter, false)
 
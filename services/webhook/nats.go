@@ -0,0 +1,73 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	webhook_module "gitea.dev/modules/webhook"
+
+	"github.com/nats-io/nats.go"
+)
+
+// natsDialer dials the NATS servers with the dialer of the webhook transport
+type natsDialer struct {
+	ctx  context.Context
+	dial brokerDialContext
+}
+
+func (d *natsDialer) Dial(network, address string) (net.Conn, error) {
+	return d.dial(d.ctx, network, address)
+}
+
+// publishNATS publishes a message to a NATS subject, the streams of JetStream capture it like any other message
+func publishNATS(ctx context.Context, rt *brokerRoundTripper, msg *brokerMessage) (string, error) {
+	opts := []nats.Option{
+		nats.Name("Gitea webhook"),
+		nats.NoReconnect(),
+		// the host is resolved by the dialer, which checks the addresses against the allowed hosts
+		nats.SkipHostLookup(),
+		nats.SetCustomDialer(&natsDialer{ctx: ctx, dial: rt.dial}),
+		func(o *nats.Options) error {
+			// used when the URL scheme is "tls" or when the server requires TLS
+			o.TLSConfig = rt.tlsConfig(msg.URL)
+			return nil
+		},
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		opts = append(opts, nats.Timeout(time.Until(deadline)))
+	}
+	nc, err := nats.Connect(msg.URL.String(), opts...)
+	if err != nil {
+		return "", err
+	}
+	defer nc.Close()
+
+	m := nats.NewMsg(msg.Topic)
+	m.Data = msg.Body
+	for k, v := range msg.Headers {
+		m.Header.Set(k, v)
+	}
+	m.Header.Set("Content-Type", msg.ContentType)
+	m.Header.Set(nats.MsgIdHdr, msg.ID)
+	if err := nc.PublishMsg(m); err != nil {
+		return "", err
+	}
+	// the server reports the denied publications before it answers the flush
+	if err := nc.FlushWithContext(ctx); err != nil {
+		return "", err
+	}
+	if err := nc.LastError(); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Published to subject %q", msg.Topic), nil
+}
+
+func init() {
+	RegisterWebhookRequester(webhook_module.NATS, newBrokerRequest)
+	registerBrokerPublisher(publishNATS, "nats", "tls")
+}
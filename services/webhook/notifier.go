@@ -14,6 +14,7 @@ import (
 	packages_model "gitea.dev/models/packages"
 	"gitea.dev/models/perm"
 	access_model "gitea.dev/models/perm/access"
+	project_model "gitea.dev/models/project"
 	repo_model "gitea.dev/models/repo"
 	user_model "gitea.dev/models/user"
 	"gitea.dev/modules/git"
@@ -883,6 +884,85 @@ func (m *webhookNotifier) DeleteRelease(ctx context.Context, doer *user_model.Us
 	sendReleaseHook(ctx, doer, rel, api.HookReleaseDeleted)
 }
 
+func (m *webhookNotifier) NewProjectColumn(ctx context.Context, doer *user_model.User, column *project_model.Column) {
+	notifyProjectColumn(ctx, doer, column, api.HookProjectColumnCreated, nil, nil)
+}
+
+func (m *webhookNotifier) UpdateProjectColumn(ctx context.Context, doer *user_model.User, column *project_model.Column) {
+	notifyProjectColumn(ctx, doer, column, api.HookProjectColumnEdited, nil, nil)
+}
+
+func (m *webhookNotifier) DeleteProjectColumn(ctx context.Context, doer *user_model.User, column *project_model.Column) {
+	notifyProjectColumn(ctx, doer, column, api.HookProjectColumnDeleted, nil, nil)
+}
+
+func (m *webhookNotifier) IssueChangeProjectColumn(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, column *project_model.Column, oldColumnID int64) {
+	var fromColumn *project_model.Column
+	if oldColumnID > 0 {
+		var err error
+		fromColumn, err = project_model.GetColumn(ctx, oldColumnID)
+		if err != nil && !project_model.IsErrProjectColumnNotExist(err) {
+			log.Error("GetColumn: %v", err)
+			return
+		}
+	}
+	notifyProjectColumn(ctx, doer, column, api.HookProjectColumnItemMoved, fromColumn, issue)
+}
+
+func notifyProjectColumn(ctx context.Context, doer *user_model.User, column *project_model.Column, action api.HookProjectColumnAction, fromColumn *project_model.Column, issue *issues_model.Issue) {
+	project, err := project_model.GetProjectByID(ctx, column.ProjectID)
+	if err != nil {
+		log.Error("GetProjectByID: %v", err)
+		return
+	}
+
+	payload := &api.ProjectColumnPayload{
+		Action:  action,
+		Project: convert.ToAPIProject(ctx, project),
+		Column:  convert.ToAPIProjectColumn(column),
+		Sender:  convert.ToUser(ctx, doer, nil),
+	}
+	if fromColumn != nil {
+		payload.FromColumn = convert.ToAPIProjectColumn(fromColumn)
+	}
+
+	// the hooks of the repository of a moved issue are triggered, the issues of an owner project belong to the repositories of the owner
+	var source EventSource
+	if issue != nil {
+		if err := issue.LoadRepo(ctx); err != nil {
+			log.Error("LoadRepo: %v", err)
+			return
+		}
+		source.Repository = issue.Repo
+		payload.Issue = convert.ToAPIIssue(ctx, doer, issue)
+	} else if project.RepoID > 0 {
+		if err := project.LoadRepo(ctx); err != nil {
+			log.Error("LoadRepo: %v", err)
+			return
+		}
+		source.Repository = project.Repo
+	} else {
+		if err := project.LoadOwner(ctx); err != nil {
+			log.Error("LoadOwner: %v", err)
+			return
+		}
+		source.Owner = project.Owner
+	}
+
+	owner := source.Owner
+	if source.Repository != nil {
+		payload.Repository = convert.ToRepo(ctx, source.Repository, access_model.Permission{AccessMode: perm.AccessModeOwner})
+		owner = source.Repository.MustOwner(ctx)
+	}
+	if owner.IsOrganization() {
+		payload.Organization = convert.ToOrganization(ctx, organization.OrgFromUser(owner))
+	}
+
+	if err := PrepareWebhooks(ctx, source, webhook_module.HookEventProjectColumn, payload); err != nil {
+		log.Error("PrepareWebhooks: %v", err)
+	}
+}
+
 func (m *webhookNotifier) SyncPushCommits(ctx context.Context, pusher *user_model.User, repo *repo_model.Repository, opts *repository.PushUpdateOptions, commits *repository.PushCommits) {
 	apiPusher := convert.ToUser(ctx, pusher, nil)
 	apiCommits, apiHeadCommit, err := commits.ToAPIPayloadCommits(ctx, repo)
@@ -0,0 +1,72 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"slices"
+
+	"gitea.dev/modules/setting"
+	webhook_module "gitea.dev/modules/webhook"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// publishRedis adds an entry to a Redis stream, the entry has a field for each header and the body in the "payload" field
+func publishRedis(ctx context.Context, rt *brokerRoundTripper, msg *brokerMessage) (string, error) {
+	opts, err := redis.ParseURL(msg.URL.String())
+	if err != nil {
+		return "", err
+	}
+	// the custom dialer replaces the one of the client which establishes the TLS connections
+	tlsConfig := opts.TLSConfig
+	if tlsConfig != nil {
+		tlsConfig.InsecureSkipVerify = setting.Webhook.SkipTLSVerify
+	}
+	opts.Dialer = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := rt.dial(ctx, network, addr)
+		if err != nil || tlsConfig == nil {
+			return conn, err
+		}
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return tlsConn, nil
+	}
+	opts.MaxRetries = -1 // the failed deliveries are retried by the webhook queue
+	opts.DisableIdentity = true
+
+	client := redis.NewClient(opts)
+	defer client.Close()
+
+	keys := make([]string, 0, len(msg.Headers))
+	for k := range msg.Headers {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	values := make([]any, 0, 2*len(keys)+4)
+	for _, k := range keys {
+		values = append(values, k, msg.Headers[k])
+	}
+	values = append(values, "Content-Type", msg.ContentType, "payload", msg.Body)
+
+	id, err := client.XAdd(ctx, &redis.XAddArgs{
+		Stream: msg.Topic,
+		Values: values,
+	}).Result()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Added entry %s to stream %q", id, msg.Topic), nil
+}
+
+func init() {
+	RegisterWebhookRequester(webhook_module.REDIS, newBrokerRequest)
+	registerBrokerPublisher(publishRedis, "redis", "rediss")
+}
@@ -180,6 +180,12 @@ func (t telegramConvertor) Status(p *api.CommitStatusPayload) (TelegramPayload,
 	return createTelegramPayloadHTML(text), nil
 }
 
+func (t telegramConvertor) ProjectColumn(p *api.ProjectColumnPayload) (TelegramPayload, error) {
+	text, _ := getProjectColumnPayloadInfo(p, htmlLinkFormatter, true)
+
+	return createTelegramPayloadHTML(text), nil
+}
+
 func (telegramConvertor) WorkflowRun(p *api.WorkflowRunPayload) (TelegramPayload, error) {
 	text, _ := getWorkflowRunPayloadInfo(p, htmlLinkFormatter, true)
 
@@ -0,0 +1,423 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	webhook_model "gitea.dev/models/webhook"
+	"gitea.dev/modules/json"
+	"gitea.dev/modules/setting"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/util"
+	webhook_module "gitea.dev/modules/webhook"
+)
+
+const (
+	payloadTemplateMaxLength = 64 * 1024
+	// payloadTemplateMaxRangeDepth limits the nested ranges, the iterations grow exponentially with the depth
+	payloadTemplateMaxRangeDepth = 3
+	// payloadTemplateMaxSize limits the size of the payloads rendered by the payload templates
+	payloadTemplateMaxSize = 1024 * 1024
+)
+
+// PayloadTemplatePreviewEvents are the events which have a sample payload to preview the payload templates
+var PayloadTemplatePreviewEvents = []webhook_module.HookEventType{
+	webhook_module.HookEventPush,
+	webhook_module.HookEventCreate,
+	webhook_module.HookEventDelete,
+	webhook_module.HookEventIssues,
+	webhook_module.HookEventIssueComment,
+	webhook_module.HookEventPullRequest,
+	webhook_module.HookEventRelease,
+}
+
+func payloadTemplateFuncs(event webhook_module.HookEventType) template.FuncMap {
+	return template.FuncMap{
+		"event":      event.Event,
+		"eventType":  func() string { return string(event) },
+		"json":       func(v any) (string, error) { b, err := json.Marshal(v); return string(b), err },
+		"lower":      strings.ToLower,
+		"upper":      strings.ToUpper,
+		"trim":       strings.TrimSpace,
+		"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+		"trimSuffix": func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+		"replace":    payloadTemplateReplace,
+		"printf":     payloadTemplatePrintf,
+		"contains":   func(substr, s string) bool { return strings.Contains(s, substr) },
+		"hasPrefix":  func(prefix, s string) bool { return strings.HasPrefix(s, prefix) },
+		"hasSuffix":  func(suffix, s string) bool { return strings.HasSuffix(s, suffix) },
+		"split":      func(sep, s string) []string { return strings.Split(s, sep) },
+		"join":       func(sep string, elems []string) string { return strings.Join(elems, sep) },
+		"truncate":   func(n int, s string) string { return util.TruncateRunes(s, n) },
+		"default": func(def, v any) any {
+			if v == nil || v == "" {
+				return def
+			}
+			return v
+		},
+		"formatTime": func(layout string, t time.Time) string { return t.Format(layout) },
+	}
+}
+
+// payloadTemplateReplace replaces the occurrences of old in s, it fails instead of allocating a too large string
+func payloadTemplateReplace(old, new, s string) (string, error) {
+	n := strings.Count(s, old)
+	if len(s)+n*(len(new)-len(old)) > payloadTemplateMaxSize {
+		return "", fmt.Errorf("the replaced string is larger than %d bytes", payloadTemplateMaxSize)
+	}
+	return strings.ReplaceAll(s, old, new), nil
+}
+
+// payloadTemplatePrintf is the printf of the templates without the large widths and precisions,
+// which would allocate up to a megabyte for each verb before the size of the payload is checked
+func payloadTemplatePrintf(format string, args ...any) (string, error) {
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		digits := 0
+		for i++; i < len(format) && strings.IndexByte("+-# 0123456789.[]*", format[i]) >= 0; i++ {
+			switch {
+			case format[i] == '*':
+				return "", errors.New("printf doesn't support the widths and precisions from the arguments")
+			case format[i] >= '0' && format[i] <= '9':
+				if digits++; digits > 3 {
+					return "", errors.New("printf doesn't support the widths and precisions larger than 999")
+				}
+			default:
+				digits = 0
+			}
+		}
+	}
+	return fmt.Sprintf(format, args...), nil
+}
+
+// checkPayloadTemplateNode rejects the actions which could make the rendering unbounded: the templates can't
+// define or call templates and can only range over the payload data, in a few nested ranges
+func checkPayloadTemplateNode(node parse.Node, rangeDepth int) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := checkPayloadTemplateNode(child, rangeDepth); err != nil {
+				return err
+			}
+		}
+	case *parse.TemplateNode:
+		return util.NewInvalidArgumentErrorf("the payload template can't use other templates: %s", n)
+	case *parse.IfNode:
+		return errors.Join(checkPayloadTemplateNode(n.List, rangeDepth), checkPayloadTemplateNode(n.ElseList, rangeDepth))
+	case *parse.WithNode:
+		return errors.Join(checkPayloadTemplateNode(n.List, rangeDepth), checkPayloadTemplateNode(n.ElseList, rangeDepth))
+	case *parse.RangeNode:
+		if !isPayloadDataPipe(n.Pipe) {
+			return util.NewInvalidArgumentErrorf("the payload template can only range over the fields of the payload: %s", n.Pipe)
+		}
+		if rangeDepth >= payloadTemplateMaxRangeDepth {
+			return util.NewInvalidArgumentErrorf("the payload template can't nest more than %d ranges", payloadTemplateMaxRangeDepth)
+		}
+		return errors.Join(checkPayloadTemplateNode(n.List, rangeDepth+1), checkPayloadTemplateNode(n.ElseList, rangeDepth))
+	}
+	return nil
+}
+
+func isPayloadDataPipe(pipe *parse.PipeNode) bool {
+	if len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+	switch arg := pipe.Cmds[0].Args[0].(type) {
+	case *parse.FieldNode, *parse.ChainNode:
+		return true
+	case *parse.VariableNode:
+		return arg.Ident[0] == "$" || len(arg.Ident) > 1
+	}
+	return false
+}
+
+func parsePayloadTemplate(text string, event webhook_module.HookEventType) (*template.Template, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, util.NewInvalidArgumentErrorf("the payload template is empty")
+	}
+	if len(text) > payloadTemplateMaxLength {
+		return nil, util.NewInvalidArgumentErrorf("the payload template is longer than %d bytes", payloadTemplateMaxLength)
+	}
+	tmpl, err := template.New("payload").Option("missingkey=zero").Funcs(payloadTemplateFuncs(event)).Parse(text)
+	if err != nil {
+		return nil, util.NewInvalidArgumentErrorf("%v", err)
+	}
+	if len(tmpl.Templates()) > 1 {
+		return nil, util.NewInvalidArgumentErrorf("the payload template can't define other templates")
+	}
+	if err := checkPayloadTemplateNode(tmpl.Tree.Root, 0); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// ValidatePayloadTemplate returns an invalid argument error if the text can't be used as a payload template
+func ValidatePayloadTemplate(text string) error {
+	_, err := parsePayloadTemplate(text, webhook_module.HookEventPush)
+	return err
+}
+
+type templatePayload []byte
+
+// limitedBuffer is a buffer which fails the writes beyond its size
+type limitedBuffer struct {
+	bytes.Buffer
+	size int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.size {
+		return 0, fmt.Errorf("the payload is larger than %d bytes", b.size)
+	}
+	return b.Buffer.Write(p)
+}
+
+// templateConvertor renders the payload template of a webhook with the payload of the event
+type templateConvertor struct {
+	tmpl *template.Template
+}
+
+func (tc templateConvertor) render(p api.Payloader) (templatePayload, error) {
+	buf := &limitedBuffer{size: payloadTemplateMaxSize}
+	if err := tc.tmpl.Execute(buf, p); err != nil {
+		return nil, err
+	}
+	if !json.Valid(buf.Bytes()) {
+		return nil, errors.New("the payload template didn't render valid JSON")
+	}
+	return buf.Bytes(), nil
+}
+
+// Create implements PayloadConvertor Create method
+func (tc templateConvertor) Create(p *api.CreatePayload) (templatePayload, error) {
+	return tc.render(p)
+}
+
+// Delete implements PayloadConvertor Delete method
+func (tc templateConvertor) Delete(p *api.DeletePayload) (templatePayload, error) {
+	return tc.render(p)
+}
+
+// Fork implements PayloadConvertor Fork method
+func (tc templateConvertor) Fork(p *api.ForkPayload) (templatePayload, error) {
+	return tc.render(p)
+}
+
+// Push implements PayloadConvertor Push method
+func (tc templateConvertor) Push(p *api.PushPayload) (templatePayload, error) {
+	return tc.render(p)
+}
+
+// Issue implements PayloadConvertor Issue method
+func (tc templateConvertor) Issue(p *api.IssuePayload) (templatePayload, error) {
+	return tc.render(p)
+}
+
+// IssueComment implements PayloadConvertor IssueComment method
+func (tc templateConvertor) IssueComment(p *api.IssueCommentPayload) (templatePayload, error) {
+	return tc.render(p)
+}
+
+// PullRequest implements PayloadConvertor PullRequest method
+func (tc templateConvertor) PullRequest(p *api.PullRequestPayload) (templatePayload, error) {
+	return tc.render(p)
+}
+
+// Review implements PayloadConvertor Review method
+func (tc templateConvertor) Review(p *api.PullRequestPayload, _ webhook_module.HookEventType) (templatePayload, error) {
+	return tc.render(p)
+}
+
+// Repository implements PayloadConvertor Repository method
+func (tc templateConvertor) Repository(p *api.RepositoryPayload) (templatePayload, error) {
+	return tc.render(p)
+}
+
+// Wiki implements PayloadConvertor Wiki method
+func (tc templateConvertor) Wiki(p *api.WikiPayload) (templatePayload, error) {
+	return tc.render(p)
+}
+
+// Release implements PayloadConvertor Release method
+func (tc templateConvertor) Release(p *api.ReleasePayload) (templatePayload, error) {
+	return tc.render(p)
+}
+
+func (tc templateConvertor) Package(p *api.PackagePayload) (templatePayload, error) {
+	return tc.render(p)
+}
+
+func (tc templateConvertor) Status(p *api.CommitStatusPayload) (templatePayload, error) {
+	return tc.render(p)
+}
+
+func (tc templateConvertor) ProjectColumn(p *api.ProjectColumnPayload) (templatePayload, error) {
+	return tc.render(p)
+}
+
+func (tc templateConvertor) WorkflowRun(p *api.WorkflowRunPayload) (templatePayload, error) {
+	return tc.render(p)
+}
+
+func (tc templateConvertor) WorkflowJob(p *api.WorkflowJobPayload) (templatePayload, error) {
+	return tc.render(p)
+}
+
+// newTemplateRequest creates the request of a webhook which renders its payload template
+func newTemplateRequest(w *webhook_model.Webhook, t *webhook_model.HookTask) (*http.Request, []byte, error) {
+	tmpl, err := parsePayloadTemplate(w.PayloadTemplate, t.EventType)
+	if err != nil {
+		return nil, nil, err
+	}
+	var tc payloadConvertor[templatePayload] = templateConvertor{tmpl: tmpl}
+	body, err := newPayload(tc, []byte(t.PayloadContent), t.EventType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, body, addDefaultHeaders(req, []byte(w.Secret), w, t, body)
+}
+
+// samplePayload returns an example payload of an event
+func samplePayload(event webhook_module.HookEventType) (api.Payloader, error) {
+	repoURL := setting.AppURL + "gitea/example"
+	owner := &api.User{ID: 1, UserName: "gitea", FullName: "Gitea", HTMLURL: setting.AppURL + "gitea"}
+	sender := &api.User{ID: 2, UserName: "octocat", FullName: "Octo Cat", Email: "octocat@example.com", HTMLURL: setting.AppURL + "octocat"}
+	repo := &api.Repository{ID: 1, Owner: owner, Name: "example", FullName: "gitea/example", HTMLURL: repoURL, DefaultBranch: "main"}
+	created := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	commit := &api.PayloadCommit{
+		ID:        "2020558fe2e34debb818a514715839cabd25e778",
+		Message:   "Fix the example\n",
+		URL:       repoURL + "/commit/2020558fe2e34debb818a514715839cabd25e778",
+		Author:    &api.PayloadUser{Name: "Octo Cat", Email: "octocat@example.com", UserName: "octocat"},
+		Committer: &api.PayloadUser{Name: "Octo Cat", Email: "octocat@example.com", UserName: "octocat"},
+		Timestamp: created,
+	}
+	issue := &api.Issue{
+		ID:       1,
+		URL:      setting.AppURL + "api/v1/repos/gitea/example/issues/1",
+		HTMLURL:  repoURL + "/issues/1",
+		Index:    1,
+		Poster:   sender,
+		Title:    "The example is broken",
+		Body:     "It doesn't build.",
+		State:    api.StateOpen,
+		Created:  created,
+		Updated:  created,
+		Labels:   []*api.Label{},
+		Assignee: owner,
+	}
+
+	switch event {
+	case webhook_module.HookEventPush:
+		return &api.PushPayload{
+			Ref:          "refs/heads/main",
+			Before:       "a4dc4b9e6e12b1b5e6c3a1f7b6a1ca3c4e5d6f70",
+			After:        commit.ID,
+			CompareURL:   repoURL + "/compare/a4dc4b9e6e12b1b5e6c3a1f7b6a1ca3c4e5d6f70..." + commit.ID,
+			Commits:      []*api.PayloadCommit{commit},
+			TotalCommits: 1,
+			HeadCommit:   commit,
+			Repo:         repo,
+			Pusher:       sender,
+			Sender:       sender,
+		}, nil
+	case webhook_module.HookEventCreate:
+		return &api.CreatePayload{Sha: commit.ID, Ref: "feature", RefType: "branch", Repo: repo, Sender: sender}, nil
+	case webhook_module.HookEventDelete:
+		return &api.DeletePayload{Ref: "feature", RefType: "branch", PusherType: api.PusherTypeUser, Repo: repo, Sender: sender}, nil
+	case webhook_module.HookEventIssues:
+		return &api.IssuePayload{Action: api.HookIssueOpened, Index: issue.Index, Issue: issue, Repository: repo, Sender: sender}, nil
+	case webhook_module.HookEventIssueComment:
+		return &api.IssueCommentPayload{
+			Action: api.HookIssueCommentCreated,
+			Issue:  issue,
+			Comment: &api.Comment{
+				ID:       1,
+				HTMLURL:  issue.HTMLURL + "#issuecomment-1",
+				IssueURL: issue.URL,
+				Poster:   owner,
+				Body:     "Thanks, I'm on it.",
+				Created:  created,
+				Updated:  created,
+			},
+			Repository: repo,
+			Sender:     owner,
+		}, nil
+	case webhook_module.HookEventPullRequest:
+		return &api.PullRequestPayload{
+			Action: api.HookIssueOpened,
+			Index:  2,
+			PullRequest: &api.PullRequest{
+				ID:      2,
+				URL:     setting.AppURL + "api/v1/repos/gitea/example/pulls/2",
+				HTMLURL: repoURL + "/pulls/2",
+				Index:   2,
+				Poster:  sender,
+				Title:   "Fix the example",
+				Body:    "Fixes #1",
+				State:   api.StateOpen,
+				Base:    &api.PRBranchInfo{Name: "main", Ref: "main", Sha: "a4dc4b9e6e12b1b5e6c3a1f7b6a1ca3c4e5d6f70", RepoID: repo.ID, Repository: repo},
+				Head:    &api.PRBranchInfo{Name: "fix", Ref: "fix", Sha: commit.ID, RepoID: repo.ID, Repository: repo},
+				Created: &created,
+				Updated: &created,
+			},
+			Repository: repo,
+			Sender:     sender,
+		}, nil
+	case webhook_module.HookEventRelease:
+		return &api.ReleasePayload{
+			Action: api.HookReleasePublished,
+			Release: &api.Release{
+				ID:          1,
+				TagName:     "v1.0.0",
+				Target:      "main",
+				Title:       "v1.0.0",
+				Note:        "The first release.",
+				HTMLURL:     repoURL + "/releases/tag/v1.0.0",
+				Publisher:   owner,
+				CreatedAt:   created,
+				PublishedAt: created,
+			},
+			Repository: repo,
+			Sender:     owner,
+		}, nil
+	}
+	return nil, util.NewInvalidArgumentErrorf("no sample payload for the event %q", event)
+}
+
+// PreviewPayloadTemplate renders a payload template with the sample payload of an event
+func PreviewPayloadTemplate(text string, event webhook_module.HookEventType) ([]byte, error) {
+	p, err := samplePayload(event)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := parsePayloadTemplate(text, event)
+	if err != nil {
+		return nil, err
+	}
+	data, err := p.JSONPayload()
+	if err != nil {
+		return nil, err
+	}
+	return newPayload[templatePayload](templateConvertor{tmpl: tmpl}, data, event)
+}
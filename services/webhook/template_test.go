@@ -0,0 +1,200 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gitea.dev/models/unittest"
+	webhook_model "gitea.dev/models/webhook"
+	"gitea.dev/modules/json"
+	webhook_module "gitea.dev/modules/webhook"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePayloadTemplate(t *testing.T) {
+	assert.NoError(t, ValidatePayloadTemplate(`{"repo": {{json .Repository.FullName}}}`))
+	assert.NoError(t, ValidatePayloadTemplate(`[{{range $i, $c := .Commits}}{{if $i}},{{end}}{{json $c.ID}}{{end}}]`))
+	assert.NoError(t, ValidatePayloadTemplate(`{{range $.Commits}}{{end}}{{with .Sender}}{{range .Email}}{{end}}{{end}}`))
+
+	for _, text := range []string{
+		"",
+		"   ",
+		`{{`,
+		`{{exec "ls"}}`,
+		`{{define "x"}}{{template "x"}}{{end}}{{template "x"}}`,
+		`{{block "x" .}}{{end}}`,
+		`{{range 100000000}}{{end}}`,
+		`{{range split "," "a,b"}}{{end}}`,
+		`{{with .Commits}}{{range $x := 5}}{{end}}{{end}}`,
+		`{{range .Commits}}{{range $.Commits}}{{range $.Commits}}{{range $.Commits}}{{end}}{{end}}{{end}}{{end}}`,
+		strings.Repeat(" ", payloadTemplateMaxLength+1),
+	} {
+		err := ValidatePayloadTemplate(text)
+		assert.Error(t, err, "%q", text)
+	}
+}
+
+func TestTemplateRequest(t *testing.T) {
+	p := pushTestPayload()
+	data, err := p.JSONPayload()
+	require.NoError(t, err)
+
+	hook := &webhook_model.Webhook{
+		RepoID:      3,
+		IsActive:    true,
+		Type:        webhook_module.GITEA,
+		URL:         "https://example.gitea.io/",
+		ContentType: webhook_model.ContentTypeTemplate,
+		PayloadTemplate: `{
+	"event": {{json event}},
+	"type": {{json eventType}},
+	"text": {{printf "%s pushed %d commits to %s" .Pusher.UserName .TotalCommits (trimPrefix "refs/heads/" .Ref) | json}},
+	"ids": [{{range $i, $c := .Commits}}{{if $i}}, {{end}}{{truncate 7 $c.ID | json}}{{end}}],
+	"repo": {{upper .Repo.Name | json}},
+	"missing": {{default "none" .Repo.Description | json}}
+}`,
+	}
+	task := &webhook_model.HookTask{
+		HookID:         hook.ID,
+		EventType:      webhook_module.HookEventPush,
+		PayloadContent: string(data),
+		PayloadVersion: 2,
+	}
+
+	req, reqBody, err := newTemplateRequest(hook, task)
+	require.NoError(t, err)
+	require.NotNil(t, req)
+
+	assert.Equal(t, "POST", req.Method)
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	assert.Equal(t, "push", req.Header.Get("X-Gitea-Event"))
+	assert.JSONEq(t, `{
+	"event": "push",
+	"type": "push",
+	"text": "user1 pushed 2 commits to test",
+	"ids": ["2020558", "2020558"],
+	"repo": "REPO",
+	"missing": "none"
+}`, string(reqBody))
+
+	t.Run("InvalidJSON", func(t *testing.T) {
+		hook.PayloadTemplate = `{"text": {{.Ref}}}`
+		_, _, err := newTemplateRequest(hook, task)
+		assert.ErrorContains(t, err, "valid JSON")
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		nested := strings.Repeat(`{{range $.Commits}}`, payloadTemplateMaxRangeDepth)
+		padding := `{{printf "` + strings.Repeat(`%999[1]d`, 200) + `" 1}}`
+		hook.PayloadTemplate = `["` + nested + padding + strings.Repeat(`{{end}}`, payloadTemplateMaxRangeDepth) + `"]`
+		_, _, err := newTemplateRequest(hook, task)
+		assert.ErrorContains(t, err, "larger than")
+
+		hook.PayloadTemplate = `[{{printf "%01000000d%[1]01000000d" 1 | json}}]`
+		_, _, err = newTemplateRequest(hook, task)
+		assert.ErrorContains(t, err, "larger than 999")
+
+		hook.PayloadTemplate = `[{{replace "" (printf "%999d" 1) .Ref | replace "" (printf "%999d" 1) | json}}]`
+		_, _, err = newTemplateRequest(hook, task)
+		assert.ErrorContains(t, err, "larger than")
+	})
+
+	t.Run("Sandboxed", func(t *testing.T) {
+		hook.PayloadTemplate = `{{template "payload" .}}`
+		_, _, err := newTemplateRequest(hook, task)
+		assert.Error(t, err)
+	})
+}
+
+func TestPreviewPayloadTemplate(t *testing.T) {
+	for _, event := range PayloadTemplatePreviewEvents {
+		payload, err := PreviewPayloadTemplate(`{"event": {{json eventType}}, "sender": {{json .Sender.UserName}}}`, event)
+		require.NoError(t, err, event)
+		var v struct {
+			Event  string `json:"event"`
+			Sender string `json:"sender"`
+		}
+		require.NoError(t, json.Unmarshal(payload, &v))
+		assert.Equal(t, string(event), v.Event)
+		assert.NotEmpty(t, v.Sender)
+	}
+
+	_, err := PreviewPayloadTemplate(`{}`, webhook_module.HookEventWiki)
+	assert.Error(t, err)
+}
+
+func TestWebhookDeliverCloudEvents(t *testing.T) {
+	assert.NoError(t, unittest.PrepareTestDatabase())
+
+	p := pushTestPayload()
+	data, err := p.JSONPayload()
+	require.NoError(t, err)
+
+	requests := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- r
+		bodies <- body
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(s.Close)
+
+	deliver := func(t *testing.T, contentType webhook_model.HookContentType) (*http.Request, []byte, *webhook_model.HookTask) {
+		hook := &webhook_model.Webhook{
+			RepoID:      3,
+			IsActive:    true,
+			Type:        webhook_module.GITEA,
+			URL:         s.URL + "/webhook",
+			HTTPMethod:  http.MethodPost,
+			ContentType: contentType,
+		}
+		require.NoError(t, webhook_model.CreateWebhook(t.Context(), hook))
+
+		hookTask, err := webhook_model.CreateHookTask(t.Context(), &webhook_model.HookTask{
+			HookID:         hook.ID,
+			EventType:      webhook_module.HookEventPush,
+			PayloadContent: string(data),
+			PayloadVersion: 2,
+		})
+		require.NoError(t, err)
+		require.NoError(t, Deliver(t.Context(), hookTask))
+		assert.True(t, hookTask.IsSucceed)
+		return <-requests, <-bodies, hookTask
+	}
+
+	t.Run("Structured", func(t *testing.T) {
+		req, body, hookTask := deliver(t, webhook_model.ContentTypeCloudEvents)
+		assert.Equal(t, CloudEventsContentType, req.Header.Get("Content-Type"))
+		assert.Equal(t, "push", req.Header.Get("X-Gitea-Event"))
+
+		var event CloudEvent
+		require.NoError(t, json.Unmarshal(body, &event))
+		assert.Equal(t, "1.0", event.SpecVersion)
+		assert.Equal(t, hookTask.UUID, event.ID)
+		assert.Equal(t, "io.gitea.push", event.Type)
+		assert.Equal(t, "test/repo", event.Subject)
+		assert.JSONEq(t, string(data), string(event.Data))
+	})
+
+	t.Run("Binary", func(t *testing.T) {
+		req, body, hookTask := deliver(t, webhook_model.ContentTypeCloudEventsBinary)
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		assert.Equal(t, "1.0", req.Header.Get("Ce-Specversion"))
+		assert.Equal(t, hookTask.UUID, req.Header.Get("Ce-Id"))
+		assert.Equal(t, "http://localhost:3000/test/repo", req.Header.Get("Ce-Source"))
+		assert.Equal(t, "io.gitea.push", req.Header.Get("Ce-Type"))
+		assert.Equal(t, "test/repo", req.Header.Get("Ce-Subject"))
+		assert.NotEmpty(t, req.Header.Get("Ce-Time"))
+		assert.Equal(t, "push", req.Header.Get("X-Gitea-Event"))
+		assert.JSONEq(t, string(data), string(body))
+	})
+}
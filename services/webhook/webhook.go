@@ -21,6 +21,7 @@ import (
 	"gitea.dev/modules/queue"
 	"gitea.dev/modules/setting"
 	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/timeutil"
 	"gitea.dev/modules/util"
 	webhook_module "gitea.dev/modules/webhook"
 )
@@ -94,6 +95,11 @@ func handler(items ...int64) []int64 {
 			log.Trace("Task[%d] has already been delivered", task.ID)
 			continue
 		}
+		if task.NextAttemptUnix > timeutil.TimeStampNow() {
+			// The retry is pushed again once its backoff has elapsed
+			log.Trace("Task[%d] is waiting for its retry", task.ID)
+			continue
+		}
 
 		if err := Deliver(ctx, task); err != nil {
 			log.Error("Unable to deliver webhook task[%d]: %v", task.ID, err)
@@ -133,6 +139,16 @@ func checkBranchFilter(branchFilter string, ref git.RefName) bool {
 // The payload is saved as-is. The adjustments depending on the webhook type happen
 // right before delivery, in the [Deliver] method.
 func PrepareWebhook(ctx context.Context, w *webhook_model.Webhook, event webhook_module.HookEventType, p api.Payloader) error {
+	return prepareWebhook(ctx, w, event, p, false)
+}
+
+// PrepareTestWebhook creates a hook task for a test delivery requested by a user,
+// its failures don't count towards the deactivation of the webhook
+func PrepareTestWebhook(ctx context.Context, w *webhook_model.Webhook, event webhook_module.HookEventType, p api.Payloader) error {
+	return prepareWebhook(ctx, w, event, p, true)
+}
+
+func prepareWebhook(ctx context.Context, w *webhook_model.Webhook, event webhook_module.HookEventType, p api.Payloader, isTest bool) error {
 	// Skip sending if webhooks are disabled.
 	if setting.DisableWebhooks {
 		return nil
@@ -158,16 +174,29 @@ func PrepareWebhook(ctx context.Context, w *webhook_model.Webhook, event webhook
 		}
 	}
 
+	if w.PathFilter != "" {
+		if files, ok := getPayloadChangedFiles(ctx, p); ok && !checkPathFilter(w.PathFilter, files) {
+			return nil
+		}
+	}
+
 	payload, err := p.JSONPayload()
 	if err != nil {
 		return fmt.Errorf("JSONPayload for %s: %w", event, err)
 	}
 
+	if ok, err := checkPayloadFilters(ctx, w, payload); err != nil {
+		return fmt.Errorf("checkPayloadFilters for %s: %w", event, err)
+	} else if !ok {
+		return nil
+	}
+
 	task, err := webhook_model.CreateHookTask(ctx, &webhook_model.HookTask{
 		HookID:         w.ID,
 		PayloadContent: string(payload),
 		EventType:      event,
 		PayloadVersion: 2,
+		IsTest:         isTest,
 	})
 	if err != nil {
 		return fmt.Errorf("CreateHookTask for %s: %w", event, err)
@@ -181,6 +181,12 @@ func (wc wechatworkConvertor) Status(p *api.CommitStatusPayload) (WechatworkPayl
 	return newWechatworkMarkdownPayload(text), nil
 }
 
+func (wc wechatworkConvertor) ProjectColumn(p *api.ProjectColumnPayload) (WechatworkPayload, error) {
+	text, _ := getProjectColumnPayloadInfo(p, noneLinkFormatter, true)
+
+	return newWechatworkMarkdownPayload(text), nil
+}
+
 func (wc wechatworkConvertor) WorkflowRun(p *api.WorkflowRunPayload) (WechatworkPayload, error) {
 	text, _ := getWorkflowRunPayloadInfo(p, noneLinkFormatter, true)
 
@@ -0,0 +1,98 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	auth_model "gitea.dev/models/auth"
+	issues_model "gitea.dev/models/issues"
+	project_model "gitea.dev/models/project"
+	"gitea.dev/models/unittest"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/tests"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIAutomationRules(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	token := getTokenForLoggedInUser(t, loginUser(t, "user2"), auth_model.AccessTokenScopeWriteRepository, auth_model.AccessTokenScopeWriteIssue)
+	urlStr := "/api/v1/repos/user2/repo1/automation/rules"
+
+	req := NewRequestWithJSON(t, "POST", urlStr, &api.CreateAutomationRuleOption{
+		Name:      "Done",
+		ProjectID: 1,
+		Trigger:   api.AutomationTriggerIssueClosed,
+		Action:    api.AutomationActionMoveToColumn,
+		ColumnID:  3,
+	}).AddTokenAuth(token)
+	moveRule := DecodeJSON(t, MakeRequest(t, req, http.StatusCreated), &api.AutomationRule{})
+	assert.True(t, moveRule.Active)
+	assert.EqualValues(t, 2, moveRule.CreatorID)
+
+	// the column has to belong to the project of the rule
+	req = NewRequestWithJSON(t, "POST", urlStr, &api.CreateAutomationRuleOption{
+		Name:      "Done",
+		ProjectID: 1,
+		Trigger:   api.AutomationTriggerIssueClosed,
+		Action:    api.AutomationActionMoveToColumn,
+		ColumnID:  4,
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+	req = NewRequestWithJSON(t, "POST", urlStr, &api.CreateAutomationRuleOption{
+		Name:    "Unknown",
+		Trigger: "unknown",
+		Action:  api.AutomationActionClose,
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	req = NewRequestWithJSON(t, "POST", urlStr, &api.CreateAutomationRuleOption{
+		Name:             "Triaged",
+		Trigger:          api.AutomationTriggerLabelRemoved,
+		ConditionLabelID: 1,
+		Action:           api.AutomationActionAddLabel,
+		LabelID:          2,
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusCreated)
+	req = NewRequestWithJSON(t, "POST", urlStr, &api.CreateAutomationRuleOption{
+		Name:             "Reopen",
+		Trigger:          api.AutomationTriggerLabelAdded,
+		ConditionLabelID: 2,
+		Action:           api.AutomationActionReopen,
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusCreated)
+
+	resp := MakeRequest(t, NewRequest(t, "GET", urlStr).AddTokenAuth(token), http.StatusOK)
+	assert.Len(t, DecodeJSON(t, resp, []*api.AutomationRule{}), 3)
+
+	// only the administrators of the repository manage the rules
+	token4 := getTokenForLoggedInUser(t, loginUser(t, "user4"), auth_model.AccessTokenScopeWriteRepository)
+	MakeRequest(t, NewRequest(t, "GET", urlStr).AddTokenAuth(token4), http.StatusForbidden)
+
+	// the closed issue is moved to the column
+	req = NewRequestWithJSON(t, "PATCH", "/api/v1/repos/user2/repo1/issues/1", &api.EditIssueOption{State: new("closed")}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusCreated)
+	unittest.AssertExistsAndLoadBean(t, &project_model.ProjectIssue{IssueID: 1, ProjectID: 1, ProjectColumnID: 3})
+	resp = MakeRequest(t, NewRequest(t, "GET", fmt.Sprintf("%s/%d/logs", urlStr, moveRule.ID)).AddTokenAuth(token), http.StatusOK)
+	logs := DecodeJSON(t, resp, []*api.AutomationRuleLog{})
+	if assert.Len(t, logs, 1) {
+		assert.True(t, logs[0].Success)
+		assert.EqualValues(t, 1, logs[0].IssueID)
+	}
+
+	// the label added by a rule doesn't run the other rules
+	MakeRequest(t, NewRequest(t, "DELETE", "/api/v1/repos/user2/repo1/issues/1/labels/1").AddTokenAuth(token), http.StatusNoContent)
+	assert.True(t, issues_model.HasIssueLabel(t.Context(), 1, 2))
+	assert.True(t, unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 1}).IsClosed)
+
+	req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("%s/%d", urlStr, moveRule.ID), &api.EditAutomationRuleOption{Active: new(false)}).AddTokenAuth(token)
+	assert.False(t, DecodeJSON(t, MakeRequest(t, req, http.StatusOK), &api.AutomationRule{}).Active)
+
+	MakeRequest(t, NewRequest(t, "DELETE", fmt.Sprintf("%s/%d", urlStr, moveRule.ID)).AddTokenAuth(token), http.StatusNoContent)
+	unittest.AssertNotExistsBean(t, &issues_model.AutomationRuleLog{RuleID: moveRule.ID})
+	MakeRequest(t, NewRequest(t, "GET", fmt.Sprintf("%s/%d", urlStr, moveRule.ID)).AddTokenAuth(token), http.StatusNotFound)
+}
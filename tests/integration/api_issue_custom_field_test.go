@@ -0,0 +1,101 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	auth_model "gitea.dev/models/auth"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/tests"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIIssueCustomFields(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	session := loginUser(t, "user2")
+	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteIssue, auth_model.AccessTokenScopeWriteRepository, auth_model.AccessTokenScopeWriteOrganization)
+
+	createField := func(t *testing.T, link string, option *api.CreateCustomFieldOption, expectedStatus int) *api.CustomField {
+		resp := MakeRequest(t, NewRequestWithJSON(t, "POST", link, option).AddTokenAuth(token), expectedStatus)
+		if expectedStatus != http.StatusCreated {
+			return nil
+		}
+		return DecodeJSON(t, resp, &api.CustomField{})
+	}
+
+	orgField := createField(t, "/api/v1/orgs/org3/custom_fields", &api.CreateCustomFieldOption{
+		Name:    "Team",
+		Type:    api.CustomFieldTypeSingleSelect,
+		Options: []string{"Backend", "Frontend"},
+	}, http.StatusCreated)
+	assert.Equal(t, "organization", orgField.Scope)
+
+	repoField := createField(t, "/api/v1/repos/user2/repo1/custom_fields", &api.CreateCustomFieldOption{
+		Name:    "Priority",
+		Type:    api.CustomFieldTypeSingleSelect,
+		Options: []string{"High", "Low"},
+	}, http.StatusCreated)
+	assert.Equal(t, "repository", repoField.Scope)
+	createField(t, "/api/v1/repos/user2/repo1/custom_fields", &api.CreateCustomFieldOption{Name: "priority", Type: api.CustomFieldTypeText}, http.StatusConflict)
+	createField(t, "/api/v1/repos/user2/repo1/custom_fields", &api.CreateCustomFieldOption{Name: "Size", Type: api.CustomFieldTypeMultiSelect}, http.StatusBadRequest)
+	reviewerField := createField(t, "/api/v1/repos/user2/repo1/custom_fields", &api.CreateCustomFieldOption{Name: "Reviewer", Type: api.CustomFieldTypeUser}, http.StatusCreated)
+
+	resp := MakeRequest(t, NewRequest(t, "GET", "/api/v1/repos/user2/repo1/custom_fields").AddTokenAuth(token), http.StatusOK)
+	assert.Len(t, DecodeJSON(t, resp, []*api.CustomField{}), 2)
+
+	req := NewRequestWithJSON(t, "PATCH", fmt.Sprintf("/api/v1/repos/user2/repo1/custom_fields/%d", repoField.ID), &api.EditCustomFieldOption{
+		Options: []string{"High", "Medium", "Low"},
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	assert.Equal(t, []string{"High", "Medium", "Low"}, DecodeJSON(t, resp, &api.CustomField{}).Options)
+	// the field of another scope can't be reached
+	MakeRequest(t, NewRequest(t, "GET", fmt.Sprintf("/api/v1/repos/user2/repo1/custom_fields/%d", orgField.ID)).AddTokenAuth(token), http.StatusNotFound)
+
+	setFields := func(t *testing.T, link string, option *api.SetIssueCustomFieldsOption, expectedStatus int) []*api.IssueCustomFieldValue {
+		resp := MakeRequest(t, NewRequestWithJSON(t, "PUT", link, option).AddTokenAuth(token), expectedStatus)
+		if expectedStatus != http.StatusOK {
+			return nil
+		}
+		return DecodeJSON(t, resp, []*api.IssueCustomFieldValue{})
+	}
+
+	link := "/api/v1/repos/user2/repo1/issues/1/custom_fields"
+	values := setFields(t, link, &api.SetIssueCustomFieldsOption{Fields: []api.SetIssueCustomFieldOption{
+		{FieldID: repoField.ID, Values: []string{"medium"}},
+		{FieldID: reviewerField.ID, Values: []string{"user4"}},
+	}}, http.StatusOK)
+	if assert.Len(t, values, 2) {
+		assert.Equal(t, []string{"Medium"}, values[0].Values)
+		assert.Equal(t, []string{"user4"}, values[1].Values)
+	}
+	setFields(t, link, &api.SetIssueCustomFieldsOption{Fields: []api.SetIssueCustomFieldOption{{FieldID: repoField.ID, Values: []string{"Urgent"}}}}, http.StatusBadRequest)
+	setFields(t, link, &api.SetIssueCustomFieldsOption{Fields: []api.SetIssueCustomFieldOption{{FieldID: reviewerField.ID, Values: []string{"user-not-exist"}}}}, http.StatusBadRequest)
+	// the fields of an organization are only available to its repositories
+	setFields(t, link, &api.SetIssueCustomFieldsOption{Fields: []api.SetIssueCustomFieldOption{{FieldID: orgField.ID, Values: []string{"Backend"}}}}, http.StatusNotFound)
+	setFields(t, "/api/v1/repos/org3/repo3/issues/1/custom_fields", &api.SetIssueCustomFieldsOption{Fields: []api.SetIssueCustomFieldOption{
+		{FieldID: orgField.ID, Values: []string{"Backend"}},
+	}}, http.StatusOK)
+
+	resp = MakeRequest(t, NewRequest(t, "GET", link).AddTokenAuth(token), http.StatusOK)
+	assert.Len(t, DecodeJSON(t, resp, []*api.IssueCustomFieldValue{}), 2)
+
+	searchLink := "/api/v1/repos/user2/repo1/issues?state=all&q=" + url.QueryEscape("field:Priority=Medium")
+	assert.Eventually(t, func() bool {
+		resp := MakeRequest(t, NewRequest(t, "GET", searchLink).AddTokenAuth(token), http.StatusOK)
+		issues := DecodeJSON(t, resp, []*api.Issue{})
+		return len(issues) == 1 && issues[0].ID == 1
+	}, 10*time.Second, 100*time.Millisecond)
+	MakeRequest(t, NewRequest(t, "GET", "/api/v1/repos/user2/repo1/issues?q="+url.QueryEscape("field:Unknown=Medium")).AddTokenAuth(token), http.StatusUnprocessableEntity)
+
+	MakeRequest(t, NewRequest(t, "DELETE", fmt.Sprintf("/api/v1/repos/user2/repo1/custom_fields/%d", repoField.ID)).AddTokenAuth(token), http.StatusNoContent)
+	resp = MakeRequest(t, NewRequest(t, "GET", link).AddTokenAuth(token), http.StatusOK)
+	assert.Len(t, DecodeJSON(t, resp, []*api.IssueCustomFieldValue{}), 1)
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	auth_model "gitea.dev/models/auth"
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/tests"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIIssueSearchQuery(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	session := loginUser(t, "user2")
+	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeReadIssue)
+
+	listIssues := func(t *testing.T, query string, expectedStatus int) []*api.Issue {
+		link := "/api/v1/repos/user2/repo1/issues?state=all&q=" + url.QueryEscape(query)
+		resp := MakeRequest(t, NewRequest(t, "GET", link).AddTokenAuth(token), expectedStatus)
+		if expectedStatus != http.StatusOK {
+			return nil
+		}
+		return DecodeJSON(t, resp, []*api.Issue{})
+	}
+
+	issues := listIssues(t, "label:label1", http.StatusOK)
+	assert.Len(t, issues, 2)
+
+	issues = listIssues(t, "label:label1 is:issue", http.StatusOK)
+	if assert.Len(t, issues, 1) {
+		assert.EqualValues(t, 1, issues[0].ID)
+	}
+
+	issues = listIssues(t, "is:pr -label:label1", http.StatusOK)
+	for _, issue := range issues {
+		assert.NotNil(t, issue.PullRequest)
+		assert.NotEqualValues(t, 2, issue.ID)
+	}
+
+	listIssues(t, "label:unknown", http.StatusUnprocessableEntity)
+	listIssues(t, "is:unknown", http.StatusUnprocessableEntity)
+
+	resp := MakeRequest(t, NewRequest(t, "GET", "/api/v1/repos/issues/search?q="+url.QueryEscape("repo:user2/repo1 label:label1 is:open")).AddTokenAuth(token), http.StatusOK)
+	issues = DecodeJSON(t, resp, []*api.Issue{})
+	assert.Len(t, issues, 2)
+}
+
+func TestAPIIssueSavedSearches(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	t.Run("User", func(t *testing.T) {
+		session := loginUser(t, "user2")
+		token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteUser)
+
+		req := NewRequestWithJSON(t, "POST", "/api/v1/user/saved_searches", &api.CreateSavedSearchOption{
+			Name:  "My bugs",
+			Query: "is:open label:bug assignee:@me",
+		}).AddTokenAuth(token)
+		resp := MakeRequest(t, req, http.StatusCreated)
+		created := DecodeJSON(t, resp, &api.SavedSearch{})
+		assert.Equal(t, "My bugs", created.Name)
+		assert.False(t, created.Pinned)
+		assert.False(t, created.Subscribed)
+
+		req = NewRequestWithJSON(t, "POST", "/api/v1/user/saved_searches", &api.CreateSavedSearchOption{
+			Name:  "Invalid",
+			Query: "is:unknown",
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+		link := fmt.Sprintf("/api/v1/user/saved_searches/%d", created.ID)
+		pinned := true
+		req = NewRequestWithJSON(t, "PATCH", link, &api.EditSavedSearchOption{Pinned: &pinned}).AddTokenAuth(token)
+		resp = MakeRequest(t, req, http.StatusOK)
+		assert.True(t, DecodeJSON(t, resp, &api.SavedSearch{}).Pinned)
+
+		MakeRequest(t, NewRequest(t, "PUT", link+"/subscription").AddTokenAuth(token), http.StatusNoContent)
+		resp = MakeRequest(t, NewRequest(t, "GET", "/api/v1/user/saved_searches").AddTokenAuth(token), http.StatusOK)
+		searches := DecodeJSON(t, resp, []*api.SavedSearch{})
+		if assert.Len(t, searches, 1) {
+			assert.True(t, searches[0].Subscribed)
+			assert.Equal(t, "is:open label:bug assignee:@me", searches[0].Query)
+		}
+
+		// the saved searches of other users are not visible
+		token4 := getTokenForLoggedInUser(t, loginUser(t, "user4"), auth_model.AccessTokenScopeWriteUser)
+		MakeRequest(t, NewRequest(t, "GET", link).AddTokenAuth(token4), http.StatusNotFound)
+
+		MakeRequest(t, NewRequest(t, "DELETE", link).AddTokenAuth(token), http.StatusNoContent)
+		MakeRequest(t, NewRequest(t, "GET", link).AddTokenAuth(token), http.StatusNotFound)
+		unittest.AssertNotExistsBean(t, &issues_model.SavedSearchSubscription{SavedSearchID: created.ID})
+	})
+
+	t.Run("Organization", func(t *testing.T) {
+		ownerToken := getTokenForLoggedInUser(t, loginUser(t, "user2"), auth_model.AccessTokenScopeWriteOrganization)
+		memberToken := getTokenForLoggedInUser(t, loginUser(t, "user4"), auth_model.AccessTokenScopeWriteOrganization)
+		otherToken := getTokenForLoggedInUser(t, loginUser(t, "user5"), auth_model.AccessTokenScopeWriteOrganization)
+
+		option := &api.CreateSavedSearchOption{Name: "Triage", Query: "is:open no:label", Pinned: true}
+		MakeRequest(t, NewRequestWithJSON(t, "POST", "/api/v1/orgs/org3/saved_searches", option).AddTokenAuth(memberToken), http.StatusForbidden)
+		resp := MakeRequest(t, NewRequestWithJSON(t, "POST", "/api/v1/orgs/org3/saved_searches", option).AddTokenAuth(ownerToken), http.StatusCreated)
+		created := DecodeJSON(t, resp, &api.SavedSearch{})
+		assert.True(t, created.Pinned)
+
+		// members can use and subscribe to the saved searches of the organization
+		link := fmt.Sprintf("/api/v1/orgs/org3/saved_searches/%d", created.ID)
+		MakeRequest(t, NewRequest(t, "PUT", link+"/subscription").AddTokenAuth(memberToken), http.StatusNoContent)
+		resp = MakeRequest(t, NewRequest(t, "GET", link).AddTokenAuth(memberToken), http.StatusOK)
+		assert.True(t, DecodeJSON(t, resp, &api.SavedSearch{}).Subscribed)
+		resp = MakeRequest(t, NewRequest(t, "GET", link).AddTokenAuth(ownerToken), http.StatusOK)
+		assert.False(t, DecodeJSON(t, resp, &api.SavedSearch{}).Subscribed)
+
+		MakeRequest(t, NewRequest(t, "DELETE", link).AddTokenAuth(memberToken), http.StatusForbidden)
+		MakeRequest(t, NewRequest(t, "GET", "/api/v1/orgs/org3/saved_searches").AddTokenAuth(otherToken), http.StatusForbidden)
+		MakeRequest(t, NewRequest(t, "DELETE", link).AddTokenAuth(ownerToken), http.StatusNoContent)
+	})
+}
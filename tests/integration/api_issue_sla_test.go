@@ -0,0 +1,105 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	auth_model "gitea.dev/models/auth"
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	"gitea.dev/modules/setting"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/modules/test"
+	"gitea.dev/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPISLAPolicies(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	defer test.MockVariableValue(&setting.DefaultUILocation, time.UTC)()
+	token := getTokenForLoggedInUser(t, loginUser(t, "user2"), auth_model.AccessTokenScopeWriteRepository, auth_model.AccessTokenScopeWriteIssue)
+	urlStr := "/api/v1/repos/user2/repo1/sla_policies"
+
+	req := NewRequestWithJSON(t, "POST", urlStr, &api.CreateSLAPolicyOption{
+		Name:               "Bugs",
+		LabelID:            1,
+		FirstResponseHours: 4,
+		ResolutionDays:     2,
+	}).AddTokenAuth(token)
+	policy := DecodeJSON(t, MakeRequest(t, req, http.StatusCreated), &api.SLAPolicy{})
+	assert.Equal(t, "repository", policy.Scope)
+
+	// the label has to be available to the repository and the policy needs a target
+	req = NewRequestWithJSON(t, "POST", urlStr, &api.CreateSLAPolicyOption{Name: "Org", LabelID: 3, ResolutionDays: 1}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+	req = NewRequestWithJSON(t, "POST", urlStr, &api.CreateSLAPolicyOption{Name: "None", LabelID: 2}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	// only the writers of the issues can change the policies
+	token4 := getTokenForLoggedInUser(t, loginUser(t, "user4"), auth_model.AccessTokenScopeWriteRepository, auth_model.AccessTokenScopeWriteIssue)
+	req = NewRequestWithJSON(t, "POST", urlStr, &api.CreateSLAPolicyOption{Name: "Bugs", LabelID: 1, ResolutionDays: 1}).AddTokenAuth(token4)
+	MakeRequest(t, req, http.StatusForbidden)
+
+	req = NewRequest(t, "GET", urlStr).AddTokenAuth(token)
+	policies := DecodeJSON(t, MakeRequest(t, req, http.StatusOK), []*api.SLAPolicy{})
+	assert.Len(t, policies, 1)
+
+	resolutionDays := 3
+	req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("%s/%d", urlStr, policy.ID), &api.EditSLAPolicyOption{ResolutionDays: &resolutionDays}).AddTokenAuth(token)
+	policy = DecodeJSON(t, MakeRequest(t, req, http.StatusOK), &api.SLAPolicy{})
+	assert.Equal(t, 3, policy.ResolutionDays)
+
+	// issue 1 was opened on Saturday 2000-01-01 and answered a few seconds later
+	req = NewRequest(t, "GET", "/api/v1/repos/user2/repo1/issues/1/sla").AddTokenAuth(token)
+	issueSLAs := DecodeJSON(t, MakeRequest(t, req, http.StatusOK), []*api.IssueSLA{})
+	require.Len(t, issueSLAs, 1)
+	assert.Equal(t, policy.ID, issueSLAs[0].PolicyID)
+	assert.Equal(t, time.Date(2000, 1, 3, 13, 0, 0, 0, time.UTC), issueSLAs[0].FirstResponseDue.UTC())
+	assert.False(t, issueSLAs[0].FirstResponseBreached)
+	assert.Equal(t, time.Date(2000, 1, 5, 17, 0, 0, 0, time.UTC), issueSLAs[0].ResolutionDue.UTC())
+	assert.True(t, issueSLAs[0].ResolutionBreached)
+
+	// the open issue 1 and pull request 2 have label1
+	req = NewRequest(t, "GET", "/api/v1/repos/user2/repo1/sla_breaches").AddTokenAuth(token)
+	breaches := DecodeJSON(t, MakeRequest(t, req, http.StatusOK), []*api.IssueSLA{})
+	require.Len(t, breaches, 2)
+	assert.EqualValues(t, 2, breaches[0].IssueIndex)
+	assert.EqualValues(t, 1, breaches[1].IssueIndex)
+	req = NewRequest(t, "GET", "/api/v1/repos/user2/repo1/sla_breaches?state=closed").AddTokenAuth(token)
+	breaches = DecodeJSON(t, MakeRequest(t, req, http.StatusOK), []*api.IssueSLA{})
+	assert.Empty(t, breaches)
+
+	req = NewRequest(t, "DELETE", fmt.Sprintf("%s/%d", urlStr, policy.ID)).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusNoContent)
+	unittest.AssertNotExistsBean(t, &issues_model.SLAPolicy{ID: policy.ID})
+}
+
+func TestAPIOrgSLAPolicies(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	token := getTokenForLoggedInUser(t, loginUser(t, "user2"), auth_model.AccessTokenScopeWriteOrganization, auth_model.AccessTokenScopeWriteIssue)
+	urlStr := "/api/v1/orgs/org3/sla_policies"
+
+	req := NewRequestWithJSON(t, "POST", urlStr, &api.CreateSLAPolicyOption{Name: "Bugs", LabelID: 3, FirstResponseHours: 8}).AddTokenAuth(token)
+	policy := DecodeJSON(t, MakeRequest(t, req, http.StatusCreated), &api.SLAPolicy{})
+	assert.Equal(t, "organization", policy.Scope)
+
+	// the labels of a repository can't be used by the organization
+	req = NewRequestWithJSON(t, "POST", urlStr, &api.CreateSLAPolicyOption{Name: "Repo", LabelID: 10, FirstResponseHours: 8}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	// the policies of another scope are not found
+	req = NewRequest(t, "GET", fmt.Sprintf("/api/v1/repos/org3/repo3/sla_policies/%d", policy.ID)).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusNotFound)
+
+	req = NewRequest(t, "GET", fmt.Sprintf("%s/%d", urlStr, policy.ID)).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusOK)
+	req = NewRequest(t, "DELETE", fmt.Sprintf("%s/%d", urlStr, policy.ID)).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusNoContent)
+}
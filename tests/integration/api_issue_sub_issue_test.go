@@ -0,0 +1,88 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	auth_model "gitea.dev/models/auth"
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/tests"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIIssueSubIssues(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	session := loginUser(t, "user2")
+	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteIssue, auth_model.AccessTokenScopeWriteRepository)
+	link := "/api/v1/repos/user2/repo1/issues/1/sub_issues"
+
+	addSubIssue := func(t *testing.T, link string, option *api.AddSubIssueOption, expectedStatus int) {
+		MakeRequest(t, NewRequestWithJSON(t, "POST", link, option).AddTokenAuth(token), expectedStatus)
+	}
+
+	// issue #4 of repo1 is closed, issue #2 of repo2 belongs to another repository of the same owner
+	addSubIssue(t, link, &api.AddSubIssueOption{Index: 4}, http.StatusCreated)
+	addSubIssue(t, link, &api.AddSubIssueOption{Owner: "user2", Name: "repo2", Index: 2, Inherit: true}, http.StatusCreated)
+	// pull requests, issues of other owners and issues which already have a parent are rejected
+	addSubIssue(t, link, &api.AddSubIssueOption{Index: 2}, http.StatusBadRequest)
+	addSubIssue(t, link, &api.AddSubIssueOption{Owner: "org3", Name: "repo3", Index: 1}, http.StatusBadRequest)
+	addSubIssue(t, "/api/v1/repos/user2/repo1/issues/4/sub_issues", &api.AddSubIssueOption{Owner: "user2", Name: "repo2", Index: 2}, http.StatusConflict)
+	// cycles are rejected
+	addSubIssue(t, "/api/v1/repos/user2/repo2/issues/2/sub_issues", &api.AddSubIssueOption{Owner: "user2", Name: "repo1", Index: 1}, http.StatusBadRequest)
+
+	resp := MakeRequest(t, NewRequest(t, "GET", link).AddTokenAuth(token), http.StatusOK)
+	subIssues := DecodeJSON(t, resp, []*api.Issue{})
+	if assert.Len(t, subIssues, 2) {
+		assert.EqualValues(t, 5, subIssues[0].ID)
+		assert.EqualValues(t, 7, subIssues[1].ID)
+	}
+
+	resp = MakeRequest(t, NewRequest(t, "GET", link+"/progress").AddTokenAuth(token), http.StatusOK)
+	assert.Equal(t, &api.SubIssueProgress{Total: 2, Closed: 1, Percent: 50}, DecodeJSON(t, resp, &api.SubIssueProgress{}))
+
+	resp = MakeRequest(t, NewRequest(t, "GET", "/api/v1/repos/user2/repo2/issues/2/parent").AddTokenAuth(token), http.StatusOK)
+	assert.EqualValues(t, 1, DecodeJSON(t, resp, &api.Issue{}).ID)
+	MakeRequest(t, NewRequest(t, "GET", "/api/v1/repos/user2/repo1/issues/1/parent").AddTokenAuth(token), http.StatusNotFound)
+
+	req := NewRequestWithJSON(t, "PUT", link+"/order", &api.ReorderSubIssuesOption{IssueIDs: []int64{7, 5}}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusNoContent)
+	resp = MakeRequest(t, NewRequest(t, "GET", link).AddTokenAuth(token), http.StatusOK)
+	subIssues = DecodeJSON(t, resp, []*api.Issue{})
+	if assert.Len(t, subIssues, 2) {
+		assert.EqualValues(t, 7, subIssues[0].ID)
+	}
+
+	// the issues without parent can be searched
+	resp = MakeRequest(t, NewRequest(t, "GET", "/api/v1/repos/user2/repo1/issues?type=issues&state=all&q="+url.QueryEscape("no:parent")).AddTokenAuth(token), http.StatusOK)
+	var ids []int64
+	for _, issue := range DecodeJSON(t, resp, []*api.Issue{}) {
+		ids = append(ids, issue.ID)
+	}
+	assert.Contains(t, ids, int64(1))
+	assert.NotContains(t, ids, int64(5))
+
+	// users who can't write the issues can't change the sub-issues
+	token4 := getTokenForLoggedInUser(t, loginUser(t, "user4"), auth_model.AccessTokenScopeWriteIssue)
+	req = NewRequestWithJSON(t, "POST", link, &api.AddSubIssueOption{Index: 1}).AddTokenAuth(token4)
+	MakeRequest(t, req, http.StatusForbidden)
+
+	removeSubIssue := func(t *testing.T, expectedStatus int) {
+		req := NewRequestWithJSON(t, "DELETE", link, &api.IssueMeta{Owner: "user2", Name: "repo1", Index: 4}).AddTokenAuth(token)
+		MakeRequest(t, req, expectedStatus)
+	}
+	removeSubIssue(t, http.StatusNoContent)
+	removeSubIssue(t, http.StatusNotFound)
+	unittest.AssertNotExistsBean(t, &issues_model.SubIssue{IssueID: 5})
+	unittest.AssertExistsAndLoadBean(t, &issues_model.SubIssue{ParentID: 1, IssueID: 7})
+
+	resp = MakeRequest(t, NewRequest(t, "GET", link+"/progress").AddTokenAuth(token), http.StatusOK)
+	assert.Equal(t, &api.SubIssueProgress{Total: 1, Closed: 0, Percent: 0}, DecodeJSON(t, resp, &api.SubIssueProgress{}))
+}
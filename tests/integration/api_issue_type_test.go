@@ -0,0 +1,88 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	auth_model "gitea.dev/models/auth"
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/tests"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIIssueTypes(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	session := loginUser(t, "user2")
+	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteIssue, auth_model.AccessTokenScopeWriteOrganization)
+
+	createType := func(t *testing.T, option *api.CreateIssueTypeOption, expectedStatus int) *api.IssueType {
+		resp := MakeRequest(t, NewRequestWithJSON(t, "POST", "/api/v1/orgs/org3/issue_types", option).AddTokenAuth(token), expectedStatus)
+		if expectedStatus != http.StatusCreated {
+			return nil
+		}
+		return DecodeJSON(t, resp, &api.IssueType{})
+	}
+
+	bug := createType(t, &api.CreateIssueTypeOption{Name: "Bug", Color: "d73a4a", Icon: "octicon-bug"}, http.StatusCreated)
+	assert.Equal(t, "#d73a4a", bug.Color)
+	feature := createType(t, &api.CreateIssueTypeOption{Name: "Feature"}, http.StatusCreated)
+	assert.Equal(t, issues_model.IssueTypeDefaultIcon, feature.Icon)
+	createType(t, &api.CreateIssueTypeOption{Name: "bug"}, http.StatusConflict)
+	createType(t, &api.CreateIssueTypeOption{Name: "Task", Icon: "octicon-unknown"}, http.StatusBadRequest)
+
+	resp := MakeRequest(t, NewRequest(t, "GET", "/api/v1/orgs/org3/issue_types").AddTokenAuth(token), http.StatusOK)
+	assert.Len(t, DecodeJSON(t, resp, []*api.IssueType{}), 2)
+
+	req := NewRequestWithJSON(t, "PATCH", fmt.Sprintf("/api/v1/orgs/org3/issue_types/%d", feature.ID), &api.EditIssueTypeOption{
+		Icon: new("octicon-rocket"),
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	assert.Equal(t, "octicon-rocket", DecodeJSON(t, resp, &api.IssueType{}).Icon)
+	// the types of an organization can't be reached through another one
+	MakeRequest(t, NewRequest(t, "GET", fmt.Sprintf("/api/v1/orgs/org17/issue_types/%d", feature.ID)).AddTokenAuth(token), http.StatusNotFound)
+
+	// the type is set by name on the issues of the organization's repositories
+	resp = MakeRequest(t, NewRequestWithJSON(t, "POST", "/api/v1/repos/org3/repo3/issues", &api.CreateIssueOption{
+		Title: "crash on start",
+		Type:  "bug",
+	}).AddTokenAuth(token), http.StatusCreated)
+	issue := DecodeJSON(t, resp, &api.Issue{})
+	if assert.NotNil(t, issue.Type) {
+		assert.Equal(t, bug.ID, issue.Type.ID)
+	}
+	MakeRequest(t, NewRequestWithJSON(t, "POST", "/api/v1/repos/org3/repo3/issues", &api.CreateIssueOption{
+		Title: "unknown type",
+		Type:  "Epic",
+	}).AddTokenAuth(token), http.StatusUnprocessableEntity)
+	MakeRequest(t, NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/issues", &api.CreateIssueOption{
+		Title: "no types for users",
+		Type:  "Bug",
+	}).AddTokenAuth(token), http.StatusUnprocessableEntity)
+
+	searchLink := "/api/v1/repos/org3/repo3/issues?state=all&q=" + url.QueryEscape("type:Bug")
+	assert.Eventually(t, func() bool {
+		resp := MakeRequest(t, NewRequest(t, "GET", searchLink).AddTokenAuth(token), http.StatusOK)
+		issues := DecodeJSON(t, resp, []*api.Issue{})
+		return len(issues) == 1 && issues[0].ID == issue.ID
+	}, 10*time.Second, 100*time.Millisecond)
+
+	issueLink := fmt.Sprintf("/api/v1/repos/org3/repo3/issues/%d", issue.Index)
+	resp = MakeRequest(t, NewRequestWithJSON(t, "PATCH", issueLink, &api.EditIssueOption{Type: new("Feature")}).AddTokenAuth(token), http.StatusCreated)
+	assert.Equal(t, feature.ID, DecodeJSON(t, resp, &api.Issue{}).Type.ID)
+
+	// deleting the type removes it from the issues
+	MakeRequest(t, NewRequest(t, "DELETE", fmt.Sprintf("/api/v1/orgs/org3/issue_types/%d", feature.ID)).AddTokenAuth(token), http.StatusNoContent)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: issue.ID}, "type_id = 0")
+	resp = MakeRequest(t, NewRequest(t, "GET", issueLink).AddTokenAuth(token), http.StatusOK)
+	assert.Nil(t, DecodeJSON(t, resp, &api.Issue{}).Type)
+}
@@ -522,6 +522,25 @@ nwIDAQAB
 		uploadPackage(t, "1.0.2", http.StatusCreated)
 		uploadPackage(t, "1.0.3", http.StatusCreated)
 
+		t.Run("Protected", func(t *testing.T) {
+			defer tests.PrintCurrentTest(t)()
+
+			defer protectPackageVersions(t, user.ID, packages.TypeChef, packageName, `1\.0\.3`)()
+
+			req := NewRequest(t, "DELETE", fmt.Sprintf("%s/cookbooks/%s/versions/%s", root, packageName, "1.0.3")).
+				AddBasicAuth(user.Name)
+			MakeRequest(t, req, http.StatusForbidden)
+
+			// the unprotected versions must not be deleted either
+			req = NewRequest(t, "DELETE", fmt.Sprintf("%s/cookbooks/%s", root, packageName)).
+				AddBasicAuth(user.Name)
+			MakeRequest(t, req, http.StatusForbidden)
+
+			pvs, err := packages.GetVersionsByPackageType(t.Context(), user.ID, packages.TypeChef)
+			assert.NoError(t, err)
+			assert.Len(t, pvs, 3)
+		})
+
 		t.Run("Version", func(t *testing.T) {
 			defer tests.PrintCurrentTest(t)()
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	stdurl "net/url"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -649,6 +650,17 @@ func TestPackageConan(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Len(t, pvs, 3)
 			})
+
+			t.Run("Protected", func(t *testing.T) {
+				defer tests.PrintCurrentTest(t)()
+
+				defer protectPackageVersions(t, user.ID, packages.TypeConan, name, regexp.QuoteMeta(version1))()
+
+				// the file of a protected version can't be overwritten with another content
+				req := NewRequestWithBody(t, "PUT", fmt.Sprintf("%s/v2/conans/%s/%s/%s/%s/revisions/%s/files/%s", url, name, version1, user1, channel1, revision1, conanfileName), strings.NewReader(buildConanfileContent(name, version1)+"\n\tchanged = True")).
+					AddTokenAuth(token)
+				MakeRequest(t, req, http.StatusConflict)
+			})
 		})
 
 		t.Run("Latest", func(t *testing.T) {
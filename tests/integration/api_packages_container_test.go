@@ -719,6 +719,33 @@ func TestPackageContainer(t *testing.T) {
 			})
 
 			t.Run("Delete", func(t *testing.T) {
+				t.Run("Protected", func(t *testing.T) {
+					defer tests.PrintCurrentTest(t)()
+
+					defer protectPackageVersions(t, user.ID, packages_model.TypeContainer, image, "latest")()
+
+					// the manifest is tagged by "latest" and "main", none of the tags must be deleted
+					req := NewRequest(t, "DELETE", fmt.Sprintf("%s/manifests/%s", url, manifestDigest)).
+						AddTokenAuth(userToken)
+					MakeRequest(t, req, http.StatusForbidden)
+
+					for _, tag := range tags {
+						req = NewRequest(t, "HEAD", fmt.Sprintf("%s/manifests/%s", url, tag)).
+							AddTokenAuth(userToken)
+						MakeRequest(t, req, http.StatusOK)
+					}
+
+					req = NewRequestWithBody(t, "PUT", fmt.Sprintf("%s/manifests/%s", url, "latest"), strings.NewReader(untaggedManifestContent)).
+						AddTokenAuth(userToken).
+						SetHeader("Content-Type", oci.MediaTypeImageManifest)
+					MakeRequest(t, req, http.StatusConflict)
+
+					req = NewRequest(t, "HEAD", fmt.Sprintf("%s/manifests/%s", url, "latest")).
+						AddTokenAuth(userToken)
+					resp := MakeRequest(t, req, http.StatusOK)
+					assert.Equal(t, manifestDigest, resp.Header().Get("Docker-Content-Digest"))
+				})
+
 				t.Run("Blob", func(t *testing.T) {
 					defer tests.PrintCurrentTest(t)()
 
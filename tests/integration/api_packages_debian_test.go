@@ -14,11 +14,13 @@ import (
 	"strings"
 	"testing"
 
+	auth_model "gitea.dev/models/auth"
 	"gitea.dev/models/packages"
 	"gitea.dev/models/unittest"
 	user_model "gitea.dev/models/user"
 	"gitea.dev/modules/base"
 	debian_module "gitea.dev/modules/packages/debian"
+	api "gitea.dev/modules/structs"
 	packages_cleanup_service "gitea.dev/services/packages/cleanup"
 	"gitea.dev/tests"
 
@@ -234,6 +236,108 @@ func TestPackageDebian(t *testing.T) {
 		})
 	}
 
+	t.Run("SnapshotAndPromote", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+
+		token := getUserToken(t, user.Name, auth_model.AccessTokenScopeWritePackage)
+		apiURL := fmt.Sprintf("/api/v1/packages/%s/-", user.Name)
+		distribution := distributions[0]
+		snapshotURL := rootURL + "/-/snapshots/snap-1"
+
+		req := NewRequestWithJSON(t, "POST", apiURL+"/snapshots", &api.CreatePackageSnapshotOption{
+			Type:         string(packages.TypeDebian),
+			Name:         "snap-1",
+			Distribution: distribution,
+			Component:    components[0],
+		}).AddTokenAuth(token)
+		resp := MakeRequest(t, req, http.StatusCreated)
+		snapshot := DecodeJSON(t, resp, &api.PackageSnapshot{})
+		assert.Equal(t, "snap-1", snapshot.Name)
+		assert.Equal(t, distribution, snapshot.Distribution)
+
+		req = NewRequestWithJSON(t, "POST", apiURL+"/snapshots", &api.CreatePackageSnapshotOption{
+			Type:         string(packages.TypeDebian),
+			Name:         "snap-1",
+			Distribution: distribution,
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusConflict)
+
+		req = NewRequest(t, "GET", fmt.Sprintf("%s/dists/%s/Release", snapshotURL, distribution))
+		resp = MakeRequest(t, req, http.StatusOK)
+		assert.Contains(t, resp.Body.String(), "Components: "+components[0]+"\n")
+
+		req = NewRequest(t, "GET", fmt.Sprintf("%s/dists/%s/InRelease", snapshotURL, distribution))
+		resp = MakeRequest(t, req, http.StatusOK)
+		assert.Contains(t, resp.Body.String(), "-----BEGIN PGP SIGNED MESSAGE-----")
+
+		req = NewRequest(t, "GET", fmt.Sprintf("%s/dists/%s/%s/binary-%s/Packages", snapshotURL, distribution, components[0], architectures[1]))
+		resp = MakeRequest(t, req, http.StatusOK)
+		assert.Contains(t, resp.Body.String(), "Package: "+packageName+"\n")
+
+		req = NewRequest(t, "GET", fmt.Sprintf("%s/dists/%s/Release", snapshotURL, distributions[1]))
+		MakeRequest(t, req, http.StatusNotFound)
+
+		req = NewRequest(t, "GET", fmt.Sprintf("%s/pool/%s/%s/%s_%s_%s.deb", snapshotURL, distribution, components[0], packageName, packageVersion, architectures[1]))
+		MakeRequest(t, req, http.StatusOK)
+
+		// the snapshot only contains the files of its component
+		req = NewRequest(t, "GET", fmt.Sprintf("%s/pool/%s/%s/%s_%s_%s.deb", snapshotURL, distribution, components[1], packageName, packageVersion, architectures[1]))
+		MakeRequest(t, req, http.StatusNotFound)
+
+		// files uploaded after the snapshot was created are not contained in it
+		req = NewRequestWithBody(t, "PUT", fmt.Sprintf("%s/pool/%s/%s/upload", rootURL, distribution, components[0]), createArchive(packageName, packageVersion2, "arm64")).
+			AddBasicAuth(user.Name)
+		MakeRequest(t, req, http.StatusCreated)
+
+		req = NewRequest(t, "GET", fmt.Sprintf("%s/pool/%s/%s/%s_%s_arm64.deb", rootURL, distribution, components[0], packageName, packageVersion2))
+		MakeRequest(t, req, http.StatusOK)
+
+		req = NewRequest(t, "GET", fmt.Sprintf("%s/pool/%s/%s/%s_%s_arm64.deb", snapshotURL, distribution, components[0], packageName, packageVersion2))
+		MakeRequest(t, req, http.StatusNotFound)
+
+		// versions contained in a snapshot can not be deleted
+		req = NewRequest(t, "DELETE", fmt.Sprintf("%s/pool/%s/%s/%s/%s/%s", rootURL, distribution, components[0], packageName, packageVersion, architectures[0])).
+			AddBasicAuth(user.Name)
+		MakeRequest(t, req, http.StatusForbidden)
+
+		req = NewRequestWithJSON(t, "POST", apiURL+"/promote", &api.PromotePackagesOption{
+			Type:      string(packages.TypeDebian),
+			From:      distribution,
+			To:        "promoted",
+			Component: components[0],
+			Packages:  []*api.PromotePackageVersion{{Name: packageName, Version: packageVersion}},
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusNoContent)
+
+		req = NewRequest(t, "GET", fmt.Sprintf("%s/dists/promoted/Release", rootURL))
+		resp = MakeRequest(t, req, http.StatusOK)
+		assert.Contains(t, resp.Body.String(), "Components: "+components[0]+"\n")
+
+		req = NewRequest(t, "GET", fmt.Sprintf("%s/dists/promoted/%s/binary-%s/Packages", rootURL, components[0], architectures[1]))
+		resp = MakeRequest(t, req, http.StatusOK)
+		assert.Contains(t, resp.Body.String(), "Version: "+packageVersion+"\n")
+		assert.NotContains(t, resp.Body.String(), "Version: "+packageVersion2+"\n")
+
+		req = NewRequest(t, "GET", fmt.Sprintf("%s/pool/promoted/%s/%s_%s_%s.deb", rootURL, components[0], packageName, packageVersion, architectures[1]))
+		MakeRequest(t, req, http.StatusOK)
+
+		req = NewRequest(t, "DELETE", fmt.Sprintf("%s/snapshots/%d", apiURL, snapshot.ID)).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusNoContent)
+
+		req = NewRequest(t, "GET", fmt.Sprintf("%s/dists/%s/Release", snapshotURL, distribution))
+		MakeRequest(t, req, http.StatusNotFound)
+
+		req = NewRequest(t, "DELETE", fmt.Sprintf("%s/pool/%s/%s/%s/%s/arm64", rootURL, distribution, components[0], packageName, packageVersion2)).
+			AddBasicAuth(user.Name)
+		MakeRequest(t, req, http.StatusNoContent)
+
+		for _, architecture := range architectures {
+			req = NewRequest(t, "DELETE", fmt.Sprintf("%s/pool/promoted/%s/%s/%s/%s", rootURL, components[0], packageName, packageVersion, architecture)).
+				AddBasicAuth(user.Name)
+			MakeRequest(t, req, http.StatusNoContent)
+		}
+	})
+
 	t.Run("Delete", func(t *testing.T) {
 		defer tests.PrintCurrentTest(t)()
 
@@ -10,6 +10,7 @@ import (
 	"mime"
 	"net/http"
 	neturl "net/url"
+	"regexp"
 	"testing"
 
 	"gitea.dev/models/packages"
@@ -201,6 +202,28 @@ func TestPackageGeneric(t *testing.T) {
 	t.Run("Delete", func(t *testing.T) {
 		defer tests.PrintCurrentTest(t)()
 
+		t.Run("Protected", func(t *testing.T) {
+			defer tests.PrintCurrentTest(t)()
+
+			defer protectPackageVersions(t, user.ID, packages.TypeGeneric, packageName, regexp.QuoteMeta(packageVersion))()
+
+			req := NewRequest(t, "DELETE", url+"/"+filename).
+				AddBasicAuth(user.Name)
+			MakeRequest(t, req, http.StatusForbidden)
+
+			req = NewRequest(t, "DELETE", url).
+				AddBasicAuth(user.Name)
+			MakeRequest(t, req, http.StatusForbidden)
+
+			pvs, err := packages.GetVersionsByPackageType(t.Context(), user.ID, packages.TypeGeneric)
+			assert.NoError(t, err)
+			assert.Len(t, pvs, 1)
+
+			pfs, err := packages.GetFilesByVersionID(t.Context(), pvs[0].ID)
+			assert.NoError(t, err)
+			assert.Len(t, pfs, 2)
+		})
+
 		t.Run("File", func(t *testing.T) {
 			defer tests.PrintCurrentTest(t)()
 
@@ -9,6 +9,7 @@ import (
 	"compress/gzip"
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 	"time"
 
@@ -95,6 +96,36 @@ dependencies:
 		req = NewRequestWithBody(t, "POST", uploadURL, bytes.NewReader(content)).
 			AddBasicAuth(user.Name)
 		MakeRequest(t, req, http.StatusCreated)
+
+		t.Run("Protected", func(t *testing.T) {
+			defer tests.PrintCurrentTest(t)()
+
+			defer protectPackageVersions(t, user.ID, packages.TypeHelm, packageName, regexp.QuoteMeta(packageVersion))()
+
+			// the same version with another content can't overwrite the protected one
+			readme := "# " + packageName
+			var buf bytes.Buffer
+			zw := gzip.NewWriter(&buf)
+			archive := tar.NewWriter(zw)
+			archive.WriteHeader(&tar.Header{
+				Name: packageName + "/Chart.yaml",
+				Mode: 0o600,
+				Size: int64(len(chartContent)),
+			})
+			archive.Write([]byte(chartContent))
+			archive.WriteHeader(&tar.Header{
+				Name: packageName + "/README.md",
+				Mode: 0o600,
+				Size: int64(len(readme)),
+			})
+			archive.Write([]byte(readme))
+			archive.Close()
+			zw.Close()
+
+			req := NewRequestWithBody(t, "POST", uploadURL, bytes.NewReader(buf.Bytes())).
+				AddBasicAuth(user.Name)
+			MakeRequest(t, req, http.StatusConflict)
+		})
 	})
 
 	t.Run("Download", func(t *testing.T) {
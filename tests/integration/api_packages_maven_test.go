@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -287,6 +288,14 @@ func TestPackageMaven(t *testing.T) {
 		putFile(t, "/maven-metadata.xml", "test", http.StatusOK)
 		putFile(t, fmt.Sprintf("/%s/maven-metadata.xml", snapshotVersion), "test", http.StatusCreated)
 		putFile(t, fmt.Sprintf("/%s/maven-metadata.xml", snapshotVersion), "test-overwrite", http.StatusCreated)
+
+		t.Run("Protected", func(t *testing.T) {
+			defer tests.PrintCurrentTest(t)()
+
+			defer protectPackageVersions(t, user.ID, packages.TypeMaven, "", regexp.QuoteMeta(snapshotVersion))()
+
+			putFile(t, fmt.Sprintf("/%s/maven-metadata.xml", snapshotVersion), "test-protected", http.StatusConflict)
+		})
 	})
 
 	t.Run("InvalidFile", func(t *testing.T) {
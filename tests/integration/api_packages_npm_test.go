@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -323,6 +324,26 @@ func TestPackageNpm(t *testing.T) {
 			AddTokenAuth(token)
 		MakeRequest(t, req, http.StatusOK)
 
+		t.Run("Protected", func(t *testing.T) {
+			defer tests.PrintCurrentTest(t)()
+
+			defer protectPackageVersions(t, user.ID, packages.TypeNpm, packageName, regexp.QuoteMeta(packageVersion+"-dummy"))()
+
+			dummyFilename := fmt.Sprintf("%s-%s-dummy.tgz", strings.Split(packageName, "/")[1], packageVersion)
+			req := NewRequest(t, "DELETE", fmt.Sprintf("%s/-/%s/%s/-rev/dummy", root, packageVersion+"-dummy", dummyFilename)).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusForbidden)
+
+			// the unprotected version must not be deleted either
+			req = NewRequest(t, "DELETE", root+"/-rev/dummy").
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusForbidden)
+
+			pvs, err := packages.GetVersionsByPackageType(t.Context(), user.ID, packages.TypeNpm)
+			assert.NoError(t, err)
+			assert.Len(t, pvs, 2)
+		})
+
 		t.Run("Version", func(t *testing.T) {
 			defer tests.PrintCurrentTest(t)()
 
@@ -16,12 +16,14 @@ import (
 	"strings"
 	"testing"
 
+	auth_model "gitea.dev/models/auth"
 	"gitea.dev/models/packages"
 	"gitea.dev/models/unittest"
 	user_model "gitea.dev/models/user"
 	"gitea.dev/modules/json"
 	rpm_module "gitea.dev/modules/packages/rpm"
 	"gitea.dev/modules/setting"
+	api "gitea.dev/modules/structs"
 	"gitea.dev/tests"
 
 	"github.com/ProtonMail/go-crypto/openpgp"
@@ -837,6 +839,76 @@ gpgkey=%sapi/packages/%s/rpm/repository.key`,
 				assert.Equal(t, packageArchitecture, primaryAfter.Packages[0].Architecture)
 			})
 
+			t.Run("SnapshotAndPromote", func(t *testing.T) {
+				defer tests.PrintCurrentTest(t)()
+
+				token := getUserToken(t, user.Name, auth_model.AccessTokenScopeWritePackage)
+				apiURL := fmt.Sprintf("/api/v1/packages/%s/-", user.Name)
+				snapshotURL := rootURL + "/-/snapshots/snap"
+
+				req := NewRequestWithJSON(t, "POST", apiURL+"/snapshots", &api.CreatePackageSnapshotOption{
+					Type:  string(packages.TypeRpm),
+					Name:  "snap",
+					Group: group,
+				}).AddTokenAuth(token)
+				resp := MakeRequest(t, req, http.StatusCreated)
+				snapshot := DecodeJSON(t, resp, &api.PackageSnapshot{})
+				assert.Equal(t, group, snapshot.Group)
+
+				req = NewRequest(t, "GET", snapshotURL+".repo")
+				resp = MakeRequest(t, req, http.StatusOK)
+				assert.Contains(t, resp.Body.String(), "baseurl="+strings.TrimSuffix(setting.AppURL, "/")+snapshotURL+"\n")
+
+				req = NewRequest(t, "GET", snapshotURL+"/repodata/repomd.xml")
+				MakeRequest(t, req, http.StatusOK)
+
+				req = NewRequest(t, "GET", snapshotURL+"/repodata/repomd.xml.asc")
+				resp = MakeRequest(t, req, http.StatusOK)
+				assert.Contains(t, resp.Body.String(), "-----BEGIN PGP SIGNATURE-----")
+
+				req = NewRequest(t, "GET", fmt.Sprintf("%s/package/%s/%s/%s", snapshotURL, packageName, packageVersion, packageArchitecture))
+				resp = MakeRequest(t, req, http.StatusOK)
+				assert.Equal(t, packageRpmContent, resp.Body.Bytes())
+
+				// versions contained in a snapshot can not be deleted
+				req = NewRequest(t, "DELETE", fmt.Sprintf("%s/package/%s/%s/%s", groupURL, packageName, packageVersion, packageArchitecture)).
+					AddBasicAuth(user.Name)
+				MakeRequest(t, req, http.StatusForbidden)
+
+				req = NewRequestWithJSON(t, "POST", apiURL+"/promote", &api.PromotePackagesOption{
+					Type:     string(packages.TypeRpm),
+					From:     group,
+					To:       "-/snapshots",
+					Packages: []*api.PromotePackageVersion{{Name: packageName, Version: packageVersion}},
+				}).AddTokenAuth(token)
+				MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+				req = NewRequestWithJSON(t, "POST", apiURL+"/promote", &api.PromotePackagesOption{
+					Type:     string(packages.TypeRpm),
+					From:     group,
+					To:       "promoted",
+					Packages: []*api.PromotePackageVersion{{Name: packageName, Version: packageVersion}},
+				}).AddTokenAuth(token)
+				MakeRequest(t, req, http.StatusNoContent)
+
+				req = NewRequest(t, "GET", rootURL+"/promoted/repodata/repomd.xml")
+				MakeRequest(t, req, http.StatusOK)
+
+				req = NewRequest(t, "GET", fmt.Sprintf("%s/promoted/package/%s/%s/%s", rootURL, packageName, packageVersion, packageArchitecture))
+				resp = MakeRequest(t, req, http.StatusOK)
+				assert.Equal(t, packageRpmContent, resp.Body.Bytes())
+
+				req = NewRequest(t, "DELETE", fmt.Sprintf("%s/snapshots/%d", apiURL, snapshot.ID)).AddTokenAuth(token)
+				MakeRequest(t, req, http.StatusNoContent)
+
+				req = NewRequest(t, "GET", snapshotURL+"/repodata/repomd.xml")
+				MakeRequest(t, req, http.StatusNotFound)
+
+				req = NewRequest(t, "DELETE", fmt.Sprintf("%s/promoted/package/%s/%s/%s", rootURL, packageName, packageVersion, packageArchitecture)).
+					AddBasicAuth(user.Name)
+				MakeRequest(t, req, http.StatusNoContent)
+			})
+
 			t.Run("Delete", func(t *testing.T) {
 				defer tests.PrintCurrentTest(t)()
 
@@ -0,0 +1,302 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	auth_model "gitea.dev/models/auth"
+	"gitea.dev/models/packages"
+	"gitea.dev/models/unittest"
+	user_model "gitea.dev/models/user"
+	terraform_registry "gitea.dev/modules/packages/terraform/registry"
+	"gitea.dev/modules/setting"
+	"gitea.dev/tests"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageTerraformRegistry(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	user := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	token := "Bearer " + getUserToken(t, user.Name, auth_model.AccessTokenScopeWritePackage)
+
+	t.Run("ServiceDiscovery", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+
+		req := NewRequest(t, "GET", "/.well-known/terraform.json")
+		resp := MakeRequest(t, req, http.StatusOK)
+
+		var result map[string]string
+		DecodeJSON(t, resp, &result)
+		assert.Equal(t, setting.AppSubURL+"/api/packages/-/terraform/modules/v1/", result["modules.v1"])
+		assert.Equal(t, setting.AppSubURL+"/api/packages/-/terraform/providers/v1/", result["providers.v1"])
+	})
+
+	t.Run("Module", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+
+		moduleName := "consul"
+		moduleSystem := "aws"
+		moduleVersion := "1.2.3"
+		readme := "# Consul module"
+
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(zw)
+		for name, content := range map[string]string{"README.md": readme, "main.tf": ""} {
+			tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(content))})
+			tw.Write([]byte(content))
+		}
+		tw.Close()
+		zw.Close()
+		content := buf.Bytes()
+
+		uploadURL := fmt.Sprintf("/api/packages/%s/terraform/modules/%s/%s/%s", user.Name, moduleName, moduleSystem, moduleVersion)
+		registryURL := fmt.Sprintf("/api/packages/-/terraform/modules/v1/%s/%s/%s", user.Name, moduleName, moduleSystem)
+
+		t.Run("Upload", func(t *testing.T) {
+			defer tests.PrintCurrentTest(t)()
+
+			req := NewRequestWithBody(t, "PUT", uploadURL, bytes.NewReader(content))
+			MakeRequest(t, req, http.StatusUnauthorized)
+
+			req = NewRequestWithBody(t, "PUT", fmt.Sprintf("/api/packages/%s/terraform/modules/%s/%s/invalid", user.Name, moduleName, moduleSystem), bytes.NewReader(content)).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusBadRequest)
+
+			req = NewRequestWithBody(t, "PUT", uploadURL, bytes.NewReader(content)).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusCreated)
+
+			pvs, err := packages.GetVersionsByPackageType(t.Context(), user.ID, packages.TypeTerraformModule)
+			require.NoError(t, err)
+			require.Len(t, pvs, 1)
+
+			pd, err := packages.GetPackageDescriptor(t.Context(), pvs[0])
+			require.NoError(t, err)
+			assert.Equal(t, moduleName+"/"+moduleSystem, pd.Package.Name)
+			assert.Equal(t, readme, pd.Metadata.(*terraform_registry.ModuleMetadata).Readme)
+			require.Len(t, pd.Files, 1)
+			assert.Equal(t, "consul-aws-1.2.3.tar.gz", pd.Files[0].File.Name)
+
+			req = NewRequestWithBody(t, "PUT", uploadURL, bytes.NewReader(content)).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusConflict)
+		})
+
+		t.Run("ListVersions", func(t *testing.T) {
+			defer tests.PrintCurrentTest(t)()
+
+			req := NewRequest(t, "GET", registryURL+"/versions")
+			resp := MakeRequest(t, req, http.StatusOK)
+
+			type versionsResponse struct {
+				Modules []struct {
+					Versions []struct {
+						Version string `json:"version"`
+					} `json:"versions"`
+				} `json:"modules"`
+			}
+
+			var result versionsResponse
+			DecodeJSON(t, resp, &result)
+			require.Len(t, result.Modules, 1)
+			require.Len(t, result.Modules[0].Versions, 1)
+			assert.Equal(t, moduleVersion, result.Modules[0].Versions[0].Version)
+
+			req = NewRequest(t, "GET", fmt.Sprintf("/api/packages/-/terraform/modules/v1/%s/%s/gcp/versions", user.Name, moduleName))
+			MakeRequest(t, req, http.StatusNotFound)
+		})
+
+		t.Run("Download", func(t *testing.T) {
+			defer tests.PrintCurrentTest(t)()
+
+			req := NewRequest(t, "GET", registryURL+"/"+moduleVersion+"/download")
+			resp := MakeRequest(t, req, http.StatusNoContent)
+
+			location := resp.Header().Get("X-Terraform-Get")
+			assert.Equal(t, setting.AppURL+strings.TrimPrefix(uploadURL, "/")+"?archive=tar.gz", location)
+
+			req = NewRequest(t, "GET", registryURL+"/9.9.9/download")
+			MakeRequest(t, req, http.StatusNotFound)
+
+			req = NewRequest(t, "GET", uploadURL)
+			resp = MakeRequest(t, req, http.StatusOK)
+			assert.Equal(t, content, resp.Body.Bytes())
+		})
+	})
+
+	t.Run("Provider", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+
+		providerType := "example"
+		providerVersion := "2.0.0"
+
+		createArchive := func(name string, binary []byte) []byte {
+			var buf bytes.Buffer
+			zw := zip.NewWriter(&buf)
+			w, _ := zw.Create(name)
+			w.Write(binary)
+			zw.Close()
+			return buf.Bytes()
+		}
+
+		// the headers of the executables which are checked against the platform
+		elfAmd64 := append([]byte("\x7fELF\x02\x01\x01"), make([]byte, 57)...)
+		elfAmd64[18] = 0x3e
+		machoArm64 := append([]byte{0xcf, 0xfa, 0xed, 0xfe, 0x0c, 0x00, 0x00, 0x01}, make([]byte, 24)...)
+
+		binaryName := fmt.Sprintf("terraform-provider-%s_v%s", providerType, providerVersion)
+		contents := map[string][]byte{
+			"linux_amd64":  createArchive(binaryName, elfAmd64),
+			"darwin_arm64": createArchive(binaryName, machoArm64),
+		}
+
+		rootURL := fmt.Sprintf("/api/packages/%s/terraform/providers/%s/%s", user.Name, providerType, providerVersion)
+		registryURL := fmt.Sprintf("/api/packages/-/terraform/providers/v1/%s/%s", user.Name, providerType)
+
+		t.Run("Upload", func(t *testing.T) {
+			defer tests.PrintCurrentTest(t)()
+
+			req := NewRequestWithBody(t, "PUT", rootURL+"/linux/amd64?protocols=invalid", bytes.NewReader(contents["linux_amd64"])).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusBadRequest)
+
+			req = NewRequestWithBody(t, "PUT", rootURL+"/linux/amd64", strings.NewReader("provider binary")).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusBadRequest)
+
+			req = NewRequestWithBody(t, "PUT", rootURL+"/linux/amd64", bytes.NewReader(createArchive("terraform-provider-other", elfAmd64))).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusBadRequest)
+
+			req = NewRequestWithBody(t, "PUT", rootURL+"/linux/arm64", bytes.NewReader(contents["linux_amd64"])).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusBadRequest)
+
+			req = NewRequestWithBody(t, "PUT", rootURL+"/linux/amd64?protocols=5.0,6.0", bytes.NewReader(contents["linux_amd64"])).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusCreated)
+
+			// the platforms of a version share the protocols
+			req = NewRequestWithBody(t, "PUT", rootURL+"/darwin/arm64?protocols=6.0", bytes.NewReader(contents["darwin_arm64"])).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusBadRequest)
+
+			req = NewRequestWithBody(t, "PUT", rootURL+"/darwin/arm64", bytes.NewReader(contents["darwin_arm64"])).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusCreated)
+
+			req = NewRequestWithBody(t, "PUT", rootURL+"/linux/amd64", bytes.NewReader(contents["linux_amd64"])).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusConflict)
+
+			pvs, err := packages.GetVersionsByPackageType(t.Context(), user.ID, packages.TypeTerraformProvider)
+			require.NoError(t, err)
+			require.Len(t, pvs, 1)
+
+			pd, err := packages.GetPackageDescriptor(t.Context(), pvs[0])
+			require.NoError(t, err)
+			assert.Equal(t, []string{"5.0", "6.0"}, pd.Metadata.(*terraform_registry.ProviderMetadata).Protocols)
+			assert.Len(t, pd.Files, 2)
+		})
+
+		t.Run("ListVersions", func(t *testing.T) {
+			defer tests.PrintCurrentTest(t)()
+
+			req := NewRequest(t, "GET", registryURL+"/versions")
+			resp := MakeRequest(t, req, http.StatusOK)
+
+			type versionsResponse struct {
+				Versions []struct {
+					Version   string   `json:"version"`
+					Protocols []string `json:"protocols"`
+					Platforms []struct {
+						OS   string `json:"os"`
+						Arch string `json:"arch"`
+					} `json:"platforms"`
+				} `json:"versions"`
+			}
+
+			var result versionsResponse
+			DecodeJSON(t, resp, &result)
+			require.Len(t, result.Versions, 1)
+			assert.Equal(t, providerVersion, result.Versions[0].Version)
+			assert.Equal(t, []string{"5.0", "6.0"}, result.Versions[0].Protocols)
+			assert.Len(t, result.Versions[0].Platforms, 2)
+		})
+
+		t.Run("Download", func(t *testing.T) {
+			defer tests.PrintCurrentTest(t)()
+
+			req := NewRequest(t, "GET", registryURL+"/"+providerVersion+"/download/windows/amd64")
+			MakeRequest(t, req, http.StatusNotFound)
+
+			req = NewRequest(t, "GET", registryURL+"/"+providerVersion+"/download/linux/amd64")
+			resp := MakeRequest(t, req, http.StatusOK)
+
+			type downloadResponse struct {
+				Protocols           []string `json:"protocols"`
+				OS                  string   `json:"os"`
+				Arch                string   `json:"arch"`
+				Filename            string   `json:"filename"`
+				DownloadURL         string   `json:"download_url"`
+				ShasumsURL          string   `json:"shasums_url"`
+				ShasumsSignatureURL string   `json:"shasums_signature_url"`
+				Shasum              string   `json:"shasum"`
+				SigningKeys         struct {
+					GPGPublicKeys []struct {
+						KeyID      string `json:"key_id"`
+						ASCIIArmor string `json:"ascii_armor"`
+					} `json:"gpg_public_keys"`
+				} `json:"signing_keys"`
+			}
+
+			var result downloadResponse
+			DecodeJSON(t, resp, &result)
+
+			hash := sha256.Sum256(contents["linux_amd64"])
+			assert.Equal(t, "terraform-provider-example_2.0.0_linux_amd64.zip", result.Filename)
+			assert.Equal(t, hex.EncodeToString(hash[:]), result.Shasum)
+			assert.Equal(t, "linux", result.OS)
+			assert.Equal(t, "amd64", result.Arch)
+			require.Len(t, result.SigningKeys.GPGPublicKeys, 1)
+
+			req = NewRequest(t, "GET", result.DownloadURL)
+			resp = MakeRequest(t, req, http.StatusOK)
+			assert.Equal(t, contents["linux_amd64"], resp.Body.Bytes())
+
+			req = NewRequest(t, "GET", result.ShasumsURL)
+			shasums := MakeRequest(t, req, http.StatusOK).Body.Bytes()
+			assert.Contains(t, string(shasums), result.Shasum+"  "+result.Filename+"\n")
+			assert.Contains(t, string(shasums), "terraform-provider-example_2.0.0_darwin_arm64.zip")
+
+			req = NewRequest(t, "GET", result.ShasumsSignatureURL)
+			signature := MakeRequest(t, req, http.StatusOK).Body.Bytes()
+
+			keyring, err := openpgp.ReadArmoredKeyRing(strings.NewReader(result.SigningKeys.GPGPublicKeys[0].ASCIIArmor))
+			require.NoError(t, err)
+			signer, err := openpgp.CheckDetachedSignature(keyring, bytes.NewReader(shasums), bytes.NewReader(signature), nil)
+			require.NoError(t, err)
+			assert.Equal(t, result.SigningKeys.GPGPublicKeys[0].KeyID, signer.PrimaryKey.KeyIdString())
+
+			req = NewRequest(t, "GET", fmt.Sprintf("/api/packages/%s/terraform/providers/repository.key", user.Name))
+			resp = MakeRequest(t, req, http.StatusOK)
+			assert.Equal(t, result.SigningKeys.GPGPublicKeys[0].ASCIIArmor, resp.Body.String())
+		})
+	})
+}
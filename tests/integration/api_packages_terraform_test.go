@@ -198,6 +198,37 @@ func TestPackageTerraform(t *testing.T) {
 		MakeRequest(t, req, http.StatusOK)
 	})
 
+	t.Run("Protected", func(t *testing.T) {
+		defer tests.PrintCurrentTest(t)()
+
+		for i := range 2 {
+			req := NewRequestWithBody(t, "POST", url, strings.NewReader(genState(i+1))).AddBasicAuth(user.Name)
+			MakeRequest(t, req, http.StatusCreated)
+		}
+
+		removeRule := protectPackageVersions(t, user.ID, packages.TypeTerraformState, packageName, "1")
+
+		req := NewRequest(t, "DELETE", url+"/versions/1").AddBasicAuth(user.Name)
+		MakeRequest(t, req, http.StatusForbidden)
+
+		// the unprotected version and the state must not be deleted either
+		req = NewRequest(t, "DELETE", url).AddBasicAuth(user.Name)
+		MakeRequest(t, req, http.StatusForbidden)
+
+		for _, serial := range []string{"1", "2"} {
+			_, err := packages.GetVersionByNameAndVersion(t.Context(), user.ID, packages.TypeTerraformState, packageName, serial)
+			assert.NoError(t, err)
+		}
+		req = NewRequest(t, "GET", url).AddBasicAuth(user.Name)
+		resp := MakeRequest(t, req, http.StatusOK)
+		assert.Equal(t, genState(2), resp.Body.String())
+
+		removeRule()
+
+		req = NewRequest(t, "DELETE", url).AddBasicAuth(user.Name)
+		MakeRequest(t, req, http.StatusOK)
+	})
+
 	t.Run("BadOperations", func(t *testing.T) {
 		t.Run("LockingIssues", func(t *testing.T) {
 			defer tests.PrintCurrentTest(t)()
@@ -695,15 +695,18 @@ func TestPackageCleanup(t *testing.T) {
 			t.Run(c.Name, func(t *testing.T) {
 				defer tests.PrintCurrentTest(t)()
 
+				versionIDs := make(map[string]int64, len(c.Versions))
 				for _, v := range c.Versions {
 					url := fmt.Sprintf("/api/packages/%s/generic/package/%s/file.bin", user.Name, v.Version)
 					req := NewRequestWithBody(t, "PUT", url, bytes.NewReader([]byte{1})).
 						AddBasicAuth(user.Name)
 					MakeRequest(t, req, http.StatusCreated)
 
+					pv, err := packages_model.GetVersionByNameAndVersion(t.Context(), user.ID, packages_model.TypeGeneric, "package", v.Version)
+					assert.NoError(t, err)
+					versionIDs[v.Version] = pv.ID
+
 					if v.Created != 0 {
-						pv, err := packages_model.GetVersionByNameAndVersion(t.Context(), user.ID, packages_model.TypeGeneric, "package", v.Version)
-						assert.NoError(t, err)
 						_, err = db.GetEngine(t.Context()).Exec("UPDATE package_version SET created_unix = ? WHERE id = ?", v.Created, pv.ID)
 						assert.NoError(t, err)
 					}
@@ -726,6 +729,14 @@ func TestPackageCleanup(t *testing.T) {
 						assert.NoError(t, err)
 					} else {
 						assert.ErrorIs(t, err, packages_model.ErrPackageNotExist, v.Version)
+
+						// the removal by the cleanup rule is recorded in the audit trail
+						_, count, err := packages_model.SearchAuditEvents(t.Context(), &packages_model.PackageAuditEventSearchOptions{
+							VersionID: versionIDs[v.Version],
+							Action:    packages_model.PackageAuditActionDelete,
+						})
+						assert.NoError(t, err)
+						assert.EqualValues(t, 1, count, v.Version)
 					}
 				}
 
@@ -734,3 +745,18 @@ func TestPackageCleanup(t *testing.T) {
 		}
 	})
 }
+
+// protectPackageVersions adds a protection rule for the versions of the package matching the pattern,
+// the returned function removes the rule again
+func protectPackageVersions(t *testing.T, ownerID int64, packageType packages_model.Type, packageName, pattern string) func() {
+	ppr, err := packages_model.InsertProtectionRule(t.Context(), &packages_model.PackageProtectionRule{
+		OwnerID:          ownerID,
+		Type:             packageType,
+		PackageName:      packageName,
+		ProtectedPattern: pattern,
+	})
+	assert.NoError(t, err)
+	return func() {
+		assert.NoError(t, packages_model.DeleteProtectionRuleByID(t.Context(), ppr.ID))
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	auth_model "gitea.dev/models/auth"
+	project_model "gitea.dev/models/project"
+	"gitea.dev/models/unittest"
+	"gitea.dev/modules/setting"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/tests"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIRepoProjects(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	session := loginUser(t, "user2")
+	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteIssue)
+
+	req := NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/projects", &api.CreateProjectOption{
+		Title:    "Roadmap",
+		Template: api.ProjectTemplateBasicKanban,
+		CardType: api.ProjectCardTypeImagesAndText,
+	}).AddTokenAuth(token)
+	project := DecodeJSON(t, MakeRequest(t, req, http.StatusCreated), &api.Project{})
+	assert.Equal(t, "Roadmap", project.Title)
+	assert.EqualValues(t, 1, project.RepoID)
+	assert.Equal(t, api.ProjectCardTypeImagesAndText, project.CardType)
+	assert.Equal(t, fmt.Sprintf("%suser2/repo1/projects/%d", setting.AppURL, project.ID), project.HTMLURL)
+	unittest.AssertExistsAndLoadBean(t, &project_model.Project{ID: project.ID, Type: project_model.TypeRepository})
+
+	req = NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/projects", &api.CreateProjectOption{
+		Title:    "Roadmap",
+		Template: "unknown",
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	resp := MakeRequest(t, NewRequest(t, "GET", "/api/v1/repos/user2/repo1/projects").AddTokenAuth(token), http.StatusOK)
+	assert.Len(t, DecodeJSON(t, resp, []*api.Project{}), 2)
+	assert.Equal(t, "2", resp.Header().Get("X-Total-Count"))
+
+	// the project is closed
+	req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("/api/v1/repos/user2/repo1/projects/%d", project.ID), &api.EditProjectOption{
+		Description: new("Next releases"),
+		State:       new("closed"),
+	}).AddTokenAuth(token)
+	project = DecodeJSON(t, MakeRequest(t, req, http.StatusOK), &api.Project{})
+	assert.Equal(t, "Next releases", project.Description)
+	assert.True(t, project.IsClosed)
+	resp = MakeRequest(t, NewRequest(t, "GET", "/api/v1/repos/user2/repo1/projects?state=closed").AddTokenAuth(token), http.StatusOK)
+	assert.Len(t, DecodeJSON(t, resp, []*api.Project{}), 1)
+
+	// the projects of another repository are not reachable
+	MakeRequest(t, NewRequest(t, "GET", fmt.Sprintf("/api/v1/repos/user2/repo2/projects/%d", project.ID)).AddTokenAuth(token), http.StatusNotFound)
+
+	// reading the projects doesn't allow to change them
+	token4 := getTokenForLoggedInUser(t, loginUser(t, "user4"), auth_model.AccessTokenScopeWriteIssue)
+	MakeRequest(t, NewRequest(t, "GET", fmt.Sprintf("/api/v1/repos/user2/repo1/projects/%d", project.ID)).AddTokenAuth(token4), http.StatusOK)
+	MakeRequest(t, NewRequest(t, "DELETE", fmt.Sprintf("/api/v1/repos/user2/repo1/projects/%d", project.ID)).AddTokenAuth(token4), http.StatusForbidden)
+
+	MakeRequest(t, NewRequest(t, "DELETE", fmt.Sprintf("/api/v1/repos/user2/repo1/projects/%d", project.ID)).AddTokenAuth(token), http.StatusNoContent)
+	unittest.AssertNotExistsBean(t, &project_model.Project{ID: project.ID})
+}
+
+func TestAPIProjectColumns(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	session := loginUser(t, "user2")
+	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteIssue)
+
+	resp := MakeRequest(t, NewRequest(t, "GET", "/api/v1/repos/user2/repo1/projects/1/columns").AddTokenAuth(token), http.StatusOK)
+	columns := DecodeJSON(t, resp, []*api.ProjectColumn{})
+	if assert.Len(t, columns, 3) {
+		assert.Equal(t, "To Do", columns[0].Title)
+		assert.True(t, columns[0].Default)
+	}
+
+	req := NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/projects/1/columns", &api.CreateProjectColumnOption{
+		Title: "Review",
+		Color: "#00aabb",
+	}).AddTokenAuth(token)
+	column := DecodeJSON(t, MakeRequest(t, req, http.StatusCreated), &api.ProjectColumn{})
+	assert.Equal(t, "Review", column.Title)
+	assert.EqualValues(t, 1, column.ProjectID)
+
+	req = NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/projects/1/columns", &api.CreateProjectColumnOption{
+		Title: "Review",
+		Color: "blue",
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	// the column becomes the default column
+	req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("/api/v1/repos/user2/repo1/projects/1/columns/%d", column.ID), &api.EditProjectColumnOption{
+		Title:   new("In Review"),
+		Sorting: new(5),
+		Default: new(true),
+	}).AddTokenAuth(token)
+	column = DecodeJSON(t, MakeRequest(t, req, http.StatusOK), &api.ProjectColumn{})
+	assert.Equal(t, "In Review", column.Title)
+	assert.Equal(t, 5, column.Sorting)
+	assert.True(t, column.Default)
+	assert.False(t, unittest.AssertExistsAndLoadBean(t, &project_model.Column{ID: 1}).Default)
+
+	req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("/api/v1/repos/user2/repo1/projects/1/columns/%d", column.ID), &api.EditProjectColumnOption{
+		Default: new(false),
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+	req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("/api/v1/repos/user2/repo1/projects/1/columns/%d", column.ID), &api.EditProjectColumnOption{
+		Sorting: new(200),
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	// the columns of other projects are not reachable
+	MakeRequest(t, NewRequest(t, "GET", "/api/v1/repos/user2/repo1/projects/1/columns/4").AddTokenAuth(token), http.StatusNotFound)
+
+	// the items of a column are listed in their order
+	resp = MakeRequest(t, NewRequest(t, "GET", "/api/v1/repos/user2/repo1/projects/1/columns/2/items").AddTokenAuth(token), http.StatusOK)
+	for _, item := range DecodeJSON(t, resp, []*api.ProjectItem{}) {
+		assert.EqualValues(t, 2, item.ColumnID)
+	}
+
+	// the default column can't be deleted, the items of a deleted column are moved to the default column
+	MakeRequest(t, NewRequest(t, "DELETE", fmt.Sprintf("/api/v1/repos/user2/repo1/projects/1/columns/%d", column.ID)).AddTokenAuth(token), http.StatusUnprocessableEntity)
+	MakeRequest(t, NewRequest(t, "DELETE", "/api/v1/repos/user2/repo1/projects/1/columns/2").AddTokenAuth(token), http.StatusNoContent)
+	unittest.AssertNotExistsBean(t, &project_model.Column{ID: 2})
+	unittest.AssertNotExistsBean(t, &project_model.ProjectIssue{ProjectID: 1, ProjectColumnID: 2})
+}
+
+func TestAPIRemoveProjectItem(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	session := loginUser(t, "user2")
+	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteIssue)
+
+	unittest.AssertExistsAndLoadBean(t, &project_model.ProjectIssue{ProjectID: 1, IssueID: 1})
+	MakeRequest(t, NewRequest(t, "DELETE", "/api/v1/repos/user2/repo1/projects/1/items/1").AddTokenAuth(token), http.StatusNoContent)
+	unittest.AssertNotExistsBean(t, &project_model.ProjectIssue{ProjectID: 1, IssueID: 1})
+	MakeRequest(t, NewRequest(t, "DELETE", "/api/v1/repos/user2/repo1/projects/1/items/1").AddTokenAuth(token), http.StatusNotFound)
+
+	// an item is added at a position of its column
+	req := NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/projects/1/items", &api.AddProjectItemOption{IssueID: 1, ColumnID: 3, Sorting: new(int64(7))}).AddTokenAuth(token)
+	item := DecodeJSON(t, MakeRequest(t, req, http.StatusCreated), &api.ProjectItem{})
+	assert.EqualValues(t, 3, item.ColumnID)
+	assert.EqualValues(t, 7, item.Sorting)
+}
+
+func TestAPIOwnerProjects(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	token2 := getTokenForLoggedInUser(t, loginUser(t, "user2"), auth_model.AccessTokenScopeWriteUser, auth_model.AccessTokenScopeWriteOrganization)
+	token5 := getTokenForLoggedInUser(t, loginUser(t, "user5"), auth_model.AccessTokenScopeWriteUser, auth_model.AccessTokenScopeWriteOrganization)
+
+	for _, owner := range []string{"/api/v1/orgs/org3", "/api/v1/users/user2"} {
+		t.Run(owner, func(t *testing.T) {
+			req := NewRequestWithJSON(t, "POST", owner+"/projects", &api.CreateProjectOption{Title: "Planning"}).AddTokenAuth(token2)
+			project := DecodeJSON(t, MakeRequest(t, req, http.StatusCreated), &api.Project{})
+			assert.Zero(t, project.RepoID)
+			assert.NotZero(t, project.OwnerID)
+
+			resp := MakeRequest(t, NewRequest(t, "GET", owner+"/projects").AddTokenAuth(token5), http.StatusOK)
+			assert.Len(t, DecodeJSON(t, resp, []*api.Project{}), 1)
+
+			req = NewRequestWithJSON(t, "POST", fmt.Sprintf("%s/projects/%d/columns", owner, project.ID), &api.CreateProjectColumnOption{Title: "Ideas"}).AddTokenAuth(token2)
+			MakeRequest(t, req, http.StatusCreated)
+
+			// only the owners can change the projects
+			req = NewRequestWithJSON(t, "POST", owner+"/projects", &api.CreateProjectOption{Title: "Planning"}).AddTokenAuth(token5)
+			MakeRequest(t, req, http.StatusForbidden)
+			req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("%s/projects/%d", owner, project.ID), &api.EditProjectOption{Title: new("Plans")}).AddTokenAuth(token5)
+			MakeRequest(t, req, http.StatusForbidden)
+
+			// the projects of repositories are not reachable
+			MakeRequest(t, NewRequest(t, "GET", owner+"/projects/1").AddTokenAuth(token2), http.StatusNotFound)
+
+			MakeRequest(t, NewRequest(t, "DELETE", fmt.Sprintf("%s/projects/%d", owner, project.ID)).AddTokenAuth(token2), http.StatusNoContent)
+		})
+	}
+}
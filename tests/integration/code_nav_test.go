@@ -0,0 +1,171 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	auth_model "gitea.dev/models/auth"
+	repo_model "gitea.dev/models/repo"
+	"gitea.dev/models/unittest"
+	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/queue"
+	api "gitea.dev/modules/structs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+type codeNavigationLocation struct {
+	Path string `json:"path"`
+	Line int    `json:"line"`
+	Link string `json:"link"`
+}
+
+type codeNavigationResult struct {
+	Precise     bool                      `json:"precise"`
+	Hover       string                    `json:"hover"`
+	Definitions []*codeNavigationLocation `json:"definitions"`
+	References  []*codeNavigationLocation `json:"references"`
+}
+
+func TestCodeNavigation(t *testing.T) {
+	onGiteaRun(t, func(t *testing.T, _ *url.URL) {
+		user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+		repo1 := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 1})
+
+		resp := testCreateFileInBranch(t, user2, repo1, createFileInBranchOptions{
+			OldBranch: "master",
+			NewBranch: "code-nav",
+		}, map[string]string{
+			"hello.go": "package main\n\nfunc Hello() string {\n\treturn \"hello\"\n}\n",
+		})
+		oldCommitID := resp.Commit.SHA
+		resp = testCreateFileInBranch(t, user2, repo1, createFileInBranchOptions{
+			OldBranch: "code-nav",
+			NewBranch: "code-nav",
+		}, map[string]string{
+			"main.go": "package main\n\nfunc main() {\n\tprintln(Hello())\n}\n",
+		})
+		commitID := resp.Commit.SHA
+
+		session := loginUser(t, user2.Name)
+		lookupCommit := func(commitID, path string, line, column int, name string, expectedStatus int) *codeNavigationResult {
+			req := NewRequestf(t, "GET", "/user2/repo1/code-nav/%s?path=%s&line=%d&column=%d&name=%s", commitID, path, line, column, name)
+			resp := session.MakeRequest(t, req, expectedStatus)
+			if expectedStatus != http.StatusOK {
+				return nil
+			}
+			return DecodeJSON(t, resp, &codeNavigationResult{})
+		}
+		lookup := func(path string, line, column int, name string) *codeNavigationResult {
+			return lookupCommit(commitID, path, line, column, name, http.StatusOK)
+		}
+
+		t.Run("Generated", func(t *testing.T) {
+			// the index of the branch head is generated in the background
+			lookupCommit(commitID, "main.go", 4, 9, "Hello", http.StatusNotFound)
+			require.NoError(t, queue.GetManager().FlushAll(t.Context(), 5*time.Second))
+
+			result := lookup("main.go", 4, 9, "Hello")
+			assert.False(t, result.Precise)
+			assert.Equal(t, "func Hello() string {", result.Hover)
+			require.Len(t, result.Definitions, 1)
+			assert.Equal(t, "hello.go", result.Definitions[0].Path)
+			assert.Equal(t, 3, result.Definitions[0].Line)
+			assert.Equal(t, "/user2/repo1/src/commit/"+commitID+"/hello.go#L3", result.Definitions[0].Link)
+			assert.Len(t, result.References, 2)
+
+			result = lookup("main.go", 4, 1, "println")
+			assert.Empty(t, result.Definitions)
+		})
+
+		t.Run("NotHead", func(t *testing.T) {
+			// only the heads of the branches and pull requests are indexed
+			lookupCommit(oldCommitID, "hello.go", 3, 6, "Hello", http.StatusNotFound)
+			require.NoError(t, queue.GetManager().FlushAll(t.Context(), 5*time.Second))
+			lookupCommit(oldCommitID, "hello.go", 3, 6, "Hello", http.StatusNotFound)
+		})
+
+		t.Run("UploadLSIF", func(t *testing.T) {
+			token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteRepository)
+			dump := strings.Join([]string{
+				`{"id":1,"type":"vertex","label":"metaData","version":"0.4.3","projectRoot":"file:///src"}`,
+				`{"id":2,"type":"vertex","label":"document","uri":"file:///src/main.go","languageId":"go"}`,
+				`{"id":3,"type":"vertex","label":"range","start":{"line":3,"character":9},"end":{"line":3,"character":14}}`,
+				`{"id":4,"type":"edge","label":"contains","outV":2,"inVs":[3]}`,
+				`{"id":5,"type":"vertex","label":"hoverResult","result":{"contents":{"kind":"markdown","value":"func Hello() string"}}}`,
+				`{"id":6,"type":"edge","label":"textDocument/hover","outV":3,"inV":5}`,
+			}, "\n")
+
+			req := NewRequestWithBody(t, "PUT", "/api/v1/repos/user2/repo1/code-navigation/"+commitID+"/lsif", strings.NewReader(dump)).
+				AddTokenAuth(token)
+			apiIndex := DecodeJSON(t, MakeRequest(t, req, http.StatusCreated), &api.CodeNavigationIndex{})
+			assert.Equal(t, commitID, apiIndex.CommitID)
+			assert.True(t, apiIndex.Precise)
+			assert.Equal(t, 1, apiIndex.Files)
+
+			result := lookup("main.go", 4, 10, "Hello")
+			assert.True(t, result.Precise)
+			assert.Equal(t, "func Hello() string", result.Hover)
+
+			req = NewRequestWithBody(t, "PUT", "/api/v1/repos/user2/repo1/code-navigation/"+commitID+"/lsif", strings.NewReader("not a dump")).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+			req = NewRequestWithBody(t, "PUT", "/api/v1/repos/user2/repo1/code-navigation/0000000000000000000000000000000000000000/lsif", strings.NewReader(dump)).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusNotFound)
+
+			readToken := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeReadRepository)
+			req = NewRequestWithBody(t, "PUT", "/api/v1/repos/user2/repo1/code-navigation/"+commitID+"/lsif", strings.NewReader(dump)).
+				AddTokenAuth(readToken)
+			MakeRequest(t, req, http.StatusForbidden)
+		})
+
+		t.Run("UploadSCIP", func(t *testing.T) {
+			token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteRepository)
+
+			appendMessage := func(b []byte, num protowire.Number, value []byte) []byte {
+				return protowire.AppendBytes(protowire.AppendTag(b, num, protowire.BytesType), value)
+			}
+			var occurrence []byte
+			occurrence = appendMessage(occurrence, 1, protowire.AppendVarint(protowire.AppendVarint(protowire.AppendVarint(nil, 2), 5), 10))
+			occurrence = appendMessage(occurrence, 2, []byte("scip-go gomod main v1 `main`/Hello()."))
+			occurrence = protowire.AppendVarint(protowire.AppendTag(occurrence, 3, protowire.VarintType), 1)
+			var symbol []byte
+			symbol = appendMessage(symbol, 1, []byte("scip-go gomod main v1 `main`/Hello()."))
+			symbol = appendMessage(symbol, 3, []byte("func Hello() string"))
+			var document []byte
+			document = appendMessage(document, 1, []byte("hello.go"))
+			document = appendMessage(document, 2, occurrence)
+			document = appendMessage(document, 3, symbol)
+			index := appendMessage(nil, 2, document)
+
+			// the indexes of any commit can be uploaded
+			req := NewRequestWithBody(t, "PUT", "/api/v1/repos/user2/repo1/code-navigation/"+oldCommitID+"/scip", bytes.NewReader(index)).
+				AddTokenAuth(token)
+			apiIndex := DecodeJSON(t, MakeRequest(t, req, http.StatusCreated), &api.CodeNavigationIndex{})
+			assert.Equal(t, oldCommitID, apiIndex.CommitID)
+			assert.True(t, apiIndex.Precise)
+			assert.Equal(t, 1, apiIndex.Files)
+
+			result := lookupCommit(oldCommitID, "hello.go", 3, 6, "Hello", http.StatusOK)
+			assert.True(t, result.Precise)
+			assert.Equal(t, "func Hello() string", result.Hover)
+			require.Len(t, result.Definitions, 1)
+			assert.Equal(t, 3, result.Definitions[0].Line)
+
+			req = NewRequestWithBody(t, "PUT", "/api/v1/repos/user2/repo1/code-navigation/"+oldCommitID+"/scip", bytes.NewReader(index[:len(index)-2])).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusUnprocessableEntity)
+		})
+	})
+}
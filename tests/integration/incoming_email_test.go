@@ -13,8 +13,10 @@ import (
 	"time"
 
 	issues_model "gitea.dev/models/issues"
+	repo_model "gitea.dev/models/repo"
 	"gitea.dev/models/unittest"
 	user_model "gitea.dev/models/user"
+	"gitea.dev/modules/gitrepo"
 	"gitea.dev/modules/setting"
 	"gitea.dev/services/mailer/incoming"
 	incoming_payload "gitea.dev/services/mailer/incoming/payload"
@@ -55,6 +57,20 @@ func TestIncomingEmail(t *testing.T) {
 			assert.NoError(t, err)
 			assert.IsType(t, ref, new(issues_model.Comment))
 			assert.Equal(t, comment.ID, ref.(*issues_model.Comment).ID)
+
+			_, err = incoming_payload.CreatePullReferencePayload(comment, "")
+			assert.Error(t, err)
+			pullPayload, err := incoming_payload.CreatePullReferencePayload(comment, "65f1bf27bc3bf70f64657658635e66094edbcb4d")
+			assert.NoError(t, err)
+
+			ref, headCommitID, err := incoming_payload.GetPullReferenceFromPayload(t.Context(), pullPayload)
+			assert.NoError(t, err)
+			assert.Equal(t, comment.ID, ref.(*issues_model.Comment).ID)
+			assert.Equal(t, "65f1bf27bc3bf70f64657658635e66094edbcb4d", headCommitID)
+
+			_, headCommitID, err = incoming_payload.GetPullReferenceFromPayload(t.Context(), commentPayload)
+			assert.NoError(t, err)
+			assert.Empty(t, headCommitID)
 		})
 
 		t.Run("Token", func(t *testing.T) {
@@ -158,6 +174,72 @@ func TestIncomingEmail(t *testing.T) {
 					assert.Equal(t, content.Attachments[0].Name, attachment.Name)
 					assert.EqualValues(t, 4, attachment.Size)
 				})
+
+				t.Run("Status", func(t *testing.T) {
+					defer tests.PrintCurrentTest(t)()
+
+					handler := &incoming.ReplyHandler{}
+
+					payload, err := incoming_payload.CreateReferencePayload(issue)
+					assert.NoError(t, err)
+
+					assert.NoError(t, handler.Handle(t.Context(), &incoming.MailContent{Content: "/close"}, user, payload))
+					unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: issue.ID, IsClosed: true})
+
+					assert.NoError(t, handler.Handle(t.Context(), &incoming.MailContent{Content: "/reopen"}, user, payload))
+					unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: issue.ID}, "is_closed = ?", false)
+				})
+
+				t.Run("Review", func(t *testing.T) {
+					defer tests.PrintCurrentTest(t)()
+
+					pull := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 2})
+					pr := unittest.AssertExistsAndLoadBean(t, &issues_model.PullRequest{IssueID: pull.ID})
+					repo := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: pull.RepoID})
+					headCommitID, err := gitrepo.GetRefCommitID(t.Context(), repo, pr.GetGitHeadRefName())
+					assert.NoError(t, err)
+					handler := &incoming.ReplyHandler{}
+
+					// the mail doesn't contain the head commit the review is made for
+					payload, err := incoming_payload.CreateReferencePayload(pull)
+					assert.NoError(t, err)
+					assert.NoError(t, handler.Handle(t.Context(), &incoming.MailContent{Content: "/approve"}, user, payload))
+					unittest.AssertNotExistsBean(t, &issues_model.Review{IssueID: pull.ID, ReviewerID: user.ID, Type: issues_model.ReviewTypeApprove})
+
+					payload, err = incoming_payload.CreatePullReferencePayload(pull, headCommitID)
+					assert.NoError(t, err)
+
+					content := &incoming.MailContent{Content: "looks good to me\n/approve"}
+					assert.NoError(t, handler.Handle(t.Context(), content, user, payload))
+					unittest.AssertExistsAndLoadBean(t, &issues_model.Review{
+						IssueID:    pull.ID,
+						ReviewerID: user.ID,
+						Type:       issues_model.ReviewTypeApprove,
+						Content:    "looks good to me",
+						CommitID:   headCommitID,
+					})
+
+					// the reply to an inline code comment is added to its conversation as a part of the review
+					comment := unittest.AssertExistsAndLoadBean(t, &issues_model.Comment{ID: 6})
+					payload, err = incoming_payload.CreatePullReferencePayload(comment, headCommitID)
+					assert.NoError(t, err)
+
+					content = &incoming.MailContent{Content: "please fix this\n/request-changes"}
+					assert.NoError(t, handler.Handle(t.Context(), content, user, payload))
+					review := unittest.AssertExistsAndLoadBean(t, &issues_model.Review{
+						IssueID:    pull.ID,
+						ReviewerID: user.ID,
+						Type:       issues_model.ReviewTypeReject,
+						CommitID:   headCommitID,
+					})
+					unittest.AssertExistsAndLoadBean(t, &issues_model.Comment{
+						Type:     issues_model.CommentTypeCode,
+						ReviewID: review.ID,
+						TreePath: comment.TreePath,
+						Line:     comment.Line,
+						Content:  "please fix this",
+					})
+				})
 			})
 
 			t.Run("Unsubscribe", func(t *testing.T) {
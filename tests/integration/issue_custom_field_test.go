@@ -0,0 +1,53 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	"gitea.dev/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueCustomFields(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	session := loginUser(t, "user2")
+
+	field := &issues_model.CustomField{RepoID: 1, Name: "Priority", Type: issues_model.CustomFieldTypeSingleSelect, Options: []string{"High", "Low"}}
+	require.NoError(t, issues_model.NewCustomField(t.Context(), field))
+	formName := fmt.Sprintf("custom_field_%d", field.ID)
+
+	resp := session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/issues/1"), http.StatusOK)
+	htmlDoc := NewHTMLParser(t, resp.Body)
+	assert.Equal(t, 1, htmlDoc.Find(fmt.Sprintf(`.issue-sidebar-custom-fields select[name="%s"]`, formName)).Length())
+
+	session.MakeRequest(t, NewRequestWithValues(t, "POST", "/user2/repo1/issues/1/custom_fields", map[string]string{
+		formName: "High",
+	}), http.StatusOK)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.CustomFieldValue{IssueID: 1, FieldID: field.ID, Value: "High"})
+
+	session.MakeRequest(t, NewRequestWithValues(t, "POST", "/user2/repo1/issues/1/custom_fields", map[string]string{
+		formName: "Urgent",
+	}), http.StatusBadRequest)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.CustomFieldValue{IssueID: 1, FieldID: field.ID, Value: "High"})
+
+	// the project board can be grouped by the field, the issues without value are in the first column
+	resp = session.MakeRequest(t, NewRequest(t, "GET", fmt.Sprintf("/user2/repo1/projects/1?group_by=%d", field.ID)), http.StatusOK)
+	htmlDoc = NewHTMLParser(t, resp.Body)
+	columns := htmlDoc.Find("#project-board .project-column")
+	if assert.Equal(t, 3, columns.Length()) {
+		assert.Equal(t, "High", strings.TrimSpace(columns.Eq(1).Find(".project-column-title-text").Text()))
+		assert.Equal(t, 1, columns.Eq(1).Find(".issue-card").Length())
+		assert.Equal(t, "1", columns.Eq(1).Find(".issue-card").AttrOr("data-issue", ""))
+		assert.Equal(t, 0, columns.Eq(2).Find(".issue-card").Length())
+	}
+	assert.Equal(t, "false", htmlDoc.Find("#project-board").AttrOr("data-project-board-writable", ""))
+}
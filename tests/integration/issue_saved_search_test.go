@@ -0,0 +1,56 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	"gitea.dev/tests"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueSavedSearches(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	session := loginUser(t, "user2")
+
+	resp := session.MakeRequest(t, NewRequest(t, "GET", "/issues?type=your_repositories&q="+url.QueryEscape("repo:user2/repo1 label:label1")), http.StatusOK)
+	htmlDoc := NewHTMLParser(t, resp.Body)
+	assert.Equal(t, 1, htmlDoc.Find("#issue-list > .item").Length())
+	assert.Equal(t, 1, htmlDoc.Find(`.saved-searches form[action$="/issues/-/saved-searches/new"]`).Length())
+
+	resp = session.MakeRequest(t, NewRequest(t, "GET", "/issues?q="+url.QueryEscape("label:")), http.StatusOK)
+	assert.Contains(t, resp.Body.String(), "Invalid search query")
+
+	session.MakeRequest(t, NewRequestWithValues(t, "POST", "/issues/-/saved-searches/new", map[string]string{
+		"name": "Label 1",
+		"q":    "label:label1",
+	}), http.StatusOK)
+	saved := unittest.AssertExistsAndLoadBean(t, &issues_model.SavedSearch{OwnerID: 2, Name: "Label 1"})
+
+	session.MakeRequest(t, NewRequest(t, "POST", fmt.Sprintf("/issues/-/saved-searches/%d/pin?pinned=true", saved.ID)), http.StatusOK)
+	session.MakeRequest(t, NewRequest(t, "POST", fmt.Sprintf("/issues/-/saved-searches/%d/subscribe?subscribe=true", saved.ID)), http.StatusOK)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.SavedSearch{ID: saved.ID, IsPinned: true})
+	unittest.AssertExistsAndLoadBean(t, &issues_model.SavedSearchSubscription{SavedSearchID: saved.ID, UserID: 2})
+
+	resp = session.MakeRequest(t, NewRequest(t, "GET", "/"), http.StatusOK)
+	htmlDoc = NewHTMLParser(t, resp.Body)
+	assert.Contains(t, htmlDoc.Find(".pinned-searches a").Text(), "Label 1")
+
+	// the saved searches of an organization can only be changed by its owners
+	orgSaved := &issues_model.SavedSearch{OwnerID: 3, Name: "Org", Query: "is:open"}
+	assert.NoError(t, issues_model.CreateSavedSearch(t.Context(), orgSaved))
+	member := loginUser(t, "user4")
+	member.MakeRequest(t, NewRequest(t, "POST", fmt.Sprintf("/org/org3/issues/-/saved-searches/%d/delete", orgSaved.ID)), http.StatusBadRequest)
+	member.MakeRequest(t, NewRequest(t, "POST", fmt.Sprintf("/org/org3/issues/-/saved-searches/%d/subscribe?subscribe=true", orgSaved.ID)), http.StatusOK)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.SavedSearchSubscription{SavedSearchID: orgSaved.ID, UserID: 4})
+
+	session.MakeRequest(t, NewRequest(t, "POST", fmt.Sprintf("/issues/-/saved-searches/%d/delete", saved.ID)), http.StatusOK)
+	unittest.AssertNotExistsBean(t, &issues_model.SavedSearch{ID: saved.ID})
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"net/http"
+	"testing"
+
+	"gitea.dev/models/db"
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	"gitea.dev/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueSubIssues(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	session := loginUser(t, "user2")
+
+	// the sub-issues inherit the milestone of the parent issue in the same repository
+	_, err := db.GetEngine(t.Context()).ID(1).Cols("milestone_id").Update(&issues_model.Issue{MilestoneID: 1})
+	require.NoError(t, err)
+
+	session.MakeRequest(t, NewRequestWithValues(t, "POST", "/user2/repo1/issues/1/sub_issues/add", map[string]string{
+		"issue":   "#4",
+		"inherit": "on",
+	}), http.StatusOK)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.SubIssue{ParentID: 1, IssueID: 5})
+	unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 5, MilestoneID: 1})
+
+	session.MakeRequest(t, NewRequestWithValues(t, "POST", "/user2/repo1/issues/1/sub_issues/add", map[string]string{
+		"issue": "repo2#2",
+	}), http.StatusOK)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.SubIssue{ParentID: 1, IssueID: 7})
+
+	// cycles are rejected
+	resp := session.MakeRequest(t, NewRequestWithValues(t, "POST", "/user2/repo2/issues/2/sub_issues/add", map[string]string{
+		"issue": "user2/repo1#1",
+	}), http.StatusBadRequest)
+	assert.Contains(t, resp.Body.String(), "its own sub-issues")
+
+	resp = session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/issues/1"), http.StatusOK)
+	htmlDoc := NewHTMLParser(t, resp.Body)
+	assert.Equal(t, 2, htmlDoc.Find(".sub-issues .divided.list > .item").Length())
+	assert.Equal(t, "1", htmlDoc.Find(".sub-issues progress").AttrOr("value", ""))
+	assert.Equal(t, "2", htmlDoc.Find(".sub-issues progress").AttrOr("max", ""))
+
+	resp = session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo2/issues/2"), http.StatusOK)
+	htmlDoc = NewHTMLParser(t, resp.Body)
+	assert.Contains(t, htmlDoc.Find(".sub-issues .list a").First().AttrOr("href", ""), "/user2/repo1/issues/1")
+
+	session.MakeRequest(t, NewRequest(t, "POST", "/user2/repo1/issues/1/sub_issues/remove?issue_id=7"), http.StatusOK)
+	unittest.AssertNotExistsBean(t, &issues_model.SubIssue{IssueID: 7})
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	issues_model "gitea.dev/models/issues"
+	"gitea.dev/models/unittest"
+	"gitea.dev/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueTypes(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	session := loginUser(t, "user2")
+
+	bug := &issues_model.IssueType{OrgID: 3, Name: "Bug", Icon: "octicon-bug"}
+	require.NoError(t, issues_model.NewIssueType(t.Context(), bug))
+	issue := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: 3, Index: 1})
+
+	resp := session.MakeRequest(t, NewRequest(t, "GET", "/org3/repo3/issues/1"), http.StatusOK)
+	htmlDoc := NewHTMLParser(t, resp.Body)
+	assert.Equal(t, 1, htmlDoc.Find(fmt.Sprintf(`.issue-sidebar-combo input[name="type_id"] ~ .dropdown .item[data-value="%d"]`, bug.ID)).Length())
+
+	session.MakeRequest(t, NewRequestWithValues(t, "POST", fmt.Sprintf("/org3/repo3/issues/type?issue_ids=%d", issue.ID), map[string]string{
+		"id": fmt.Sprint(bug.ID),
+	}), http.StatusOK)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: issue.ID, TypeID: bug.ID})
+
+	// the type is shown in the issue list
+	resp = session.MakeRequest(t, NewRequest(t, "GET", "/org3/repo3/issues?state=all"), http.StatusOK)
+	htmlDoc = NewHTMLParser(t, resp.Body)
+	assert.Equal(t, "Bug", strings.TrimSpace(htmlDoc.Find(".issue-type-badge").First().Text()))
+
+	// the new issues can have a type too
+	resp = session.MakeRequest(t, NewRequestWithValues(t, "POST", "/org3/repo3/issues/new", map[string]string{
+		"title":   "new issue with a type",
+		"type_id": fmt.Sprint(bug.ID),
+	}), http.StatusOK)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: 3, Title: "new issue with a type", TypeID: bug.ID})
+
+	// the types of other organizations can't be set
+	other := &issues_model.IssueType{OrgID: 6, Name: "Bug"}
+	require.NoError(t, issues_model.NewIssueType(t.Context(), other))
+	session.MakeRequest(t, NewRequestWithValues(t, "POST", fmt.Sprintf("/org3/repo3/issues/type?issue_ids=%d", issue.ID), map[string]string{
+		"id": fmt.Sprint(other.ID),
+	}), http.StatusBadRequest)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: issue.ID, TypeID: bug.ID})
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	auth_model "gitea.dev/models/auth"
+	project_model "gitea.dev/models/project"
+	"gitea.dev/models/unittest"
+	api "gitea.dev/modules/structs"
+	"gitea.dev/tests"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectViews(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	session := loginUser(t, "user2")
+
+	session.MakeRequest(t, NewRequestWithURLValues(t, "POST", "/user2/repo1/projects/1/views/new", url.Values{
+		"name":     {"Table"},
+		"layout":   {"table"},
+		"fields":   {"status", "labels"},
+		"group_by": {"status"},
+		"sort_by":  {"number"},
+	}), http.StatusOK)
+	view := unittest.AssertExistsAndLoadBean(t, &project_model.View{ProjectID: 1, Name: "Table"})
+	assert.Equal(t, []string{"status", "labels"}, view.Fields)
+
+	groupTitles := func(t *testing.T) []string {
+		resp := session.MakeRequest(t, NewRequest(t, "GET", fmt.Sprintf("/user2/repo1/projects/1?view=%d", view.ID)), http.StatusOK)
+		htmlDoc := NewHTMLParser(t, resp.Body)
+		assert.Zero(t, htmlDoc.Find("#project-board").Length())
+		return htmlDoc.Find(".project-view-group .gt-ellipsis").Map(func(_ int, s *goquery.Selection) string {
+			return strings.TrimSpace(s.Text())
+		})
+	}
+	assert.Equal(t, []string{"To Do", "In Progress", "Done"}, groupTitles(t))
+
+	session.MakeRequest(t, NewRequestWithValues(t, "POST", fmt.Sprintf("/user2/repo1/projects/1/views/%d/edit", view.ID), map[string]string{
+		"name":   "Roadmap",
+		"layout": "roadmap",
+	}), http.StatusOK)
+	assert.Equal(t, []string{"milestone1", "milestone3"}, groupTitles(t))
+
+	// the views can't be grouped by any field
+	session.MakeRequest(t, NewRequestWithValues(t, "POST", fmt.Sprintf("/user2/repo1/projects/1/views/%d/edit", view.ID), map[string]string{
+		"name":     "Roadmap",
+		"layout":   "roadmap",
+		"group_by": "assignees",
+	}), http.StatusBadRequest)
+
+	// the board is still the default view
+	resp := session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/projects/1"), http.StatusOK)
+	assert.Equal(t, 1, NewHTMLParser(t, resp.Body).Find("#project-board").Length())
+
+	// the views of a project are not reachable from another one
+	MakeRequest(t, NewRequest(t, "GET", fmt.Sprintf("/user2/repo1/projects/4?view=%d", view.ID)), http.StatusNotFound)
+	loginUser(t, "user4").MakeRequest(t, NewRequest(t, "POST", fmt.Sprintf("/user2/repo1/projects/1/views/%d/delete", view.ID)), http.StatusNotFound)
+
+	session.MakeRequest(t, NewRequest(t, "POST", fmt.Sprintf("/user2/repo1/projects/1/views/%d/delete", view.ID)), http.StatusOK)
+	unittest.AssertNotExistsBean(t, &project_model.View{ID: view.ID})
+}
+
+func TestAPIProjectViews(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	session := loginUser(t, "user2")
+	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteIssue)
+
+	req := NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/projects/1/views", &api.CreateProjectViewOption{
+		Name:   "Table",
+		Layout: api.ProjectViewLayoutTable,
+		Fields: []string{"assignees"},
+		SortBy: "title",
+	}).AddTokenAuth(token)
+	view := DecodeJSON(t, MakeRequest(t, req, http.StatusCreated), &api.ProjectView{})
+	assert.Equal(t, []string{"assignees"}, view.Fields)
+
+	req = NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/projects/1/views", &api.CreateProjectViewOption{
+		Name:   "Table",
+		Layout: api.ProjectViewLayoutTable,
+		Fields: []string{"field:1000"},
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	resp := MakeRequest(t, NewRequest(t, "GET", "/api/v1/repos/user2/repo1/projects/1/views").AddTokenAuth(token), http.StatusOK)
+	assert.Len(t, DecodeJSON(t, resp, []*api.ProjectView{}), 1)
+
+	req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("/api/v1/repos/user2/repo1/projects/1/views/%d", view.ID), &api.EditProjectViewOption{
+		Filter: new("is:open"),
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	assert.Equal(t, "is:open", DecodeJSON(t, resp, &api.ProjectView{}).Filter)
+
+	req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("/api/v1/repos/user2/repo1/projects/1/views/%d", view.ID), &api.EditProjectViewOption{
+		SortBy: new("labels"),
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	// the views belong to the project of the repository
+	MakeRequest(t, NewRequest(t, "GET", fmt.Sprintf("/api/v1/repos/user2/repo2/projects/1/views/%d", view.ID)).AddTokenAuth(token), http.StatusNotFound)
+
+	MakeRequest(t, NewRequest(t, "DELETE", fmt.Sprintf("/api/v1/repos/user2/repo1/projects/1/views/%d", view.ID)).AddTokenAuth(token), http.StatusNoContent)
+	unittest.AssertNotExistsBean(t, &project_model.View{ID: view.ID})
+}
+
+func TestAPIProjectItems(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	session := loginUser(t, "user2")
+	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteIssue)
+
+	listItems := func(t *testing.T) map[int64]*api.ProjectItem {
+		resp := MakeRequest(t, NewRequest(t, "GET", "/api/v1/repos/user2/repo1/projects/1/items").AddTokenAuth(token), http.StatusOK)
+		items := make(map[int64]*api.ProjectItem)
+		for _, item := range DecodeJSON(t, resp, []*api.ProjectItem{}) {
+			items[item.Issue.ID] = item
+		}
+		return items
+	}
+	items := listItems(t)
+	assert.Len(t, items, 4)
+	assert.EqualValues(t, 2, items[3].ColumnID)
+
+	// a pull request is added to a column
+	req := NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/projects/1/items", &api.AddProjectItemOption{IssueID: 11, ColumnID: 2}).AddTokenAuth(token)
+	item := DecodeJSON(t, MakeRequest(t, req, http.StatusCreated), &api.ProjectItem{})
+	assert.EqualValues(t, 2, item.ColumnID)
+	unittest.AssertExistsAndLoadBean(t, &project_model.ProjectIssue{ProjectID: 1, IssueID: 11, ProjectColumnID: 2})
+
+	// an item of the project is moved to another column
+	req = NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/projects/1/items", &api.AddProjectItemOption{IssueID: 1, ColumnID: 3}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusCreated)
+	items = listItems(t)
+	assert.Len(t, items, 5)
+	assert.EqualValues(t, 3, items[1].ColumnID)
+
+	// the issues of other repositories and the columns of other projects can't be used
+	req = NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/projects/1/items", &api.AddProjectItemOption{IssueID: 4}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+	req = NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/projects/1/items", &api.AddProjectItemOption{IssueID: 1, ColumnID: 4}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	// reading the project doesn't allow to change it
+	token4 := getTokenForLoggedInUser(t, loginUser(t, "user4"), auth_model.AccessTokenScopeWriteIssue)
+	MakeRequest(t, NewRequest(t, "GET", "/api/v1/repos/user2/repo1/projects/1/items").AddTokenAuth(token4), http.StatusOK)
+	req = NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/projects/1/items", &api.AddProjectItemOption{IssueID: 1}).AddTokenAuth(token4)
+	MakeRequest(t, req, http.StatusForbidden)
+}
@@ -68,6 +68,122 @@ func TestNewWebHookLink(t *testing.T) {
 	}
 }
 
+func TestBrokerWebhookSettings(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	session := loginUser(t, "user2")
+
+	resp := session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/settings/hooks/redis/new"), http.StatusOK)
+	htmlDoc := NewHTMLParser(t, resp.Body)
+	assert.Equal(t, "gitea.{owner}.{repo}.{event}", htmlDoc.GetInputValueByName("topic"))
+	assert.Equal(t, 0, htmlDoc.Find("input[name=exchange]").Length())
+
+	// a broker URL with a scheme of another type is rejected
+	req := NewRequestWithValues(t, "POST", "/user2/repo1/settings/hooks/redis/new", map[string]string{
+		"broker_url": "nats://localhost:4222",
+		"topic":      "gitea:{repo}",
+		"events":     "push_only",
+		"active":     "true",
+	})
+	session.MakeRequest(t, req, http.StatusOK)
+	unittest.AssertNotExistsBean(t, &webhook.Webhook{RepoID: 1, Type: webhook_module.REDIS})
+
+	req = NewRequestWithValues(t, "POST", "/user2/repo1/settings/hooks/redis/new", map[string]string{
+		"broker_url":   "redis://localhost:6379/0",
+		"topic":        "gitea:{repo}",
+		"cloud_events": "on",
+		"events":       "push_only",
+		"active":       "true",
+	})
+	session.MakeRequest(t, req, http.StatusSeeOther)
+	hook := unittest.AssertExistsAndLoadBean(t, &webhook.Webhook{RepoID: 1, Type: webhook_module.REDIS})
+	assert.Equal(t, "redis://localhost:6379/0", hook.URL)
+	assert.JSONEq(t, `{"topic":"gitea:{repo}","cloudevents":true}`, hook.Meta)
+
+	resp = session.MakeRequest(t, NewRequest(t, "GET", fmt.Sprintf("/user2/repo1/settings/hooks/%d", hook.ID)), http.StatusOK)
+	htmlDoc = NewHTMLParser(t, resp.Body)
+	assert.Equal(t, "gitea:{repo}", htmlDoc.GetInputValueByName("topic"))
+	assert.Equal(t, 1, htmlDoc.Find("input[name=cloud_events]:checked").Length())
+
+	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteRepository)
+	req = NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/hooks", api.CreateHookOption{
+		Type: webhook_module.AMQP,
+		Config: api.CreateHookOptionConfig{
+			"content_type": "json",
+			"url":          "https://localhost/amqp",
+		},
+		Active: true,
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	req = NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/hooks", api.CreateHookOption{
+		Type: webhook_module.AMQP,
+		Config: api.CreateHookOptionConfig{
+			"content_type": "json",
+			"url":          "amqp://localhost:5672/",
+			"exchange":     "amq.topic",
+		},
+		Active: true,
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusCreated)
+	apiHook := DecodeJSON(t, resp, &api.Hook{})
+	assert.Equal(t, "amq.topic", apiHook.Config["exchange"])
+	assert.Empty(t, apiHook.Config["topic"])
+	assert.Equal(t, "false", apiHook.Config["cloudevents"])
+
+	req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("/api/v1/repos/user2/repo1/hooks/%d", apiHook.ID), api.EditHookOption{
+		Config: map[string]string{"topic": "{owner}.{repo}", "cloudevents": "true"},
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	apiHook = DecodeJSON(t, resp, &api.Hook{})
+	assert.Equal(t, "amq.topic", apiHook.Config["exchange"])
+	assert.Equal(t, "{owner}.{repo}", apiHook.Config["topic"])
+	assert.Equal(t, "true", apiHook.Config["cloudevents"])
+}
+
+func TestChatWebhookSettings(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	session := loginUser(t, "user2")
+
+	req := NewRequestWithValues(t, "POST", "/user2/repo1/settings/hooks/googlechat/new", map[string]string{
+		"payload_url": "https://chat.googleapis.com/v1/spaces/AAAA/messages?key=k&token=t",
+		"events":      "push_only",
+		"active":      "true",
+	})
+	session.MakeRequest(t, req, http.StatusSeeOther)
+	hook := unittest.AssertExistsAndLoadBean(t, &webhook.Webhook{RepoID: 1, Type: webhook_module.GOOGLECHAT})
+	assert.Equal(t, webhook.ContentTypeJSON, hook.ContentType)
+
+	resp := session.MakeRequest(t, NewRequest(t, "GET", fmt.Sprintf("/user2/repo1/settings/hooks/%d", hook.ID)), http.StatusOK)
+	htmlDoc := NewHTMLParser(t, resp.Body)
+	assert.Equal(t, hook.URL, htmlDoc.GetInputValueByName("payload_url"))
+
+	req = NewRequestWithValues(t, "POST", "/user2/repo1/settings/hooks/mattermost/new", map[string]string{
+		"payload_url": "https://mattermost.example.com/hooks/xxx",
+		"channel":     " town-square ",
+		"username":    "gitea",
+		"events":      "push_only",
+		"active":      "true",
+	})
+	session.MakeRequest(t, req, http.StatusSeeOther)
+	hook = unittest.AssertExistsAndLoadBean(t, &webhook.Webhook{RepoID: 1, Type: webhook_module.MATTERMOST})
+	assert.JSONEq(t, `{"channel":"town-square","username":"gitea","icon_url":""}`, hook.Meta)
+
+	resp = session.MakeRequest(t, NewRequest(t, "GET", fmt.Sprintf("/user2/repo1/settings/hooks/%d", hook.ID)), http.StatusOK)
+	htmlDoc = NewHTMLParser(t, resp.Body)
+	assert.Equal(t, "town-square", htmlDoc.GetInputValueByName("channel"))
+	assert.Equal(t, "gitea", htmlDoc.GetInputValueByName("username"))
+
+	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteRepository)
+	req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("/api/v1/repos/user2/repo1/hooks/%d", hook.ID), api.EditHookOption{
+		Config: map[string]string{"icon_url": "https://example.com/gitea.png"},
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	apiHook := DecodeJSON(t, resp, &api.Hook{})
+	assert.Equal(t, "town-square", apiHook.Config["channel"])
+	assert.Equal(t, "gitea", apiHook.Config["username"])
+	assert.Equal(t, "https://example.com/gitea.png", apiHook.Config["icon_url"])
+}
+
 func testAPICreateWebhookForRepo(t *testing.T, session *TestSession, userName, repoName, url, event string, branchFilter ...string) {
 	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeAll)
 	var branchFilterString string
@@ -900,6 +1016,49 @@ func Test_WebhookPackage(t *testing.T) {
 	})
 }
 
+func Test_WebhookProjectColumn(t *testing.T) {
+	onGiteaRun(t, func(t *testing.T, giteaURL *url.URL) {
+		var payloads []api.ProjectColumnPayload
+		var triggeredEvent string
+		provider := newMockWebhookProvider(func(r *http.Request) {
+			assert.Contains(t, r.Header["X-Gitea-Event-Type"], "project_column", "X-Gitea-Event-Type should contain project_column")
+			content, _ := io.ReadAll(r.Body)
+			var payload api.ProjectColumnPayload
+			err := json.Unmarshal(content, &payload)
+			assert.NoError(t, err)
+			payloads = append(payloads, payload)
+			triggeredEvent = "project_column"
+		}, http.StatusOK)
+		defer provider.Close()
+
+		// 1. create a new webhook with special webhook for repo1
+		session := loginUser(t, "user2")
+
+		testAPICreateWebhookForRepo(t, session, "user2", "repo1", provider.URL(), "project_column")
+
+		// 2. trigger the webhook
+		token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteIssue)
+		req := NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/projects/1/columns", &api.CreateProjectColumnOption{Title: "Review"}).AddTokenAuth(token)
+		column := DecodeJSON(t, MakeRequest(t, req, http.StatusCreated), &api.ProjectColumn{})
+		req = NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/projects/1/items", &api.AddProjectItemOption{IssueID: 1, ColumnID: column.ID}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusCreated)
+
+		// 3. validate the webhook is triggered
+		assert.Equal(t, "project_column", triggeredEvent)
+		require.Len(t, payloads, 2)
+		assert.Equal(t, api.HookProjectColumnCreated, payloads[0].Action)
+		assert.Equal(t, "Review", payloads[0].Column.Title)
+		assert.EqualValues(t, 1, payloads[0].Project.ID)
+		assert.Equal(t, "user2/repo1", payloads[0].Repository.FullName)
+		assert.Equal(t, "user2", payloads[0].Sender.UserName)
+
+		assert.Equal(t, api.HookProjectColumnItemMoved, payloads[1].Action)
+		assert.Equal(t, column.ID, payloads[1].Column.ID)
+		assert.EqualValues(t, 1, payloads[1].FromColumn.ID)
+		assert.EqualValues(t, 1, payloads[1].Issue.ID)
+	})
+}
+
 func Test_WebhookStatus(t *testing.T) {
 	onGiteaRun(t, func(t *testing.T, giteaURL *url.URL) {
 		var payloads []api.CommitStatusPayload
@@ -1849,3 +2008,161 @@ jobs:
 		assert.Equal(t, "user2/repo1", webhookData.payloads[i].Repo.FullName)
 	}
 }
+
+func TestWebhookPayloadTemplateSettings(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	session := loginUser(t, "user2")
+
+	resp := session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/settings/hooks/gitea/new"), http.StatusOK)
+	htmlDoc := NewHTMLParser(t, resp.Body)
+	assert.Equal(t, 1, htmlDoc.Find(`#content_type ~ .menu .item[data-value="3"]`).Length())
+	assert.Equal(t, 1, htmlDoc.Find("textarea[name=payload_template]").Length())
+
+	req := NewRequestWithValues(t, "POST", "/user2/repo1/settings/hooks/template_preview", map[string]string{
+		"payload_template": `{"text": {{printf "%s opened %s" .Sender.UserName .Issue.Title | json}}}`,
+		"preview_event":    "issues",
+	})
+	resp = session.MakeRequest(t, req, http.StatusOK)
+	preview := DecodeJSON(t, resp, &struct {
+		Payload string `json:"payload"`
+	}{})
+	assert.JSONEq(t, `{"text": "octocat opened The example is broken"}`, preview.Payload)
+
+	req = NewRequestWithValues(t, "POST", "/user2/repo1/settings/hooks/template_preview", map[string]string{
+		"payload_template": `{"text": {{.Issue.Title}}}`,
+		"preview_event":    "issues",
+	})
+	resp = session.MakeRequest(t, req, http.StatusBadRequest)
+	assert.Contains(t, resp.Body.String(), "valid JSON")
+
+	// an invalid template is rejected
+	req = NewRequestWithValues(t, "POST", "/user2/repo1/settings/hooks/gitea/new", map[string]string{
+		"payload_url":      "http://localhost/template",
+		"http_method":      "POST",
+		"content_type":     "3",
+		"payload_template": `{{define "x"}}{{end}}`,
+		"events":           "push_only",
+		"active":           "true",
+	})
+	session.MakeRequest(t, req, http.StatusOK)
+	unittest.AssertNotExistsBean(t, &webhook.Webhook{RepoID: 1, URL: "http://localhost/template"})
+
+	req = NewRequestWithValues(t, "POST", "/user2/repo1/settings/hooks/gitea/new", map[string]string{
+		"payload_url":      "http://localhost/template",
+		"http_method":      "POST",
+		"content_type":     "3",
+		"payload_template": `{"ref": {{json .Ref}}}`,
+		"events":           "push_only",
+		"active":           "true",
+	})
+	session.MakeRequest(t, req, http.StatusSeeOther)
+	hook := unittest.AssertExistsAndLoadBean(t, &webhook.Webhook{RepoID: 1, URL: "http://localhost/template"})
+	assert.Equal(t, webhook.ContentTypeTemplate, hook.ContentType)
+	assert.Equal(t, `{"ref": {{json .Ref}}}`, hook.PayloadTemplate)
+
+	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteRepository)
+	req = NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/hooks", api.CreateHookOption{
+		Type: webhook_module.GITEA,
+		Config: api.CreateHookOptionConfig{
+			"content_type": "template",
+			"url":          "http://localhost/api-template",
+		},
+		Active: true,
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	req = NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/hooks", api.CreateHookOption{
+		Type: webhook_module.GITEA,
+		Config: api.CreateHookOptionConfig{
+			"content_type":     "template",
+			"url":              "http://localhost/api-template",
+			"payload_template": `{"event": {{json eventType}}}`,
+		},
+		Active: true,
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusCreated)
+	apiHook := DecodeJSON(t, resp, &api.Hook{})
+	assert.Equal(t, "template", apiHook.Config["content_type"])
+	assert.Equal(t, `{"event": {{json eventType}}}`, apiHook.Config["payload_template"])
+
+	req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("/api/v1/repos/user2/repo1/hooks/%d", apiHook.ID), api.EditHookOption{
+		Config: map[string]string{"content_type": "cloudevents_binary"},
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	apiHook = DecodeJSON(t, resp, &api.Hook{})
+	assert.Equal(t, "cloudevents_binary", apiHook.Config["content_type"])
+	assert.Empty(t, apiHook.Config["payload_template"])
+}
+
+func TestWebhookFilterSettings(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+	session := loginUser(t, "user2")
+
+	req := NewRequestWithValues(t, "POST", "/user2/repo1/settings/hooks/gitea/new", map[string]string{
+		"payload_url":     "http://localhost/filters",
+		"http_method":     "POST",
+		"content_type":    "1",
+		"events":          "send_everything",
+		"active":          "true",
+		"path_filter":     "docs/**",
+		"label_filter":    "bug, security",
+		"action_filter":   "Opened,closed",
+		"exclude_senders": "renovate-bot,org3/team1",
+	})
+	session.MakeRequest(t, req, http.StatusSeeOther)
+	hook := unittest.AssertExistsAndLoadBean(t, &webhook.Webhook{RepoID: 1, URL: "http://localhost/filters"})
+	assert.Equal(t, "docs/**", hook.PathFilter)
+	assert.Equal(t, []string{"bug", "security"}, hook.LabelFilter)
+	assert.Equal(t, []string{"opened", "closed"}, hook.ActionFilter)
+	assert.Empty(t, hook.IncludeSenders)
+	assert.Equal(t, []string{"renovate-bot", "org3/team1"}, hook.ExcludeSenders)
+
+	resp := session.MakeRequest(t, NewRequest(t, "GET", fmt.Sprintf("/user2/repo1/settings/hooks/%d", hook.ID)), http.StatusOK)
+	htmlDoc := NewHTMLParser(t, resp.Body)
+	assert.Equal(t, "bug,security", htmlDoc.GetInputValueByName("label_filter"))
+	assert.Equal(t, "renovate-bot,org3/team1", htmlDoc.GetInputValueByName("exclude_senders"))
+
+	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteRepository)
+	req = NewRequestWithJSON(t, "GET", fmt.Sprintf("/api/v1/repos/user2/repo1/hooks/%d", hook.ID), nil).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	apiHook := DecodeJSON(t, resp, &api.Hook{})
+	assert.Equal(t, "docs/**", apiHook.PathFilter)
+	assert.Equal(t, []string{"opened", "closed"}, apiHook.ActionFilter)
+
+	// the filters which aren't in the options are kept
+	pathFilter := "**.go"
+	req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("/api/v1/repos/user2/repo1/hooks/%d", hook.ID), api.EditHookOption{
+		PathFilter:     &pathFilter,
+		IncludeSenders: []string{"user2"},
+		LabelFilter:    []string{},
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	apiHook = DecodeJSON(t, resp, &api.Hook{})
+	assert.Equal(t, "**.go", apiHook.PathFilter)
+	assert.Empty(t, apiHook.LabelFilter)
+	assert.Equal(t, []string{"opened", "closed"}, apiHook.ActionFilter)
+	assert.Equal(t, []string{"user2"}, apiHook.IncludeSenders)
+
+	pathFilter = "{docs"
+	req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("/api/v1/repos/user2/repo1/hooks/%d", hook.ID), api.EditHookOption{
+		PathFilter: &pathFilter,
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	req = NewRequestWithJSON(t, "POST", "/api/v1/repos/user2/repo1/hooks", api.CreateHookOption{
+		Type: webhook_module.GITEA,
+		Config: api.CreateHookOptionConfig{
+			"content_type": "json",
+			"url":          "http://localhost/api-filters",
+		},
+		HookFilters: api.HookFilters{
+			ActionFilter:   []string{"opened"},
+			ExcludeSenders: []string{"renovate-bot"},
+		},
+		Active: true,
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusCreated)
+	apiHook = DecodeJSON(t, resp, &api.Hook{})
+	assert.Equal(t, []string{"opened"}, apiHook.ActionFilter)
+	assert.Equal(t, []string{"renovate-bot"}, apiHook.ExcludeSenders)
+}
@@ -111,6 +111,12 @@ func PrepareCleanPackageData(t testing.TB) {
 		&packages_model.PackageProperty{},
 		&packages_model.PackageBlobUpload{},
 		&packages_model.PackageCleanupRule{},
+		&packages_model.PackageProtectionRule{},
+		&packages_model.PackageDownloadStat{},
+		&packages_model.PackageAuditEvent{},
+		&packages_model.PackageVulnerability{},
+		&packages_model.PackageSnapshot{},
+		&packages_model.PackageSnapshotFile{},
 	))
 	assert.NoError(t, storage.Clean(storage.Packages))
 }
@@ -183,6 +183,7 @@ function reducedSourcemapPlugin(): Plugin {
     'js/external-render-frontend.',
     'js/external-render-helper.',
     'js/eventsource.sharedworker.',
+    'js/webpush.serviceworker.',
   ];
   return {
     name: 'reduced-sourcemap',
@@ -271,6 +272,7 @@ export default defineConfig(commonViteOpts({
         swagger: join(import.meta.dirname, 'web_src/js/swagger.ts'),
         'external-render-frontend': join(import.meta.dirname, 'web_src/js/external-render-frontend.ts'),
         'eventsource.sharedworker': join(import.meta.dirname, 'web_src/js/eventsource.sharedworker.ts'),
+        'webpush.serviceworker': join(import.meta.dirname, 'web_src/js/webpush.serviceworker.ts'),
         devtest: join(import.meta.dirname, 'web_src/css/devtest.css'),
         ...themes,
       },
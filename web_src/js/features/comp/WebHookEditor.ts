@@ -24,15 +24,37 @@ export function initCompWebHookEditor() {
 
   // some webhooks (like Gitea) allow to set the request method (GET/POST), and it would toggle the "Content Type" field
   const httpMethodInput = document.querySelector<HTMLInputElement>('#http_method');
+  const contentTypeInput = document.querySelector<HTMLInputElement>('#content_type');
   if (httpMethodInput) {
     const updateContentType = function () {
       const visible = httpMethodInput.value === 'POST';
-      toggleElem(document.querySelector('#content_type')!.closest('.field')!, visible);
+      toggleElem(contentTypeInput!.closest('.field')!, visible);
+      // the "custom template" content type renders the payload with the payload template
+      toggleElem('.payload-template', visible && contentTypeInput!.value === '3');
     };
     updateContentType();
     httpMethodInput.addEventListener('change', updateContentType);
+    contentTypeInput!.addEventListener('change', updateContentType);
   }
 
+  // Preview the payload template with a sample payload
+  document.querySelector<HTMLButtonElement>('#payload_template_preview')?.addEventListener('click', async function () {
+    const result = document.querySelector<HTMLElement>('#payload_template_preview_result')!;
+    const data = new FormData();
+    data.append('payload_template', document.querySelector<HTMLTextAreaElement>('#payload_template')!.value);
+    data.append('preview_event', document.querySelector<HTMLInputElement>('#payload_template_preview_event')!.value);
+    this.classList.add('is-loading', 'disabled');
+    try {
+      const resp = await POST(this.getAttribute('data-link')!, {data});
+      const json = await resp.json();
+      result.textContent = resp.ok ? json.payload : json.errorMessage;
+      result.classList.toggle('tw-text-red', !resp.ok);
+      showElem(result);
+    } finally {
+      this.classList.remove('is-loading', 'disabled');
+    }
+  });
+
   // Test delivery
   document.querySelector<HTMLButtonElement>('#test-delivery')?.addEventListener('click', async function () {
     this.classList.add('is-loading', 'disabled');
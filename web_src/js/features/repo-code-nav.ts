@@ -0,0 +1,148 @@
+import {GET} from '../modules/fetch.ts';
+import {createTippy, getAttachedTippyInstance} from '../modules/tippy.ts';
+import {html, htmlRaw} from '../utils/html.ts';
+import {addDelegatedEventListener, createElementFromHTML, isPlainClick} from '../utils/dom.ts';
+
+type CodeNavLocation = {
+  path: string,
+  line: number,
+  column: number,
+  link: string,
+};
+
+type CodeNavResult = {
+  precise: boolean,
+  hover: string,
+  definitions: Array<CodeNavLocation>,
+  references: Array<CodeNavLocation>,
+  i18n: {
+    definitions: string,
+    references: string,
+  },
+};
+
+type CodeNavTarget = {
+  anchor: Element,
+  path: string,
+  line: number,
+  column: number,
+  name: string,
+};
+
+const identifierChar = /[\p{L}\p{N}_]/u;
+const identifierRegexp = /^[\p{L}_][\p{L}\p{N}_]*$/u;
+
+function caretAt(e: MouseEvent): {node: Node, offset: number} | null {
+  if (document.caretPositionFromPoint) {
+    const pos = document.caretPositionFromPoint(e.clientX, e.clientY);
+    return pos ? {node: pos.offsetNode, offset: pos.offset} : null;
+  }
+  const range = document.caretRangeFromPoint?.(e.clientX, e.clientY);
+  return range ? {node: range.startContainer, offset: range.startOffset} : null;
+}
+
+// the column is counted in UTF-16 code units, like JavaScript string lengths and LSIF positions
+function textOffsetInLine(codeInner: Element, target: Node): number {
+  let offset = 0;
+  const walker = document.createTreeWalker(codeInner, NodeFilter.SHOW_TEXT);
+  for (let node = walker.nextNode(); node; node = walker.nextNode()) {
+    if (node === target) return offset;
+    offset += node.textContent!.length;
+  }
+  return -1;
+}
+
+function findLineAndPath(codeInner: Element): {line: number, path: string} | null {
+  const tr = codeInner.closest('tr');
+  if (!tr) return null;
+
+  const fileView = codeInner.closest('.file-view[data-code-nav-path]');
+  if (fileView) {
+    const lineNum = tr.querySelector('td.lines-num span[data-line-number]');
+    if (!lineNum) return null;
+    return {line: parseInt(lineNum.getAttribute('data-line-number')!), path: fileView.getAttribute('data-code-nav-path')!};
+  }
+
+  // in diffs, only the lines of the new file can be looked up in the index of the head commit
+  if (codeInner.closest('td.lines-code-old, td.blob-hunk')) return null;
+  const fileBox = codeInner.closest('.diff-file-box[data-new-filename]');
+  const lineNum = tr.querySelector('td.lines-num-new[data-line-num]')?.getAttribute('data-line-num');
+  if (!fileBox || !lineNum) return null;
+  return {line: parseInt(lineNum), path: fileBox.getAttribute('data-new-filename')!};
+}
+
+function findTarget(codeInner: Element, e: MouseEvent): CodeNavTarget | null {
+  const caret = caretAt(e);
+  if (!caret || caret.node.nodeType !== Node.TEXT_NODE || !codeInner.contains(caret.node)) return null;
+
+  const text = caret.node.textContent!;
+  let start = caret.offset;
+  let end = caret.offset;
+  while (start > 0 && identifierChar.test(text[start - 1])) start--;
+  while (end < text.length && identifierChar.test(text[end])) end++;
+  const name = text.substring(start, end);
+  if (!identifierRegexp.test(name)) return null;
+
+  const lineAndPath = findLineAndPath(codeInner);
+  if (!lineAndPath) return null;
+
+  return {
+    anchor: caret.node.parentElement!,
+    name,
+    column: textOffsetInLine(codeInner, caret.node) + start,
+    ...lineAndPath,
+  };
+}
+
+function renderLocations(title: string, locations: Array<CodeNavLocation>): string {
+  if (!locations.length) return '';
+  const items = locations.map((l) => html`<a class="item" href="${l.link}">${l.path}:${l.line}</a>`);
+  return html`
+    <div class="code-nav-section">
+      <div class="code-nav-title">${title}</div>
+      <div class="code-nav-locations">${htmlRaw(items.join(''))}</div>
+    </div>`;
+}
+
+async function showCodeNavPopup(link: string, target: CodeNavTarget) {
+  const params = new URLSearchParams({
+    path: target.path,
+    line: String(target.line),
+    column: String(target.column),
+    name: target.name,
+  });
+  const resp = await GET(`${link}?${params.toString()}`);
+  if (!resp.ok) return;
+  const data: CodeNavResult = await resp.json();
+  if (!data.hover && !data.definitions.length && !data.references.length) return;
+
+  const content = createElementFromHTML(html`
+    <div class="code-nav-popup">
+      ${data.hover ? htmlRaw(html`<pre class="code-nav-hover">${data.hover}</pre>`) : ''}
+      ${htmlRaw(renderLocations(data.i18n.definitions, data.definitions))}
+      ${htmlRaw(renderLocations(data.i18n.references, data.references))}
+    </div>`);
+
+  getAttachedTippyInstance(target.anchor)?.destroy();
+  createTippy(target.anchor, {
+    theme: 'default',
+    content,
+    trigger: 'manual',
+    placement: 'bottom-start',
+    interactive: true,
+    hideOnClick: true,
+    role: 'dialog',
+    onHidden: (instance) => instance.destroy(),
+  }).show();
+}
+
+export function initRepoCodeNavigation() {
+  addDelegatedEventListener<HTMLElement, MouseEvent>(document, 'click', '[data-code-nav-link] .code-inner', (codeInner, e) => {
+    // do not interfere with selecting text or clicking links
+    if (!isPlainClick(e) || !window.getSelection()!.isCollapsed || (e.target as Element).closest('a, button')) return;
+    const target = findTarget(codeInner, e);
+    if (!target) return;
+    const link = codeInner.closest('[data-code-nav-link]')!.getAttribute('data-code-nav-link')!;
+    showCodeNavPopup(link, target);
+  });
+}
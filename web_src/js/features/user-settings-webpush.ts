@@ -0,0 +1,56 @@
+import {decodeURLEncodedBase64} from '../utils.ts';
+import {showElem} from '../utils/dom.ts';
+import {POST} from '../modules/fetch.ts';
+import {showErrorToast} from '../modules/toast.ts';
+
+export async function initUserSettingsWebPush() {
+  const elSettings = document.querySelector<HTMLElement>('#web-push-settings');
+  if (!elSettings) return;
+
+  const elUnsupported = elSettings.querySelector('.web-push-unsupported')!;
+  const btnSubscribe = elSettings.querySelector('.web-push-subscribe')!;
+  const btnUnsubscribe = elSettings.querySelector('.web-push-unsubscribe')!;
+  if (!window.isSecureContext || !('serviceWorker' in navigator) || !('PushManager' in window)) {
+    showElem(elUnsupported);
+    return;
+  }
+
+  const {serviceWorkerUrl, vapidPublicKey, subscribeUrl, unsubscribeUrl} = elSettings.dataset;
+  const registration = await navigator.serviceWorker.register(serviceWorkerUrl!);
+  const subscription = await registration.pushManager.getSubscription();
+  showElem(subscription ? btnUnsubscribe : btnSubscribe);
+
+  btnSubscribe.addEventListener('click', async () => {
+    if (await Notification.requestPermission() !== 'granted') {
+      showErrorToast(elSettings.getAttribute('data-text-permission-denied')!);
+      return;
+    }
+    const sub = await registration.pushManager.subscribe({
+      userVisibleOnly: true,
+      applicationServerKey: decodeURLEncodedBase64(vapidPublicKey!),
+    });
+    const {keys} = sub.toJSON();
+    const data = new FormData();
+    data.append('endpoint', sub.endpoint);
+    data.append('p256dh', keys!.p256dh);
+    data.append('auth', keys!.auth);
+    const resp = await POST(subscribeUrl!, {data});
+    if (!resp.ok) {
+      await sub.unsubscribe();
+      showErrorToast(elSettings.getAttribute('data-text-subscribe-failed')!);
+      return;
+    }
+    window.location.reload();
+  });
+
+  btnUnsubscribe.addEventListener('click', async () => {
+    const sub = await registration.pushManager.getSubscription();
+    if (sub) {
+      const data = new FormData();
+      data.append('endpoint', sub.endpoint);
+      await POST(unsubscribeUrl!, {data});
+      await sub.unsubscribe();
+    }
+    window.location.reload();
+  });
+}
@@ -25,6 +25,7 @@ import {initRepoEllipsisButton, initCommitStatuses, initAvatarStackPopup, initCo
 import {initRepoTopicBar} from './features/repo-home.ts';
 import {initAdminCommon} from './features/admin/common.ts';
 import {initRepoCodeView} from './features/repo-code.ts';
+import {initRepoCodeNavigation} from './features/repo-code-nav.ts';
 import {initSshKeyFormParser} from './features/sshkey-helper.ts';
 import {initUserSettings} from './features/user-settings.ts';
 import {initRepoActivityTopAuthorsChart, initRepoArchiveLinks} from './features/repo-common.ts';
@@ -56,6 +57,7 @@ import {initRepositorySearch} from './features/repo-search.ts';
 import {initColorPickers} from './features/colorpicker.ts';
 import {initAdminSelfCheck} from './features/admin/selfcheck.ts';
 import {initOAuth2SettingsDisableCheckbox} from './features/oauth2-settings.ts';
+import {initUserSettingsWebPush} from './features/user-settings-webpush.ts';
 import {initGlobalFetchAction} from './features/common-fetch-action.ts';
 import {initCommmPageComponents, initGlobalComponent, initGlobalDropdown, initGlobalInput} from './features/common-page.ts';
 import {initGlobalButtonClickOnEnter, initGlobalButtons} from './features/common-button.ts';
@@ -120,6 +122,7 @@ const initPerformanceTracer = callInitFunctions([
   initRepoArchiveLinks,
   initRepoBranchButton,
   initRepoCodeView,
+  initRepoCodeNavigation,
   initBranchSelectorTabs,
   initRepoEllipsisButton,
   initCommitFileHistoryFollowRename,
@@ -153,6 +156,7 @@ const initPerformanceTracer = callInitFunctions([
   initUserAuthWebAuthn,
   initUserAuthWebAuthnRegister,
   initUserSettings,
+  initUserSettingsWebPush,
   initRepoDiffView,
   initColorPickers,
 
@@ -0,0 +1,34 @@
+// Service worker of the browser push notifications, it shows the notifications pushed by the server
+// even if no Gitea page is open. The payload is the JSON of services/uinotification personalNotification.
+type PushPayload = {
+  title: string,
+  body: string,
+  url: string,
+};
+
+const sw = self as unknown as ServiceWorkerGlobalScope;
+
+sw.addEventListener('push', (event: PushEvent) => {
+  if (!event.data) return;
+  const payload: PushPayload = event.data.json();
+  event.waitUntil(sw.registration.showNotification(payload.title, {
+    body: payload.body,
+    data: {url: payload.url},
+    tag: payload.url, // replace the previous notification of the same issue or comment
+  }));
+});
+
+sw.addEventListener('notificationclick', (event: NotificationEvent) => {
+  event.notification.close();
+  const url = event.notification.data?.url;
+  if (!url) return;
+  event.waitUntil((async () => {
+    const windows = await sw.clients.matchAll({type: 'window', includeUncontrolled: true});
+    const existing = windows.find((client) => client.url === url);
+    if (existing) {
+      await existing.focus();
+      return;
+    }
+    await sw.clients.openWindow(url);
+  })());
+});